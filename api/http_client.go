@@ -16,6 +16,23 @@ type tokenGetter interface {
 	ActiveToken(string) (string, string)
 }
 
+// Middleware decorates an http.RoundTripper with additional behavior, such as
+// adding headers, logging, caching, or retrying requests. The transport
+// helpers in this package — AuthTokenMiddleware, CacheTTLMiddleware,
+// ExtractHeader, and (*Limiter).LimitRequests — all fit this shape and compose
+// with any externally registered middleware.
+type Middleware func(http.RoundTripper) http.RoundTripper
+
+// WrapTransport applies middlewares to rt in order. Because each middleware
+// wraps the previous transport, the last middleware in the list sees a request
+// first.
+func WrapTransport(rt http.RoundTripper, middlewares ...Middleware) http.RoundTripper {
+	for _, middleware := range middlewares {
+		rt = middleware(rt)
+	}
+	return rt
+}
+
 type HTTPClientOptions struct {
 	AppVersion     string
 	CacheTTL       time.Duration
@@ -24,6 +41,11 @@ type HTTPClientOptions struct {
 	Log            io.Writer
 	LogColorize    bool
 	LogVerboseHTTP bool
+
+	// Middlewares wrap the fully constructed transport, so they observe each
+	// request before the built-in concerns such as authentication and caching
+	// act on it.
+	Middlewares []Middleware
 }
 
 func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
@@ -61,10 +83,16 @@ func NewHTTPClient(opts HTTPClientOptions) (*http.Client, error) {
 		return nil, err
 	}
 
+	if (opts.LogVerboseHTTP || debugEnabled) && opts.Log != nil {
+		client.Transport = LogGraphQLCost(client.Transport, opts.Log)
+	}
+
 	if opts.Config != nil {
 		client.Transport = AddAuthTokenHeader(client.Transport, opts.Config)
 	}
 
+	client.Transport = WrapTransport(client.Transport, opts.Middlewares...)
+
 	return client, nil
 }
 
@@ -74,6 +102,22 @@ func NewCachedHTTPClient(httpClient *http.Client, ttl time.Duration) *http.Clien
 	return &newClient
 }
 
+// CacheTTLMiddleware returns a Middleware that asks the caching layer to keep
+// responses for the given duration.
+func CacheTTLMiddleware(ttl time.Duration) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return AddCacheTTLHeader(rt, ttl)
+	}
+}
+
+// AuthTokenMiddleware returns a Middleware that resolves and attaches an
+// authentication token for the host targeted by each request.
+func AuthTokenMiddleware(cfg tokenGetter) Middleware {
+	return func(rt http.RoundTripper) http.RoundTripper {
+		return AddAuthTokenHeader(rt, cfg)
+	}
+}
+
 // AddCacheTTLHeader adds an header to the request telling the cache that the request
 // should be cached for a specified amount of time.
 func AddCacheTTLHeader(rt http.RoundTripper, ttl time.Duration) http.RoundTripper {
@@ -110,7 +154,7 @@ func AddAuthTokenHeader(rt http.RoundTripper, cfg tokenGetter) http.RoundTripper
 
 // ExtractHeader extracts a named header from any response received by this client and,
 // if non-blank, saves it to dest.
-func ExtractHeader(name string, dest *string) func(http.RoundTripper) http.RoundTripper {
+func ExtractHeader(name string, dest *string) Middleware {
 	return func(tr http.RoundTripper) http.RoundTripper {
 		return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
 			res, err := tr.RoundTrip(req)
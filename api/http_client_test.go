@@ -157,6 +157,37 @@ func TestNewHTTPClient(t *testing.T) {
 	}
 }
 
+func TestNewHTTPClientMiddlewares(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer ts.Close()
+
+	var order []string
+	recorder := func(name string) Middleware {
+		return func(rt http.RoundTripper) http.RoundTripper {
+			return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return rt.RoundTrip(req)
+			}}
+		}
+	}
+
+	client, err := NewHTTPClient(HTTPClientOptions{
+		AppVersion:  "v1.2.3",
+		Middlewares: []Middleware{recorder("first"), recorder("second")},
+	})
+	require.NoError(t, err)
+
+	res, err := client.Get(ts.URL)
+	require.NoError(t, err)
+	assert.Equal(t, 204, res.StatusCode)
+
+	// The last registered middleware wraps the whole transport and therefore
+	// sees the request first.
+	assert.Equal(t, []string{"second", "first"}, order)
+}
+
 func TestHTTPClientRedirectAuthenticationHeaderHandling(t *testing.T) {
 	var request *http.Request
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
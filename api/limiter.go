@@ -0,0 +1,146 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultHostConcurrency is the cap on concurrent requests to a single host
+// enforced by the shared limiter.
+const DefaultHostConcurrency = 8
+
+// rateLimitPacingThreshold is the number of remaining requests under which the
+// limiter starts spreading requests out until the rate limit window resets.
+const rateLimitPacingThreshold = 20
+
+// Limiter coordinates how many requests gh has in flight against a single host,
+// so bulk commands spinning up worker pools share one per-host cap instead of
+// each picking its own pool size. It also paces requests down when a host's
+// rate-limit headers signal that the remaining quota is nearly exhausted.
+type Limiter struct {
+	concurrency int
+
+	mu    sync.Mutex
+	hosts map[string]*hostLimiter
+
+	now   func() time.Time
+	sleep func(time.Duration)
+}
+
+type hostLimiter struct {
+	slots chan struct{}
+
+	mu         sync.Mutex
+	pauseUntil time.Time
+}
+
+// NewLimiter returns a Limiter allowing up to concurrency in-flight requests
+// per host.
+func NewLimiter(concurrency int) *Limiter {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	return &Limiter{
+		concurrency: concurrency,
+		hosts:       map[string]*hostLimiter{},
+		now:         time.Now,
+		sleep:       time.Sleep,
+	}
+}
+
+var sharedLimiter = NewLimiter(DefaultHostConcurrency)
+
+// SharedLimiter returns the process-wide limiter that bulk operations register
+// their requests with.
+func SharedLimiter() *Limiter {
+	return sharedLimiter
+}
+
+func (l *Limiter) forHost(host string) *hostLimiter {
+	key := strings.ToLower(host)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	h := l.hosts[key]
+	if h == nil {
+		h = &hostLimiter{slots: make(chan struct{}, l.concurrency)}
+		l.hosts[key] = h
+	}
+	return h
+}
+
+// Acquire blocks until the host has a free request slot and any rate-limit
+// pause has elapsed, and returns the function that releases the slot.
+func (l *Limiter) Acquire(host string) func() {
+	h := l.forHost(host)
+	h.slots <- struct{}{}
+
+	h.mu.Lock()
+	pause := h.pauseUntil
+	h.mu.Unlock()
+	if d := pause.Sub(l.now()); d > 0 {
+		l.sleep(d)
+	}
+
+	return func() {
+		<-h.slots
+	}
+}
+
+// observe adjusts pacing for a host based on the rate-limit headers of a
+// response. With the quota exhausted, requests wait for the window to reset;
+// with the quota nearly exhausted, the remaining requests are spread out over
+// the time left in the window.
+func (l *Limiter) observe(host string, resp *http.Response) {
+	remaining, err := strconv.Atoi(resp.Header.Get("X-Ratelimit-Remaining"))
+	if err != nil || remaining > rateLimitPacingThreshold {
+		return
+	}
+	reset, err := strconv.ParseInt(resp.Header.Get("X-Ratelimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+
+	now := l.now()
+	resetAt := time.Unix(reset, 0)
+	var until time.Time
+	if remaining < 1 {
+		until = resetAt
+	} else if window := resetAt.Sub(now); window > 0 {
+		until = now.Add(window / time.Duration(remaining))
+	}
+
+	h := l.forHost(host)
+	h.mu.Lock()
+	if until.After(h.pauseUntil) {
+		h.pauseUntil = until
+	}
+	h.mu.Unlock()
+}
+
+// LimitRequests wraps rt so that every request first registers with the
+// limiter for its destination host.
+func (l *Limiter) LimitRequests(rt http.RoundTripper) http.RoundTripper {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		release := l.Acquire(req.URL.Hostname())
+		defer release()
+		resp, err := rt.RoundTrip(req)
+		if err == nil {
+			l.observe(req.URL.Hostname(), resp)
+		}
+		return resp, err
+	}}
+}
+
+// NewLimitedHTTPClient returns a copy of httpClient whose requests are gated
+// by the shared per-host limiter.
+func NewLimitedHTTPClient(httpClient *http.Client) *http.Client {
+	newClient := *httpClient
+	newClient.Transport = sharedLimiter.LimitRequests(httpClient.Transport)
+	return &newClient
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLimiterCapsConcurrencyPerHost(t *testing.T) {
+	limiter := NewLimiter(2)
+
+	var current, peak int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release := limiter.Acquire("github.com")
+			defer release()
+			n := atomic.AddInt32(&current, 1)
+			for {
+				p := atomic.LoadInt32(&peak)
+				if n <= p || atomic.CompareAndSwapInt32(&peak, p, n) {
+					break
+				}
+			}
+			time.Sleep(time.Millisecond)
+			atomic.AddInt32(&current, -1)
+		}()
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, peak, int32(2))
+}
+
+func TestLimiterPacesOnLowRateLimit(t *testing.T) {
+	now := time.Now()
+	var slept time.Duration
+	limiter := NewLimiter(1)
+	limiter.now = func() time.Time { return now }
+	limiter.sleep = func(d time.Duration) { slept += d }
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-Ratelimit-Remaining", "0")
+	resp.Header.Set("X-Ratelimit-Reset", fmt.Sprintf("%d", now.Add(30*time.Second).Unix()))
+	limiter.observe("github.com", resp)
+
+	release := limiter.Acquire("github.com")
+	release()
+
+	assert.GreaterOrEqual(t, slept, 29*time.Second)
+
+	// other hosts are not affected
+	slept = 0
+	release = limiter.Acquire("ghe.example.com")
+	release()
+	assert.Zero(t, slept)
+}
+
+func TestLimiterIgnoresHealthyRateLimit(t *testing.T) {
+	now := time.Now()
+	var slept time.Duration
+	limiter := NewLimiter(1)
+	limiter.now = func() time.Time { return now }
+	limiter.sleep = func(d time.Duration) { slept += d }
+
+	resp := &http.Response{Header: http.Header{}}
+	resp.Header.Set("X-Ratelimit-Remaining", "4999")
+	resp.Header.Set("X-Ratelimit-Reset", fmt.Sprintf("%d", now.Add(time.Hour).Unix()))
+	limiter.observe("github.com", resp)
+
+	release := limiter.Acquire("github.com")
+	release()
+
+	assert.Zero(t, slept)
+}
+
+func TestLimitRequests(t *testing.T) {
+	limiter := NewLimiter(1)
+	rt := limiter.LimitRequests(&funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 200, Header: http.Header{}, Request: req}, nil
+	}})
+
+	req, err := http.NewRequest("GET", "https://api.github.com/repos/OWNER/REPO", nil)
+	require.NoError(t, err)
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	assert.Equal(t, 200, resp.StatusCode)
+}
@@ -103,6 +103,48 @@ func CommentUpdate(client *Client, repoHost string, params CommentUpdateInput) (
 	return mutation.UpdateIssueComment.IssueComment.URL, nil
 }
 
+type CommentMinimizeInput struct {
+	CommentId  string
+	Classifier string
+}
+
+func CommentMinimize(client *Client, repoHost string, params CommentMinimizeInput) error {
+	var mutation struct {
+		MinimizeComment struct {
+			MinimizedComment struct {
+				IsMinimized bool
+			}
+		} `graphql:"minimizeComment(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.MinimizeCommentInput{
+			SubjectID:  githubv4.ID(params.CommentId),
+			Classifier: githubv4.ReportedContentClassifiers(params.Classifier),
+		},
+	}
+
+	return client.Mutate(repoHost, "CommentMinimize", &mutation, variables)
+}
+
+func CommentUnminimize(client *Client, repoHost string, commentId string) error {
+	var mutation struct {
+		UnminimizeComment struct {
+			UnminimizedComment struct {
+				IsMinimized bool
+			}
+		} `graphql:"unminimizeComment(input: $input)"`
+	}
+
+	variables := map[string]interface{}{
+		"input": githubv4.UnminimizeCommentInput{
+			SubjectID: githubv4.ID(commentId),
+		},
+	}
+
+	return client.Mutate(repoHost, "CommentUnminimize", &mutation, variables)
+}
+
 func CommentDelete(client *Client, repoHost string, params CommentDeleteInput) error {
 	var mutation struct {
 		DeleteIssueComment struct {
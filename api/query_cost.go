@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// GraphQLRateBudget is the number of points a token may spend against the
+// GraphQL API per hour.
+const GraphQLRateBudget = 5000
+
+// graphqlCostWarningFraction controls when a query is worth warning about: a
+// single query estimated to consume at least 1/graphqlCostWarningFraction of
+// the hourly budget.
+const graphqlCostWarningFraction = 10
+
+var connectionArgRE = regexp.MustCompile(`\b(?:first|last)\s*:\s*(\d+|\$[_A-Za-z][_0-9A-Za-z]*)`)
+
+var stringLiteralRE = regexp.MustCompile(`"(?:[^"\\]|\\.)*"`)
+
+// EstimateGraphQLCost approximates the rate limit cost of a GraphQL query the
+// way the server calculates it: add up the maximum number of nodes that each
+// connection in the query can return, divide the total by 100, and round up,
+// with a minimum cost of one point. Page sizes are read from inline first and
+// last arguments, or resolved from variables when an argument references one.
+func EstimateGraphQLCost(query string, variables map[string]interface{}) int {
+	var nodes int
+	multipliers := []int{1}
+	pageSize := 0
+
+	for i := 0; i < len(query); i++ {
+		switch query[i] {
+		case '#':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+		case '"':
+			for i++; i < len(query) && query[i] != '"'; i++ {
+				if query[i] == '\\' {
+					i++
+				}
+			}
+		case '(':
+			j := i + 1
+			for j < len(query) && query[j] != ')' {
+				if query[j] == '"' {
+					for j++; j < len(query) && query[j] != '"'; j++ {
+						if query[j] == '\\' {
+							j++
+						}
+					}
+				}
+				j++
+			}
+			pageSize = pageSizeFromArguments(query[i+1:j], variables)
+			i = j
+		case '{':
+			multiplier := multipliers[len(multipliers)-1]
+			if pageSize > 0 {
+				nodes += multiplier * pageSize
+				multiplier *= pageSize
+				pageSize = 0
+			}
+			multipliers = append(multipliers, multiplier)
+		case '}':
+			if len(multipliers) > 1 {
+				multipliers = multipliers[:len(multipliers)-1]
+			}
+			pageSize = 0
+		}
+	}
+
+	cost := (nodes + 99) / 100
+	if cost < 1 {
+		cost = 1
+	}
+	return cost
+}
+
+func pageSizeFromArguments(args string, variables map[string]interface{}) int {
+	m := connectionArgRE.FindStringSubmatch(stringLiteralRE.ReplaceAllString(args, `""`))
+	if m == nil {
+		return 0
+	}
+	if strings.HasPrefix(m[1], "$") {
+		return intVariable(variables[m[1][1:]])
+	}
+	n, _ := strconv.Atoi(m[1])
+	return n
+}
+
+func intVariable(v interface{}) int {
+	switch v := v.(type) {
+	case int:
+		return v
+	case int64:
+		return int(v)
+	case float64:
+		return int(v)
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return int(n)
+		}
+	case string:
+		if n, err := strconv.Atoi(v); err == nil {
+			return n
+		}
+	}
+	return 0
+}
+
+// ActualGraphQLCost reports the query cost that the server included in the
+// extensions of a GraphQL response body, if any.
+func ActualGraphQLCost(body []byte) (int, bool) {
+	var payload struct {
+		Extensions struct {
+			Cost json.RawMessage `json:"cost"`
+		} `json:"extensions"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil || len(payload.Extensions.Cost) == 0 {
+		return 0, false
+	}
+
+	var cost int
+	if err := json.Unmarshal(payload.Extensions.Cost, &cost); err == nil {
+		return cost, true
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(payload.Extensions.Cost, &fields); err != nil {
+		return 0, false
+	}
+	for _, key := range []string{"actualQueryCost", "requestedQueryCost"} {
+		if raw, ok := fields[key]; ok {
+			if err := json.Unmarshal(raw, &cost); err == nil {
+				return cost, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// LogGraphQLCost wraps rt to report GraphQL query cost in the verbose log: the
+// estimated cost of every outgoing query, a warning when a single query is
+// expected to consume a large fraction of the hourly rate budget, and the
+// actual cost when the server reports one in response extensions.
+func LogGraphQLCost(rt http.RoundTripper, log io.Writer) http.RoundTripper {
+	return &funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		if req.Body == nil || !strings.HasSuffix(req.URL.Path, "/graphql") {
+			return rt.RoundTrip(req)
+		}
+
+		reqBody, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(reqBody))
+
+		var payload struct {
+			Query     string                 `json:"query"`
+			Variables map[string]interface{} `json:"variables"`
+		}
+		if err := json.Unmarshal(reqBody, &payload); err == nil && payload.Query != "" {
+			cost := EstimateGraphQLCost(payload.Query, payload.Variables)
+			fmt.Fprintf(log, "* Estimated GraphQL query cost: %d\n", cost)
+			if cost*graphqlCostWarningFraction >= GraphQLRateBudget {
+				fmt.Fprintf(log, "* WARNING: estimated cost is more than a tenth of the hourly GraphQL rate budget of %d points\n", GraphQLRateBudget)
+			}
+		}
+
+		resp, err := rt.RoundTrip(req)
+		if err != nil || resp.Body == nil {
+			return resp, err
+		}
+
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return resp, err
+		}
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(respBody))
+		if cost, ok := ActualGraphQLCost(respBody); ok {
+			fmt.Fprintf(log, "* Actual GraphQL query cost: %d\n", cost)
+		}
+		return resp, nil
+	}}
+}
@@ -0,0 +1,179 @@
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateGraphQLCost(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		variables map[string]interface{}
+		want      int
+	}{
+		{
+			name:  "no connections",
+			query: `query { viewer { login } }`,
+			want:  1,
+		},
+		{
+			name:  "single small connection",
+			query: `{ repository(owner: "cli", name: "cli") { pullRequests(first: 30) { nodes { title } } } }`,
+			want:  1,
+		},
+		{
+			name:  "single large connection",
+			query: `{ viewer { repositories(first: 500) { nodes { name } } } }`,
+			want:  5,
+		},
+		{
+			name: "nested connections multiply",
+			query: `{
+				repository(owner: "cli", name: "cli") {
+					issues(first: 100) {
+						nodes {
+							comments(last: 50) { nodes { body } }
+						}
+					}
+				}
+			}`,
+			want: 51,
+		},
+		{
+			name:      "page size from variable",
+			query:     `query($limit: Int!) { viewer { repositories(first: $limit) { nodes { name } } } }`,
+			variables: map[string]interface{}{"limit": 300},
+			want:      3,
+		},
+		{
+			name:      "page size from JSON-decoded variable",
+			query:     `query($limit: Int!) { viewer { repositories(first: $limit) { nodes { name } } } }`,
+			variables: map[string]interface{}{"limit": float64(300)},
+			want:      3,
+		},
+		{
+			name:  "unresolved variable is skipped",
+			query: `query($limit: Int!) { viewer { repositories(first: $limit) { nodes { name } } } }`,
+			want:  1,
+		},
+		{
+			name: "comments and strings are ignored",
+			query: `{
+				# first: 900
+				repository(owner: "first: 900", name: "cli") {
+					issues(first: 200) { nodes { title } }
+				}
+			}`,
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EstimateGraphQLCost(tt.query, tt.variables))
+		})
+	}
+}
+
+func TestActualGraphQLCost(t *testing.T) {
+	tests := []struct {
+		name   string
+		body   string
+		want   int
+		wantOK bool
+	}{
+		{
+			name:   "numeric cost",
+			body:   `{"data":{},"extensions":{"cost":12}}`,
+			want:   12,
+			wantOK: true,
+		},
+		{
+			name:   "cost object",
+			body:   `{"data":{},"extensions":{"cost":{"actualQueryCost":7,"throttleStatus":{}}}}`,
+			want:   7,
+			wantOK: true,
+		},
+		{
+			name:   "requested cost only",
+			body:   `{"data":{},"extensions":{"cost":{"requestedQueryCost":3}}}`,
+			want:   3,
+			wantOK: true,
+		},
+		{
+			name: "no extensions",
+			body: `{"data":{}}`,
+		},
+		{
+			name: "not JSON",
+			body: `hello`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ActualGraphQLCost([]byte(tt.body))
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestLogGraphQLCost(t *testing.T) {
+	stub := funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "query")
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(`{"data":{},"extensions":{"cost":{"actualQueryCost":6}}}`)),
+		}, nil
+	}}
+
+	log := &bytes.Buffer{}
+	client := &http.Client{Transport: LogGraphQLCost(stub, log)}
+
+	req, err := http.NewRequest("POST", "https://api.github.com/graphql",
+		strings.NewReader(`{"query":"{ viewer { repositories(first: 100000) { nodes { name } } } }"}`))
+	require.NoError(t, err)
+	resp, err := client.Do(req)
+	require.NoError(t, err)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), `"actualQueryCost":6`)
+
+	assert.Equal(t, joinLines(
+		"* Estimated GraphQL query cost: 1000",
+		"* WARNING: estimated cost is more than a tenth of the hourly GraphQL rate budget of 5000 points",
+		"* Actual GraphQL query cost: 6",
+	), log.String())
+}
+
+func TestLogGraphQLCostIgnoresRESTRequests(t *testing.T) {
+	stub := funcTripper{roundTrip: func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	}}
+
+	log := &bytes.Buffer{}
+	client := &http.Client{Transport: LogGraphQLCost(stub, log)}
+
+	req, err := http.NewRequest("GET", "https://api.github.com/repos/cli/cli", nil)
+	require.NoError(t, err)
+	_, err = client.Do(req)
+	require.NoError(t, err)
+
+	assert.Empty(t, log.String())
+}
+
+func joinLines(lines ...string) string {
+	return strings.Join(lines, "\n") + "\n"
+}
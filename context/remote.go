@@ -3,6 +3,7 @@ package context
 import (
 	"fmt"
 	"net/url"
+	"sort"
 	"strings"
 
 	"github.com/cli/cli/v2/git"
@@ -57,6 +58,24 @@ func (r Remotes) ResolvedRemote() (*Remote, error) {
 	return nil, fmt.Errorf("no resolved remote found")
 }
 
+// SortByPriority sorts the remotes so that names listed in priority come
+// first, in the order given, followed by the rest ranked by the default
+// scoring: upstream, then github, then origin, then any other name. The sort
+// is stable, so remotes with equal rank keep the order git reported them in.
+func (r Remotes) SortByPriority(priority []string) {
+	rank := func(name string) int {
+		for i, p := range priority {
+			if strings.EqualFold(name, p) {
+				return len(priority) - i + 3
+			}
+		}
+		return remoteNameSortScore(name)
+	}
+	sort.SliceStable(r, func(i, j int) bool {
+		return rank(r[i].Name) > rank(r[j].Name)
+	})
+}
+
 func remoteNameSortScore(name string) int {
 	switch strings.ToLower(name) {
 	case "upstream":
@@ -137,3 +137,28 @@ func Test_FilterByHosts(t *testing.T) {
 	assert.Equal(t, r1, f[0])
 	assert.Equal(t, r2, f[1])
 }
+
+func Test_SortByPriority(t *testing.T) {
+	r1 := &Remote{Remote: &git.Remote{Name: "mona"}, Repo: ghrepo.New("monalisa", "myfork")}
+	r2 := &Remote{Remote: &git.Remote{Name: "origin"}, Repo: ghrepo.New("monalisa", "octo-cat")}
+	r3 := &Remote{Remote: &git.Remote{Name: "upstream"}, Repo: ghrepo.New("hubot", "tools")}
+
+	t.Run("default ranking", func(t *testing.T) {
+		list := Remotes{r1, r2, r3}
+		list.SortByPriority(nil)
+		assert.Equal(t, Remotes{r3, r2, r1}, list)
+	})
+
+	t.Run("pinned names rank above the default ranking", func(t *testing.T) {
+		list := Remotes{r1, r2, r3}
+		list.SortByPriority([]string{"mona", "origin"})
+		assert.Equal(t, Remotes{r1, r2, r3}, list)
+	})
+
+	t.Run("stable for equal ranks", func(t *testing.T) {
+		r4 := &Remote{Remote: &git.Remote{Name: "lisa"}, Repo: ghrepo.New("lisa", "myfork")}
+		list := Remotes{r1, r4, r3}
+		list.SortByPriority(nil)
+		assert.Equal(t, Remotes{r3, r1, r4}, list)
+	})
+}
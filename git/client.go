@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"net/url"
+	"os"
 	"os/exec"
 	"path"
 	"regexp"
@@ -26,8 +27,8 @@ const MergeBaseConfig = "gh-merge-base"
 var remoteRE = regexp.MustCompile(`(.+)\s+(.+)\s+\((push|fetch)\)`)
 
 // This regexp exists to match lines of the following form:
-// 6a6872b918c601a0e730710ad8473938a7516d30\u0000title 1\u0000Body 1\u0000\n
-// 7a6872b918c601a0e730710ad8473938a7516d31\u0000title 2\u0000Body 2\u0000
+// 6a6872b918c601a0e730710ad8473938a7516d30\u0000title 1\u0000Author\u0000author@example.com\u0000Body 1\u0000\n
+// 7a6872b918c601a0e730710ad8473938a7516d31\u0000title 2\u0000Author\u0000author@example.com\u0000Body 2\u0000
 //
 // This is the format we use when collecting commit information,
 // with null bytes as separators. Using null bytes this way allows for us
@@ -43,7 +44,7 @@ var remoteRE = regexp.MustCompile(`(.+)\s+(.+)\s+\((push|fetch)\)`)
 // match as few characters as possible while still satisfying the rest of the regexp.
 // This is important because it allows us to match the first null byte after the title and body,
 // rather than the last null byte in the entire string.
-var commitLogRE = regexp.MustCompile(`(?m)^[0-9a-fA-F]{7,40}\x00.*?\x00[\S\s]*?\x00$`)
+var commitLogRE = regexp.MustCompile(`(?m)^[0-9a-fA-F]{7,40}\x00.*?\x00.*?\x00.*?\x00[\S\s]*?\x00$`)
 
 type errWithExitCode interface {
 	ExitCode() int
@@ -192,6 +193,39 @@ func (c *Client) Remotes(ctx context.Context) (RemoteSet, error) {
 	return remotes, nil
 }
 
+// ConfiguredRemotesForHost returns only the remotes whose effective fetch or
+// push URL, i.e. after any url.<base>.insteadOf rewrites, points at the given
+// host. The hostname comparison is case-insensitive and ignores an "ssh." or
+// "www." prefix on the remote URL.
+func (c *Client) ConfiguredRemotesForHost(ctx context.Context, host string) (RemoteSet, error) {
+	remotes, err := c.Remotes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	filtered := RemoteSet{}
+	for _, r := range remotes {
+		if remotePointsToHost(r, host) {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
+
+func remotePointsToHost(r *Remote, host string) bool {
+	for _, u := range []*url.URL{r.FetchURL, r.PushURL} {
+		if u == nil {
+			continue
+		}
+		hostname := strings.ToLower(u.Hostname())
+		hostname = strings.TrimPrefix(hostname, "ssh.")
+		hostname = strings.TrimPrefix(hostname, "www.")
+		if hostname == strings.ToLower(host) {
+			return true
+		}
+	}
+	return false
+}
+
 func (c *Client) UpdateRemoteURL(ctx context.Context, name, url string) error {
 	args := []string{"remote", "set-url", name, url}
 	cmd, err := c.Command(ctx, args...)
@@ -281,6 +315,40 @@ func (c *Client) Config(ctx context.Context, name string) (string, error) {
 	return firstLine(out), nil
 }
 
+// ConfigAll returns every value of a multi-valued configuration key, in the
+// order git reports them. A key that is not set yields no values and no error.
+func (c *Client) ConfigAll(ctx context.Context, name string) ([]string, error) {
+	args := []string{"config", "--get-all", name}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		var gitErr *GitError
+		if ok := errors.As(err, &gitErr); ok && gitErr.ExitCode == 1 {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(out) == 0 {
+		return nil, nil
+	}
+	return outputLines(out), nil
+}
+
+// SetLocalConfig sets the named value in the repository-local git config.
+func (c *Client) SetLocalConfig(ctx context.Context, name, value string) error {
+	args := []string{"config", "--local", name, value}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	// No output expected but check for any printed git error.
+	_, err = cmd.Output()
+	return err
+}
+
 func (c *Client) UncommittedChangeCount(ctx context.Context) (int, error) {
 	args := []string{"status", "--porcelain"}
 	cmd, err := c.Command(ctx, args...)
@@ -301,13 +369,69 @@ func (c *Client) UncommittedChangeCount(ctx context.Context) (int, error) {
 	return count, nil
 }
 
+// CommitIdentity is an author or committer override for CommitAll.
+type CommitIdentity struct {
+	Name  string
+	Email string
+}
+
+// CommitAll stages every change in the working tree and records a commit with
+// the given message. The author and committer can be overridden without
+// touching git configuration; unless both overrides are given, a missing
+// user.name or user.email is reported as a *UserConfigError before anything
+// is staged.
+func (c *Client) CommitAll(ctx context.Context, message string, author, committer *CommitIdentity, mods ...CommandModifier) error {
+	if author == nil || committer == nil {
+		for _, key := range []string{"user.name", "user.email"} {
+			if _, err := c.Config(ctx, key); err != nil {
+				var gitErr *GitError
+				if errors.As(err, &gitErr) && gitErr.ExitCode == 1 {
+					return &UserConfigError{Key: key}
+				}
+				return err
+			}
+		}
+	}
+
+	addCmd, err := c.Command(ctx, "add", "--all")
+	if err != nil {
+		return err
+	}
+	if _, err := addCmd.Output(); err != nil {
+		return err
+	}
+
+	args := []string{"commit", "--message", message}
+	if author != nil {
+		args = append(args, "--author", fmt.Sprintf("%s <%s>", author.Name, author.Email))
+	}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	if committer != nil {
+		if cmd.Env == nil {
+			cmd.Env = os.Environ()
+		}
+		cmd.Env = append(cmd.Env,
+			"GIT_COMMITTER_NAME="+committer.Name,
+			"GIT_COMMITTER_EMAIL="+committer.Email,
+		)
+	}
+	for _, mod := range mods {
+		mod(cmd)
+	}
+	_, err = cmd.Output()
+	return err
+}
+
 func (c *Client) Commits(ctx context.Context, baseRef, headRef string) ([]*Commit, error) {
 	// The formatting directive %x00 indicates that git should include the null byte as a separator.
 	// We use this because it is not a valid character to include in a commit message. Previously,
 	// commas were used here but when we Split on them, we would get incorrect results if commit titles
 	// happened to contain them.
 	// https://git-scm.com/docs/pretty-formats#Documentation/pretty-formats.txt-emx00em
-	args := []string{"-c", "log.ShowSignature=false", "log", "--pretty=format:%H%x00%s%x00%b%x00", "--cherry", fmt.Sprintf("%s...%s", baseRef, headRef)}
+	args := []string{"-c", "log.ShowSignature=false", "log", "--pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00", "--cherry", fmt.Sprintf("%s...%s", baseRef, headRef)}
 	cmd, err := c.Command(ctx, args...)
 	if err != nil {
 		return nil, err
@@ -321,21 +445,23 @@ func (c *Client) Commits(ctx context.Context, baseRef, headRef string) ([]*Commi
 	commitLogs := commitLogRE.FindAllString(string(out), -1)
 	for _, commitLog := range commitLogs {
 		//  Each line looks like this:
-		//  6a6872b918c601a0e730710ad8473938a7516d30\u0000title 1\u0000Body 1\u0000\n
+		//  6a6872b918c601a0e730710ad8473938a7516d30\u0000title 1\u0000Author\u0000author@example.com\u0000Body 1\u0000\n
 
 		//  Or with an optional body:
-		//  6a6872b918c601a0e730710ad8473938a7516d30\u0000title 1\u0000\u0000\n
+		//  6a6872b918c601a0e730710ad8473938a7516d30\u0000title 1\u0000Author\u0000author@example.com\u0000\u0000\n
 
 		//  Therefore after splitting we will have:
-		//  ["6a6872b918c601a0e730710ad8473938a7516d30", "title 1", "Body 1", ""]
+		//  ["6a6872b918c601a0e730710ad8473938a7516d30", "title 1", "Author", "author@example.com", "Body 1", ""]
 
 		//  Or with an optional body:
-		//  ["6a6872b918c601a0e730710ad8473938a7516d30", "title 1", "", ""]
+		//  ["6a6872b918c601a0e730710ad8473938a7516d30", "title 1", "Author", "author@example.com", "", ""]
 		commitLogParts := strings.Split(commitLog, "\u0000")
 		commits = append(commits, &Commit{
-			Sha:   commitLogParts[0],
-			Title: commitLogParts[1],
-			Body:  commitLogParts[2],
+			Sha:         commitLogParts[0],
+			Title:       commitLogParts[1],
+			AuthorName:  commitLogParts[2],
+			AuthorEmail: commitLogParts[3],
+			Body:        commitLogParts[4],
 		})
 	}
 
@@ -346,6 +472,38 @@ func (c *Client) Commits(ctx context.Context, baseRef, headRef string) ([]*Commi
 	return commits, nil
 }
 
+// ChangedFiles lists the paths of files changed in commits reachable from
+// headRef but not from baseRef.
+func (c *Client) ChangedFiles(ctx context.Context, baseRef, headRef string) ([]string, error) {
+	args := []string{"diff", "--name-only", fmt.Sprintf("%s...%s", baseRef, headRef)}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var files []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// ReadFileAtRef returns the contents of a file as recorded at the given ref.
+func (c *Client) ReadFileAtRef(ctx context.Context, ref, fileName string) ([]byte, error) {
+	args := []string{"show", fmt.Sprintf("%s:%s", ref, fileName)}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return nil, err
+	}
+	return cmd.Output()
+}
+
 func (c *Client) LastCommit(ctx context.Context) (*Commit, error) {
 	output, err := c.lookupCommit(ctx, "HEAD", "%H,%s")
 	if err != nil {
@@ -647,6 +805,17 @@ func (c *Client) HasLocalBranch(ctx context.Context, branch string) bool {
 	return err == nil
 }
 
+// CheckRefFormat reports whether a string is a well-formed branch name.
+func (c *Client) CheckRefFormat(ctx context.Context, name string) bool {
+	args := []string{"check-ref-format", "--branch", name}
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return false
+	}
+	_, err = cmd.Output()
+	return err == nil
+}
+
 func (c *Client) TrackingBranchNames(ctx context.Context, prefix string) []string {
 	args := []string{"branch", "-r", "--format", "%(refname:strip=3)"}
 	if prefix != "" {
@@ -774,11 +943,59 @@ func (c *Client) AddRemote(ctx context.Context, name, urlStr string, trackingBra
 
 // Below are commands that make network calls and need authentication credentials supplied from gh.
 
+// FetchOptions alters the flags that Fetch passes to git. The zero value
+// fetches with git's defaults; SingleRefFetchOptions returns the fast path
+// used when fetching one specific ref such as a pull request head.
+type FetchOptions struct {
+	// NoTags disables fetching tags that point into the fetched history.
+	NoTags bool
+	// NoPrune passes --prune=false to override any fetch.prune configuration.
+	NoPrune bool
+	// NegotiationTips limits the commits reported to the server during
+	// negotiation to those reachable from the given refs, instead of walking
+	// all local refs. The refs must exist locally.
+	NegotiationTips []string
+}
+
+// Args returns the extra command line flags described by the options.
+func (o FetchOptions) Args() []string {
+	var args []string
+	if o.NoTags {
+		args = append(args, "--no-tags")
+	}
+	if o.NoPrune {
+		args = append(args, "--prune=false")
+	}
+	for _, tip := range o.NegotiationTips {
+		if tip != "" {
+			args = append(args, fmt.Sprintf("--negotiation-tip=%s", tip))
+		}
+	}
+	return args
+}
+
+// SingleRefFetchOptions returns the fast-path defaults for fetching a single
+// ref: tags are skipped, pruning is disabled, and commit negotiation is
+// limited to the given local tips. On repositories with tens of thousands of
+// refs this significantly reduces negotiation time.
+func SingleRefFetchOptions(negotiationTips ...string) FetchOptions {
+	return FetchOptions{
+		NoTags:          true,
+		NoPrune:         true,
+		NegotiationTips: negotiationTips,
+	}
+}
+
 func (c *Client) Fetch(ctx context.Context, remote string, refspec string, mods ...CommandModifier) error {
+	return c.FetchWithOptions(ctx, remote, refspec, FetchOptions{}, mods...)
+}
+
+func (c *Client) FetchWithOptions(ctx context.Context, remote string, refspec string, opts FetchOptions, mods ...CommandModifier) error {
 	args := []string{"fetch", remote}
 	if refspec != "" {
 		args = append(args, refspec)
 	}
+	args = append(args, opts.Args()...)
 	cmd, err := c.AuthenticatedCommand(ctx, AllMatchingCredentialsPattern, args...)
 	if err != nil {
 		return err
@@ -816,6 +1033,26 @@ func (c *Client) Push(ctx context.Context, remote string, ref string, mods ...Co
 	return cmd.Run()
 }
 
+// Init initializes a new git repository in dir. When initialBranch is
+// non-empty the repository is created with that branch checked out instead of
+// the user's init.defaultBranch.
+func (c *Client) Init(ctx context.Context, dir, initialBranch string, mods ...CommandModifier) error {
+	args := []string{"init"}
+	if initialBranch != "" {
+		args = append(args, "--initial-branch", initialBranch)
+	}
+	args = append(args, dir)
+	cmd, err := c.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	for _, mod := range mods {
+		mod(cmd)
+	}
+	_, err = cmd.Output()
+	return err
+}
+
 func (c *Client) Clone(ctx context.Context, cloneURL string, args []string, mods ...CommandModifier) (string, error) {
 	// Note that even if this is an SSH clone URL, we are setting the pattern anyway.
 	// We could write some code to prevent this, but it also doesn't seem harmful.
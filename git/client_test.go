@@ -182,6 +182,48 @@ func TestClientRemotes_no_resolved_remote(t *testing.T) {
 	assert.Equal(t, "test", rs[3].Name)
 }
 
+func TestClientConfiguredRemotesForHost(t *testing.T) {
+	tempDir := t.TempDir()
+	initRepo(t, tempDir)
+	gitDir := filepath.Join(tempDir, ".git")
+	remoteFile := filepath.Join(gitDir, "config")
+	remotes := `
+[url "https://github.com/"]
+	insteadOf = gh:
+[remote "origin"]
+	url = gh:monalisa/origin.git
+[remote "enterprise"]
+	url = https://ghe.io/monalisa/origin.git
+[remote "fork"]
+	url = git@github.com:hubot/fork.git
+`
+	f, err := os.OpenFile(remoteFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0755)
+	assert.NoError(t, err)
+	_, err = f.Write([]byte(remotes))
+	assert.NoError(t, err)
+	err = f.Close()
+	assert.NoError(t, err)
+	client := Client{
+		RepoDir: tempDir,
+	}
+
+	rs, err := client.ConfiguredRemotesForHost(context.Background(), "GitHub.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(rs))
+	assert.Equal(t, "origin", rs[0].Name)
+	assert.Equal(t, "https://github.com/monalisa/origin.git", rs[0].FetchURL.String())
+	assert.Equal(t, "fork", rs[1].Name)
+
+	rs, err = client.ConfiguredRemotesForHost(context.Background(), "ghe.io")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(rs))
+	assert.Equal(t, "enterprise", rs[0].Name)
+
+	rs, err = client.ConfiguredRemotesForHost(context.Background(), "example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(rs))
+}
+
 func TestParseRemotes(t *testing.T) {
 	remoteList := []string{
 		"mona\tgit@github.com:monalisa/myfork.git (fetch)",
@@ -438,6 +480,54 @@ func TestClientConfig(t *testing.T) {
 	}
 }
 
+func TestClientConfigAll(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantOut       []string
+		wantErrorMsg  string
+	}{
+		{
+			name:        "multiple values",
+			cmdStdout:   "one\ntwo\n",
+			wantCmdArgs: `path/to/git config --get-all gh.pr-merge-hook`,
+			wantOut:     []string{"one", "two"},
+		},
+		{
+			name:          "key not set",
+			cmdExitStatus: 1,
+			wantCmdArgs:   `path/to/git config --get-all gh.pr-merge-hook`,
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 2,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git config --get-all gh.pr-merge-hook`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			out, err := client.ConfigAll(context.Background(), "gh.pr-merge-hook")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			assert.Equal(t, tt.wantOut, out)
+		})
+	}
+}
+
 func TestClientUncommittedChangeCount(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -480,10 +570,103 @@ func TestClientUncommittedChangeCount(t *testing.T) {
 	}
 }
 
+func TestClientChangedFiles(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantFiles     []string
+		wantErrorMsg  string
+	}{
+		{
+			name:        "no changes",
+			wantCmdArgs: `path/to/git diff --name-only base...feature`,
+			wantFiles:   nil,
+		},
+		{
+			name:        "changed files",
+			cmdStdout:   "cmd/main.go\ndocs/readme.md\n",
+			wantCmdArgs: `path/to/git diff --name-only base...feature`,
+			wantFiles:   []string{"cmd/main.go", "docs/readme.md"},
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 1,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git diff --name-only base...feature`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			files, err := client.ChangedFiles(context.Background(), "base", "feature")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg != "" {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantFiles, files)
+		})
+	}
+}
+
+func TestClientReadFileAtRef(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantContents  string
+		wantErrorMsg  string
+	}{
+		{
+			name:         "file exists",
+			cmdStdout:    "* @octocat\n",
+			wantCmdArgs:  `path/to/git show HEAD:.github/CODEOWNERS`,
+			wantContents: "* @octocat\n",
+		},
+		{
+			name:          "file missing at ref",
+			cmdExitStatus: 128,
+			cmdStderr:     "fatal: path '.github/CODEOWNERS' does not exist in 'HEAD'",
+			wantCmdArgs:   `path/to/git show HEAD:.github/CODEOWNERS`,
+			wantErrorMsg:  "failed to run git: fatal: path '.github/CODEOWNERS' does not exist in 'HEAD'",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			contents, err := client.ReadFileAtRef(context.Background(), "HEAD", ".github/CODEOWNERS")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg != "" {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantContents, string(contents))
+		})
+	}
+}
+
 type stubbedCommit struct {
-	Sha   string
-	Title string
-	Body  string
+	Sha         string
+	Title       string
+	Body        string
+	AuthorName  string
+	AuthorEmail string
 }
 
 type stubbedCommitsCommandData struct {
@@ -513,7 +696,7 @@ func TestClientCommits(t *testing.T) {
 					},
 				},
 			},
-			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry SHA1...SHA2`,
+			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry SHA1...SHA2`,
 			wantCommits: []*Commit{{
 				Sha:   "6a6872b918c601a0e730710ad8473938a7516d30",
 				Title: "testing testability test",
@@ -524,17 +707,21 @@ func TestClientCommits(t *testing.T) {
 			testData: stubbedCommitsCommandData{
 				Commits: []stubbedCommit{
 					{
-						Sha:   "6a6872b918c601a0e730710ad8473938a7516d30",
-						Title: "testing testability test",
-						Body:  "This is the body",
+						Sha:         "6a6872b918c601a0e730710ad8473938a7516d30",
+						Title:       "testing testability test",
+						Body:        "This is the body",
+						AuthorName:  "Octo Cat",
+						AuthorEmail: "octo@github.com",
 					},
 				},
 			},
-			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry SHA1...SHA2`,
+			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry SHA1...SHA2`,
 			wantCommits: []*Commit{{
-				Sha:   "6a6872b918c601a0e730710ad8473938a7516d30",
-				Title: "testing testability test",
-				Body:  "This is the body",
+				Sha:         "6a6872b918c601a0e730710ad8473938a7516d30",
+				Title:       "testing testability test",
+				Body:        "This is the body",
+				AuthorName:  "Octo Cat",
+				AuthorEmail: "octo@github.com",
 			}},
 		},
 		{
@@ -553,7 +740,7 @@ func TestClientCommits(t *testing.T) {
 					},
 				},
 			},
-			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry SHA1...SHA2`,
+			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry SHA1...SHA2`,
 			wantCommits: []*Commit{
 				{
 					Sha:   "6a6872b918c601a0e730710ad8473938a7516d30",
@@ -582,7 +769,7 @@ func TestClientCommits(t *testing.T) {
 					},
 				},
 			},
-			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry SHA1...SHA2`,
+			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry SHA1...SHA2`,
 			wantCommits: []*Commit{
 				{
 					Sha:   "6a6872b918c601a0e730710ad8473938a7516d30",
@@ -611,7 +798,7 @@ func TestClientCommits(t *testing.T) {
 					},
 				},
 			},
-			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry SHA1...SHA2`,
+			wantCmdArgs: `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry SHA1...SHA2`,
 			wantCommits: []*Commit{
 				{
 					Sha:   "6a6872b918c601a0e730710ad8473938a7516d30",
@@ -630,7 +817,7 @@ func TestClientCommits(t *testing.T) {
 			testData: stubbedCommitsCommandData{
 				Commits: []stubbedCommit{},
 			},
-			wantCmdArgs:  `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry SHA1...SHA2`,
+			wantCmdArgs:  `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry SHA1...SHA2`,
 			wantErrorMsg: "could not find any commits between SHA1 and SHA2",
 		},
 		{
@@ -639,7 +826,7 @@ func TestClientCommits(t *testing.T) {
 				ErrMsg:     "git error message",
 				ExitStatus: 1,
 			},
-			wantCmdArgs:  `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry SHA1...SHA2`,
+			wantCmdArgs:  `path/to/git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry SHA1...SHA2`,
 			wantErrorMsg: "failed to run git: git error message",
 		},
 	}
@@ -679,6 +866,10 @@ func TestCommitsHelperProcess(t *testing.T) {
 			sb.WriteString("\u0000")
 			sb.WriteString(commit.Title)
 			sb.WriteString("\u0000")
+			sb.WriteString(commit.AuthorName)
+			sb.WriteString("\u0000")
+			sb.WriteString(commit.AuthorEmail)
+			sb.WriteString("\u0000")
 			sb.WriteString(commit.Body)
 			sb.WriteString("\u0000")
 			sb.WriteString("\n")
@@ -1344,6 +1535,41 @@ func TestClientHasLocalBranch(t *testing.T) {
 	}
 }
 
+func TestClientCheckRefFormat(t *testing.T) {
+	tests := []struct {
+		name          string
+		cmdExitStatus int
+		cmdStdout     string
+		cmdStderr     string
+		wantCmdArgs   string
+		wantOut       bool
+	}{
+		{
+			name:        "valid branch name",
+			wantCmdArgs: `path/to/git check-ref-format --branch feature/123-fix`,
+			wantOut:     true,
+		},
+		{
+			name:          "invalid branch name",
+			cmdExitStatus: 1,
+			wantCmdArgs:   `path/to/git check-ref-format --branch feature/123-fix`,
+			wantOut:       false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, tt.cmdStdout, tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			out := client.CheckRefFormat(context.Background(), "feature/123-fix")
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			assert.Equal(t, out, tt.wantOut)
+		})
+	}
+}
+
 func TestClientCheckoutBranch(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -1677,6 +1903,45 @@ func TestClientFetch(t *testing.T) {
 	}
 }
 
+func TestClientFetchWithOptions(t *testing.T) {
+	tests := []struct {
+		name     string
+		opts     FetchOptions
+		commands mockedCommands
+	}{
+		{
+			name: "zero options fetch with git defaults",
+			opts: FetchOptions{},
+			commands: map[args]commandResult{
+				`path/to/git -c credential.helper= -c credential.helper=!"gh" auth git-credential fetch origin trunk`: {
+					ExitStatus: 0,
+				},
+			},
+		},
+		{
+			name: "single ref fast path",
+			opts: SingleRefFetchOptions("trunk"),
+			commands: map[args]commandResult{
+				`path/to/git -c credential.helper= -c credential.helper=!"gh" auth git-credential fetch origin trunk --no-tags --prune=false --negotiation-tip=trunk`: {
+					ExitStatus: 0,
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdCtx := createMockedCommandContext(t, tt.commands)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			err := client.FetchWithOptions(context.Background(), "origin", "trunk", tt.opts)
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestClientPull(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1783,6 +2048,136 @@ func TestClientPush(t *testing.T) {
 	}
 }
 
+func TestClientInit(t *testing.T) {
+	tests := []struct {
+		name          string
+		initialBranch string
+		cmdExitStatus int
+		cmdStderr     string
+		wantCmdArgs   string
+		wantErrorMsg  string
+	}{
+		{
+			name:        "init",
+			wantCmdArgs: `path/to/git init /path/to/new`,
+		},
+		{
+			name:          "init with initial branch",
+			initialBranch: "trunk",
+			wantCmdArgs:   `path/to/git init --initial-branch trunk /path/to/new`,
+		},
+		{
+			name:          "git error",
+			cmdExitStatus: 1,
+			cmdStderr:     "git error message",
+			wantCmdArgs:   `path/to/git init /path/to/new`,
+			wantErrorMsg:  "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd, cmdCtx := createCommandContext(t, tt.cmdExitStatus, "", tt.cmdStderr)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			err := client.Init(context.Background(), "/path/to/new", tt.initialBranch)
+			assert.Equal(t, tt.wantCmdArgs, strings.Join(cmd.Args[3:], " "))
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+		})
+	}
+}
+
+func TestClientCommitAll(t *testing.T) {
+	tests := []struct {
+		name         string
+		author       *CommitIdentity
+		committer    *CommitIdentity
+		cmds         mockedCommands
+		wantErrorMsg string
+		wantUserErr  bool
+	}{
+		{
+			name: "commits staged and unstaged changes",
+			cmds: mockedCommands{
+				`path/to/git config user.name`:                {Stdout: "monalisa"},
+				`path/to/git config user.email`:               {Stdout: "monalisa@github.com"},
+				`path/to/git add --all`:                       {},
+				`path/to/git commit --message initial commit`: {},
+			},
+		},
+		{
+			name:   "author override",
+			author: &CommitIdentity{Name: "Octo Cat", Email: "octo@github.com"},
+			cmds: mockedCommands{
+				`path/to/git config user.name`:  {Stdout: "monalisa"},
+				`path/to/git config user.email`: {Stdout: "monalisa@github.com"},
+				`path/to/git add --all`:         {},
+				`path/to/git commit --message initial commit --author Octo Cat <octo@github.com>`: {},
+			},
+		},
+		{
+			name:      "author and committer overrides skip identity check",
+			author:    &CommitIdentity{Name: "Octo Cat", Email: "octo@github.com"},
+			committer: &CommitIdentity{Name: "Octo Cat", Email: "octo@github.com"},
+			cmds: mockedCommands{
+				`path/to/git add --all`: {},
+				`path/to/git commit --message initial commit --author Octo Cat <octo@github.com>`: {},
+			},
+		},
+		{
+			name: "unset user.name yields typed error",
+			cmds: mockedCommands{
+				`path/to/git config user.name`: {ExitStatus: 1},
+			},
+			wantErrorMsg: "git: user.name is not set; set it with `git config --global user.name \"Your Name\"`",
+			wantUserErr:  true,
+		},
+		{
+			name: "unset user.email yields typed error",
+			cmds: mockedCommands{
+				`path/to/git config user.name`:  {Stdout: "monalisa"},
+				`path/to/git config user.email`: {ExitStatus: 1},
+			},
+			wantErrorMsg: "git: user.email is not set; set it with `git config --global user.email \"you@example.com\"`",
+			wantUserErr:  true,
+		},
+		{
+			name: "commit failure",
+			cmds: mockedCommands{
+				`path/to/git config user.name`:                {Stdout: "monalisa"},
+				`path/to/git config user.email`:               {Stdout: "monalisa@github.com"},
+				`path/to/git add --all`:                       {},
+				`path/to/git commit --message initial commit`: {ExitStatus: 2, Stderr: "git error message"},
+			},
+			wantErrorMsg: "failed to run git: git error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmdCtx := createMockedCommandContext(t, tt.cmds)
+			client := Client{
+				GitPath:        "path/to/git",
+				commandContext: cmdCtx,
+			}
+			err := client.CommitAll(context.Background(), "initial commit", tt.author, tt.committer)
+			if tt.wantErrorMsg == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErrorMsg)
+			}
+			if tt.wantUserErr {
+				var userErr *UserConfigError
+				assert.ErrorAs(t, err, &userErr)
+			}
+		})
+	}
+}
+
 func TestClientClone(t *testing.T) {
 	tests := []struct {
 		name          string
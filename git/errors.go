@@ -21,6 +21,21 @@ func (e *NotInstalled) Unwrap() error {
 	return e.err
 }
 
+// UserConfigError indicates that a git identity setting required to create a
+// commit is missing; its message carries the remediation hint.
+type UserConfigError struct {
+	// Key is the unset configuration key, user.name or user.email.
+	Key string
+}
+
+func (e *UserConfigError) Error() string {
+	example := `"Your Name"`
+	if e.Key == "user.email" {
+		example = `"you@example.com"`
+	}
+	return fmt.Sprintf("git: %s is not set; set it with `git config --global %s %s`", e.Key, e.Key, example)
+}
+
 type GitError struct {
 	ExitCode int
 	Stderr   string
@@ -0,0 +1,233 @@
+package git
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"testing"
+)
+
+// FakeClient is an in-memory test double covering the subset of the Client
+// surface that command packages most commonly consume: branch configuration,
+// remotes, local config, and push/fetch/pull. It records every operation it
+// performs so tests can assert on what was invoked, without stubbing git
+// executions via run.Stub or the TestHelperProcess pattern.
+//
+// The zero value is ready to use; populate the exported fields to seed state.
+type FakeClient struct {
+	// CurrentBranchName is returned by CurrentBranch. When empty,
+	// CurrentBranch returns ErrNotOnAnyBranch, mirroring detached HEAD.
+	CurrentBranchName string
+
+	// RemoteSet is returned by Remotes and grown by AddRemote.
+	RemoteSet RemoteSet
+
+	// BranchConfigs maps branch names to the configuration returned by
+	// ReadBranchConfig. SetBranchConfig updates the matching entry the same
+	// way git would interpret the key.
+	BranchConfigs map[string]BranchConfig
+
+	// ConfigValues maps configuration keys to their values, consulted by
+	// Config and ConfigAll and appended to by SetLocalConfig.
+	ConfigValues map[string][]string
+
+	// LocalBranches is the set of branch names reported by HasLocalBranch.
+	// CheckoutNewBranch adds to it and DeleteLocalBranch removes from it.
+	LocalBranches []string
+
+	// StubbedErrors maps an operation name, e.g. "push", to an error that
+	// the corresponding method returns after recording its invocation.
+	StubbedErrors map[string]error
+
+	mu          sync.Mutex
+	invocations []FakeInvocation
+}
+
+// FakeInvocation is one recorded operation of a FakeClient: the operation
+// name, e.g. "fetch", followed by its string arguments in declaration order.
+type FakeInvocation struct {
+	Operation string
+	Args      []string
+}
+
+func (c *FakeClient) record(operation string, args ...string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.invocations = append(c.invocations, FakeInvocation{Operation: operation, Args: args})
+	return c.StubbedErrors[operation]
+}
+
+// Invocations returns every operation recorded so far, in order.
+func (c *FakeClient) Invocations() []FakeInvocation {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.invocations)
+}
+
+// AssertInvoked fails the test unless an operation with the given name and
+// arguments was recorded.
+func (c *FakeClient) AssertInvoked(t testing.TB, operation string, args ...string) {
+	t.Helper()
+	want := FakeInvocation{Operation: operation, Args: args}
+	for _, invocation := range c.Invocations() {
+		if invocation.Operation == want.Operation && slices.Equal(invocation.Args, want.Args) {
+			return
+		}
+	}
+	t.Errorf("expected git operation %q with arguments %q to have been invoked", operation, args)
+}
+
+// AssertNotInvoked fails the test if any operation with the given name was
+// recorded.
+func (c *FakeClient) AssertNotInvoked(t testing.TB, operation string) {
+	t.Helper()
+	for _, invocation := range c.Invocations() {
+		if invocation.Operation == operation {
+			t.Errorf("expected git operation %q not to have been invoked, but it was invoked with arguments %q", operation, invocation.Args)
+			return
+		}
+	}
+}
+
+func (c *FakeClient) CurrentBranch(ctx context.Context) (string, error) {
+	if err := c.record("current-branch"); err != nil {
+		return "", err
+	}
+	if c.CurrentBranchName == "" {
+		return "", ErrNotOnAnyBranch
+	}
+	return c.CurrentBranchName, nil
+}
+
+func (c *FakeClient) Remotes(ctx context.Context) (RemoteSet, error) {
+	if err := c.record("remotes"); err != nil {
+		return nil, err
+	}
+	return slices.Clone(c.RemoteSet), nil
+}
+
+func (c *FakeClient) AddRemote(ctx context.Context, name, urlStr string, trackingBranches []string) (*Remote, error) {
+	if err := c.record("add-remote", append([]string{name, urlStr}, trackingBranches...)...); err != nil {
+		return nil, err
+	}
+	remote := NewRemote(name, urlStr)
+	c.RemoteSet = append(c.RemoteSet, remote)
+	return remote, nil
+}
+
+func (c *FakeClient) SetRemoteResolution(ctx context.Context, name, resolution string) error {
+	if err := c.record("set-remote-resolution", name, resolution); err != nil {
+		return err
+	}
+	for _, remote := range c.RemoteSet {
+		if remote.Name == name {
+			remote.Resolved = resolution
+		}
+	}
+	return nil
+}
+
+func (c *FakeClient) Config(ctx context.Context, name string) (string, error) {
+	if err := c.record("config", name); err != nil {
+		return "", err
+	}
+	values := c.ConfigValues[name]
+	if len(values) == 0 {
+		return "", &GitError{ExitCode: 1, Stderr: fmt.Sprintf("unknown config key %s", name)}
+	}
+	return values[0], nil
+}
+
+func (c *FakeClient) ConfigAll(ctx context.Context, name string) ([]string, error) {
+	if err := c.record("config-all", name); err != nil {
+		return nil, err
+	}
+	return slices.Clone(c.ConfigValues[name]), nil
+}
+
+func (c *FakeClient) SetLocalConfig(ctx context.Context, name, value string) error {
+	if err := c.record("set-local-config", name, value); err != nil {
+		return err
+	}
+	if c.ConfigValues == nil {
+		c.ConfigValues = map[string][]string{}
+	}
+	c.ConfigValues[name] = append(c.ConfigValues[name], value)
+	return nil
+}
+
+func (c *FakeClient) ReadBranchConfig(ctx context.Context, branch string) (BranchConfig, error) {
+	if err := c.record("read-branch-config", branch); err != nil {
+		return BranchConfig{}, err
+	}
+	return c.BranchConfigs[branch], nil
+}
+
+func (c *FakeClient) SetBranchConfig(ctx context.Context, branch, name, value string) error {
+	if err := c.record("set-branch-config", branch, name, value); err != nil {
+		return err
+	}
+	if c.BranchConfigs == nil {
+		c.BranchConfigs = map[string]BranchConfig{}
+	}
+	cfg := c.BranchConfigs[branch]
+	switch name {
+	case "remote":
+		cfg.RemoteURL, cfg.RemoteName = parseRemoteURLOrName(value)
+	case "pushremote":
+		cfg.PushRemoteURL, cfg.PushRemoteName = parseRemoteURLOrName(value)
+	case "merge":
+		cfg.MergeRef = value
+	case MergeBaseConfig:
+		cfg.MergeBase = value
+	}
+	c.BranchConfigs[branch] = cfg
+	return nil
+}
+
+func (c *FakeClient) HasLocalBranch(ctx context.Context, branch string) bool {
+	_ = c.record("has-local-branch", branch)
+	return slices.Contains(c.LocalBranches, branch)
+}
+
+func (c *FakeClient) CheckoutBranch(ctx context.Context, branch string) error {
+	if err := c.record("checkout", branch); err != nil {
+		return err
+	}
+	c.CurrentBranchName = branch
+	return nil
+}
+
+func (c *FakeClient) CheckoutNewBranch(ctx context.Context, remoteName, branch string) error {
+	if err := c.record("checkout-new-branch", remoteName, branch); err != nil {
+		return err
+	}
+	if !slices.Contains(c.LocalBranches, branch) {
+		c.LocalBranches = append(c.LocalBranches, branch)
+	}
+	c.CurrentBranchName = branch
+	return nil
+}
+
+func (c *FakeClient) DeleteLocalBranch(ctx context.Context, branch string) error {
+	if err := c.record("delete-local-branch", branch); err != nil {
+		return err
+	}
+	c.LocalBranches = slices.DeleteFunc(c.LocalBranches, func(b string) bool {
+		return b == branch
+	})
+	return nil
+}
+
+func (c *FakeClient) Push(ctx context.Context, remote string, ref string, mods ...CommandModifier) error {
+	return c.record("push", remote, ref)
+}
+
+func (c *FakeClient) Pull(ctx context.Context, remote, branch string, mods ...CommandModifier) error {
+	return c.record("pull", remote, branch)
+}
+
+func (c *FakeClient) Fetch(ctx context.Context, remote string, refspec string, mods ...CommandModifier) error {
+	return c.record("fetch", remote, refspec)
+}
@@ -0,0 +1,104 @@
+package git
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFakeClientCurrentBranch(t *testing.T) {
+	client := &FakeClient{}
+	_, err := client.CurrentBranch(context.Background())
+	assert.ErrorIs(t, err, ErrNotOnAnyBranch)
+
+	client.CurrentBranchName = "trunk"
+	branch, err := client.CurrentBranch(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "trunk", branch)
+}
+
+func TestFakeClientRemotes(t *testing.T) {
+	client := &FakeClient{
+		RemoteSet: RemoteSet{NewRemote("origin", "https://github.com/OWNER/REPO.git")},
+	}
+
+	_, err := client.AddRemote(context.Background(), "upstream", "https://github.com/PARENT/REPO.git", []string{"main"})
+	require.NoError(t, err)
+	require.NoError(t, client.SetRemoteResolution(context.Background(), "upstream", "base"))
+
+	remotes, err := client.Remotes(context.Background())
+	require.NoError(t, err)
+	require.Len(t, remotes, 2)
+	assert.Equal(t, "upstream", remotes[1].Name)
+	assert.Equal(t, "base", remotes[1].Resolved)
+
+	client.AssertInvoked(t, "add-remote", "upstream", "https://github.com/PARENT/REPO.git", "main")
+}
+
+func TestFakeClientConfig(t *testing.T) {
+	client := &FakeClient{}
+
+	_, err := client.Config(context.Background(), "gh.example")
+	var gitErr *GitError
+	require.ErrorAs(t, err, &gitErr)
+	assert.Equal(t, 1, gitErr.ExitCode)
+
+	require.NoError(t, client.SetLocalConfig(context.Background(), "gh.example", "one"))
+	require.NoError(t, client.SetLocalConfig(context.Background(), "gh.example", "two"))
+
+	value, err := client.Config(context.Background(), "gh.example")
+	require.NoError(t, err)
+	assert.Equal(t, "one", value)
+
+	values, err := client.ConfigAll(context.Background(), "gh.example")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"one", "two"}, values)
+}
+
+func TestFakeClientBranchConfig(t *testing.T) {
+	client := &FakeClient{}
+
+	require.NoError(t, client.SetBranchConfig(context.Background(), "feature", "remote", "origin"))
+	require.NoError(t, client.SetBranchConfig(context.Background(), "feature", "merge", "refs/heads/feature"))
+	require.NoError(t, client.SetBranchConfig(context.Background(), "feature", MergeBaseConfig, "trunk"))
+
+	cfg, err := client.ReadBranchConfig(context.Background(), "feature")
+	require.NoError(t, err)
+	assert.Equal(t, BranchConfig{
+		RemoteName: "origin",
+		MergeRef:   "refs/heads/feature",
+		MergeBase:  "trunk",
+	}, cfg)
+}
+
+func TestFakeClientBranches(t *testing.T) {
+	client := &FakeClient{LocalBranches: []string{"trunk"}}
+
+	require.NoError(t, client.CheckoutNewBranch(context.Background(), "origin", "feature"))
+	assert.Equal(t, "feature", client.CurrentBranchName)
+	assert.True(t, client.HasLocalBranch(context.Background(), "feature"))
+
+	require.NoError(t, client.CheckoutBranch(context.Background(), "trunk"))
+	require.NoError(t, client.DeleteLocalBranch(context.Background(), "feature"))
+	assert.False(t, client.HasLocalBranch(context.Background(), "feature"))
+}
+
+func TestFakeClientRecordsInvocations(t *testing.T) {
+	stubbedErr := errors.New("remote rejected the push")
+	client := &FakeClient{
+		StubbedErrors: map[string]error{"push": stubbedErr},
+	}
+
+	require.NoError(t, client.Fetch(context.Background(), "origin", "+refs/heads/main:refs/remotes/origin/main"))
+	assert.ErrorIs(t, client.Push(context.Background(), "origin", "HEAD:feature"), stubbedErr)
+
+	invocations := client.Invocations()
+	require.Len(t, invocations, 2)
+	assert.Equal(t, FakeInvocation{Operation: "fetch", Args: []string{"origin", "+refs/heads/main:refs/remotes/origin/main"}}, invocations[0])
+
+	client.AssertInvoked(t, "push", "origin", "HEAD:feature")
+	client.AssertNotInvoked(t, "pull")
+}
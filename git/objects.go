@@ -1,6 +1,7 @@
 package git
 
 import (
+	"encoding/json"
 	"net/url"
 	"strings"
 )
@@ -39,6 +40,46 @@ func (r *Remote) String() string {
 	return r.Name
 }
 
+// RemoteURL is the JSON shape of a remote URL: the raw form as git reported
+// it alongside the normalized form that gh uses when matching hosts.
+type RemoteURL struct {
+	Raw        string `json:"raw"`
+	Normalized string `json:"normalized"`
+}
+
+// NewRemoteURL derives both serializations from a parsed remote URL: the
+// normalized form has credentials stripped, the host lowercased, and any
+// trailing ".git" removed.
+func NewRemoteURL(u *url.URL) *RemoteURL {
+	if u == nil {
+		return nil
+	}
+	nu := *u
+	nu.User = nil
+	nu.Host = strings.ToLower(nu.Host)
+	nu.Path = strings.TrimSuffix(nu.Path, ".git")
+	return &RemoteURL{
+		Raw:        u.String(),
+		Normalized: nu.String(),
+	}
+}
+
+// MarshalJSON serializes the remote with both raw and normalized URL forms,
+// since the parsed net/url representation does not round-trip as JSON.
+func (r *Remote) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Name     string     `json:"name"`
+		Resolved string     `json:"resolved,omitempty"`
+		FetchURL *RemoteURL `json:"fetchUrl,omitempty"`
+		PushURL  *RemoteURL `json:"pushUrl,omitempty"`
+	}{
+		Name:     r.Name,
+		Resolved: r.Resolved,
+		FetchURL: NewRemoteURL(r.FetchURL),
+		PushURL:  NewRemoteURL(r.PushURL),
+	})
+}
+
 func NewRemote(name string, u string) *Remote {
 	pu, _ := url.Parse(u)
 	return &Remote{
@@ -55,9 +96,11 @@ type Ref struct {
 }
 
 type Commit struct {
-	Sha   string
-	Title string
-	Body  string
+	Sha         string
+	Title       string
+	Body        string
+	AuthorName  string
+	AuthorEmail string
 }
 
 // These are the keys we read from the git branch.<name> config.
@@ -0,0 +1,39 @@
+package authflow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/internal/ghinstance"
+)
+
+// RevokeToken revokes an OAuth access token that was created by the GitHub
+// CLI OAuth app. Tokens not created by the CLI, such as personal access
+// tokens, cannot be revoked this way.
+func RevokeToken(httpClient *http.Client, hostname, accessToken string) error {
+	body, err := json.Marshal(map[string]string{"access_token": accessToken})
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%sapplications/%s/token", ghinstance.RESTPrefix(hostname), oauthClientID)
+	req, err := http.NewRequest("DELETE", url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.SetBasicAuth(oauthClientID, oauthClientSecret)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("HTTP %d revoking token", resp.StatusCode)
+	}
+	return nil
+}
@@ -6,10 +6,12 @@ import (
 	"os"
 	"path/filepath"
 	"slices"
+	"strings"
 
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/keyring"
 	o "github.com/cli/cli/v2/pkg/option"
+	"github.com/cli/cli/v2/utils"
 	ghauth "github.com/cli/go-gh/v2/pkg/auth"
 	ghConfig "github.com/cli/go-gh/v2/pkg/config"
 )
@@ -22,15 +24,21 @@ const (
 	aliasesKey            = "aliases"
 	browserKey            = "browser" // used by cli/go-gh to open URLs in web browsers
 	colorLabelsKey        = "color_labels"
+	credentialProviderKey = "credential_provider"
 	editorKey             = "editor" // used by cli/go-gh to open interactive text editor
 	gitProtocolKey        = "git_protocol"
 	hostsKey              = "hosts" // used by cli/go-gh to locate authenticated host tokens
 	httpUnixSocketKey     = "http_unix_socket"
+	jsonFieldsKey         = "json_fields"
+	keyringBackendKey     = "keyring_backend"
 	oauthTokenKey         = "oauth_token" // used by cli/go-gh to locate authenticated host tokens
 	pagerKey              = "pager"
 	promptKey             = "prompt"
 	preferEditorPromptKey = "prefer_editor_prompt"
+	remotePriorityKey     = "remote_priority"
+	savedRepliesKey       = "saved_replies"
 	spinnerKey            = "spinner"
+	timezoneKey           = "timezone"
 	userKey               = "user"
 	usersKey              = "users"
 	versionKey            = "version"
@@ -41,15 +49,43 @@ func NewConfig() (gh.Config, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &cfg{c}, nil
+	config := &cfg{cfg: c}
+	if dir, err := os.Getwd(); err == nil {
+		if path, ok := findLocalConfig(dir); ok {
+			if local, err := readLocalConfig(path); err == nil {
+				config.local = local
+			} else {
+				// A broken checked-in file must not break every gh command
+				// run in the tree; warn and use the user configuration only.
+				fmt.Fprintf(os.Stderr, "warning: ignoring %s: %v\n", path, err)
+			}
+		}
+	}
+	// On machines without a system keyring, tokens can be kept in an
+	// encrypted file instead of the plain text config. The backend has to be
+	// selected before any authentication configuration is accessed.
+	if backend := config.GetOrDefault("", keyringBackendKey); backend.IsSome() && backend.Unwrap().Value == "file" {
+		keyring.UseFileBackend(filepath.Join(ghConfig.StateDir(), "keyring.enc"))
+	}
+	return config, nil
 }
 
 // Implements Config interface
 type cfg struct {
 	cfg *ghConfig.Config
+	// local holds repository-local overrides from a checked-in .gh.yml
+	// file, or nil when none applies. It is read-only: Set and Write only
+	// ever touch the user-level configuration.
+	local *ghConfig.Config
 }
 
 func (c *cfg) get(hostname, key string) o.Option[string] {
+	if c.local != nil {
+		if val, ok := localGet(c.local, key); ok {
+			return o.Some(val)
+		}
+	}
+
 	if hostname != "" {
 		val, err := c.cfg.Get([]string{hostsKey, hostname, key})
 		if err == nil {
@@ -110,6 +146,10 @@ func (c *cfg) Aliases() gh.AliasConfig {
 	return &AliasConfig{cfg: c.cfg}
 }
 
+func (c *cfg) SavedReplies() gh.SavedReplyConfig {
+	return &SavedReplyConfig{cfg: c.cfg}
+}
+
 func (c *cfg) Authentication() gh.AuthConfig {
 	return &AuthConfig{cfg: c.cfg}
 }
@@ -232,6 +272,27 @@ func (c *AuthConfig) ActiveToken(hostname string) (string, string) {
 	if c.tokenOverride != nil {
 		return c.tokenOverride(hostname)
 	}
+	// A zero-value AuthConfig has no backing config, so there is no
+	// credential provider to consult.
+	if c.cfg != nil {
+		if provider, err := c.cfg.Get([]string{hostsKey, hostname, credentialProviderKey}); err == nil && provider != "" {
+			// Tokens from environment variables keep the highest precedence even
+			// when a credential provider is configured for the host.
+			if token, source := ghauth.TokenFromEnvOrConfig(hostname); token != "" && strings.HasSuffix(source, "_TOKEN") {
+				return token, source
+			}
+			if token, err := tokenFromCredentialProvider(hostname, provider); err == nil && token != "" {
+				return token, credentialProviderKey
+			} else if err != nil {
+				// Fall back to any stored token, but make the failure visible:
+				// in CI the provider mints short-lived tokens and a stored one
+				// is likely stale.
+				if debugEnabled, _ := utils.IsDebugEnabled(); debugEnabled {
+					fmt.Fprintf(os.Stderr, "warning: credential provider for %s failed: %v\n", hostname, err)
+				}
+			}
+		}
+	}
 	token, source := ghauth.TokenFromEnvOrConfig(hostname)
 	if token == "" {
 		var err error
@@ -528,6 +589,27 @@ func (a *AliasConfig) All() map[string]string {
 	return out
 }
 
+type SavedReplyConfig struct {
+	cfg *ghConfig.Config
+}
+
+func (s *SavedReplyConfig) Get(name string) (string, error) {
+	return s.cfg.Get([]string{savedRepliesKey, name})
+}
+
+func (s *SavedReplyConfig) All() map[string]string {
+	out := map[string]string{}
+	keys, err := s.cfg.Keys([]string{savedRepliesKey})
+	if err != nil {
+		return out
+	}
+	for _, key := range keys {
+		val, _ := s.cfg.Get([]string{savedRepliesKey, key})
+		out[key] = val
+	}
+	return out
+}
+
 func fallbackConfig() *ghConfig.Config {
 	return ghConfig.ReadFromString(defaultConfigStr)
 }
@@ -663,6 +745,31 @@ var Options = []ConfigOption{
 			return c.AccessiblePrompter(hostname).Value
 		},
 	},
+	{
+		Key:          credentialProviderKey,
+		Description:  "a command to execute at call time to obtain an authentication token for a host, in the form \"exec:<command>\"",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, credentialProviderKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:           keyringBackendKey,
+		Description:   "where to store authentication credentials: the operating system keyring or an encrypted file",
+		DefaultValue:  "system",
+		AllowedValues: []string{"system", "file"},
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, keyringBackendKey).UnwrapOrZero().Value
+		},
+	},
+	{
+		Key:          remotePriorityKey,
+		Description:  "a comma-separated list of git remote names to prefer, in descending order of priority",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, remotePriorityKey).UnwrapOrZero().Value
+		},
+	},
 	{
 		Key:           spinnerKey,
 		Description:   "whether to use a animated spinner as a progress indicator",
@@ -672,6 +779,14 @@ var Options = []ConfigOption{
 			return c.Spinner(hostname).Value
 		},
 	},
+	{
+		Key:          timezoneKey,
+		Description:  "the IANA time zone name used when rendering absolute timestamps, e.g. \"America/New_York\"",
+		DefaultValue: "",
+		CurrentValue: func(c gh.Config, hostname string) string {
+			return c.GetOrDefault(hostname, timezoneKey).UnwrapOrZero().Value
+		},
+	},
 }
 
 func HomeDirPath(subdir string) (string, error) {
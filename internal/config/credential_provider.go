@@ -0,0 +1,70 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/cli/safeexec"
+	"github.com/google/shlex"
+)
+
+const execProviderPrefix = "exec:"
+
+var (
+	providerMu    sync.Mutex
+	providerCache = map[string]string{}
+
+	// runCredentialProvider executes a credential provider command and returns
+	// the token it prints to stdout. It is a variable so tests can stub it.
+	runCredentialProvider = func(hostname, command string) (string, error) {
+		argv, err := shlex.Split(command)
+		if err != nil || len(argv) == 0 {
+			return "", fmt.Errorf("invalid credential provider command: %q", command)
+		}
+		exe, err := safeexec.LookPath(argv[0])
+		if err != nil {
+			return "", err
+		}
+		cmd := exec.Command(exe, argv[1:]...)
+		cmd.Env = append(os.Environ(), "GH_HOST="+hostname)
+		cmd.Stderr = os.Stderr
+		out, err := cmd.Output()
+		if err != nil {
+			return "", fmt.Errorf("credential provider %q failed: %w", argv[0], err)
+		}
+		return strings.TrimSpace(string(out)), nil
+	}
+)
+
+// tokenFromCredentialProvider obtains a token from the credential provider
+// configured for the host. Providers have the form "exec:<command>"; the
+// command is executed with GH_HOST set and must print a token to stdout.
+// This allows CI systems to mint short-lived tokens (e.g. via an OIDC
+// exchange) at call time instead of storing static ones.
+//
+// Results are cached for the lifetime of the process since provider commands
+// may perform a network exchange on every invocation.
+func tokenFromCredentialProvider(hostname, provider string) (string, error) {
+	command, ok := strings.CutPrefix(provider, execProviderPrefix)
+	if !ok {
+		return "", fmt.Errorf("unsupported credential provider %q: only \"exec:<command>\" providers are supported", provider)
+	}
+
+	providerMu.Lock()
+	defer providerMu.Unlock()
+
+	cacheKey := hostname + "\x00" + command
+	if token, ok := providerCache[cacheKey]; ok {
+		return token, nil
+	}
+
+	token, err := runCredentialProvider(hostname, command)
+	if err != nil {
+		return "", err
+	}
+	providerCache[cacheKey] = token
+	return token, nil
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/keyring"
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+	"github.com/stretchr/testify/require"
+)
+
+func stubCredentialProvider(t *testing.T, stub func(hostname, command string) (string, error)) {
+	t.Helper()
+	original := runCredentialProvider
+	runCredentialProvider = stub
+	t.Cleanup(func() {
+		runCredentialProvider = original
+		providerMu.Lock()
+		defer providerMu.Unlock()
+		providerCache = map[string]string{}
+	})
+}
+
+func TestActiveTokenFromCredentialProvider(t *testing.T) {
+	var calls int
+	stubCredentialProvider(t, func(hostname, command string) (string, error) {
+		calls++
+		require.Equal(t, "github.com", hostname)
+		require.Equal(t, "my-helper --mint", command)
+		return "provider-token", nil
+	})
+
+	cfg := ghConfig.ReadFromString(`
+hosts:
+  github.com:
+    user: monalisa
+    credential_provider: "exec:my-helper --mint"
+`)
+	authCfg := &AuthConfig{cfg: cfg}
+
+	token, source := authCfg.ActiveToken("github.com")
+	require.Equal(t, "provider-token", token)
+	require.Equal(t, "credential_provider", source)
+
+	// The provider result is cached for the lifetime of the process.
+	_, _ = authCfg.ActiveToken("github.com")
+	require.Equal(t, 1, calls)
+}
+
+func TestActiveTokenFallsBackWhenCredentialProviderFails(t *testing.T) {
+	stubCredentialProvider(t, func(hostname, command string) (string, error) {
+		return "", errors.New("exchange failed")
+	})
+
+	keyring.MockInit()
+	require.NoError(t, keyring.Set("gh:ghe.example.com", "", "stored-token"))
+
+	cfg := ghConfig.ReadFromString(`
+hosts:
+  ghe.example.com:
+    user: monalisa
+    credential_provider: "exec:my-helper"
+`)
+	authCfg := &AuthConfig{cfg: cfg}
+
+	token, source := authCfg.ActiveToken("ghe.example.com")
+	require.Equal(t, "stored-token", token)
+	require.Equal(t, "keyring", source)
+}
+
+func TestActiveTokenWithoutBackingConfig(t *testing.T) {
+	// A zero-value AuthConfig is a valid construction; resolving a token
+	// must not panic when there is no backing config to consult.
+	keyring.MockInit()
+	authCfg := &AuthConfig{}
+
+	token, _ := authCfg.ActiveToken("github.com")
+	require.Empty(t, token)
+}
+
+func TestTokenFromCredentialProviderRejectsUnknownSchemes(t *testing.T) {
+	_, err := tokenFromCredentialProvider("github.com", "oidc:audience")
+	require.ErrorContains(t, err, "unsupported credential provider")
+}
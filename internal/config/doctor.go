@@ -0,0 +1,147 @@
+package config
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+)
+
+// FindOption returns the schema entry for a configuration key.
+func FindOption(key string) (ConfigOption, bool) {
+	for _, opt := range Options {
+		if opt.Key == key {
+			return opt, true
+		}
+	}
+	return ConfigOption{}, false
+}
+
+// deprecatedKeys maps configuration keys that gh no longer reads to advice
+// on what replaced them.
+var deprecatedKeys = map[string]string{
+	// tokens lived in the main config file before the hosts file existed
+	oauthTokenKey: "authentication tokens are managed by `gh auth login` and no longer read from here",
+}
+
+// structuralKeys are top-level keys that hold nested configuration rather
+// than a setting, so they are exempt from schema checks.
+var structuralKeys = []string{aliasesKey, hostsKey, versionKey}
+
+// hostOnlyKeys are keys that are valid inside a host entry but are managed by
+// `gh auth` rather than the configuration schema.
+var hostOnlyKeys = []string{userKey, usersKey, oauthTokenKey, gitProtocolKey}
+
+type DiagnosticSeverity int
+
+const (
+	DiagnosticWarning DiagnosticSeverity = iota
+	DiagnosticError
+)
+
+// Diagnostic is one problem found in the configuration on disk, with an
+// optional suggestion for how to fix it.
+type Diagnostic struct {
+	Severity   DiagnosticSeverity
+	Message    string
+	Suggestion string
+}
+
+// DiagnoseConfigFile reads the configuration from disk and reports unknown
+// keys, deprecated settings, invalid values, and per-host inconsistencies.
+func DiagnoseConfigFile() ([]Diagnostic, error) {
+	c, err := ghConfig.Read(fallbackConfig())
+	if err != nil {
+		return nil, err
+	}
+	return Diagnose(c), nil
+}
+
+// Diagnose checks every top-level and per-host key against the configuration
+// schema described by Options.
+func Diagnose(c *ghConfig.Config) []Diagnostic {
+	var diagnostics []Diagnostic
+
+	topLevelKeys, err := c.Keys(nil)
+	if err != nil {
+		return nil
+	}
+	for _, key := range topLevelKeys {
+		if slices.Contains(structuralKeys, key) {
+			continue
+		}
+		value, _ := c.Get([]string{key})
+		diagnostics = append(diagnostics, checkKey(key, value, "")...)
+	}
+
+	hosts, err := c.Keys([]string{hostsKey})
+	if err != nil {
+		return diagnostics
+	}
+	for _, host := range hosts {
+		hostKeys, err := c.Keys([]string{hostsKey, host})
+		if err != nil {
+			continue
+		}
+		for _, key := range hostKeys {
+			if slices.Contains(hostOnlyKeys, key) {
+				continue
+			}
+			value, _ := c.Get([]string{hostsKey, host, key})
+			diagnostics = append(diagnostics, checkKey(key, value, host)...)
+
+			// a per-host value identical to the global one is a likely
+			// leftover and makes future global changes surprising
+			if globalValue, err := c.Get([]string{key}); err == nil && globalValue == value {
+				diagnostics = append(diagnostics, Diagnostic{
+					Severity:   DiagnosticWarning,
+					Message:    fmt.Sprintf("%q for host %q repeats the global value %q", key, host, value),
+					Suggestion: fmt.Sprintf("remove the host-level entry and rely on the global `%s` setting", key),
+				})
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+func checkKey(key, value, host string) []Diagnostic {
+	if advice, ok := deprecatedKeys[key]; ok {
+		return []Diagnostic{{
+			Severity:   DiagnosticWarning,
+			Message:    fmt.Sprintf("%s is deprecated", describeKey(key, host)),
+			Suggestion: advice,
+		}}
+	}
+
+	opt, known := FindOption(key)
+	if !known {
+		return []Diagnostic{{
+			Severity:   DiagnosticWarning,
+			Message:    fmt.Sprintf("%s is not a known configuration key", describeKey(key, host)),
+			Suggestion: "remove it from the configuration file; gh will never read it",
+		}}
+	}
+
+	if len(opt.AllowedValues) > 0 && !slices.Contains(opt.AllowedValues, value) {
+		suggestion := fmt.Sprintf("set a valid value with `gh config set %s <%s>`", key, strings.Join(opt.AllowedValues, "|"))
+		if host != "" {
+			suggestion += fmt.Sprintf(" using `--host %s`", host)
+		}
+		return []Diagnostic{{
+			Severity:   DiagnosticError,
+			Message:    fmt.Sprintf("%s has invalid value %q", describeKey(key, host), value),
+			Suggestion: suggestion,
+		}}
+	}
+
+	return nil
+}
+
+func describeKey(key, host string) string {
+	if host != "" {
+		return fmt.Sprintf("%q for host %q", key, host)
+	}
+	return fmt.Sprintf("%q", key)
+}
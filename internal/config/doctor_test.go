@@ -0,0 +1,79 @@
+package config
+
+import (
+	"testing"
+
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnoseCleanConfig(t *testing.T) {
+	c := ghConfig.ReadFromString(`
+git_protocol: ssh
+editor: vim
+hosts:
+  github.com:
+    user: user1
+    oauth_token: xyz
+`)
+	assert.Empty(t, Diagnose(c))
+}
+
+func TestDiagnoseUnknownKey(t *testing.T) {
+	c := ghConfig.ReadFromString(`
+git_protocl: ssh
+`)
+	diagnostics := Diagnose(c)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, DiagnosticWarning, diagnostics[0].Severity)
+	assert.Equal(t, `"git_protocl" is not a known configuration key`, diagnostics[0].Message)
+}
+
+func TestDiagnoseDeprecatedKey(t *testing.T) {
+	c := ghConfig.ReadFromString(`
+oauth_token: xyz
+`)
+	diagnostics := Diagnose(c)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, DiagnosticWarning, diagnostics[0].Severity)
+	assert.Equal(t, `"oauth_token" is deprecated`, diagnostics[0].Message)
+	assert.Contains(t, diagnostics[0].Suggestion, "gh auth login")
+}
+
+func TestDiagnoseInvalidValue(t *testing.T) {
+	c := ghConfig.ReadFromString(`
+git_protocol: rsync
+`)
+	diagnostics := Diagnose(c)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, DiagnosticError, diagnostics[0].Severity)
+	assert.Equal(t, `"git_protocol" has invalid value "rsync"`, diagnostics[0].Message)
+	assert.Equal(t, "set a valid value with `gh config set git_protocol <https|ssh>`", diagnostics[0].Suggestion)
+}
+
+func TestDiagnosePerHostInvalidValue(t *testing.T) {
+	c := ghConfig.ReadFromString(`
+hosts:
+  ghe.example.com:
+    prompt: sometimes
+`)
+	diagnostics := Diagnose(c)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, DiagnosticError, diagnostics[0].Severity)
+	assert.Equal(t, `"prompt" for host "ghe.example.com" has invalid value "sometimes"`, diagnostics[0].Message)
+	assert.Contains(t, diagnostics[0].Suggestion, "--host ghe.example.com")
+}
+
+func TestDiagnoseRedundantPerHostValue(t *testing.T) {
+	c := ghConfig.ReadFromString(`
+editor: vim
+hosts:
+  github.com:
+    editor: vim
+`)
+	diagnostics := Diagnose(c)
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, DiagnosticWarning, diagnostics[0].Severity)
+	assert.Equal(t, `"editor" for host "github.com" repeats the global value "vim"`, diagnostics[0].Message)
+}
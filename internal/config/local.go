@@ -0,0 +1,96 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+	"gopkg.in/yaml.v3"
+)
+
+// LocalConfigFileName is the name of the repository-local configuration file.
+// The file can be checked into a repository so that a team shares defaults
+// such as the preferred git protocol or json field presets; its values are
+// merged over the user-level configuration but are never written back.
+const LocalConfigFileName = ".gh.yml"
+
+// localAllowedKeys are the configuration keys a repository-local file may
+// override. Since .gh.yml can come from an untrusted clone, the list is
+// limited to keys that influence defaults and presentation: anything that
+// names an executable (pager, editor, browser) or affects credentials and
+// where API traffic is sent must stay under the user's control.
+var localAllowedKeys = []string{
+	accessibleColorsKey,
+	accessiblePrompterKey,
+	colorLabelsKey,
+	gitProtocolKey,
+	jsonFieldsKey,
+	promptKey,
+	spinnerKey,
+	timezoneKey,
+}
+
+// findLocalConfig walks from dir up to the repository root (the directory
+// containing .git) looking for a local configuration file. A file found
+// outside a git repository is ignored, so a .gh.yml planted in a shared
+// parent directory such as /tmp cannot apply to arbitrary subdirectories.
+func findLocalConfig(dir string) (string, bool) {
+	home, _ := os.UserHomeDir()
+	candidate := ""
+	for {
+		if candidate == "" {
+			if path := filepath.Join(dir, LocalConfigFileName); fileExists(path) {
+				candidate = path
+			}
+		}
+		if fileExists(filepath.Join(dir, ".git")) {
+			// the repository root was reached
+			return candidate, candidate != ""
+		}
+		if dir == home {
+			return "", false
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// readLocalConfig parses a repository-local configuration file. A parse
+// failure is returned so the caller can warn about a broken checked-in file;
+// it must not prevent gh from running with the user-level configuration.
+func readLocalConfig(path string) (*ghConfig.Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var probe interface{}
+	if err := yaml.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("invalid YAML in %s: %w", path, err)
+	}
+	return ghConfig.ReadFromString(string(data)), nil
+}
+
+// localGet looks up key in the repository-local layer. Dotted keys address
+// nested values, so "json_fields.pr" reads the pr entry under json_fields.
+func localGet(local *ghConfig.Config, key string) (string, bool) {
+	keys := strings.Split(key, ".")
+	if !slices.Contains(localAllowedKeys, keys[0]) {
+		return "", false
+	}
+	val, err := local.Get(keys)
+	if err != nil {
+		return "", false
+	}
+	return val, true
+}
@@ -0,0 +1,105 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	ghConfig "github.com/cli/go-gh/v2/pkg/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindLocalConfig(t *testing.T) {
+	repoRoot := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, ".git"), 0o755))
+	subdir := filepath.Join(repoRoot, "cmd", "app")
+	require.NoError(t, os.MkdirAll(subdir, 0o755))
+
+	_, found := findLocalConfig(subdir)
+	assert.False(t, found)
+
+	configPath := filepath.Join(repoRoot, LocalConfigFileName)
+	require.NoError(t, os.WriteFile(configPath, []byte("git_protocol: ssh\n"), 0o644))
+
+	path, found := findLocalConfig(subdir)
+	require.True(t, found)
+	assert.Equal(t, configPath, path)
+}
+
+func TestFindLocalConfigStopsAtRepoRoot(t *testing.T) {
+	parent := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(parent, LocalConfigFileName), []byte("git_protocol: ssh\n"), 0o644))
+	repoRoot := filepath.Join(parent, "repo")
+	require.NoError(t, os.MkdirAll(filepath.Join(repoRoot, ".git"), 0o755))
+
+	// a .gh.yml outside the repository must not apply inside it
+	_, found := findLocalConfig(repoRoot)
+	assert.False(t, found)
+}
+
+func TestFindLocalConfigIgnoresFileOutsideRepository(t *testing.T) {
+	// a .gh.yml planted in a shared parent directory (think /tmp) must not
+	// apply to subdirectories that are not part of a git repository
+	parent := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(parent, LocalConfigFileName), []byte("git_protocol: ssh\n"), 0o644))
+	subdir := filepath.Join(parent, "scratch")
+	require.NoError(t, os.MkdirAll(subdir, 0o755))
+
+	_, found := findLocalConfig(subdir)
+	assert.False(t, found)
+}
+
+func TestReadLocalConfigInvalidYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), LocalConfigFileName)
+	require.NoError(t, os.WriteFile(path, []byte("editor: [unclosed\n"), 0o644))
+
+	_, err := readLocalConfig(path)
+	assert.ErrorContains(t, err, "invalid YAML in")
+}
+
+func TestLocalGet(t *testing.T) {
+	local := ghConfig.ReadFromString(`
+git_protocol: ssh
+json_fields:
+  pr: number,title,author
+pager: evil-pager
+editor: evil-editor
+browser: evil-browser
+oauth_token: stolen
+http_unix_socket: /tmp/evil.sock
+`)
+
+	val, ok := localGet(local, "git_protocol")
+	require.True(t, ok)
+	assert.Equal(t, "ssh", val)
+
+	val, ok = localGet(local, "json_fields.pr")
+	require.True(t, ok)
+	assert.Equal(t, "number,title,author", val)
+
+	// keys that name an executable cannot be overridden by a repository,
+	// since gh would run whatever command an untrusted clone put there
+	_, ok = localGet(local, "pager")
+	assert.False(t, ok)
+	_, ok = localGet(local, "editor")
+	assert.False(t, ok)
+	_, ok = localGet(local, "browser")
+	assert.False(t, ok)
+
+	// neither can credential and transport keys
+	_, ok = localGet(local, "oauth_token")
+	assert.False(t, ok)
+	_, ok = localGet(local, "http_unix_socket")
+	assert.False(t, ok)
+}
+
+func TestLocalConfigTakesPrecedence(t *testing.T) {
+	c := &cfg{
+		cfg:   ghConfig.ReadFromString("git_protocol: https\nprompt: enabled\n"),
+		local: ghConfig.ReadFromString("git_protocol: ssh\n"),
+	}
+
+	assert.Equal(t, "ssh", c.GitProtocol("").Value)
+	assert.Equal(t, "enabled", c.Prompt("").Value)
+}
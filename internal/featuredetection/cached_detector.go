@@ -0,0 +1,198 @@
+package featuredetection
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+
+	ghauth "github.com/cli/go-gh/v2/pkg/auth"
+)
+
+// cachedFeaturesVersion identifies the on-disk format and is incremented whenever the
+// feature structs change, so stale entries never misreport a capability.
+const cachedFeaturesVersion = 2
+
+// cachedFeaturesTTL bounds how stale a persisted detection result may get before the
+// host is probed again.
+const cachedFeaturesTTL = 24 * time.Hour
+
+// cachedFeatures is the persisted form of one host's detection results. Sections are
+// pointers so that only the feature sets that were actually probed get recorded.
+type cachedFeatures struct {
+	Version     int                  `json:"version"`
+	Host        string               `json:"host"`
+	GHESVersion string               `json:"ghes_version,omitempty"`
+	RecordedAt  time.Time            `json:"recorded_at"`
+	Issue       *IssueFeatures       `json:"issue,omitempty"`
+	PullRequest *PullRequestFeatures `json:"pull_request,omitempty"`
+	Repository  *RepositoryFeatures  `json:"repository,omitempty"`
+}
+
+type cachedDetector struct {
+	delegate   Detector
+	httpClient *http.Client
+	host       string
+	cacheDir   string
+
+	versionOnce sync.Once
+	ghesVersion string
+
+	mu       sync.Mutex
+	features *cachedFeatures
+	now      func() time.Time
+}
+
+// NewCachedDetector wraps a Detector so that detection results are persisted per host
+// under cacheDir and reused across processes until they expire. Entries are keyed by
+// the GHES version reported by the host, so a server upgrade triggers a fresh probe
+// instead of serving capabilities detected against the previous release.
+func NewCachedDetector(delegate Detector, httpClient *http.Client, host, cacheDir string) Detector {
+	return &cachedDetector{
+		delegate:   delegate,
+		httpClient: httpClient,
+		host:       host,
+		cacheDir:   cacheDir,
+		now:        time.Now,
+	}
+}
+
+func (d *cachedDetector) IssueFeatures() (IssueFeatures, error) {
+	if c := d.load(); c != nil && c.Issue != nil {
+		return *c.Issue, nil
+	}
+	features, err := d.delegate.IssueFeatures()
+	if err != nil {
+		return features, err
+	}
+	d.store(func(c *cachedFeatures) {
+		c.Issue = &features
+	})
+	return features, nil
+}
+
+func (d *cachedDetector) PullRequestFeatures() (PullRequestFeatures, error) {
+	if c := d.load(); c != nil && c.PullRequest != nil {
+		return *c.PullRequest, nil
+	}
+	features, err := d.delegate.PullRequestFeatures()
+	if err != nil {
+		return features, err
+	}
+	d.store(func(c *cachedFeatures) {
+		c.PullRequest = &features
+	})
+	return features, nil
+}
+
+func (d *cachedDetector) RepositoryFeatures() (RepositoryFeatures, error) {
+	if c := d.load(); c != nil && c.Repository != nil {
+		return *c.Repository, nil
+	}
+	features, err := d.delegate.RepositoryFeatures()
+	if err != nil {
+		return features, err
+	}
+	d.store(func(c *cachedFeatures) {
+		c.Repository = &features
+	})
+	return features, nil
+}
+
+// ProjectsV1 is derived from the host alone, so there is nothing worth caching.
+func (d *cachedDetector) ProjectsV1() gh.ProjectsV1Support {
+	return d.delegate.ProjectsV1()
+}
+
+func (d *cachedDetector) cachePath() string {
+	// hosts never contain path separators, but be defensive about ports
+	name := strings.ReplaceAll(d.host, ":", "-") + ".json"
+	return filepath.Join(d.cacheDir, "feature-detection", name)
+}
+
+// serverVersion returns the version string a GHES host reports from its meta endpoint,
+// or an empty string for github.com and hosts that could not be queried. It is fetched
+// at most once per process.
+func (d *cachedDetector) serverVersion() string {
+	d.versionOnce.Do(func() {
+		if !ghauth.IsEnterprise(d.host) {
+			return
+		}
+		var meta struct {
+			InstalledVersion string `json:"installed_version"`
+		}
+		client := api.NewClientFromHTTP(d.httpClient)
+		if err := client.REST(d.host, "GET", "meta", nil, &meta); err == nil {
+			d.ghesVersion = meta.InstalledVersion
+		}
+	})
+	return d.ghesVersion
+}
+
+// load returns the persisted detection results for the host, or nil when there is no
+// usable entry: missing, corrupt, expired, a different format version, or recorded
+// against a different GHES version.
+func (d *cachedDetector) load() *cachedFeatures {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.features != nil {
+		return d.features
+	}
+
+	data, err := os.ReadFile(d.cachePath())
+	if err != nil {
+		return nil
+	}
+	var c cachedFeatures
+	if err := json.Unmarshal(data, &c); err != nil {
+		return nil
+	}
+	if c.Version != cachedFeaturesVersion || c.Host != d.host {
+		return nil
+	}
+	if d.now().Sub(c.RecordedAt) > cachedFeaturesTTL {
+		return nil
+	}
+	if c.GHESVersion != d.serverVersion() {
+		return nil
+	}
+
+	d.features = &c
+	return d.features
+}
+
+// store merges freshly detected features into the persisted entry. Failures are
+// ignored: the cache is an optimization and detection already succeeded.
+func (d *cachedDetector) store(fill func(*cachedFeatures)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	c := d.features
+	if c == nil {
+		c = &cachedFeatures{
+			Version:     cachedFeaturesVersion,
+			Host:        d.host,
+			GHESVersion: d.serverVersion(),
+			RecordedAt:  d.now(),
+		}
+	}
+	fill(c)
+	d.features = c
+
+	data, err := json.Marshal(c)
+	if err != nil {
+		return
+	}
+	path := d.cachePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0600)
+}
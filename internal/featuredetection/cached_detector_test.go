@@ -0,0 +1,106 @@
+package featuredetection
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingDetector records how many times each feature set was probed.
+type countingDetector struct {
+	issueCalls       int
+	pullRequestCalls int
+	repositoryCalls  int
+}
+
+func (d *countingDetector) IssueFeatures() (IssueFeatures, error) {
+	d.issueCalls++
+	return IssueFeatures{StateReason: true}, nil
+}
+
+func (d *countingDetector) PullRequestFeatures() (PullRequestFeatures, error) {
+	d.pullRequestCalls++
+	return PullRequestFeatures{MergeQueue: true}, nil
+}
+
+func (d *countingDetector) RepositoryFeatures() (RepositoryFeatures, error) {
+	d.repositoryCalls++
+	return RepositoryFeatures{AutoMerge: true}, nil
+}
+
+func (d *countingDetector) ProjectsV1() gh.ProjectsV1Support {
+	return gh.ProjectsV1Unsupported
+}
+
+func TestCachedDetectorPersistsAcrossInstances(t *testing.T) {
+	cacheDir := t.TempDir()
+	delegate := &countingDetector{}
+
+	d1 := NewCachedDetector(delegate, &http.Client{}, "github.com", cacheDir)
+	features, err := d1.IssueFeatures()
+	require.NoError(t, err)
+	assert.True(t, features.StateReason)
+
+	// a second detector, as built by a later invocation, reads the persisted entry
+	d2 := NewCachedDetector(delegate, &http.Client{}, "github.com", cacheDir)
+	_, err = d2.IssueFeatures()
+	require.NoError(t, err)
+	_, err = d2.PullRequestFeatures()
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, delegate.issueCalls)
+	assert.Equal(t, 1, delegate.pullRequestCalls)
+}
+
+func TestCachedDetectorExpiry(t *testing.T) {
+	cacheDir := t.TempDir()
+	delegate := &countingDetector{}
+
+	d := NewCachedDetector(delegate, &http.Client{}, "github.com", cacheDir)
+	_, err := d.IssueFeatures()
+	require.NoError(t, err)
+
+	expired := NewCachedDetector(delegate, &http.Client{}, "github.com", cacheDir).(*cachedDetector)
+	expired.now = func() time.Time { return time.Now().Add(cachedFeaturesTTL + time.Minute) }
+	_, err = expired.IssueFeatures()
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, delegate.issueCalls)
+}
+
+func TestCachedDetectorGHESVersionKeying(t *testing.T) {
+	cacheDir := t.TempDir()
+	delegate := &countingDetector{}
+
+	stubMeta := func(version string) *http.Client {
+		reg := &httpmock.Registry{}
+		reg.Register(
+			httpmock.REST("GET", "api/v3/meta"),
+			httpmock.StringResponse(`{"installed_version": "`+version+`"}`),
+		)
+		httpClient := &http.Client{}
+		httpmock.ReplaceTripper(httpClient, reg)
+		return httpClient
+	}
+
+	d1 := NewCachedDetector(delegate, stubMeta("3.12.0"), "ghes.example.com", cacheDir)
+	_, err := d1.IssueFeatures()
+	require.NoError(t, err)
+
+	// same server version reuses the entry
+	d2 := NewCachedDetector(delegate, stubMeta("3.12.0"), "ghes.example.com", cacheDir)
+	_, err = d2.IssueFeatures()
+	require.NoError(t, err)
+	assert.Equal(t, 1, delegate.issueCalls)
+
+	// an upgraded server invalidates it
+	d3 := NewCachedDetector(delegate, stubMeta("3.13.0"), "ghes.example.com", cacheDir)
+	_, err = d3.IssueFeatures()
+	require.NoError(t, err)
+	assert.Equal(t, 2, delegate.issueCalls)
+}
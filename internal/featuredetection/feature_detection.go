@@ -19,10 +19,14 @@ type Detector interface {
 
 type IssueFeatures struct {
 	StateReason bool
+	IssueTypes  bool
+	SubIssues   bool
 }
 
 var allIssueFeatures = IssueFeatures{
 	StateReason: true,
+	IssueTypes:  true,
+	SubIssues:   true,
 }
 
 type PullRequestFeatures struct {
@@ -44,12 +48,14 @@ type RepositoryFeatures struct {
 	PullRequestTemplateQuery bool
 	VisibilityField          bool
 	AutoMerge                bool
+	Attestations             bool
 }
 
 var allRepositoryFeatures = RepositoryFeatures{
 	PullRequestTemplateQuery: true,
 	VisibilityField:          true,
 	AutoMerge:                true,
+	Attestations:             true,
 }
 
 type detector struct {
@@ -91,6 +97,12 @@ func (d *detector) IssueFeatures() (IssueFeatures, error) {
 		if field.Name == "stateReason" {
 			features.StateReason = true
 		}
+		if field.Name == "issueType" {
+			features.IssueTypes = true
+		}
+		if field.Name == "subIssues" {
+			features.SubIssues = true
+		}
 	}
 
 	return features, nil
@@ -178,6 +190,9 @@ func (d *detector) RepositoryFeatures() (RepositoryFeatures, error) {
 				Name string
 			} `graphql:"fields(includeDeprecated: true)"`
 		} `graphql:"Repository: __type(name: \"Repository\")"`
+		Attestation struct {
+			Name string
+		} `graphql:"Attestation: __type(name: \"Attestation\")"`
 	}
 
 	gql := api.NewClientFromHTTP(d.httpClient)
@@ -199,6 +214,8 @@ func (d *detector) RepositoryFeatures() (RepositoryFeatures, error) {
 		}
 	}
 
+	features.Attestations = featureDetection.Attestation.Name != ""
+
 	return features, nil
 }
 
@@ -24,6 +24,8 @@ func TestIssueFeatures(t *testing.T) {
 			hostname: "github.com",
 			wantFeatures: IssueFeatures{
 				StateReason: true,
+				IssueTypes:  true,
+				SubIssues:   true,
 			},
 			wantErr: false,
 		},
@@ -32,6 +34,8 @@ func TestIssueFeatures(t *testing.T) {
 			hostname: "stampname.ghe.com",
 			wantFeatures: IssueFeatures{
 				StateReason: true,
+				IssueTypes:  true,
+				SubIssues:   true,
 			},
 			wantErr: false,
 		},
@@ -61,6 +65,23 @@ func TestIssueFeatures(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:     "GHE has issue types and sub-issues",
+			hostname: "git.my.org",
+			queryResponse: map[string]string{
+				`query Issue_fields\b`: heredoc.Doc(`
+					{ "data": { "Issue": { "fields": [
+						{"name": "issueType"},
+						{"name": "subIssues"}
+					] } } }
+				`),
+			},
+			wantFeatures: IssueFeatures{
+				IssueTypes: true,
+				SubIssues:  true,
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -278,6 +299,7 @@ func TestRepositoryFeatures(t *testing.T) {
 				PullRequestTemplateQuery: true,
 				VisibilityField:          true,
 				AutoMerge:                true,
+				Attestations:             true,
 			},
 			wantErr: false,
 		},
@@ -288,6 +310,7 @@ func TestRepositoryFeatures(t *testing.T) {
 				PullRequestTemplateQuery: true,
 				VisibilityField:          true,
 				AutoMerge:                true,
+				Attestations:             true,
 			},
 			wantErr: false,
 		},
@@ -347,6 +370,20 @@ func TestRepositoryFeatures(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:     "GHE has attestation type",
+			hostname: "git.my.org",
+			queryResponse: map[string]string{
+				`query Repository_fields\b`: heredoc.Doc(`
+					{ "data": { "Repository": { "fields": [] },
+						"Attestation": { "name": "Attestation" } } }
+				`),
+			},
+			wantFeatures: RepositoryFeatures{
+				Attestations: true,
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tt := range tests {
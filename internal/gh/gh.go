@@ -61,6 +61,9 @@ type Config interface {
 	// Aliases provides persistent storage and modification of command aliases.
 	Aliases() AliasConfig
 
+	// SavedReplies provides access to canned comment bodies defined in configuration.
+	SavedReplies() SavedReplyConfig
+
 	// Authentication provides persistent storage and modification of authentication configuration.
 	Authentication() AuthConfig
 
@@ -182,3 +185,13 @@ type AliasConfig interface {
 	// All returns a map of all aliases to their corresponding expansions.
 	All() map[string]string
 }
+
+// SavedReplyConfig reads canned comment bodies defined under the
+// saved_replies configuration key.
+type SavedReplyConfig interface {
+	// Get retrieves the body template for a named saved reply.
+	Get(name string) (body string, err error)
+
+	// All returns a map of all saved reply names to their body templates.
+	All() map[string]string
+}
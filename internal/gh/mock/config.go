@@ -64,6 +64,9 @@ var _ gh.Config = &ConfigMock{}
 //			PromptFunc: func(hostname string) gh.ConfigEntry {
 //				panic("mock out the Prompt method")
 //			},
+//			SavedRepliesFunc: func() gh.SavedReplyConfig {
+//				panic("mock out the SavedReplies method")
+//			},
 //			SetFunc: func(hostname string, key string, value string)  {
 //				panic("mock out the Set method")
 //			},
@@ -128,6 +131,9 @@ type ConfigMock struct {
 	// PromptFunc mocks the Prompt method.
 	PromptFunc func(hostname string) gh.ConfigEntry
 
+	// SavedRepliesFunc mocks the SavedReplies method.
+	SavedRepliesFunc func() gh.SavedReplyConfig
+
 	// SetFunc mocks the Set method.
 	SetFunc func(hostname string, key string, value string)
 
@@ -213,6 +219,9 @@ type ConfigMock struct {
 			// Hostname is the hostname argument value.
 			Hostname string
 		}
+		// SavedReplies holds details about calls to the SavedReplies method.
+		SavedReplies []struct {
+		}
 		// Set holds details about calls to the Set method.
 		Set []struct {
 			// Hostname is the hostname argument value.
@@ -249,6 +258,7 @@ type ConfigMock struct {
 	lockPager              sync.RWMutex
 	lockPreferEditorPrompt sync.RWMutex
 	lockPrompt             sync.RWMutex
+	lockSavedReplies       sync.RWMutex
 	lockSet                sync.RWMutex
 	lockSpinner            sync.RWMutex
 	lockVersion            sync.RWMutex
@@ -724,6 +734,33 @@ func (mock *ConfigMock) PromptCalls() []struct {
 	return calls
 }
 
+// SavedReplies calls SavedRepliesFunc.
+func (mock *ConfigMock) SavedReplies() gh.SavedReplyConfig {
+	if mock.SavedRepliesFunc == nil {
+		panic("ConfigMock.SavedRepliesFunc: method is nil but Config.SavedReplies was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockSavedReplies.Lock()
+	mock.calls.SavedReplies = append(mock.calls.SavedReplies, callInfo)
+	mock.lockSavedReplies.Unlock()
+	return mock.SavedRepliesFunc()
+}
+
+// SavedRepliesCalls gets all the calls that were made to SavedReplies.
+// Check the length with:
+//
+//	len(mockedConfig.SavedRepliesCalls())
+func (mock *ConfigMock) SavedRepliesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockSavedReplies.RLock()
+	calls = mock.calls.SavedReplies
+	mock.lockSavedReplies.RUnlock()
+	return calls
+}
+
 // Set calls SetFunc.
 func (mock *ConfigMock) Set(hostname string, key string, value string) {
 	if mock.SetFunc == nil {
@@ -2,14 +2,17 @@ package ghcmd
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path/filepath"
 	"strings"
+	"syscall"
 	"time"
 
 	surveyCore "github.com/AlecAivazis/survey/v2/core"
@@ -18,6 +21,7 @@ import (
 	"github.com/cli/cli/v2/internal/build"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/config/migration"
+	"github.com/cli/cli/v2/internal/metrics"
 	"github.com/cli/cli/v2/internal/update"
 	"github.com/cli/cli/v2/pkg/cmd/factory"
 	"github.com/cli/cli/v2/pkg/cmd/root"
@@ -47,15 +51,41 @@ const (
 	exitPending exitCode = 8
 )
 
-func Main() exitCode {
+func Main() (status exitCode) {
 	buildDate := build.Date
 	buildVersion := build.Version
 	hasDebug, _ := utils.IsDebugEnabled()
 
+	// executedCommandPath is filled in once command line parsing has resolved
+	// which command is running, for the opt-in local metrics store.
+	executedCommandPath := "gh"
+	if metrics.Enabled() {
+		invocationStart := time.Now()
+		defer func() {
+			if err := metrics.Record(metrics.FilePath(), executedCommandPath, invocationStart, int(status)); err != nil && hasDebug {
+				fmt.Fprintf(os.Stderr, "warning: recording metrics failed: %v\n", err)
+			}
+		}()
+	}
+
 	cmdFactory := factory.New(buildVersion)
 	stderr := cmdFactory.IOStreams.ErrOut
 
-	ctx := context.Background()
+	// Cancel the invocation context on the first interrupt or termination
+	// signal so that commands, subprocesses, and in-flight requests can shut
+	// down cleanly. After the first signal the handler is removed, so a
+	// second signal falls back to the default behavior of killing the
+	// process.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-signalChan
+		cancel()
+		signal.Stop(signalChan)
+	}()
+	cmdFactory.Context = ctx
 
 	if cfg, err := cmdFactory.Config(); err == nil {
 		var m migration.MultiAccount
@@ -108,6 +138,17 @@ func Main() exitCode {
 		expandedArgs = os.Args[1:]
 	}
 
+	// `gh --explain <command line>` prints how the invocation would resolve —
+	// alias expansion, extension dispatch, or internal command — without
+	// executing it.
+	if len(expandedArgs) > 0 && expandedArgs[0] == "--explain" {
+		if err := root.Explain(cmdFactory.IOStreams.Out, rootCmd, cmdFactory, expandedArgs[1:]); err != nil {
+			fmt.Fprintln(stderr, err)
+			return exitError
+		}
+		return exitOK
+	}
+
 	// translate `gh help <command>` to `gh <command> --help` for extensions.
 	if len(expandedArgs) >= 2 && expandedArgs[0] == "help" && isExtensionCommand(rootCmd, expandedArgs[1:]) {
 		expandedArgs = expandedArgs[1:]
@@ -116,7 +157,11 @@ func Main() exitCode {
 
 	rootCmd.SetArgs(expandedArgs)
 
-	if cmd, err := rootCmd.ExecuteContextC(ctx); err != nil {
+	cmd, err := rootCmd.ExecuteContextC(ctx)
+	if cmd != nil {
+		executedCommandPath = cmd.CommandPath()
+	}
+	if err != nil {
 		var pagerPipeError *iostreams.ErrClosedPagerPipe
 		var noResultsError cmdutil.NoResultsError
 		var extError *root.ExternalCommandExitError
@@ -147,22 +192,16 @@ func Main() exitCode {
 			return exitCode(extError.ExitCode())
 		}
 
-		printError(stderr, err, cmd, hasDebug)
+		hint := errorHint(err)
 
-		if strings.Contains(err.Error(), "Incorrect function") {
-			fmt.Fprintln(stderr, "You appear to be running in MinTTY without pseudo terminal support.")
-			fmt.Fprintln(stderr, "To learn about workarounds for this error, run:  gh help mintty")
+		if os.Getenv("GH_ERROR_FORMAT") == "json" {
+			printJSONError(stderr, err, hint)
 			return exitError
 		}
 
-		var httpErr api.HTTPError
-		if errors.As(err, &httpErr) && httpErr.StatusCode == 401 {
-			fmt.Fprintln(stderr, "Try authenticating with:  gh auth login")
-		} else if u := factory.SSOURL(); u != "" {
-			// handles organization SAML enforcement error
-			fmt.Fprintf(stderr, "Authorize in your web browser:  %s\n", u)
-		} else if msg := httpErr.ScopesSuggestion(); msg != "" {
-			fmt.Fprintln(stderr, msg)
+		printError(stderr, err, cmd, hasDebug)
+		if hint != "" {
+			fmt.Fprintln(stderr, hint)
 		}
 
 		return exitError
@@ -199,6 +238,65 @@ func isExtensionCommand(rootCmd *cobra.Command, args []string) bool {
 	return err == nil && c != nil && c.GroupID == "extension"
 }
 
+// errorHint returns remediation advice to print after an error, or the empty
+// string when there is none to give.
+func errorHint(err error) string {
+	if strings.Contains(err.Error(), "Incorrect function") {
+		return "You appear to be running in MinTTY without pseudo terminal support.\n" +
+			"To learn about workarounds for this error, run:  gh help mintty"
+	}
+
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == 401 {
+		return "Try authenticating with:  gh auth login"
+	} else if u := factory.SSOURL(); u != "" {
+		// handles organization SAML enforcement error
+		return fmt.Sprintf("Authorize in your web browser:  %s", u)
+	} else if msg := httpErr.ScopesSuggestion(); msg != "" {
+		return msg
+	}
+	return ""
+}
+
+// errorEnvelope is the structured form of a command failure emitted when
+// GH_ERROR_FORMAT=json is set, so that wrapping tools can branch on error
+// properties instead of matching display strings.
+type errorEnvelope struct {
+	Type       string `json:"type"`
+	Message    string `json:"message"`
+	HTTPStatus int    `json:"httpStatus,omitempty"`
+	RequestID  string `json:"requestId,omitempty"`
+	Hint       string `json:"hint,omitempty"`
+}
+
+func printJSONError(out io.Writer, err error, hint string) {
+	envelope := errorEnvelope{
+		Type:    "unknown",
+		Message: err.Error(),
+		Hint:    hint,
+	}
+
+	var httpErr api.HTTPError
+	var gqlErr api.GraphQLError
+	var flagErr *cmdutil.FlagError
+	var dnsErr *net.DNSError
+	if errors.As(err, &httpErr) {
+		envelope.Type = "http"
+		envelope.HTTPStatus = httpErr.StatusCode
+		envelope.RequestID = httpErr.Headers.Get("X-Github-Request-Id")
+	} else if errors.As(err, &gqlErr) {
+		envelope.Type = "graphql"
+	} else if errors.As(err, &flagErr) {
+		envelope.Type = "usage"
+	} else if errors.As(err, &dnsErr) {
+		envelope.Type = "network"
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetEscapeHTML(false)
+	_ = encoder.Encode(envelope)
+}
+
 func printError(out io.Writer, err error, cmd *cobra.Command, debug bool) {
 	var dnsError *net.DNSError
 	if errors.As(err, &dnsError) {
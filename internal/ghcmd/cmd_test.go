@@ -5,9 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"net/url"
 	"testing"
 
+	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/pkg/cmdutil"
+	ghAPI "github.com/cli/go-gh/v2/pkg/api"
 	"github.com/spf13/cobra"
 )
 
@@ -76,3 +80,59 @@ check your internet connection or https://githubstatus.com
 		})
 	}
 }
+
+func Test_printJSONError(t *testing.T) {
+	requestURL, _ := url.Parse("https://api.github.com/repos/OWNER/REPO")
+	httpErr := api.HTTPError{
+		HTTPError: &ghAPI.HTTPError{
+			StatusCode: 404,
+			Message:    "Not Found",
+			RequestURL: requestURL,
+			Headers:    http.Header{"X-Github-Request-Id": []string{"1234:5678"}},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		err     error
+		hint    string
+		wantOut string
+	}{
+		{
+			name:    "generic error",
+			err:     errors.New("the app exploded"),
+			wantOut: "{\"type\":\"unknown\",\"message\":\"the app exploded\"}\n",
+		},
+		{
+			name:    "HTTP error",
+			err:     fmt.Errorf("failed to fetch: %w", httpErr),
+			wantOut: "{\"type\":\"http\",\"message\":\"failed to fetch: HTTP 404: Not Found (https://api.github.com/repos/OWNER/REPO)\",\"httpStatus\":404,\"requestId\":\"1234:5678\"}\n",
+		},
+		{
+			name:    "flag error",
+			err:     cmdutil.FlagErrorf("unknown flag --foo"),
+			wantOut: "{\"type\":\"usage\",\"message\":\"unknown flag --foo\"}\n",
+		},
+		{
+			name:    "DNS error",
+			err:     fmt.Errorf("DNS oopsie: %w", &net.DNSError{Name: "api.github.com"}),
+			wantOut: "{\"type\":\"network\",\"message\":\"DNS oopsie: lookup api.github.com: \"}\n",
+		},
+		{
+			name:    "with hint",
+			err:     errors.New("the app exploded"),
+			hint:    "Try authenticating with:  gh auth login",
+			wantOut: "{\"type\":\"unknown\",\"message\":\"the app exploded\",\"hint\":\"Try authenticating with:  gh auth login\"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			printJSONError(out, tt.err, tt.hint)
+			if gotOut := out.String(); gotOut != tt.wantOut {
+				t.Errorf("printJSONError() = %q, want %q", gotOut, tt.wantOut)
+			}
+		})
+	}
+}
@@ -29,6 +29,20 @@ func TenantName(h string) (string, bool) {
 	return cutSuffix(normalizedHostName, "."+tenancyHost)
 }
 
+// IsTenancy reports whether h is a data-residency tenant instance, i.e. a
+// subdomain of ghe.com, rather than github.com or a GitHub Enterprise Server.
+func IsTenancy(h string) bool {
+	return ghauth.IsTenancy(h)
+}
+
+// NormalizeHostname collapses service subdomains such as api.* and gist.* to
+// the canonical host name for github.com, github.localhost, and ghe.com
+// tenants. Enterprise Server host names are returned lowercased but otherwise
+// unchanged.
+func NormalizeHostname(h string) string {
+	return ghauth.NormalizeHostname(h)
+}
+
 func isGarage(h string) bool {
 	return strings.EqualFold(h, "garage.github.com")
 }
@@ -53,6 +67,11 @@ func GraphQLEndpoint(hostname string) string {
 	if strings.EqualFold(hostname, localhost) {
 		return fmt.Sprintf("http://api.%s/graphql", hostname)
 	}
+	// normalize so that service subdomains of a tenant, such as
+	// gist.tenant.ghe.com, still produce api.tenant.ghe.com
+	if IsTenancy(hostname) {
+		return fmt.Sprintf("https://api.%s/graphql", NormalizeHostname(hostname))
+	}
 	return fmt.Sprintf("https://api.%s/graphql", hostname)
 }
 
@@ -66,6 +85,9 @@ func RESTPrefix(hostname string) string {
 	if strings.EqualFold(hostname, localhost) {
 		return fmt.Sprintf("http://api.%s/", hostname)
 	}
+	if IsTenancy(hostname) {
+		return fmt.Sprintf("https://api.%s/", NormalizeHostname(hostname))
+	}
 	return fmt.Sprintf("https://api.%s/", hostname)
 }
 
@@ -87,9 +109,30 @@ func GistHost(hostname string) string {
 	if strings.EqualFold(hostname, localhost) {
 		return fmt.Sprintf("%s/gist/", hostname)
 	}
+	if IsTenancy(hostname) {
+		return fmt.Sprintf("gist.%s/", NormalizeHostname(hostname))
+	}
 	return fmt.Sprintf("gist.%s/", hostname)
 }
 
+// RawContentHost returns the host serving raw file and gist content for an
+// instance, with a trailing slash, mirroring the shape of GistHost.
+func RawContentHost(hostname string) string {
+	if isGarage(hostname) {
+		return fmt.Sprintf("%s/raw/", hostname)
+	}
+	if ghauth.IsEnterprise(hostname) {
+		return fmt.Sprintf("%s/raw/", hostname)
+	}
+	if strings.EqualFold(hostname, localhost) {
+		return fmt.Sprintf("%s/raw/", hostname)
+	}
+	if IsTenancy(hostname) {
+		return fmt.Sprintf("raw.%s/", NormalizeHostname(hostname))
+	}
+	return "raw.githubusercontent.com/"
+}
+
 func HostPrefix(hostname string) string {
 	if strings.EqualFold(hostname, localhost) {
 		return fmt.Sprintf("http://%s/", hostname)
@@ -48,6 +48,46 @@ func TestTenantName(t *testing.T) {
 	}
 }
 
+func TestIsTenancy(t *testing.T) {
+	tests := []struct {
+		host string
+		want bool
+	}{
+		{host: "github.com", want: false},
+		{host: "github.localhost", want: false},
+		{host: "garage.github.com", want: false},
+		{host: "ghe.io", want: false},
+		{host: "ghe.com", want: false},
+		{host: "tenant.ghe.com", want: true},
+		{host: "api.tenant.ghe.com", want: true},
+		{host: "gist.tenant.ghe.com", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsTenancy(tt.host))
+		})
+	}
+}
+
+func TestNormalizeHostname(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{host: "GitHub.com", want: "github.com"},
+		{host: "api.github.com", want: "github.com"},
+		{host: "ghe.io", want: "ghe.io"},
+		{host: "tenant.ghe.com", want: "tenant.ghe.com"},
+		{host: "api.tenant.ghe.com", want: "tenant.ghe.com"},
+		{host: "gist.tenant.ghe.com", want: "tenant.ghe.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			assert.Equal(t, tt.want, NormalizeHostname(tt.host))
+		})
+	}
+}
+
 func TestHostnameValidator(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -113,6 +153,10 @@ func TestGraphQLEndpoint(t *testing.T) {
 			host: "tenant.ghe.com",
 			want: "https://api.tenant.ghe.com/graphql",
 		},
+		{
+			host: "gist.tenant.ghe.com",
+			want: "https://api.tenant.ghe.com/graphql",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.host, func(t *testing.T) {
@@ -148,6 +192,10 @@ func TestRESTPrefix(t *testing.T) {
 			host: "tenant.ghe.com",
 			want: "https://api.tenant.ghe.com/",
 		},
+		{
+			host: "gist.tenant.ghe.com",
+			want: "https://api.tenant.ghe.com/",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.host, func(t *testing.T) {
@@ -157,3 +205,81 @@ func TestRESTPrefix(t *testing.T) {
 		})
 	}
 }
+
+func TestGistHost(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{
+			host: "github.com",
+			want: "gist.github.com/",
+		},
+		{
+			host: "github.localhost",
+			want: "github.localhost/gist/",
+		},
+		{
+			host: "garage.github.com",
+			want: "garage.github.com/gist/",
+		},
+		{
+			host: "ghe.io",
+			want: "ghe.io/gist/",
+		},
+		{
+			host: "tenant.ghe.com",
+			want: "gist.tenant.ghe.com/",
+		},
+		{
+			host: "api.tenant.ghe.com",
+			want: "gist.tenant.ghe.com/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			if got := GistHost(tt.host); got != tt.want {
+				t.Errorf("GistHost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRawContentHost(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{
+			host: "github.com",
+			want: "raw.githubusercontent.com/",
+		},
+		{
+			host: "github.localhost",
+			want: "github.localhost/raw/",
+		},
+		{
+			host: "garage.github.com",
+			want: "garage.github.com/raw/",
+		},
+		{
+			host: "ghe.io",
+			want: "ghe.io/raw/",
+		},
+		{
+			host: "tenant.ghe.com",
+			want: "raw.tenant.ghe.com/",
+		},
+		{
+			host: "gist.tenant.ghe.com",
+			want: "raw.tenant.ghe.com/",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.host, func(t *testing.T) {
+			if got := RawContentHost(tt.host); got != tt.want {
+				t.Errorf("RawContentHost() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
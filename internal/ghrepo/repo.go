@@ -10,7 +10,9 @@ import (
 	"github.com/cli/go-gh/v2/pkg/repository"
 )
 
-// Interface describes an object that represents a GitHub repository
+// Interface describes an object that represents a GitHub repository. On hosts
+// that nest repositories under group paths, RepoOwner carries the full
+// "GROUP/SUBGROUP" owner path rather than a single name.
 type Interface interface {
 	RepoName() string
 	RepoOwner() string
@@ -51,10 +53,44 @@ func FromFullName(nwo string) (Interface, error) {
 // explicitly include a hostname.
 func FromFullNameWithHost(nwo, fallbackHost string) (Interface, error) {
 	repo, err := repository.ParseWithHost(nwo, fallbackHost)
-	if err != nil {
-		return nil, err
+	if err == nil {
+		return NewWithHost(repo.Owner, repo.Name, repo.Host), nil
 	}
-	return NewWithHost(repo.Owner, repo.Name, repo.Host), nil
+	if nested, nestedErr := fromNestedFullName(nwo, fallbackHost); nestedErr == nil {
+		return nested, nil
+	}
+	return nil, err
+}
+
+// fromNestedFullName parses values with more than one slash in the owner
+// position, where everything between the host and the repository name is a
+// nested owner path, e.g. "HOST/GROUP/SUBGROUP/REPO".
+func fromNestedFullName(nwo, fallbackHost string) (Interface, error) {
+	if strings.Contains(nwo, "://") {
+		u, err := url.Parse(nwo)
+		if err != nil {
+			return nil, err
+		}
+		return FromURL(u)
+	}
+
+	parts := strings.Split(strings.Trim(nwo, "/"), "/")
+	if len(parts) < 3 {
+		return nil, fmt.Errorf(`expected the "[HOST/]OWNER/REPO" format, got %q`, nwo)
+	}
+
+	host := fallbackHost
+	if strings.ContainsAny(parts[0], ".:") {
+		host = parts[0]
+		parts = parts[1:]
+	}
+	if len(parts) < 2 || !supportsNestedPath(host) {
+		return nil, fmt.Errorf(`expected the "[HOST/]OWNER/REPO" format, got %q`, nwo)
+	}
+
+	owner := strings.Join(parts[:len(parts)-1], "/")
+	name := strings.TrimSuffix(parts[len(parts)-1], ".git")
+	return NewWithHost(owner, name, host), nil
 }
 
 // FromURL extracts the GitHub repository information from a git remote URL
@@ -63,12 +99,26 @@ func FromURL(u *url.URL) (Interface, error) {
 		return nil, fmt.Errorf("no hostname detected")
 	}
 
-	parts := strings.SplitN(strings.Trim(u.Path, "/"), "/", 3)
-	if len(parts) != 2 {
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) < 2 || (len(parts) > 2 && !supportsNestedPath(u.Hostname())) {
 		return nil, fmt.Errorf("invalid path: %s", u.Path)
 	}
 
-	return NewWithHost(parts[0], strings.TrimSuffix(parts[1], ".git"), u.Hostname()), nil
+	owner := strings.Join(parts[:len(parts)-1], "/")
+	return NewWithHost(owner, strings.TrimSuffix(parts[len(parts)-1], ".git"), u.Hostname()), nil
+}
+
+// supportsNestedPath reports whether a host may expose repositories under
+// nested group paths. GitHub-owned hosts always use a flat OWNER/REPO layout.
+func supportsNestedPath(hostname string) bool {
+	normalized := normalizeHostname(hostname)
+	if normalized == ghinstance.Default() {
+		return false
+	}
+	if _, isTenant := ghinstance.TenantName(normalized); isTenant {
+		return false
+	}
+	return true
 }
 
 func normalizeHostname(h string) string {
@@ -50,6 +50,20 @@ func Test_repoFromURL(t *testing.T) {
 			host:   "example.com",
 			err:    nil,
 		},
+		{
+			name:   "non-GitHub hostname with nested group path",
+			input:  "https://example.com/group/subgroup/repo.git",
+			result: "group/subgroup/repo",
+			host:   "example.com",
+			err:    nil,
+		},
+		{
+			name:   "tenancy hostname with nested group path",
+			input:  "https://tenant.ghe.com/group/subgroup/repo",
+			result: "",
+			host:   "",
+			err:    errors.New("invalid path: /group/subgroup/repo"),
+		},
 		{
 			name:   "filesystem path",
 			input:  "/path/to/file",
@@ -190,6 +204,36 @@ func TestFromFullName(t *testing.T) {
 			wantName:     "REPO",
 			wantErr:      nil,
 		},
+		{
+			name:      "nested group path with hostname",
+			input:     "example.org/GROUP/SUBGROUP/REPO",
+			wantHost:  "example.org",
+			wantOwner: "GROUP/SUBGROUP",
+			wantName:  "REPO",
+			wantErr:   nil,
+		},
+		{
+			name:         "nested group path with default host override",
+			input:        "GROUP/SUBGROUP/SUBSUBGROUP/REPO",
+			hostOverride: "override.com",
+			wantHost:     "override.com",
+			wantOwner:    "GROUP/SUBGROUP/SUBSUBGROUP",
+			wantName:     "REPO",
+			wantErr:      nil,
+		},
+		{
+			name:      "nested group path full URL",
+			input:     "https://example.org/GROUP/SUBGROUP/REPO.git",
+			wantHost:  "example.org",
+			wantOwner: "GROUP/SUBGROUP",
+			wantName:  "REPO",
+			wantErr:   nil,
+		},
+		{
+			name:    "nested group path rejected on github.com",
+			input:   "github.com/GROUP/SUBGROUP/REPO",
+			wantErr: errors.New(`expected the "[HOST/]OWNER/REPO" format, got "github.com/GROUP/SUBGROUP/REPO"`),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,179 @@
+package keyring
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"golang.org/x/crypto/nacl/secretbox"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	fileBackendVersion = 1
+
+	// ghKeyringPassphrase names the environment variable that, when set, is
+	// used to derive the encryption key for the file backend. Without it the
+	// key is derived from stable machine properties, which protects tokens
+	// against casual reads of the file but not against an attacker that can
+	// run code on the same machine as the same user.
+	ghKeyringPassphrase = "GH_KEYRING_PASSPHRASE"
+)
+
+// secretStore abstracts over where secrets are held so that the system
+// keyring can be swapped for the encrypted file backend on headless machines.
+type secretStore interface {
+	Set(service, user, secret string) error
+	Get(service, user string) (string, error)
+	Delete(service, user string) error
+}
+
+// UseFileBackend routes all keyring operations to an encrypted file at the
+// given path instead of the operating system keyring. Entries are sealed
+// with NaCl secretbox using a key derived via scrypt from either the
+// GH_KEYRING_PASSPHRASE environment variable or a machine-derived key.
+func UseFileBackend(path string) {
+	activeBackend = &fileBackend{path: path}
+}
+
+// fileStore is the on-disk representation of the encrypted file backend.
+type fileStore struct {
+	Version int               `json:"version"`
+	Salt    string            `json:"salt"`
+	Secrets map[string]string `json:"secrets"`
+}
+
+type fileBackend struct {
+	mu   sync.Mutex
+	path string
+}
+
+func (f *fileBackend) Set(service, user, secret string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	store, key, err := f.open()
+	if err != nil {
+		return err
+	}
+
+	var nonce [24]byte
+	if _, err := rand.Read(nonce[:]); err != nil {
+		return err
+	}
+	sealed := secretbox.Seal(nonce[:], []byte(secret), &nonce, key)
+	store.Secrets[secretKey(service, user)] = base64.StdEncoding.EncodeToString(sealed)
+
+	return f.write(store)
+}
+
+func (f *fileBackend) Get(service, user string) (string, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	store, key, err := f.open()
+	if err != nil {
+		return "", err
+	}
+
+	encoded, ok := store.Secrets[secretKey(service, user)]
+	if !ok {
+		return "", ErrNotFound
+	}
+	sealed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < 24 {
+		return "", fmt.Errorf("keyring file %s is corrupt", f.path)
+	}
+	var nonce [24]byte
+	copy(nonce[:], sealed[:24])
+	secret, ok := secretbox.Open(nil, sealed[24:], &nonce, key)
+	if !ok {
+		return "", fmt.Errorf("failed to decrypt secret from %s: wrong passphrase or corrupt file", f.path)
+	}
+	return string(secret), nil
+}
+
+func (f *fileBackend) Delete(service, user string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	store, _, err := f.open()
+	if err != nil {
+		return err
+	}
+	delete(store.Secrets, secretKey(service, user))
+	return f.write(store)
+}
+
+// open reads the store from disk, initializing a fresh one with a random
+// salt if the file does not exist yet, and derives the encryption key.
+func (f *fileBackend) open() (*fileStore, *[32]byte, error) {
+	store := &fileStore{Version: fileBackendVersion, Secrets: map[string]string{}}
+
+	data, err := os.ReadFile(f.path)
+	if errors.Is(err, os.ErrNotExist) {
+		salt := make([]byte, 16)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, nil, err
+		}
+		store.Salt = hex.EncodeToString(salt)
+	} else if err != nil {
+		return nil, nil, err
+	} else if err := json.Unmarshal(data, store); err != nil {
+		return nil, nil, fmt.Errorf("keyring file %s is corrupt: %w", f.path, err)
+	}
+
+	if store.Secrets == nil {
+		store.Secrets = map[string]string{}
+	}
+
+	salt, err := hex.DecodeString(store.Salt)
+	if err != nil {
+		return nil, nil, fmt.Errorf("keyring file %s is corrupt: %w", f.path, err)
+	}
+	derived, err := scrypt.Key(passphrase(), salt, 1<<15, 8, 1, 32)
+	if err != nil {
+		return nil, nil, err
+	}
+	key := new([32]byte)
+	copy(key[:], derived)
+	return store, key, nil
+}
+
+func (f *fileBackend) write(store *fileStore) error {
+	data, err := json.Marshal(store)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.path), 0700); err != nil {
+		return err
+	}
+	return os.WriteFile(f.path, data, 0600)
+}
+
+func secretKey(service, user string) string {
+	return service + "\x00" + user
+}
+
+func passphrase() []byte {
+	if pass := os.Getenv(ghKeyringPassphrase); pass != "" {
+		return []byte(pass)
+	}
+	return machineKey()
+}
+
+// machineKey derives a stable per-machine, per-user key for when no
+// passphrase is configured.
+func machineKey() []byte {
+	hostname, _ := os.Hostname()
+	home, _ := os.UserHomeDir()
+	sum := sha256.Sum256([]byte("gh-keyring:" + hostname + ":" + home))
+	return []byte(hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,55 @@
+package keyring
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFileBackendRoundTrip(t *testing.T) {
+	t.Setenv(ghKeyringPassphrase, "test-passphrase")
+
+	UseFileBackend(filepath.Join(t.TempDir(), "keyring.enc"))
+	t.Cleanup(func() { activeBackend = systemBackend{} })
+
+	require.NoError(t, Set("gh:github.com", "monalisa", "gho_abc123"))
+
+	secret, err := Get("gh:github.com", "monalisa")
+	require.NoError(t, err)
+	require.Equal(t, "gho_abc123", secret)
+
+	require.NoError(t, Delete("gh:github.com", "monalisa"))
+
+	_, err = Get("gh:github.com", "monalisa")
+	require.ErrorIs(t, err, ErrNotFound)
+}
+
+func TestFileBackendWrongPassphrase(t *testing.T) {
+	t.Setenv(ghKeyringPassphrase, "test-passphrase")
+
+	UseFileBackend(filepath.Join(t.TempDir(), "keyring.enc"))
+	t.Cleanup(func() { activeBackend = systemBackend{} })
+
+	require.NoError(t, Set("gh:github.com", "monalisa", "gho_abc123"))
+
+	t.Setenv(ghKeyringPassphrase, "not-the-passphrase")
+
+	_, err := Get("gh:github.com", "monalisa")
+	require.ErrorContains(t, err, "wrong passphrase or corrupt file")
+}
+
+func TestFileBackendSecretsAreNotStoredInPlainText(t *testing.T) {
+	t.Setenv(ghKeyringPassphrase, "test-passphrase")
+
+	path := filepath.Join(t.TempDir(), "keyring.enc")
+	UseFileBackend(path)
+	t.Cleanup(func() { activeBackend = systemBackend{} })
+
+	require.NoError(t, Set("gh:github.com", "monalisa", "gho_abc123"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), "gho_abc123")
+}
@@ -10,6 +10,26 @@ import (
 
 var ErrNotFound = errors.New("secret not found in keyring")
 
+// activeBackend determines where secrets are stored. It defaults to the
+// operating system keyring and may be swapped for the encrypted file backend
+// via UseFileBackend.
+var activeBackend secretStore = systemBackend{}
+
+// systemBackend stores secrets in the operating system keyring.
+type systemBackend struct{}
+
+func (systemBackend) Set(service, user, secret string) error {
+	return keyring.Set(service, user, secret)
+}
+
+func (systemBackend) Get(service, user string) (string, error) {
+	return keyring.Get(service, user)
+}
+
+func (systemBackend) Delete(service, user string) error {
+	return keyring.Delete(service, user)
+}
+
 type TimeoutError struct {
 	message string
 }
@@ -23,7 +43,7 @@ func Set(service, user, secret string) error {
 	ch := make(chan error, 1)
 	go func() {
 		defer close(ch)
-		ch <- keyring.Set(service, user, secret)
+		ch <- activeBackend.Set(service, user, secret)
 	}()
 	select {
 	case err := <-ch:
@@ -41,7 +61,7 @@ func Get(service, user string) (string, error) {
 	}, 1)
 	go func() {
 		defer close(ch)
-		val, err := keyring.Get(service, user)
+		val, err := activeBackend.Get(service, user)
 		ch <- struct {
 			val string
 			err error
@@ -63,7 +83,7 @@ func Delete(service, user string) error {
 	ch := make(chan error, 1)
 	go func() {
 		defer close(ch)
-		ch <- keyring.Delete(service, user)
+		ch <- activeBackend.Delete(service, user)
 	}()
 	select {
 	case err := <-ch:
@@ -74,9 +94,11 @@ func Delete(service, user string) error {
 }
 
 func MockInit() {
+	activeBackend = systemBackend{}
 	keyring.MockInit()
 }
 
 func MockInitWithError(err error) {
+	activeBackend = systemBackend{}
 	keyring.MockInitWithError(err)
 }
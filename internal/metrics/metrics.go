@@ -0,0 +1,109 @@
+// Package metrics implements an opt-in, local-only store of command
+// invocations. When enabled, each run of gh appends one JSON line recording
+// the command name, duration, number of API requests, and exit status to a
+// file under the state directory. Nothing is ever sent off the machine; the
+// store exists so that users and admins can inspect which commands are slow
+// or failing with `gh metrics report`.
+package metrics
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync/atomic"
+	"time"
+
+	"github.com/cli/cli/v2/internal/config"
+)
+
+// Enabled reports whether the user has opted in to local metrics collection
+// by setting GH_METRICS_ENABLED to a truthy value.
+func Enabled() bool {
+	value, isSet := os.LookupEnv("GH_METRICS_ENABLED")
+	falseyValues := []string{"false", "0", "no", ""}
+	return isSet && !slices.Contains(falseyValues, value)
+}
+
+// FilePath is where invocation records are appended, one JSON object per line.
+func FilePath() string {
+	return filepath.Join(config.StateDir(), "metrics.jsonl")
+}
+
+// Entry is one recorded command invocation.
+type Entry struct {
+	Timestamp time.Time `json:"timestamp"`
+	Command   string    `json:"command"`
+	Duration  int64     `json:"durationMs"`
+	APICalls  int64     `json:"apiCalls"`
+	ExitCode  int       `json:"exitCode"`
+}
+
+// apiCalls counts API requests made during this invocation. It is process
+// global because the HTTP client is constructed by the factory long before
+// the executed command is known.
+var apiCalls atomic.Int64
+
+type countingRoundTripper struct {
+	rt http.RoundTripper
+}
+
+func (c countingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	apiCalls.Add(1)
+	return c.rt.RoundTrip(req)
+}
+
+// CountRequests wraps rt so that every request it performs is counted
+// towards the current invocation's API call total.
+func CountRequests(rt http.RoundTripper) http.RoundTripper {
+	return countingRoundTripper{rt: rt}
+}
+
+// Record appends an entry for the command that just finished. Failures are
+// returned rather than printed; recording is best-effort and callers are
+// expected to ignore errors outside of debug output.
+func Record(path, command string, started time.Time, exitStatus int) error {
+	entry := Entry{
+		Timestamp: started.UTC(),
+		Command:   command,
+		Duration:  time.Since(started).Milliseconds(),
+		APICalls:  apiCalls.Load(),
+		ExitCode:  exitStatus,
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return json.NewEncoder(f).Encode(entry)
+}
+
+// Read loads all recorded entries from path. Lines that fail to parse are
+// skipped so that a truncated write cannot make the whole store unreadable.
+// A missing file yields no entries and no error.
+func Read(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
@@ -0,0 +1,74 @@
+package metrics
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnabled(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		enabled bool
+	}{
+		{name: "unset", value: "", enabled: false},
+		{name: "truthy", value: "1", enabled: true},
+		{name: "true", value: "true", enabled: true},
+		{name: "falsey", value: "0", enabled: false},
+		{name: "false", value: "false", enabled: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.value != "" {
+				t.Setenv("GH_METRICS_ENABLED", tt.value)
+			}
+			assert.Equal(t, tt.enabled, Enabled())
+		})
+	}
+}
+
+func TestRecordAndRead(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+
+	require.NoError(t, Record(path, "gh pr list", time.Now().Add(-50*time.Millisecond), 0))
+	require.NoError(t, Record(path, "gh pr view", time.Now(), 1))
+
+	entries, err := Read(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "gh pr list", entries[0].Command)
+	assert.Equal(t, 0, entries[0].ExitCode)
+	assert.GreaterOrEqual(t, entries[0].Duration, int64(50))
+	assert.Equal(t, "gh pr view", entries[1].Command)
+	assert.Equal(t, 1, entries[1].ExitCode)
+}
+
+func TestReadMissingFile(t *testing.T) {
+	entries, err := Read(filepath.Join(t.TempDir(), "missing.jsonl"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestReadSkipsMalformedLines(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+	require.NoError(t, Record(path, "gh issue list", time.Now(), 0))
+
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0o600)
+	require.NoError(t, err)
+	_, err = f.WriteString("{truncated\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	require.NoError(t, Record(path, "gh issue view", time.Now(), 0))
+
+	entries, err := Read(path)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "gh issue list", entries[0].Command)
+	assert.Equal(t, "gh issue view", entries[1].Command)
+}
@@ -0,0 +1,165 @@
+package prompter
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// answersPrompter replays prompt responses from a JSON file instead of
+// prompting interactively. The file maps the prompt message shown to the
+// user to its answer: a string for text and select prompts, an array of
+// strings for multi-select prompts, and a boolean for confirm prompts.
+// This turns interactive flows into scriptable ones without PTY emulation.
+type answersPrompter struct {
+	path    string
+	answers map[string]json.RawMessage
+	loadErr error
+}
+
+// NewFromAnswersFile returns a Prompter that answers every prompt from the
+// JSON file at path. Problems reading or parsing the file are reported when
+// the first prompt is answered, so that commands which never prompt are
+// unaffected by a bad answers file.
+func NewFromAnswersFile(path string) Prompter {
+	p := &answersPrompter{path: path}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		p.loadErr = fmt.Errorf("could not read answers file: %w", err)
+		return p
+	}
+	if err := json.Unmarshal(data, &p.answers); err != nil {
+		p.loadErr = fmt.Errorf("could not parse answers file %s: %w", path, err)
+	}
+	return p
+}
+
+func (p *answersPrompter) lookup(prompt string) (json.RawMessage, error) {
+	if p.loadErr != nil {
+		return nil, p.loadErr
+	}
+	raw, ok := p.answers[prompt]
+	if !ok {
+		return nil, fmt.Errorf("no answer for prompt %q in %s", prompt, p.path)
+	}
+	return raw, nil
+}
+
+func (p *answersPrompter) stringAnswer(prompt string) (string, error) {
+	raw, err := p.lookup(prompt)
+	if err != nil {
+		return "", err
+	}
+	var answer string
+	if err := json.Unmarshal(raw, &answer); err != nil {
+		return "", fmt.Errorf("answer for prompt %q must be a string", prompt)
+	}
+	return answer, nil
+}
+
+func (p *answersPrompter) Select(prompt, defaultValue string, options []string) (int, error) {
+	answer, err := p.stringAnswer(prompt)
+	if err != nil {
+		return -1, err
+	}
+	for i, o := range options {
+		if o == answer {
+			return i, nil
+		}
+	}
+	return -1, fmt.Errorf("answer %q for prompt %q does not match any option", answer, prompt)
+}
+
+func (p *answersPrompter) MultiSelect(prompt string, defaults, options []string) ([]int, error) {
+	raw, err := p.lookup(prompt)
+	if err != nil {
+		return nil, err
+	}
+	var answers []string
+	if err := json.Unmarshal(raw, &answers); err != nil {
+		return nil, fmt.Errorf("answer for prompt %q must be an array of strings", prompt)
+	}
+	var indexes []int
+	for _, answer := range answers {
+		found := false
+		for i, o := range options {
+			if o == answer {
+				indexes = append(indexes, i)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("answer %q for prompt %q does not match any option", answer, prompt)
+		}
+	}
+	return indexes, nil
+}
+
+func (p *answersPrompter) FuzzySelect(prompt, defaultValue string, options []string) (int, error) {
+	return p.Select(prompt, defaultValue, options)
+}
+
+func (p *answersPrompter) FuzzyMultiSelect(prompt string, defaults, options []string) ([]int, error) {
+	return p.MultiSelect(prompt, defaults, options)
+}
+
+func (p *answersPrompter) Input(prompt, defaultValue string) (string, error) {
+	return p.stringAnswer(prompt)
+}
+
+func (p *answersPrompter) Password(prompt string) (string, error) {
+	return p.stringAnswer(prompt)
+}
+
+func (p *answersPrompter) Confirm(prompt string, defaultValue bool) (bool, error) {
+	raw, err := p.lookup(prompt)
+	if err != nil {
+		return false, err
+	}
+	var answer bool
+	if err := json.Unmarshal(raw, &answer); err != nil {
+		return false, fmt.Errorf("answer for prompt %q must be a boolean", prompt)
+	}
+	return answer, nil
+}
+
+func (p *answersPrompter) AuthToken() (string, error) {
+	result, err := p.stringAnswer("Paste your authentication token:")
+	if err != nil {
+		return "", err
+	}
+	if result == "" {
+		return "", fmt.Errorf("token is required")
+	}
+	return result, nil
+}
+
+// ConfirmDeletion looks up the required value itself as the prompt key, so
+// an answers file confirms a deletion by restating what is being deleted.
+func (p *answersPrompter) ConfirmDeletion(requiredValue string) error {
+	answer, err := p.stringAnswer(requiredValue)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(answer, requiredValue) {
+		return fmt.Errorf("You entered %s", answer)
+	}
+	return nil
+}
+
+func (p *answersPrompter) InputHostname() (string, error) {
+	return p.stringAnswer("Hostname:")
+}
+
+// MarkdownEditor returns the answer verbatim; no editor is launched. When
+// blankAllowed is true a missing answer skips the editor instead of failing.
+func (p *answersPrompter) MarkdownEditor(prompt, defaultValue string, blankAllowed bool) (string, error) {
+	if p.loadErr == nil {
+		if _, ok := p.answers[prompt]; !ok && blankAllowed {
+			return "", nil
+		}
+	}
+	return p.stringAnswer(prompt)
+}
@@ -0,0 +1,96 @@
+package prompter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeAnswersFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "answers.json")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+	return path
+}
+
+func TestAnswersPrompterSelect(t *testing.T) {
+	p := NewFromAnswersFile(writeAnswersFile(t, `{"Pick one": "beta"}`))
+
+	result, err := p.Select("Pick one", "", []string{"alpha", "beta", "gamma"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, result)
+
+	_, err = p.Select("Pick one", "", []string{"alpha"})
+	assert.EqualError(t, err, `answer "beta" for prompt "Pick one" does not match any option`)
+}
+
+func TestAnswersPrompterMultiSelect(t *testing.T) {
+	p := NewFromAnswersFile(writeAnswersFile(t, `{"Labels": ["bug", "help wanted"]}`))
+
+	result, err := p.MultiSelect("Labels", nil, []string{"bug", "docs", "help wanted"})
+	require.NoError(t, err)
+	assert.Equal(t, []int{0, 2}, result)
+}
+
+func TestAnswersPrompterConfirm(t *testing.T) {
+	p := NewFromAnswersFile(writeAnswersFile(t, `{"Proceed?": true, "Delete?": "yes"}`))
+
+	result, err := p.Confirm("Proceed?", false)
+	require.NoError(t, err)
+	assert.True(t, result)
+
+	_, err = p.Confirm("Delete?", false)
+	assert.EqualError(t, err, `answer for prompt "Delete?" must be a boolean`)
+}
+
+func TestAnswersPrompterInput(t *testing.T) {
+	p := NewFromAnswersFile(writeAnswersFile(t, `{"Title": "a bug report"}`))
+
+	result, err := p.Input("Title", "default")
+	require.NoError(t, err)
+	assert.Equal(t, "a bug report", result)
+}
+
+func TestAnswersPrompterConfirmDeletion(t *testing.T) {
+	p := NewFromAnswersFile(writeAnswersFile(t, `{"my-repo": "my-repo", "other-repo": "nope"}`))
+
+	assert.NoError(t, p.ConfirmDeletion("my-repo"))
+	assert.EqualError(t, p.ConfirmDeletion("other-repo"), "You entered nope")
+}
+
+func TestAnswersPrompterMarkdownEditor(t *testing.T) {
+	p := NewFromAnswersFile(writeAnswersFile(t, `{"Body": "some *markdown*"}`))
+
+	result, err := p.MarkdownEditor("Body", "", false)
+	require.NoError(t, err)
+	assert.Equal(t, "some *markdown*", result)
+
+	result, err = p.MarkdownEditor("Comment", "", true)
+	require.NoError(t, err)
+	assert.Equal(t, "", result)
+
+	_, err = p.MarkdownEditor("Comment", "", false)
+	assert.EqualError(t, err, `no answer for prompt "Comment" in `+p.(*answersPrompter).path)
+}
+
+func TestAnswersPrompterMissingAnswer(t *testing.T) {
+	path := writeAnswersFile(t, `{}`)
+	p := NewFromAnswersFile(path)
+
+	_, err := p.Input("Title", "")
+	assert.EqualError(t, err, `no answer for prompt "Title" in `+path)
+}
+
+func TestAnswersPrompterBadFile(t *testing.T) {
+	p := NewFromAnswersFile(writeAnswersFile(t, `not json`))
+
+	_, err := p.Input("Title", "")
+	assert.ErrorContains(t, err, "could not parse answers file")
+
+	p = NewFromAnswersFile(filepath.Join(t.TempDir(), "missing.json"))
+	_, err = p.Confirm("Proceed?", false)
+	assert.ErrorContains(t, err, "could not read answers file")
+}
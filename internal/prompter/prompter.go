@@ -2,6 +2,7 @@ package prompter
 
 import (
 	"fmt"
+	"os"
 	"slices"
 	"strings"
 
@@ -21,6 +22,12 @@ type Prompter interface {
 	Select(prompt string, defaultValue string, options []string) (int, error)
 	// MultiSelect prompts the user to select one or more options from a list of options.
 	MultiSelect(prompt string, defaults []string, options []string) ([]int, error)
+	// FuzzySelect prompts the user to select an option from a list of options, with
+	// fuzzy filtering and pagination to keep large option sets navigable.
+	FuzzySelect(prompt string, defaultValue string, options []string) (int, error)
+	// FuzzyMultiSelect prompts the user to select one or more options from a list of
+	// options, with fuzzy filtering and pagination to keep large option sets navigable.
+	FuzzyMultiSelect(prompt string, defaults []string, options []string) ([]int, error)
 	// Input prompts the user to enter a string value.
 	Input(prompt string, defaultValue string) (string, error)
 	// Password prompts the user to enter a password.
@@ -44,6 +51,10 @@ type Prompter interface {
 }
 
 func New(editorCmd string, io *iostreams.IOStreams) Prompter {
+	if answersFile := os.Getenv("GH_PROMPT_ANSWERS"); answersFile != "" {
+		return NewFromAnswersFile(answersFile)
+	}
+
 	if io.AccessiblePrompterEnabled() {
 		return &accessiblePrompter{
 			stdin:     io.In,
@@ -165,6 +176,17 @@ func (p *accessiblePrompter) MultiSelect(prompt string, defaults []string, optio
 	return result, nil
 }
 
+// FuzzySelect behaves like Select: the accessible prompter is line-oriented
+// and enumerates every option, so there is no list to filter or page through.
+func (p *accessiblePrompter) FuzzySelect(prompt, defaultValue string, options []string) (int, error) {
+	return p.Select(prompt, defaultValue, options)
+}
+
+// FuzzyMultiSelect behaves like MultiSelect for the same reason as FuzzySelect.
+func (p *accessiblePrompter) FuzzyMultiSelect(prompt string, defaults []string, options []string) ([]int, error) {
+	return p.MultiSelect(prompt, defaults, options)
+}
+
 func (p *accessiblePrompter) Input(prompt, defaultValue string) (string, error) {
 	result := defaultValue
 	prompt = p.addDefaultsToPrompt(prompt, []string{defaultValue})
@@ -336,6 +358,59 @@ func (p *surveyPrompter) MultiSelect(prompt string, defaultValues, options []str
 	return p.prompter.MultiSelect(prompt, defaultValues, options)
 }
 
+// fuzzyPageSize is how many options are visible at once in fuzzy prompts,
+// which are meant for option sets that run into the hundreds.
+const fuzzyPageSize = 15
+
+func (p *surveyPrompter) FuzzySelect(prompt, defaultValue string, options []string) (int, error) {
+	q := &survey.Select{
+		Message:  prompt,
+		Options:  options,
+		PageSize: fuzzyPageSize,
+		Filter:   fuzzyFilter,
+	}
+	if defaultValue != "" {
+		q.Default = defaultValue
+	}
+	var result int
+	err := p.ask(q, &result)
+	return result, err
+}
+
+func (p *surveyPrompter) FuzzyMultiSelect(prompt string, defaultValues, options []string) ([]int, error) {
+	q := &survey.MultiSelect{
+		Message:  prompt,
+		Options:  options,
+		PageSize: fuzzyPageSize,
+		Filter:   fuzzyFilter,
+	}
+	if len(defaultValues) > 0 {
+		q.Default = defaultValues
+	}
+	var result []int
+	err := p.ask(q, &result)
+	return result, err
+}
+
+// fuzzyFilter matches options that contain the typed filter as a
+// subsequence, so "fbz" matches "foo-bar-baz".
+func fuzzyFilter(filter, value string, _ int) bool {
+	filterRunes := []rune(strings.ToLower(filter))
+	if len(filterRunes) == 0 {
+		return true
+	}
+	i := 0
+	for _, r := range strings.ToLower(value) {
+		if r == filterRunes[i] {
+			i++
+			if i == len(filterRunes) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (p *surveyPrompter) Input(prompt, defaultValue string) (string, error) {
 	return p.prompter.Input(prompt, defaultValue)
 }
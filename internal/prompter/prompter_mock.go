@@ -26,6 +26,12 @@ var _ Prompter = &PrompterMock{}
 //			ConfirmDeletionFunc: func(requiredValue string) error {
 //				panic("mock out the ConfirmDeletion method")
 //			},
+//			FuzzyMultiSelectFunc: func(prompt string, defaults []string, options []string) ([]int, error) {
+//				panic("mock out the FuzzyMultiSelect method")
+//			},
+//			FuzzySelectFunc: func(prompt string, defaultValue string, options []string) (int, error) {
+//				panic("mock out the FuzzySelect method")
+//			},
 //			InputFunc: func(prompt string, defaultValue string) (string, error) {
 //				panic("mock out the Input method")
 //			},
@@ -60,6 +66,12 @@ type PrompterMock struct {
 	// ConfirmDeletionFunc mocks the ConfirmDeletion method.
 	ConfirmDeletionFunc func(requiredValue string) error
 
+	// FuzzyMultiSelectFunc mocks the FuzzyMultiSelect method.
+	FuzzyMultiSelectFunc func(prompt string, defaults []string, options []string) ([]int, error)
+
+	// FuzzySelectFunc mocks the FuzzySelect method.
+	FuzzySelectFunc func(prompt string, defaultValue string, options []string) (int, error)
+
 	// InputFunc mocks the Input method.
 	InputFunc func(prompt string, defaultValue string) (string, error)
 
@@ -95,6 +107,24 @@ type PrompterMock struct {
 			// RequiredValue is the requiredValue argument value.
 			RequiredValue string
 		}
+		// FuzzyMultiSelect holds details about calls to the FuzzyMultiSelect method.
+		FuzzyMultiSelect []struct {
+			// Prompt is the prompt argument value.
+			Prompt string
+			// Defaults is the defaults argument value.
+			Defaults []string
+			// Options is the options argument value.
+			Options []string
+		}
+		// FuzzySelect holds details about calls to the FuzzySelect method.
+		FuzzySelect []struct {
+			// Prompt is the prompt argument value.
+			Prompt string
+			// DefaultValue is the defaultValue argument value.
+			DefaultValue string
+			// Options is the options argument value.
+			Options []string
+		}
 		// Input holds details about calls to the Input method.
 		Input []struct {
 			// Prompt is the prompt argument value.
@@ -138,15 +168,17 @@ type PrompterMock struct {
 			Options []string
 		}
 	}
-	lockAuthToken       sync.RWMutex
-	lockConfirm         sync.RWMutex
-	lockConfirmDeletion sync.RWMutex
-	lockInput           sync.RWMutex
-	lockInputHostname   sync.RWMutex
-	lockMarkdownEditor  sync.RWMutex
-	lockMultiSelect     sync.RWMutex
-	lockPassword        sync.RWMutex
-	lockSelect          sync.RWMutex
+	lockAuthToken        sync.RWMutex
+	lockConfirm          sync.RWMutex
+	lockConfirmDeletion  sync.RWMutex
+	lockFuzzyMultiSelect sync.RWMutex
+	lockFuzzySelect      sync.RWMutex
+	lockInput            sync.RWMutex
+	lockInputHostname    sync.RWMutex
+	lockMarkdownEditor   sync.RWMutex
+	lockMultiSelect      sync.RWMutex
+	lockPassword         sync.RWMutex
+	lockSelect           sync.RWMutex
 }
 
 // AuthToken calls AuthTokenFunc.
@@ -244,6 +276,86 @@ func (mock *PrompterMock) ConfirmDeletionCalls() []struct {
 	return calls
 }
 
+// FuzzyMultiSelect calls FuzzyMultiSelectFunc.
+func (mock *PrompterMock) FuzzyMultiSelect(prompt string, defaults []string, options []string) ([]int, error) {
+	if mock.FuzzyMultiSelectFunc == nil {
+		panic("PrompterMock.FuzzyMultiSelectFunc: method is nil but Prompter.FuzzyMultiSelect was just called")
+	}
+	callInfo := struct {
+		Prompt   string
+		Defaults []string
+		Options  []string
+	}{
+		Prompt:   prompt,
+		Defaults: defaults,
+		Options:  options,
+	}
+	mock.lockFuzzyMultiSelect.Lock()
+	mock.calls.FuzzyMultiSelect = append(mock.calls.FuzzyMultiSelect, callInfo)
+	mock.lockFuzzyMultiSelect.Unlock()
+	return mock.FuzzyMultiSelectFunc(prompt, defaults, options)
+}
+
+// FuzzyMultiSelectCalls gets all the calls that were made to FuzzyMultiSelect.
+// Check the length with:
+//
+//	len(mockedPrompter.FuzzyMultiSelectCalls())
+func (mock *PrompterMock) FuzzyMultiSelectCalls() []struct {
+	Prompt   string
+	Defaults []string
+	Options  []string
+} {
+	var calls []struct {
+		Prompt   string
+		Defaults []string
+		Options  []string
+	}
+	mock.lockFuzzyMultiSelect.RLock()
+	calls = mock.calls.FuzzyMultiSelect
+	mock.lockFuzzyMultiSelect.RUnlock()
+	return calls
+}
+
+// FuzzySelect calls FuzzySelectFunc.
+func (mock *PrompterMock) FuzzySelect(prompt string, defaultValue string, options []string) (int, error) {
+	if mock.FuzzySelectFunc == nil {
+		panic("PrompterMock.FuzzySelectFunc: method is nil but Prompter.FuzzySelect was just called")
+	}
+	callInfo := struct {
+		Prompt       string
+		DefaultValue string
+		Options      []string
+	}{
+		Prompt:       prompt,
+		DefaultValue: defaultValue,
+		Options:      options,
+	}
+	mock.lockFuzzySelect.Lock()
+	mock.calls.FuzzySelect = append(mock.calls.FuzzySelect, callInfo)
+	mock.lockFuzzySelect.Unlock()
+	return mock.FuzzySelectFunc(prompt, defaultValue, options)
+}
+
+// FuzzySelectCalls gets all the calls that were made to FuzzySelect.
+// Check the length with:
+//
+//	len(mockedPrompter.FuzzySelectCalls())
+func (mock *PrompterMock) FuzzySelectCalls() []struct {
+	Prompt       string
+	DefaultValue string
+	Options      []string
+} {
+	var calls []struct {
+		Prompt       string
+		DefaultValue string
+		Options      []string
+	}
+	mock.lockFuzzySelect.RLock()
+	calls = mock.calls.FuzzySelect
+	mock.lockFuzzySelect.RUnlock()
+	return calls
+}
+
 // Input calls InputFunc.
 func (mock *PrompterMock) Input(prompt string, defaultValue string) (string, error) {
 	if mock.InputFunc == nil {
@@ -2,6 +2,8 @@ package prompter
 
 import (
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 
@@ -13,6 +15,7 @@ func NewMockPrompter(t *testing.T) *MockPrompter {
 	m := &MockPrompter{
 		t:                    t,
 		PrompterMock:         *ghPrompter.NewMock(t),
+		promptStubs:          map[string][]*promptStub{},
 		authTokenStubs:       []authTokenStub{},
 		confirmDeletionStubs: []confirmDeletionStub{},
 		inputHostnameStubs:   []inputHostnameStub{},
@@ -25,12 +28,39 @@ func NewMockPrompter(t *testing.T) *MockPrompter {
 type MockPrompter struct {
 	t *testing.T
 	ghPrompter.PrompterMock
+	promptStubs          map[string][]*promptStub
+	unordered            bool
+	transcript           []string
 	authTokenStubs       []authTokenStub
 	confirmDeletionStubs []confirmDeletionStub
 	inputHostnameStubs   []inputHostnameStub
 	markdownEditorStubs  []markdownEditorStub
 }
 
+// promptStub is an expectation for one of the core prompt kinds: Select, MultiSelect,
+// Input, Password, or Confirm. It matches a prompt message either exactly or by regexp.
+type promptStub struct {
+	prompt        string
+	re            *regexp.Regexp
+	options       []string
+	assertOptions bool
+	fn            interface{}
+}
+
+func (s *promptStub) matches(prompt string) bool {
+	if s.re != nil {
+		return s.re.MatchString(prompt)
+	}
+	return s.prompt == prompt
+}
+
+func (s *promptStub) String() string {
+	if s.re != nil {
+		return fmt.Sprintf("/%s/", s.re)
+	}
+	return fmt.Sprintf("%q", s.prompt)
+}
+
 type authTokenStub struct {
 	fn func() (string, error)
 }
@@ -49,6 +79,122 @@ type markdownEditorStub struct {
 	fn     func(string, string, bool) (string, error)
 }
 
+// AllowUnorderedPrompts lets prompts of the same kind happen in any order relative to how
+// their stubs were registered. Surveys that reorder questions based on earlier answers are
+// brittle to test with the default strict ordering.
+func (m *MockPrompter) AllowUnorderedPrompts() {
+	m.unordered = true
+}
+
+// Transcript returns a line per prompt answered so far, for debugging interactive flows.
+func (m *MockPrompter) Transcript() []string {
+	return m.transcript
+}
+
+// pop consumes the stub answering a prompt. In ordered mode the next registered stub of the
+// kind must match; in unordered mode the first matching stub of the kind is used.
+func (m *MockPrompter) pop(kind, prompt string) (*promptStub, error) {
+	stubs := m.promptStubs[kind]
+	if m.unordered {
+		for i, s := range stubs {
+			if s.matches(prompt) {
+				m.promptStubs[kind] = append(stubs[:i:i], stubs[i+1:]...)
+				return s, nil
+			}
+		}
+		return nil, NoSuchPromptErr(prompt)
+	}
+	if len(stubs) == 0 {
+		return nil, NoSuchPromptErr(prompt)
+	}
+	s := stubs[0]
+	m.promptStubs[kind] = stubs[1:]
+	if !s.matches(prompt) {
+		return nil, NoSuchPromptErr(prompt)
+	}
+	return s, nil
+}
+
+func (m *MockPrompter) record(kind, prompt, answer string, err error) {
+	if err != nil {
+		m.transcript = append(m.transcript, fmt.Sprintf("%s %q -> error: %v", kind, prompt, err))
+		return
+	}
+	m.transcript = append(m.transcript, fmt.Sprintf("%s %q -> %q", kind, prompt, answer))
+}
+
+func (m *MockPrompter) Select(prompt, defaultValue string, options []string) (int, error) {
+	s, err := m.pop("Select", prompt)
+	if err != nil {
+		m.record("Select", prompt, "", err)
+		return -1, err
+	}
+	if s.assertOptions {
+		AssertOptions(m.t, s.options, options)
+	}
+	index, err := s.fn.(func(string, string, []string) (int, error))(prompt, defaultValue, options)
+	answer := ""
+	if err == nil && index >= 0 && index < len(options) {
+		answer = options[index]
+	}
+	m.record("Select", prompt, answer, err)
+	return index, err
+}
+
+func (m *MockPrompter) MultiSelect(prompt string, defaultValues, options []string) ([]int, error) {
+	s, err := m.pop("MultiSelect", prompt)
+	if err != nil {
+		m.record("MultiSelect", prompt, "", err)
+		return []int{}, err
+	}
+	if s.assertOptions {
+		AssertOptions(m.t, s.options, options)
+	}
+	indexes, err := s.fn.(func(string, []string, []string) ([]int, error))(prompt, defaultValues, options)
+	var answers []string
+	for _, index := range indexes {
+		if index >= 0 && index < len(options) {
+			answers = append(answers, options[index])
+		}
+	}
+	m.record("MultiSelect", prompt, strings.Join(answers, ", "), err)
+	return indexes, err
+}
+
+func (m *MockPrompter) Input(prompt, defaultValue string) (string, error) {
+	s, err := m.pop("Input", prompt)
+	if err != nil {
+		m.record("Input", prompt, "", err)
+		return "", err
+	}
+	answer, err := s.fn.(func(string, string) (string, error))(prompt, defaultValue)
+	m.record("Input", prompt, answer, err)
+	return answer, err
+}
+
+func (m *MockPrompter) Password(prompt string) (string, error) {
+	s, err := m.pop("Password", prompt)
+	if err != nil {
+		m.record("Password", prompt, "", err)
+		return "", err
+	}
+	answer, err := s.fn.(func(string) (string, error))(prompt)
+	// never reproduce the answer itself; transcripts end up in test logs
+	m.record("Password", prompt, "(hidden)", err)
+	return answer, err
+}
+
+func (m *MockPrompter) Confirm(prompt string, defaultValue bool) (bool, error) {
+	s, err := m.pop("Confirm", prompt)
+	if err != nil {
+		m.record("Confirm", prompt, "", err)
+		return false, err
+	}
+	answer, err := s.fn.(func(string, bool) (bool, error))(prompt, defaultValue)
+	m.record("Confirm", prompt, strconv.FormatBool(answer), err)
+	return answer, err
+}
+
 func (m *MockPrompter) AuthToken() (string, error) {
 	var s authTokenStub
 	if len(m.authTokenStubs) == 0 {
@@ -69,6 +215,17 @@ func (m *MockPrompter) ConfirmDeletion(prompt string) error {
 	return s.fn(prompt)
 }
 
+// FuzzySelect answers from the same stubs as Select, so tests register
+// expectations with RegisterSelect regardless of which variant the code uses.
+func (m *MockPrompter) FuzzySelect(prompt, defaultValue string, options []string) (int, error) {
+	return m.Select(prompt, defaultValue, options)
+}
+
+// FuzzyMultiSelect answers from the same stubs as MultiSelect; see FuzzySelect.
+func (m *MockPrompter) FuzzyMultiSelect(prompt string, defaults, options []string) ([]int, error) {
+	return m.MultiSelect(prompt, defaults, options)
+}
+
 func (m *MockPrompter) InputHostname() (string, error) {
 	var s inputHostnameStub
 	if len(m.inputHostnameStubs) == 0 {
@@ -92,6 +249,53 @@ func (m *MockPrompter) MarkdownEditor(prompt, defaultValue string, blankAllowed
 	return s.fn(prompt, defaultValue, blankAllowed)
 }
 
+func (m *MockPrompter) RegisterSelect(prompt string, opts []string, stub func(_, _ string, _ []string) (int, error)) {
+	m.promptStubs["Select"] = append(m.promptStubs["Select"], &promptStub{prompt: prompt, options: opts, assertOptions: true, fn: stub})
+}
+
+// RegisterSelectRegexp is like RegisterSelect, but matches any Select prompt whose message
+// matches the regular expression pattern. Pass nil opts to skip asserting the options.
+func (m *MockPrompter) RegisterSelectRegexp(pattern string, opts []string, stub func(_, _ string, _ []string) (int, error)) {
+	m.promptStubs["Select"] = append(m.promptStubs["Select"], &promptStub{re: regexp.MustCompile(pattern), options: opts, assertOptions: opts != nil, fn: stub})
+}
+
+func (m *MockPrompter) RegisterMultiSelect(prompt string, d, opts []string, stub func(_ string, _, _ []string) ([]int, error)) {
+	m.promptStubs["MultiSelect"] = append(m.promptStubs["MultiSelect"], &promptStub{prompt: prompt, options: opts, assertOptions: true, fn: stub})
+}
+
+// RegisterMultiSelectRegexp is the regexp-matching variant of RegisterMultiSelect; see
+// RegisterSelectRegexp.
+func (m *MockPrompter) RegisterMultiSelectRegexp(pattern string, opts []string, stub func(_ string, _, _ []string) ([]int, error)) {
+	m.promptStubs["MultiSelect"] = append(m.promptStubs["MultiSelect"], &promptStub{re: regexp.MustCompile(pattern), options: opts, assertOptions: opts != nil, fn: stub})
+}
+
+func (m *MockPrompter) RegisterInput(prompt string, stub func(_, _ string) (string, error)) {
+	m.promptStubs["Input"] = append(m.promptStubs["Input"], &promptStub{prompt: prompt, fn: stub})
+}
+
+// RegisterInputRegexp is the regexp-matching variant of RegisterInput.
+func (m *MockPrompter) RegisterInputRegexp(pattern string, stub func(_, _ string) (string, error)) {
+	m.promptStubs["Input"] = append(m.promptStubs["Input"], &promptStub{re: regexp.MustCompile(pattern), fn: stub})
+}
+
+func (m *MockPrompter) RegisterPassword(prompt string, stub func(string) (string, error)) {
+	m.promptStubs["Password"] = append(m.promptStubs["Password"], &promptStub{prompt: prompt, fn: stub})
+}
+
+// RegisterPasswordRegexp is the regexp-matching variant of RegisterPassword.
+func (m *MockPrompter) RegisterPasswordRegexp(pattern string, stub func(string) (string, error)) {
+	m.promptStubs["Password"] = append(m.promptStubs["Password"], &promptStub{re: regexp.MustCompile(pattern), fn: stub})
+}
+
+func (m *MockPrompter) RegisterConfirm(prompt string, stub func(_ string, _ bool) (bool, error)) {
+	m.promptStubs["Confirm"] = append(m.promptStubs["Confirm"], &promptStub{prompt: prompt, fn: stub})
+}
+
+// RegisterConfirmRegexp is the regexp-matching variant of RegisterConfirm.
+func (m *MockPrompter) RegisterConfirmRegexp(pattern string, stub func(_ string, _ bool) (bool, error)) {
+	m.promptStubs["Confirm"] = append(m.promptStubs["Confirm"], &promptStub{re: regexp.MustCompile(pattern), fn: stub})
+}
+
 func (m *MockPrompter) RegisterAuthToken(stub func() (string, error)) {
 	m.authTokenStubs = append(m.authTokenStubs, authTokenStub{fn: stub})
 }
@@ -110,6 +314,11 @@ func (m *MockPrompter) RegisterMarkdownEditor(prompt string, stub func(string, s
 
 func (m *MockPrompter) Verify() {
 	errs := []string{}
+	for _, kind := range []string{"Select", "MultiSelect", "Input", "Password", "Confirm"} {
+		for _, s := range m.promptStubs[kind] {
+			errs = append(errs, fmt.Sprintf("%s %s", kind, s))
+		}
+	}
 	if len(m.authTokenStubs) > 0 {
 		errs = append(errs, "AuthToken")
 	}
@@ -126,6 +335,9 @@ func (m *MockPrompter) Verify() {
 		m.t.Helper()
 		m.t.Errorf("%d unmatched calls to %s", len(errs), strings.Join(errs, ","))
 	}
+	if m.t.Failed() && len(m.transcript) > 0 {
+		m.t.Logf("prompt transcript:\n  %s", strings.Join(m.transcript, "\n  "))
+	}
 }
 
 func AssertOptions(t *testing.T, expected, actual []string) {
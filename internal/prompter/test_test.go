@@ -0,0 +1,65 @@
+package prompter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMockPrompterRegexpStubs(t *testing.T) {
+	pm := NewMockPrompter(t)
+	pm.RegisterSelectRegexp(`^Choose a base branch`, nil, func(_, _ string, opts []string) (int, error) {
+		return IndexFor(opts, "main")
+	})
+	pm.RegisterConfirmRegexp(`\?$`, func(_ string, _ bool) (bool, error) {
+		return true, nil
+	})
+
+	index, err := pm.Select("Choose a base branch for PR #123", "", []string{"main", "trunk"})
+	require.NoError(t, err)
+	assert.Equal(t, 0, index)
+
+	confirmed, err := pm.Confirm("Submit?", false)
+	require.NoError(t, err)
+	assert.True(t, confirmed)
+}
+
+func TestMockPrompterUnordered(t *testing.T) {
+	pm := NewMockPrompter(t)
+	pm.AllowUnorderedPrompts()
+	pm.RegisterInput("Title", func(_, _ string) (string, error) {
+		return "a title", nil
+	})
+	pm.RegisterInput("Body", func(_, _ string) (string, error) {
+		return "a body", nil
+	})
+
+	body, err := pm.Input("Body", "")
+	require.NoError(t, err)
+	assert.Equal(t, "a body", body)
+
+	title, err := pm.Input("Title", "")
+	require.NoError(t, err)
+	assert.Equal(t, "a title", title)
+}
+
+func TestMockPrompterTranscript(t *testing.T) {
+	pm := NewMockPrompter(t)
+	pm.RegisterSelect("Flavor", []string{"vanilla", "chocolate"}, func(_, _ string, opts []string) (int, error) {
+		return IndexFor(opts, "chocolate")
+	})
+	pm.RegisterPassword("Token", func(_ string) (string, error) {
+		return "hunter2", nil
+	})
+
+	_, err := pm.Select("Flavor", "", []string{"vanilla", "chocolate"})
+	require.NoError(t, err)
+	_, err = pm.Password("Token")
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`Select "Flavor" -> "chocolate"`,
+		`Password "Token" -> "(hidden)"`,
+	}, pm.Transcript())
+}
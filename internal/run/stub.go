@@ -2,9 +2,12 @@ package run
 
 import (
 	"fmt"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"sort"
 	"strings"
 )
 
@@ -22,9 +25,9 @@ type T interface {
 func Stub() (*CommandStubber, func(T)) {
 	cs := &CommandStubber{}
 	teardown := setPrepareCmd(func(cmd *exec.Cmd) Runnable {
-		s := cs.find(cmd.Args)
+		s := cs.find(cmd)
 		if s == nil {
-			panic(fmt.Sprintf("no exec stub for `%s`", strings.Join(cmd.Args, " ")))
+			panic(cs.describeMismatch(cmd))
 		}
 		for _, c := range s.callbacks {
 			c(cmd.Args)
@@ -40,7 +43,7 @@ func Stub() (*CommandStubber, func(T)) {
 			if s.matched {
 				continue
 			}
-			unmatched = append(unmatched, s.pattern.String())
+			unmatched = append(unmatched, s.String())
 		}
 		if len(unmatched) == 0 {
 			return
@@ -66,46 +69,160 @@ func setPrepareCmd(fn func(*exec.Cmd) Runnable) func() {
 
 // CommandStubber stubs out invocations to external commands.
 type CommandStubber struct {
-	stubs []*commandStub
+	stubs   []*commandStub
+	ordered bool
 }
 
 // Register a stub for an external command. Pattern is a regular expression, output is the standard output
-// from a command. Pass callbacks to inspect raw arguments that the command was invoked with.
-func (cs *CommandStubber) Register(pattern string, exitStatus int, output string, callbacks ...CommandCallback) {
+// from a command. Pass callbacks to inspect raw arguments that the command was invoked with. The returned
+// stub may be further constrained, e.g. with WithEnv.
+func (cs *CommandStubber) Register(pattern string, exitStatus int, output string, callbacks ...CommandCallback) *commandStub {
 	if len(pattern) < 1 {
 		panic("cannot use empty regexp pattern")
 	}
 	if strings.HasPrefix(pattern, "git") {
 		pattern = addGitAuthentication(pattern)
 	}
-	cs.stubs = append(cs.stubs, &commandStub{
+	s := &commandStub{
 		pattern:    regexp.MustCompile(pattern),
 		exitStatus: exitStatus,
 		stdout:     output,
 		callbacks:  callbacks,
-	})
+	}
+	cs.stubs = append(cs.stubs, s)
+	return s
+}
+
+// RegisterExact is like Register, but matches only an invocation whose argument slice equals args
+// exactly, so argument regressions that a loose regular expression would tolerate fail the test.
+// No git authentication arguments are injected into args.
+func (cs *CommandStubber) RegisterExact(args []string, exitStatus int, output string, callbacks ...CommandCallback) *commandStub {
+	if len(args) < 1 {
+		panic("cannot use empty argument list")
+	}
+	s := &commandStub{
+		exactArgs:  args,
+		exitStatus: exitStatus,
+		stdout:     output,
+		callbacks:  callbacks,
+	}
+	cs.stubs = append(cs.stubs, s)
+	return s
+}
+
+// EnableStrictOrdering makes each invocation match only the next unmatched stub in registration
+// order, instead of the first unmatched stub that happens to match.
+func (cs *CommandStubber) EnableStrictOrdering() {
+	cs.ordered = true
 }
 
-func (cs *CommandStubber) find(args []string) *commandStub {
-	line := strings.Join(args, " ")
+func (cs *CommandStubber) find(cmd *exec.Cmd) *commandStub {
 	for _, s := range cs.stubs {
-		if !s.matched && s.pattern.MatchString(line) {
+		if s.matched {
+			continue
+		}
+		if s.matches(cmd) {
 			return s
 		}
+		if cs.ordered {
+			return nil
+		}
 	}
 	return nil
 }
 
+// describeMismatch explains why no stub matched cmd, diffing the expected invocations against the
+// actual one.
+func (cs *CommandStubber) describeMismatch(cmd *exec.Cmd) string {
+	var remaining []string
+	for _, s := range cs.stubs {
+		if !s.matched {
+			remaining = append(remaining, s.String())
+		}
+	}
+	line := strings.Join(cmd.Args, " ")
+	if cs.ordered && len(remaining) > 0 {
+		return fmt.Sprintf("expected next exec to match %s, got `%s`", remaining[0], line)
+	}
+	msg := fmt.Sprintf("no exec stub for `%s`", line)
+	if len(remaining) > 0 {
+		msg += fmt.Sprintf("\nremaining expectations:\n  %s", strings.Join(remaining, "\n  "))
+	}
+	return msg
+}
+
 type CommandCallback func([]string)
 
 type commandStub struct {
 	pattern    *regexp.Regexp
+	exactArgs  []string
+	wantEnv    map[string]string
 	matched    bool
 	exitStatus int
 	stdout     string
 	callbacks  []CommandCallback
 }
 
+// WithEnv constrains the stub to invocations whose environment contains the given variable. It may
+// be chained to assert several variables.
+func (s *commandStub) WithEnv(key, value string) *commandStub {
+	if s.wantEnv == nil {
+		s.wantEnv = map[string]string{}
+	}
+	s.wantEnv[key] = value
+	return s
+}
+
+func (s *commandStub) matches(cmd *exec.Cmd) bool {
+	if s.exactArgs != nil {
+		if !slices.Equal(cmd.Args, s.exactArgs) {
+			return false
+		}
+	} else if !s.pattern.MatchString(strings.Join(cmd.Args, " ")) {
+		return false
+	}
+	for key, want := range s.wantEnv {
+		if got, ok := lookupEnv(cmd, key); !ok || got != want {
+			return false
+		}
+	}
+	return true
+}
+
+// String renders the stub's expectation for failure messages.
+func (s *commandStub) String() string {
+	var b strings.Builder
+	if s.exactArgs != nil {
+		fmt.Fprintf(&b, "`%s`", strings.Join(s.exactArgs, " "))
+	} else {
+		b.WriteString(s.pattern.String())
+	}
+	keys := make([]string, 0, len(s.wantEnv))
+	for key := range s.wantEnv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		fmt.Fprintf(&b, " (env %s=%s)", key, s.wantEnv[key])
+	}
+	return b.String()
+}
+
+// lookupEnv finds an environment variable for the invocation, falling back to the process
+// environment that a nil cmd.Env would inherit.
+func lookupEnv(cmd *exec.Cmd, key string) (string, bool) {
+	if cmd.Env == nil {
+		return os.LookupEnv(key)
+	}
+	// later entries win, matching how the operating system resolves duplicates
+	for i := len(cmd.Env) - 1; i >= 0; i-- {
+		if name, value, ok := strings.Cut(cmd.Env[i], "="); ok && name == key {
+			return value, true
+		}
+	}
+	return "", false
+}
+
 type errWithExitCode struct {
 	message  string
 	exitCode int
@@ -125,7 +242,7 @@ func (s *commandStub) Run() error {
 		// It's nontrivial to construct a fake `exec.ExitError` instance, so we return an error type
 		// that has the `ExitCode() int` method.
 		return errWithExitCode{
-			message:  fmt.Sprintf("%s exited with status %d", s.pattern, s.exitStatus),
+			message:  fmt.Sprintf("%s exited with status %d", s, s.exitStatus),
 			exitCode: s.exitStatus,
 		}
 	}
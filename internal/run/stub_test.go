@@ -0,0 +1,70 @@
+package run
+
+import (
+	"os/exec"
+	"testing"
+)
+
+func TestCommandStubber_RegisterExact(t *testing.T) {
+	cs := &CommandStubber{}
+	cs.RegisterExact([]string{"git", "checkout", "feature branch"}, 0, "")
+
+	if s := cs.find(exec.Command("git", "checkout", "feature branch")); s == nil {
+		t.Error("expected exact stub to match")
+	}
+	if s := cs.find(exec.Command("git", "checkout", "feature")); s != nil {
+		t.Error("expected exact stub not to match different arguments")
+	}
+}
+
+func TestCommandStubber_WithEnv(t *testing.T) {
+	cs := &CommandStubber{}
+	cs.Register(`git status`, 0, "").WithEnv("GIT_DIR", ".git")
+
+	cmd := exec.Command("git", "status")
+	cmd.Env = []string{"GIT_DIR=elsewhere"}
+	if s := cs.find(cmd); s != nil {
+		t.Error("expected stub not to match wrong environment value")
+	}
+
+	cmd.Env = []string{"GIT_DIR=elsewhere", "GIT_DIR=.git"}
+	if s := cs.find(cmd); s == nil {
+		t.Error("expected stub to match environment with later entry winning")
+	}
+}
+
+func TestCommandStubber_EnableStrictOrdering(t *testing.T) {
+	cs := &CommandStubber{}
+	cs.EnableStrictOrdering()
+	cs.Register(`git fetch`, 0, "")
+	cs.Register(`git status`, 0, "")
+
+	if s := cs.find(exec.Command("git", "status")); s != nil {
+		t.Error("expected out-of-order invocation not to match")
+	}
+
+	wantMsg := "expected next exec to match git fetch, got `git status`"
+	if msg := cs.describeMismatch(exec.Command("git", "status")); msg != wantMsg {
+		t.Errorf("describeMismatch() = %q, want %q", msg, wantMsg)
+	}
+
+	fetch := cs.find(exec.Command("git", "fetch"))
+	if fetch == nil {
+		t.Fatal("expected in-order invocation to match")
+	}
+	fetch.matched = true
+	if s := cs.find(exec.Command("git", "status")); s == nil {
+		t.Error("expected next stub to match once its predecessor matched")
+	}
+}
+
+func TestCommandStubber_describeMismatch(t *testing.T) {
+	cs := &CommandStubber{}
+	cs.Register(`git fetch`, 0, "")
+	cs.RegisterExact([]string{"git", "status"}, 0, "").WithEnv("GIT_DIR", ".git")
+
+	wantMsg := "no exec stub for `git push`\nremaining expectations:\n  git fetch\n  `git status` (env GIT_DIR=.git)"
+	if msg := cs.describeMismatch(exec.Command("git", "push")); msg != wantMsg {
+		t.Errorf("describeMismatch() = %q, want %q", msg, wantMsg)
+	}
+}
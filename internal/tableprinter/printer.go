@@ -0,0 +1,371 @@
+package tableprinter
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cli/cli/v2/internal/text"
+)
+
+// ColumnPolicy constrains how a column is sized when a table is wider than the
+// viewport. The zero value leaves the column fully flexible.
+type ColumnPolicy struct {
+	// MinWidth is the narrowest the column may be squeezed to.
+	MinWidth int
+	// MaxWidth caps the column even when the viewport has room to spare.
+	MaxWidth int
+	// Priority orders columns for shrinking: columns with higher priority
+	// numbers give up their width first.
+	Priority int
+}
+
+// minSqueezedWidth is the narrowest that a column without an explicit
+// MinWidth will be squeezed to, leaving room for a truncation ellipsis.
+const minSqueezedWidth = 5
+
+type tableField struct {
+	text         string
+	truncateFunc func(int, string) string
+	paddingFunc  func(int, string) string
+	colorFunc    func(string) string
+}
+
+type fieldOption func(*tableField)
+
+// WithTruncate overrides the truncation function for the field. The function should transform a string
+// argument into a string that fits within the given display width. The default behavior is to truncate the
+// value by adding "..." in the end. The truncation function will be called before padding and coloring.
+// Pass nil to disable truncation for this value.
+func WithTruncate(fn func(int, string) string) fieldOption {
+	return func(f *tableField) {
+		f.truncateFunc = fn
+	}
+}
+
+// WithPadding overrides the padding function for the field. The function should transform a string argument
+// into a string that is padded to fit within the given display width. The default behavior is to pad fields
+// with spaces except for the last field. The padding function will be called after truncation and before coloring.
+// Pass nil to disable padding for this value.
+func WithPadding(fn func(int, string) string) fieldOption {
+	return func(f *tableField) {
+		f.paddingFunc = fn
+	}
+}
+
+// WithColor sets the color function for the field. The function should transform a string value by wrapping
+// it in ANSI escape codes. The color function will not be used if the table was initialized in non-terminal mode.
+// The color function will be called before truncation and padding.
+func WithColor(fn func(string) string) fieldOption {
+	return func(f *tableField) {
+		f.colorFunc = fn
+	}
+}
+
+type printer interface {
+	AddHeader([]string, ...fieldOption)
+	AddField(string, ...fieldOption)
+	EndRow()
+	Render() error
+}
+
+// newPrinter initializes a table printer engine for the given terminal mode
+// and width. Column measurements use display width so that CJK characters and
+// emoji, which occupy two terminal cells, stay aligned.
+func newPrinter(w io.Writer, isTTY bool, maxWidth int, policies []ColumnPolicy) printer {
+	if isTTY {
+		return &ttyTablePrinter{
+			out:      w,
+			maxWidth: maxWidth,
+			policies: policies,
+		}
+	}
+	return &tsvTablePrinter{
+		out: w,
+	}
+}
+
+type ttyTablePrinter struct {
+	out        io.Writer
+	maxWidth   int
+	policies   []ColumnPolicy
+	hasHeaders bool
+	rows       [][]tableField
+}
+
+func (t *ttyTablePrinter) AddHeader(columns []string, opts ...fieldOption) {
+	if t.hasHeaders {
+		return
+	}
+
+	t.hasHeaders = true
+	for _, column := range columns {
+		t.AddField(column, opts...)
+	}
+	t.EndRow()
+}
+
+func (t *ttyTablePrinter) AddField(s string, opts ...fieldOption) {
+	if t.rows == nil {
+		t.rows = make([][]tableField, 1)
+	}
+	rowI := len(t.rows) - 1
+	field := tableField{
+		text:         s,
+		truncateFunc: text.Truncate,
+	}
+	for _, opt := range opts {
+		opt(&field)
+	}
+	t.rows[rowI] = append(t.rows[rowI], field)
+}
+
+func (t *ttyTablePrinter) EndRow() {
+	t.rows = append(t.rows, []tableField{})
+}
+
+func (t *ttyTablePrinter) Render() error {
+	if len(t.rows) == 0 {
+		return nil
+	}
+
+	delim := "  "
+	numCols := len(t.rows[0])
+	colWidths := t.calculateColumnWidths(len(delim))
+
+	for _, row := range t.rows {
+		for col, field := range row {
+			if col > 0 {
+				if _, err := fmt.Fprint(t.out, delim); err != nil {
+					return err
+				}
+			}
+			truncVal := field.text
+			if field.truncateFunc != nil {
+				truncVal = field.truncateFunc(colWidths[col], field.text)
+			}
+			if field.paddingFunc != nil {
+				truncVal = field.paddingFunc(colWidths[col], truncVal)
+			} else if col < numCols-1 {
+				truncVal = text.PadRight(colWidths[col], truncVal)
+			}
+			if field.colorFunc != nil {
+				truncVal = field.colorFunc(truncVal)
+			}
+			if _, err := fmt.Fprint(t.out, truncVal); err != nil {
+				return err
+			}
+		}
+		if len(row) > 0 {
+			if _, err := fmt.Fprint(t.out, "\n"); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (t *ttyTablePrinter) calculateColumnWidths(delimSize int) []int {
+	if len(t.policies) > 0 {
+		return t.policyColumnWidths(delimSize)
+	}
+	return t.flexibleColumnWidths(delimSize)
+}
+
+// flexibleColumnWidths distributes the viewport evenly between columns that
+// need truncating, which is the historical layout for tables without column
+// policies.
+func (t *ttyTablePrinter) flexibleColumnWidths(delimSize int) []int {
+	numCols := len(t.rows[0])
+	maxColWidths := make([]int, numCols)
+	colWidths := make([]int, numCols)
+
+	for _, row := range t.rows {
+		for col, field := range row {
+			w := text.DisplayWidth(field.text)
+			if w > maxColWidths[col] {
+				maxColWidths[col] = w
+			}
+			// if this field has disabled truncating, ensure that the column is wide enough
+			if field.truncateFunc == nil && w > colWidths[col] {
+				colWidths[col] = w
+			}
+		}
+	}
+
+	availWidth := func() int {
+		setWidths := 0
+		for col := 0; col < numCols; col++ {
+			setWidths += colWidths[col]
+		}
+		return t.maxWidth - delimSize*(numCols-1) - setWidths
+	}
+	numFixedCols := func() int {
+		fixedCols := 0
+		for col := 0; col < numCols; col++ {
+			if colWidths[col] > 0 {
+				fixedCols++
+			}
+		}
+		return fixedCols
+	}
+
+	// set the widths of short columns
+	if w := availWidth(); w > 0 {
+		if numFlexColumns := numCols - numFixedCols(); numFlexColumns > 0 {
+			perColumn := w / numFlexColumns
+			for col := 0; col < numCols; col++ {
+				if max := maxColWidths[col]; max < perColumn {
+					colWidths[col] = max
+				}
+			}
+		}
+	}
+
+	// truncate long columns to the remaining available width
+	if numFlexColumns := numCols - numFixedCols(); numFlexColumns > 0 {
+		perColumn := availWidth() / numFlexColumns
+		for col := 0; col < numCols; col++ {
+			if colWidths[col] == 0 {
+				if max := maxColWidths[col]; max < perColumn {
+					colWidths[col] = max
+				} else if perColumn > 0 {
+					colWidths[col] = perColumn
+				}
+			}
+		}
+	}
+
+	// add the remainder to truncated columns
+	if w := availWidth(); w > 0 {
+		for col := 0; col < numCols; col++ {
+			d := maxColWidths[col] - colWidths[col]
+			toAdd := w
+			if d < toAdd {
+				toAdd = d
+			}
+			colWidths[col] += toAdd
+			w -= toAdd
+			if w <= 0 {
+				break
+			}
+		}
+	}
+
+	return colWidths
+}
+
+// policyColumnWidths sizes every column to its content, then squeezes columns
+// in descending priority order until the table fits the viewport.
+func (t *ttyTablePrinter) policyColumnWidths(delimSize int) []int {
+	numCols := len(t.rows[0])
+	colWidths := make([]int, numCols)
+	fixed := make([]bool, numCols)
+
+	for _, row := range t.rows {
+		for col, field := range row {
+			if w := text.DisplayWidth(field.text); w > colWidths[col] {
+				colWidths[col] = w
+			}
+			if field.truncateFunc == nil {
+				fixed[col] = true
+			}
+		}
+	}
+
+	for col := range colWidths {
+		if p := t.policy(col); p.MaxWidth > 0 && colWidths[col] > p.MaxWidth && !fixed[col] {
+			colWidths[col] = p.MaxWidth
+		}
+	}
+
+	totalWidth := 0
+	for _, w := range colWidths {
+		totalWidth += w
+	}
+	deficit := totalWidth - (t.maxWidth - delimSize*(numCols-1))
+
+	for _, priority := range t.sortedPriorities() {
+		if deficit <= 0 {
+			break
+		}
+		// squeeze the widest column of the group first so that members end
+		// up roughly level with each other
+		for deficit > 0 {
+			squeezeCol := -1
+			for col := 0; col < numCols; col++ {
+				if t.policy(col).Priority != priority || fixed[col] || colWidths[col] <= t.minWidth(col) {
+					continue
+				}
+				if squeezeCol == -1 || colWidths[col] > colWidths[squeezeCol] {
+					squeezeCol = col
+				}
+			}
+			if squeezeCol == -1 {
+				break
+			}
+			colWidths[squeezeCol]--
+			deficit--
+		}
+	}
+
+	return colWidths
+}
+
+func (t *ttyTablePrinter) policy(col int) ColumnPolicy {
+	if col < len(t.policies) {
+		return t.policies[col]
+	}
+	return ColumnPolicy{}
+}
+
+func (t *ttyTablePrinter) minWidth(col int) int {
+	if min := t.policy(col).MinWidth; min > 0 {
+		return min
+	}
+	return minSqueezedWidth
+}
+
+// sortedPriorities returns the distinct column priorities in descending
+// order, so that the highest-numbered priority is squeezed first.
+func (t *ttyTablePrinter) sortedPriorities() []int {
+	seen := map[int]bool{}
+	var priorities []int
+	for col := 0; col < len(t.rows[0]); col++ {
+		if p := t.policy(col).Priority; !seen[p] {
+			seen[p] = true
+			priorities = append(priorities, p)
+		}
+	}
+	for i := 0; i < len(priorities); i++ {
+		for j := i + 1; j < len(priorities); j++ {
+			if priorities[j] > priorities[i] {
+				priorities[i], priorities[j] = priorities[j], priorities[i]
+			}
+		}
+	}
+	return priorities
+}
+
+type tsvTablePrinter struct {
+	out        io.Writer
+	currentCol int
+}
+
+func (t *tsvTablePrinter) AddHeader(_ []string, _ ...fieldOption) {}
+
+func (t *tsvTablePrinter) AddField(text string, _ ...fieldOption) {
+	if t.currentCol > 0 {
+		fmt.Fprint(t.out, "\t")
+	}
+	fmt.Fprint(t.out, text)
+	t.currentCol++
+}
+
+func (t *tsvTablePrinter) EndRow() {
+	fmt.Fprint(t.out, "\n")
+	t.currentCol = 0
+}
+
+func (t *tsvTablePrinter) Render() error {
+	return nil
+}
@@ -0,0 +1,95 @@
+package tableprinter_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testColorScheme() *iostreams.ColorScheme {
+	ios, _, _, _ := iostreams.Test()
+	return ios.ColorScheme()
+}
+
+func TestRenderFitsNaturalWidths(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := tableprinter.NewWithWriter(buf, true, 80, testColorScheme(), tableprinter.NoHeader)
+
+	table.AddField("#1")
+	table.AddField("hello world")
+	table.EndRow()
+	table.AddField("#20")
+	table.AddField("hi")
+	table.EndRow()
+
+	require.NoError(t, table.Render())
+	assert.Equal(t, "#1   hello world\n#20  hi\n", buf.String())
+}
+
+func TestRenderAlignsWideGlyphs(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := tableprinter.NewWithWriter(buf, true, 80, testColorScheme(), tableprinter.NoHeader)
+
+	// "你好" occupies four terminal cells, the same as "tost"
+	table.AddField("你好")
+	table.AddField("one")
+	table.EndRow()
+	table.AddField("tost")
+	table.AddField("two")
+	table.EndRow()
+
+	require.NoError(t, table.Render())
+	assert.Equal(t, "你好  one\ntost  two\n", buf.String())
+}
+
+func TestRenderSqueezesByPriority(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := tableprinter.NewWithWriter(buf, true, 28, testColorScheme(), tableprinter.NoHeader,
+		tableprinter.ColumnPolicy{MinWidth: 4},
+		tableprinter.ColumnPolicy{MinWidth: 8, Priority: 1},
+		tableprinter.ColumnPolicy{MinWidth: 6, Priority: 2},
+	)
+
+	table.AddField("#101")
+	table.AddField("a title that runs long")
+	table.AddField("feature-branch")
+	table.EndRow()
+
+	require.NoError(t, table.Render())
+	// the branch column has the highest priority and is squeezed all the way
+	// to its minimum before the title gives up the remaining width
+	assert.Equal(t, "#101  a title tha...  fea...\n", buf.String())
+}
+
+func TestRenderCapsColumnAtMaxWidth(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := tableprinter.NewWithWriter(buf, true, 80, testColorScheme(), tableprinter.NoHeader,
+		tableprinter.ColumnPolicy{},
+		tableprinter.ColumnPolicy{MaxWidth: 10},
+	)
+
+	table.AddField("#1")
+	table.AddField("a needlessly verbose description")
+	table.EndRow()
+
+	require.NoError(t, table.Render())
+	assert.Equal(t, "#1  a needl...\n", buf.String())
+}
+
+func TestRenderNonTTYIgnoresPolicies(t *testing.T) {
+	buf := &bytes.Buffer{}
+	table := tableprinter.NewWithWriter(buf, false, 80, testColorScheme(), tableprinter.NoHeader,
+		tableprinter.ColumnPolicy{MaxWidth: 4},
+	)
+
+	table.AddField("a needlessly verbose description")
+	table.AddField("two")
+	table.EndRow()
+
+	require.NoError(t, table.Render())
+	assert.Equal(t, "a needlessly verbose description\ttwo\n", buf.String())
+}
@@ -1,65 +1,123 @@
 package tableprinter
 
 import (
+	"fmt"
 	"io"
 	"strings"
 	"time"
 
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/iostreams"
-	"github.com/cli/go-gh/v2/pkg/tableprinter"
 )
 
 type TablePrinter struct {
-	tableprinter.TablePrinter
-	isTTY bool
-	cs    *iostreams.ColorScheme
+	printer
+	isTTY      bool
+	cs         *iostreams.ColorScheme
+	timeFormat TimeFormat
+	location   *time.Location
 }
 
+// TimeFormat controls how AddTimeField renders timestamps.
+type TimeFormat string
+
+const (
+	// TimeFormatDefault renders relative times on terminals and RFC 3339
+	// timestamps otherwise.
+	TimeFormatDefault TimeFormat = ""
+	// TimeFormatRelative always renders relative times such as "about 3 hours ago".
+	TimeFormatRelative TimeFormat = "relative"
+	// TimeFormatISO always renders RFC 3339 timestamps.
+	TimeFormatISO TimeFormat = "iso"
+	// TimeFormatLocal renders timestamps in a human-readable layout in the
+	// configured time zone, falling back to the system time zone.
+	TimeFormatLocal TimeFormat = "local"
+)
+
+// localTimeLayout is the layout used by TimeFormatLocal.
+const localTimeLayout = "2006-01-02 15:04:05 MST"
+
 // IsTTY gets whether the TablePrinter will render to a terminal.
 func (t *TablePrinter) IsTTY() bool {
 	return t.isTTY
 }
 
-// AddTimeField in TTY mode displays the fuzzy time difference between now and t.
-// In non-TTY mode it just displays t with the time.RFC3339 format.
+// SetTimeFormat overrides how subsequent AddTimeField calls render timestamps.
+func (tp *TablePrinter) SetTimeFormat(format TimeFormat) {
+	tp.timeFormat = format
+}
+
+// SetTimeLocation sets the time zone that absolute timestamps are rendered in.
+// Without it, timestamps keep the zone they carry, which for API responses is UTC.
+func (tp *TablePrinter) SetTimeLocation(loc *time.Location) {
+	tp.location = loc
+}
+
+// AddTimeField displays t according to the configured TimeFormat. By default
+// that is the fuzzy time difference between now and t in TTY mode, and the
+// time.RFC3339 format otherwise.
 func (tp *TablePrinter) AddTimeField(now, t time.Time, c func(string) string) {
+	if tp.location != nil {
+		t = t.In(tp.location)
+	}
 	var tf string
-	if tp.isTTY {
+	switch tp.timeFormat {
+	case TimeFormatRelative:
 		tf = text.FuzzyAgo(now, t)
-	} else {
+	case TimeFormatISO:
 		tf = t.Format(time.RFC3339)
+	case TimeFormatLocal:
+		if tp.location == nil {
+			t = t.Local()
+		}
+		tf = t.Format(localTimeLayout)
+	default:
+		if tp.isTTY {
+			tf = text.FuzzyAgo(now, t)
+		} else {
+			tf = t.Format(time.RFC3339)
+		}
 	}
 	tp.AddField(tf, WithColor(c))
 }
 
-var (
-	WithColor    = tableprinter.WithColor
-	WithPadding  = tableprinter.WithPadding
-	WithTruncate = tableprinter.WithTruncate
-)
+// TimeLocationFromConfig returns the time zone named by the `timezone`
+// configuration setting, or nil when no time zone is configured.
+func TimeLocationFromConfig(cfg gh.Config) (*time.Location, error) {
+	entry, ok := cfg.GetOrDefault("", "timezone").Value()
+	if !ok || entry.Value == "" {
+		return nil, nil
+	}
+	loc, err := time.LoadLocation(entry.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q in configuration: %w", entry.Value, err)
+	}
+	return loc, nil
+}
 
 type headerOption struct {
 	columns []string
 }
 
-// New creates a TablePrinter from an IOStreams.
-func New(ios *iostreams.IOStreams, headers headerOption) *TablePrinter {
+// New creates a TablePrinter from an IOStreams. Optional column policies
+// control how columns shrink when the terminal is narrower than the table.
+func New(ios *iostreams.IOStreams, headers headerOption, policies ...ColumnPolicy) *TablePrinter {
 	maxWidth := 80
 	isTTY := ios.IsStdoutTTY()
 	if isTTY {
 		maxWidth = ios.TerminalWidth()
 	}
 
-	return NewWithWriter(ios.Out, isTTY, maxWidth, ios.ColorScheme(), headers)
+	return NewWithWriter(ios.Out, isTTY, maxWidth, ios.ColorScheme(), headers, policies...)
 }
 
 // NewWithWriter creates a TablePrinter from a Writer, whether the output is a terminal, the terminal width, and more.
-func NewWithWriter(w io.Writer, isTTY bool, maxWidth int, cs *iostreams.ColorScheme, headers headerOption) *TablePrinter {
+func NewWithWriter(w io.Writer, isTTY bool, maxWidth int, cs *iostreams.ColorScheme, headers headerOption, policies ...ColumnPolicy) *TablePrinter {
 	tp := &TablePrinter{
-		TablePrinter: tableprinter.New(w, isTTY, maxWidth),
-		isTTY:        isTTY,
-		cs:           cs,
+		printer: newPrinter(w, isTTY, maxWidth, policies),
+		isTTY:   isTTY,
+		cs:      cs,
 	}
 
 	if isTTY && len(headers.columns) > 0 {
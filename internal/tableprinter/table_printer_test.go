@@ -1,8 +1,11 @@
 package tableprinter_test
 
 import (
+	"bytes"
 	"testing"
+	"time"
 
+	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/stretchr/testify/require"
@@ -20,3 +23,93 @@ func TestHeadersAreNotMutated(t *testing.T) {
 	// The provided headers should not be mutated
 	require.Equal(t, []string{"one", "two", "three"}, headers)
 }
+
+func TestAddTimeFieldFormats(t *testing.T) {
+	now := time.Date(2024, time.May, 1, 12, 0, 0, 0, time.UTC)
+	created := time.Date(2024, time.May, 1, 9, 0, 0, 0, time.UTC)
+
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		isTTY    bool
+		format   tableprinter.TimeFormat
+		location *time.Location
+		want     string
+	}{
+		{
+			name:   "default renders relative times on terminals",
+			isTTY:  true,
+			format: tableprinter.TimeFormatDefault,
+			want:   "about 3 hours ago\n",
+		},
+		{
+			name:   "default renders RFC 3339 timestamps off terminals",
+			isTTY:  false,
+			format: tableprinter.TimeFormatDefault,
+			want:   "2024-05-01T09:00:00Z\n",
+		},
+		{
+			name:   "relative renders relative times off terminals",
+			isTTY:  false,
+			format: tableprinter.TimeFormatRelative,
+			want:   "about 3 hours ago\n",
+		},
+		{
+			name:   "iso renders RFC 3339 timestamps on terminals",
+			isTTY:  true,
+			format: tableprinter.TimeFormatISO,
+			want:   "2024-05-01T09:00:00Z\n",
+		},
+		{
+			name:     "iso honors the configured time zone",
+			isTTY:    false,
+			format:   tableprinter.TimeFormatISO,
+			location: newYork,
+			want:     "2024-05-01T05:00:00-04:00\n",
+		},
+		{
+			name:     "local honors the configured time zone",
+			isTTY:    false,
+			format:   tableprinter.TimeFormatLocal,
+			location: newYork,
+			want:     "2024-05-01 05:00:00 EDT\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			buf := &bytes.Buffer{}
+			tp := tableprinter.NewWithWriter(buf, tt.isTTY, 80, ios.ColorScheme(), tableprinter.NoHeader)
+			tp.SetTimeFormat(tt.format)
+			tp.SetTimeLocation(tt.location)
+
+			tp.AddTimeField(now, created, nil)
+			tp.EndRow()
+			require.NoError(t, tp.Render())
+
+			require.Equal(t, tt.want, buf.String())
+		})
+	}
+}
+
+func TestTimeLocationFromConfig(t *testing.T) {
+	t.Run("no timezone configured", func(t *testing.T) {
+		loc, err := tableprinter.TimeLocationFromConfig(config.NewBlankConfig())
+		require.NoError(t, err)
+		require.Nil(t, loc)
+	})
+
+	t.Run("valid timezone", func(t *testing.T) {
+		loc, err := tableprinter.TimeLocationFromConfig(config.NewFromString("timezone: America/New_York\n"))
+		require.NoError(t, err)
+		require.Equal(t, "America/New_York", loc.String())
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		_, err := tableprinter.TimeLocationFromConfig(config.NewFromString("timezone: Mars/Olympus_Mons\n"))
+		require.ErrorContains(t, err, `invalid timezone "Mars/Olympus_Mons"`)
+	})
+}
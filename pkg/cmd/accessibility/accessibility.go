@@ -1,8 +1,6 @@
 package accessibility
 
 import (
-	"fmt"
-
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/text"
@@ -36,7 +34,7 @@ func NewCmdAccessibility(f *cmdutil.Factory) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if opts.Web {
 				if opts.IO.IsStdoutTTY() {
-					fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(webURL))
+					opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(webURL))
 				}
 				return opts.Browser.Browse(webURL)
 			}
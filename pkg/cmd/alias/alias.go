@@ -3,6 +3,7 @@ package alias
 import (
 	"github.com/MakeNowJust/heredoc"
 	deleteCmd "github.com/cli/cli/v2/pkg/cmd/alias/delete"
+	exportCmd "github.com/cli/cli/v2/pkg/cmd/alias/exports"
 	importCmd "github.com/cli/cli/v2/pkg/cmd/alias/imports"
 	listCmd "github.com/cli/cli/v2/pkg/cmd/alias/list"
 	setCmd "github.com/cli/cli/v2/pkg/cmd/alias/set"
@@ -24,6 +25,7 @@ func NewCmdAlias(f *cmdutil.Factory) *cobra.Command {
 	cmdutil.DisableAuthCheck(cmd)
 
 	cmd.AddCommand(deleteCmd.NewCmdDelete(f, nil))
+	cmd.AddCommand(exportCmd.NewCmdExport(f, nil))
 	cmd.AddCommand(importCmd.NewCmdImport(f, nil))
 	cmd.AddCommand(listCmd.NewCmdList(f, nil))
 	cmd.AddCommand(setCmd.NewCmdSet(f, nil))
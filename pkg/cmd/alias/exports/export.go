@@ -0,0 +1,98 @@
+package exports
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type ExportOptions struct {
+	Config func() (gh.Config, error)
+	IO     *iostreams.IOStreams
+
+	Filename string
+}
+
+func NewCmdExport(f *cmdutil.Factory, runF func(*ExportOptions) error) *cobra.Command {
+	opts := &ExportOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "export [<filename>]",
+		Short: "Export aliases to a YAML file",
+		Long: heredoc.Docf(`
+			Export your aliases as a YAML file that %[1]sgh alias import%[1]s understands,
+			so a set of aliases can be shared with a team or moved between machines.
+
+			Without an argument, or with %[1]s-%[1]s, the aliases are written to standard output.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Export aliases to a file
+			$ gh alias export aliases.yml
+
+			# Export aliases to standard output
+			$ gh alias export
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Filename = "-"
+			if len(args) > 0 {
+				opts.Filename = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return exportRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func exportRun(opts *ExportOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	aliasMap := cfg.Aliases().All()
+
+	if len(aliasMap) == 0 {
+		return cmdutil.NewNoResultsError("no aliases configured")
+	}
+
+	out, err := yaml.Marshal(aliasMap)
+	if err != nil {
+		return err
+	}
+
+	if opts.Filename == "-" {
+		_, err = opts.IO.Out.Write(out)
+		return err
+	}
+
+	if err := os.WriteFile(opts.Filename, out, 0o644); err != nil {
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Exported %d aliases to %q\n",
+			cs.SuccessIcon(),
+			len(aliasMap),
+			opts.Filename,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package exports
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdExport(t *testing.T) {
+	tests := []struct {
+		name       string
+		cli        string
+		wants      ExportOptions
+		wantsError string
+	}{
+		{
+			name: "no filename",
+			cli:  "",
+			wants: ExportOptions{
+				Filename: "-",
+			},
+		},
+		{
+			name: "stdout arg",
+			cli:  "-",
+			wants: ExportOptions{
+				Filename: "-",
+			},
+		},
+		{
+			name: "filename arg",
+			cli:  "aliases.yml",
+			wants: ExportOptions{
+				Filename: "aliases.yml",
+			},
+		},
+		{
+			name:       "multiple filenames",
+			cli:        "aliases1 aliases2",
+			wantsError: "accepts at most 1 arg(s), received 2",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: ios}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ExportOptions
+			cmd := NewCmdExport(f, func(opts *ExportOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsError != "" {
+				assert.EqualError(t, err, tt.wantsError)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wants.Filename, gotOpts.Filename)
+		})
+	}
+}
+
+func TestExportRun(t *testing.T) {
+	initConfig := heredoc.Doc(`
+        aliases:
+            co: pr checkout
+            igrep: '!gh issue list --label="$1" | grep "$2"'
+    `)
+	wantYAML := heredoc.Doc(`
+        co: pr checkout
+        igrep: '!gh issue list --label="$1" | grep "$2"'
+    `)
+
+	t.Run("to stdout", func(t *testing.T) {
+		ios, _, stdout, _ := iostreams.Test()
+		opts := &ExportOptions{
+			IO: ios,
+			Config: func() (gh.Config, error) {
+				return config.NewFromString(initConfig), nil
+			},
+			Filename: "-",
+		}
+
+		require.NoError(t, exportRun(opts))
+		assert.Equal(t, wantYAML, stdout.String())
+	})
+
+	t.Run("to file", func(t *testing.T) {
+		tmpFile := filepath.Join(t.TempDir(), "aliases.yml")
+		ios, _, stdout, stderr := iostreams.Test()
+		ios.SetStdoutTTY(true)
+		opts := &ExportOptions{
+			IO: ios,
+			Config: func() (gh.Config, error) {
+				return config.NewFromString(initConfig), nil
+			},
+			Filename: tmpFile,
+		}
+
+		require.NoError(t, exportRun(opts))
+		assert.Equal(t, "", stdout.String())
+		assert.Equal(t, "✓ Exported 2 aliases to "+`"`+tmpFile+`"`+"\n", stderr.String())
+
+		contents, err := os.ReadFile(tmpFile)
+		require.NoError(t, err)
+		assert.Equal(t, wantYAML, string(contents))
+	})
+
+	t.Run("no aliases configured", func(t *testing.T) {
+		ios, _, _, _ := iostreams.Test()
+		opts := &ExportOptions{
+			IO: ios,
+			Config: func() (gh.Config, error) {
+				return config.NewFromString(""), nil
+			},
+			Filename: "-",
+		}
+
+		err := exportRun(opts)
+		var noResultsErr cmdutil.NoResultsError
+		assert.ErrorAs(t, err, &noResultsErr)
+	})
+}
@@ -58,6 +58,9 @@ type ApiOptions struct {
 	CacheTTL            time.Duration
 	FilterOutput        string
 	Verbose             bool
+	ValidateQuery       bool
+	SchemaDump          bool
+	MaxCost             int
 }
 
 func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command {
@@ -254,6 +257,18 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 				}
 			}
 
+			if opts.ValidateQuery && opts.RequestPath != "graphql" {
+				return cmdutil.FlagErrorf("the `--validate` option is only supported for the \"graphql\" endpoint")
+			}
+
+			if opts.SchemaDump && opts.RequestPath != "graphql" {
+				return cmdutil.FlagErrorf("the `--schema-dump` option is only supported for the \"graphql\" endpoint")
+			}
+
+			if opts.MaxCost > 0 && opts.RequestPath != "graphql" {
+				return cmdutil.FlagErrorf("the `--max-cost` option is only supported for the \"graphql\" endpoint")
+			}
+
 			if err := cmdutil.MutuallyExclusive(
 				"only one of `--template`, `--jq`, `--silent`, or `--verbose` may be used",
 				opts.Verbose,
@@ -286,6 +301,9 @@ func NewCmdApi(f *cmdutil.Factory, runF func(*ApiOptions) error) *cobra.Command
 	cmd.Flags().StringVarP(&opts.FilterOutput, "jq", "q", "", "Query to select values from the response using jq syntax")
 	cmd.Flags().DurationVar(&opts.CacheTTL, "cache", 0, "Cache the response, e.g. \"3600s\", \"60m\", \"1h\"")
 	cmd.Flags().BoolVar(&opts.Verbose, "verbose", false, "Include full HTTP request and response in the output")
+	cmd.Flags().BoolVar(&opts.ValidateQuery, "validate", false, "With the \"graphql\" endpoint, check the query against the schema before sending")
+	cmd.Flags().BoolVar(&opts.SchemaDump, "schema-dump", false, "With the \"graphql\" endpoint, print the introspection schema and exit")
+	cmd.Flags().IntVar(&opts.MaxCost, "max-cost", 0, "With the \"graphql\" endpoint, abort when the estimated query cost exceeds this many rate limit `points`")
 	return cmd
 }
 
@@ -396,6 +414,34 @@ func apiRun(opts *ApiOptions) error {
 		host = opts.Hostname
 	}
 
+	if opts.SchemaDump {
+		return schemaDumpRun(httpClient, host, bodyWriter)
+	}
+
+	if opts.ValidateQuery {
+		queryStr, _ := params["query"].(string)
+		if queryStr == "" {
+			return errors.New("no GraphQL query to validate; pass one with `-f query='...'`")
+		}
+		schema, err := fetchSchema(httpClient, host)
+		if err != nil {
+			return err
+		}
+		if validationErrors := schema.validateQuery(queryStr); len(validationErrors) > 0 {
+			cs := opts.IO.ColorScheme()
+			for _, validationError := range validationErrors {
+				fmt.Fprintf(opts.IO.ErrOut, "%s %s\n", cs.FailureIcon(), validationError)
+			}
+			return cmdutil.SilentError
+		}
+	}
+
+	if isGraphQL {
+		if err := checkQueryCost(opts, params); err != nil {
+			return err
+		}
+	}
+
 	tmpl := template.New(bodyWriter, opts.IO.TerminalWidth(), opts.IO.ColorEnabled())
 	err = tmpl.Parse(opts.Template)
 	if err != nil {
@@ -531,6 +577,26 @@ func processResponse(resp *http.Response, opts *ApiOptions, bodyWriter, headersW
 	return
 }
 
+// checkQueryCost estimates the rate limit cost of a GraphQL query before it
+// runs, aborts when the estimate exceeds --max-cost, and warns when a single
+// invocation would consume a large fraction of the hourly rate budget.
+func checkQueryCost(opts *ApiOptions, params map[string]interface{}) error {
+	queryStr, _ := params["query"].(string)
+	if queryStr == "" {
+		return nil
+	}
+
+	cost := api.EstimateGraphQLCost(queryStr, params)
+	if opts.MaxCost > 0 && cost > opts.MaxCost {
+		return fmt.Errorf("estimated query cost %d exceeds the --max-cost limit of %d", cost, opts.MaxCost)
+	}
+	if cost*10 >= api.GraphQLRateBudget {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Estimated query cost %d is more than a tenth of the hourly GraphQL rate budget of %d points\n",
+			opts.IO.ColorScheme().WarningIcon(), cost, api.GraphQLRateBudget)
+	}
+	return nil
+}
+
 var placeholderRE = regexp.MustCompile(`(\:(owner|repo|branch)\b|\{[a-z]+\})`)
 
 // fillPlaceholders replaces placeholders with values from the current repository
@@ -1383,6 +1383,57 @@ func Test_apiRun_cache(t *testing.T) {
 	assert.Equal(t, "", stderr.String(), "stderr")
 }
 
+func Test_checkQueryCost(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     map[string]interface{}
+		maxCost    int
+		err        string
+		wantStderr string
+	}{
+		{
+			name:   "no query",
+			params: map[string]interface{}{},
+		},
+		{
+			name: "small query",
+			params: map[string]interface{}{
+				"query": `{ viewer { repositories(first: 100) { nodes { name } } } }`,
+			},
+			maxCost: 10,
+		},
+		{
+			name: "query exceeds max cost",
+			params: map[string]interface{}{
+				"query": `{ viewer { repositories(first: $limit) { nodes { name } } } }`,
+				"limit": 5000,
+			},
+			maxCost: 10,
+			err:     "estimated query cost 50 exceeds the --max-cost limit of 10",
+		},
+		{
+			name: "large query warns",
+			params: map[string]interface{}{
+				"query": `{ repository(owner: "OWNER", name: "REPO") { issues(first: 100) { nodes { comments(first: 500) { nodes { body } } } } } }`,
+			},
+			wantStderr: "! Estimated query cost 501 is more than a tenth of the hourly GraphQL rate budget of 5000 points\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, stderr := iostreams.Test()
+			opts := &ApiOptions{IO: ios, MaxCost: tt.maxCost}
+			err := checkQueryCost(opts, tt.params)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
+
 func Test_openUserFile(t *testing.T) {
 	f, err := os.CreateTemp(t.TempDir(), "gh-test")
 	if err != nil {
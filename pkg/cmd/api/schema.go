@@ -0,0 +1,431 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+)
+
+// schemaDumpQuery is the full introspection document, suitable for feeding
+// into editor tooling that consumes an introspection result.
+const schemaDumpQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+    directives { name description locations args { ...InputValue } }
+  }
+}
+fragment FullType on __Type {
+  kind
+  name
+  description
+  fields(includeDeprecated: true) {
+    name
+    description
+    args { ...InputValue }
+    type { ...TypeRef }
+    isDeprecated
+    deprecationReason
+  }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) { name description isDeprecated deprecationReason }
+  possibleTypes { ...TypeRef }
+}
+fragment InputValue on __InputValue {
+  name
+  description
+  type { ...TypeRef }
+  defaultValue
+}
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } } } }
+}`
+
+// schemaDumpRun prints the raw introspection response for the host, cached
+// like schema validation so repeated dumps do not re-query the API.
+func schemaDumpRun(httpClient *http.Client, host string, w io.Writer) error {
+	cachedClient := api.NewCachedHTTPClient(httpClient, time.Hour*24)
+	resp, err := httpRequest(cachedClient, host, "POST", "graphql", map[string]interface{}{"query": schemaDumpQuery}, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 400 {
+		return api.HandleHTTPError(resp)
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
+
+// introspectionQuery fetches just enough of the schema to resolve which
+// fields exist on which types. Type references are unwrapped through a fixed
+// number of NON_NULL/LIST layers, which covers every type in the GitHub API.
+const introspectionQuery = `query IntrospectionSchema {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    types {
+      name
+      kind
+      fields(includeDeprecated: true) {
+        name
+        type { name ofType { name ofType { name ofType { name ofType { name ofType { name ofType { name } } } } } } }
+      }
+    }
+  }
+}`
+
+type schemaTypeRef struct {
+	Name   string
+	OfType *schemaTypeRef
+}
+
+// namedType unwraps NON_NULL and LIST wrappers down to the named type.
+func (r *schemaTypeRef) namedType() string {
+	for r != nil {
+		if r.Name != "" {
+			return r.Name
+		}
+		r = r.OfType
+	}
+	return ""
+}
+
+type introspectionResponse struct {
+	Schema struct {
+		QueryType    struct{ Name string }
+		MutationType struct{ Name string }
+		Types        []struct {
+			Name   string
+			Kind   string
+			Fields []struct {
+				Name string
+				Type schemaTypeRef
+			}
+		}
+	} `json:"__schema"`
+}
+
+// graphqlSchema is an index of the object types of a GraphQL API and the
+// result type of each of their fields.
+type graphqlSchema struct {
+	queryType    string
+	mutationType string
+	// fields maps a type name to its field names, each pointing at the named
+	// type of the field's result.
+	fields map[string]map[string]string
+}
+
+// fetchSchema retrieves the introspection schema for a host, caching the
+// response so repeated validations do not re-query the API.
+func fetchSchema(httpClient *http.Client, host string) (*graphqlSchema, error) {
+	cachedClient := api.NewCachedHTTPClient(httpClient, time.Hour*24)
+	apiClient := api.NewClientFromHTTP(cachedClient)
+
+	var resp introspectionResponse
+	if err := apiClient.GraphQL(host, introspectionQuery, nil, &resp); err != nil {
+		return nil, fmt.Errorf("could not fetch GraphQL schema: %w", err)
+	}
+
+	schema := &graphqlSchema{
+		queryType:    resp.Schema.QueryType.Name,
+		mutationType: resp.Schema.MutationType.Name,
+		fields:       map[string]map[string]string{},
+	}
+	for _, t := range resp.Schema.Types {
+		if t.Fields == nil {
+			continue
+		}
+		fields := make(map[string]string, len(t.Fields))
+		for _, f := range t.Fields {
+			fields[f.Name] = f.Type.namedType()
+		}
+		schema.fields[t.Name] = fields
+	}
+	return schema, nil
+}
+
+// queryValidationError is a schema violation at a position in the query text.
+type queryValidationError struct {
+	line    int
+	column  int
+	message string
+}
+
+func (e queryValidationError) Error() string {
+	return fmt.Sprintf("%d:%d: %s", e.line, e.column, e.message)
+}
+
+// validateQuery checks every field selection in a query against the schema
+// and reports each unknown field with its line and column. Only field
+// existence is validated; arguments and variables are not checked.
+func (s *graphqlSchema) validateQuery(query string) []queryValidationError {
+	v := &queryValidator{schema: s, tokens: tokenizeQuery(query)}
+	v.validateDocument()
+	return v.errors
+}
+
+type queryToken struct {
+	kind   string // "name", "punct", "spread", "string", "other"
+	value  string
+	line   int
+	column int
+}
+
+// tokenizeQuery splits a query into tokens with positions, following the
+// GraphQL lexical grammar closely enough for field validation.
+func tokenizeQuery(query string) []queryToken {
+	var tokens []queryToken
+	line, column := 1, 1
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case r == '\n':
+			line++
+			column = 1
+			i++
+		case r == ' ' || r == '\t' || r == '\r' || r == ',':
+			column++
+			i++
+		case r == '#':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+				column++
+			}
+		case r == '"':
+			start := i
+			i++
+			for i < len(runes) && runes[i] != '"' {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			i++
+			tokens = append(tokens, queryToken{kind: "string", value: string(runes[start:min(i, len(runes))]), line: line, column: column})
+			column += i - start
+		case r == '.' && i+2 < len(runes) && runes[i+1] == '.' && runes[i+2] == '.':
+			tokens = append(tokens, queryToken{kind: "spread", value: "...", line: line, column: column})
+			i += 3
+			column += 3
+		case isNameRune(r, true):
+			start := i
+			for i < len(runes) && isNameRune(runes[i], false) {
+				i++
+			}
+			tokens = append(tokens, queryToken{kind: "name", value: string(runes[start:i]), line: line, column: column})
+			column += i - start
+		case strings.ContainsRune("!$():=@[]{|}", r):
+			tokens = append(tokens, queryToken{kind: "punct", value: string(r), line: line, column: column})
+			i++
+			column++
+		default:
+			tokens = append(tokens, queryToken{kind: "other", value: string(r), line: line, column: column})
+			i++
+			column++
+		}
+	}
+	return tokens
+}
+
+func isNameRune(r rune, first bool) bool {
+	if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') {
+		return true
+	}
+	return !first && r >= '0' && r <= '9'
+}
+
+type queryValidator struct {
+	schema *graphqlSchema
+	tokens []queryToken
+	pos    int
+	errors []queryValidationError
+}
+
+func (v *queryValidator) peek() *queryToken {
+	if v.pos >= len(v.tokens) {
+		return nil
+	}
+	return &v.tokens[v.pos]
+}
+
+func (v *queryValidator) next() *queryToken {
+	t := v.peek()
+	if t != nil {
+		v.pos++
+	}
+	return t
+}
+
+func (v *queryValidator) errorf(t *queryToken, format string, args ...interface{}) {
+	e := queryValidationError{message: fmt.Sprintf(format, args...)}
+	if t != nil {
+		e.line = t.line
+		e.column = t.column
+	}
+	v.errors = append(v.errors, e)
+}
+
+// skipBalanced consumes tokens until the punctuation that opened a group is
+// balanced out, e.g. the arguments of a field.
+func (v *queryValidator) skipBalanced(open, close string) {
+	depth := 0
+	for t := v.next(); t != nil; t = v.next() {
+		if t.kind == "punct" && t.value == open {
+			depth++
+		} else if t.kind == "punct" && t.value == close {
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+func (v *queryValidator) validateDocument() {
+	for t := v.peek(); t != nil; t = v.peek() {
+		switch {
+		case t.kind == "punct" && t.value == "{":
+			v.validateSelectionSet(v.schema.queryType)
+		case t.kind == "name" && (t.value == "query" || t.value == "subscription"):
+			v.validateOperation(v.schema.queryType)
+		case t.kind == "name" && t.value == "mutation":
+			v.validateOperation(v.schema.mutationType)
+		case t.kind == "name" && t.value == "fragment":
+			v.validateFragmentDefinition()
+		default:
+			v.next()
+		}
+	}
+}
+
+func (v *queryValidator) validateOperation(rootType string) {
+	v.next() // operation keyword
+	if t := v.peek(); t != nil && t.kind == "name" {
+		v.next() // operation name
+	}
+	if t := v.peek(); t != nil && t.kind == "punct" && t.value == "(" {
+		v.skipBalanced("(", ")") // variable definitions
+	}
+	v.skipDirectives()
+	if t := v.peek(); t != nil && t.kind == "punct" && t.value == "{" {
+		v.validateSelectionSet(rootType)
+	}
+}
+
+func (v *queryValidator) validateFragmentDefinition() {
+	v.next() // fragment
+	v.next() // fragment name
+	var onType string
+	if t := v.peek(); t != nil && t.kind == "name" && t.value == "on" {
+		v.next()
+		if t := v.next(); t != nil {
+			onType = t.value
+			if _, known := v.schema.fields[onType]; !known {
+				v.errorf(t, "unknown type %q", onType)
+				onType = ""
+			}
+		}
+	}
+	v.skipDirectives()
+	if t := v.peek(); t != nil && t.kind == "punct" && t.value == "{" {
+		v.validateSelectionSet(onType)
+	}
+}
+
+// validateSelectionSet checks the fields selected on typeName. An empty type
+// name means the enclosing selection could not be resolved; its fields are
+// then skipped rather than reported, to avoid cascading errors.
+func (v *queryValidator) validateSelectionSet(typeName string) {
+	v.next() // {
+	for t := v.peek(); t != nil; t = v.peek() {
+		switch {
+		case t.kind == "punct" && t.value == "}":
+			v.next()
+			return
+		case t.kind == "spread":
+			v.next()
+			if t := v.peek(); t != nil && t.kind == "name" && t.value == "on" {
+				v.next()
+				var onType string
+				if t := v.next(); t != nil {
+					onType = t.value
+					if _, known := v.schema.fields[onType]; !known {
+						v.errorf(t, "unknown type %q", onType)
+						onType = ""
+					}
+				}
+				v.skipDirectives()
+				if t := v.peek(); t != nil && t.kind == "punct" && t.value == "{" {
+					v.validateSelectionSet(onType)
+				}
+			} else if t != nil && t.kind == "name" {
+				v.next() // fragment spread; validated at its definition
+				v.skipDirectives()
+			}
+		case t.kind == "name":
+			v.validateField(typeName)
+		default:
+			v.next()
+		}
+	}
+}
+
+func (v *queryValidator) validateField(typeName string) {
+	fieldToken := v.next()
+	fieldName := fieldToken.value
+
+	// An alias takes the form `alias: field`.
+	if t := v.peek(); t != nil && t.kind == "punct" && t.value == ":" {
+		v.next()
+		if t := v.next(); t != nil {
+			fieldToken = t
+			fieldName = t.value
+		}
+	}
+
+	var resultType string
+	if strings.HasPrefix(fieldName, "__") {
+		// Meta fields such as __typename are always available; their
+		// selections are not validated.
+	} else if typeName != "" {
+		if fieldType, ok := v.schema.fields[typeName][fieldName]; ok {
+			resultType = fieldType
+		} else {
+			v.errorf(fieldToken, "field %q is not defined on type %q", fieldName, typeName)
+		}
+	}
+
+	if t := v.peek(); t != nil && t.kind == "punct" && t.value == "(" {
+		v.skipBalanced("(", ")") // arguments
+	}
+	v.skipDirectives()
+	if t := v.peek(); t != nil && t.kind == "punct" && t.value == "{" {
+		// Scalar result types are absent from the field index; their
+		// sub-selections are skipped rather than validated.
+		if _, known := v.schema.fields[resultType]; !known {
+			resultType = ""
+		}
+		v.validateSelectionSet(resultType)
+	}
+}
+
+func (v *queryValidator) skipDirectives() {
+	for t := v.peek(); t != nil && t.kind == "punct" && t.value == "@"; t = v.peek() {
+		v.next()
+		v.next() // directive name
+		if t := v.peek(); t != nil && t.kind == "punct" && t.value == "(" {
+			v.skipBalanced("(", ")")
+		}
+	}
+}
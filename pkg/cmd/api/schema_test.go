@@ -0,0 +1,141 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testSchema() *graphqlSchema {
+	return &graphqlSchema{
+		queryType:    "Query",
+		mutationType: "Mutation",
+		fields: map[string]map[string]string{
+			"Query": {
+				"viewer":     "User",
+				"repository": "Repository",
+			},
+			"Mutation": {
+				"addStar": "AddStarPayload",
+			},
+			"User": {
+				"login": "String",
+				"name":  "String",
+			},
+			"Repository": {
+				"name":  "String",
+				"owner": "User",
+			},
+			"AddStarPayload": {
+				"clientMutationId": "String",
+			},
+		},
+	}
+}
+
+func Test_validateQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantErr []string
+	}{
+		{
+			name:  "valid query",
+			query: `query { viewer { login name } repository(owner: "cli", name: "cli") { name owner { login } } }`,
+		},
+		{
+			name:  "valid mutation",
+			query: `mutation($id: ID!) { addStar(input: {starrableId: $id}) { clientMutationId } }`,
+		},
+		{
+			name:  "shorthand query",
+			query: `{ viewer { login } }`,
+		},
+		{
+			name:    "unknown root field",
+			query:   "{\n  viewers {\n    login\n  }\n}",
+			wantErr: []string{`2:3: field "viewers" is not defined on type "Query"`},
+		},
+		{
+			name:    "unknown nested field",
+			query:   "{\n  viewer {\n    handle\n  }\n}",
+			wantErr: []string{`3:5: field "handle" is not defined on type "User"`},
+		},
+		{
+			name:  "unknown field suppresses cascading errors",
+			query: `{ viewers { alsoUnknown } }`,
+			wantErr: []string{
+				`1:3: field "viewers" is not defined on type "Query"`,
+			},
+		},
+		{
+			name:    "aliased field",
+			query:   `{ me: viewer { login } nope: missing { x } }`,
+			wantErr: []string{`1:30: field "missing" is not defined on type "Query"`},
+		},
+		{
+			name:  "meta fields are allowed",
+			query: `{ __typename __schema { types { name } } viewer { __typename } }`,
+		},
+		{
+			name:    "fragment definition",
+			query:   "fragment userBits on User {\n  login\n  handle\n}\nquery { viewer { ...userBits } }",
+			wantErr: []string{`3:3: field "handle" is not defined on type "User"`},
+		},
+		{
+			name:    "fragment on unknown type",
+			query:   `fragment f on Uzer { login }`,
+			wantErr: []string{`1:15: unknown type "Uzer"`},
+		},
+		{
+			name:    "inline fragment",
+			query:   `{ viewer { ... on User { login handle } } }`,
+			wantErr: []string{`1:32: field "handle" is not defined on type "User"`},
+		},
+		{
+			name:  "directives and comments are skipped",
+			query: "# a comment\nquery($flag: Boolean!) {\n  viewer @include(if: $flag) {\n    login\n  }\n}",
+		},
+		{
+			name:  "sub-selection on scalar is not validated",
+			query: `{ viewer { login { whatever } } }`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := testSchema().validateQuery(tt.query)
+			var got []string
+			for _, err := range errs {
+				got = append(got, err.Error())
+			}
+			assert.Equal(t, tt.wantErr, got)
+		})
+	}
+}
+
+func Test_tokenizeQuery(t *testing.T) {
+	tokens := tokenizeQuery("query Q {\n  field(arg: \"a, b\") # trailing\n  ...f\n}")
+	var got []string
+	for _, tok := range tokens {
+		got = append(got, tok.kind+" "+tok.value)
+	}
+	assert.Equal(t, []string{
+		"name query",
+		"name Q",
+		"punct {",
+		"name field",
+		"punct (",
+		"name arg",
+		"punct :",
+		`string "a, b"`,
+		"punct )",
+		"spread ...",
+		"name f",
+		"punct }",
+	}, got)
+
+	assert.Equal(t, 2, tokens[3].line)
+	assert.Equal(t, 3, tokens[3].column)
+	assert.Equal(t, 3, tokens[9].line)
+	assert.Equal(t, 3, tokens[9].column)
+}
@@ -51,6 +51,11 @@ func NewDownloadCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Comman
 			file names. To accommodate, a dash will be used to separate the algorithm
 			from the digest in the attestations file name. For example, if the digest
 			is "sha256:1234", the file will be named "sha256-1234.jsonl".
+
+			Pass %[1]s--predicate-type sbom%[1]s to keep only attestations whose predicate is
+			an SPDX or CycloneDX software bill of materials. With %[1]s--extract%[1]s, the
+			predicate of the first matching attestation is additionally written to the
+			given file, so an SBOM can be pulled out of the bundle without post-processing.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Download attestations for a local artifact linked with an organization
@@ -110,7 +115,8 @@ func NewDownloadCmd(f *cmdutil.Factory, runF func(*Options) error) *cobra.Comman
 	downloadCmd.Flags().StringVarP(&opts.Repo, "repo", "R", "", "Repository name in the format <owner>/<repo>")
 	downloadCmd.MarkFlagsMutuallyExclusive("owner", "repo")
 	downloadCmd.MarkFlagsOneRequired("owner", "repo")
-	downloadCmd.Flags().StringVarP(&opts.PredicateType, "predicate-type", "", "", "Filter attestations by provided predicate type")
+	downloadCmd.Flags().StringVarP(&opts.PredicateType, "predicate-type", "", "", "Filter attestations by provided predicate type, or `sbom` for any SPDX or CycloneDX predicate")
+	downloadCmd.Flags().StringVarP(&opts.Extract, "extract", "", "", "Additionally write the predicate of the first matching attestation to a file")
 	cmdutil.StringEnumFlag(downloadCmd, &opts.DigestAlgorithm, "digest-alg", "d", "sha256", []string{"sha256", "sha512"}, "The algorithm used to compute a digest of the artifact")
 	downloadCmd.Flags().IntVarP(&opts.Limit, "limit", "L", api.DefaultLimit, "Maximum number of attestations to fetch")
 	downloadCmd.Flags().StringVarP(&opts.Hostname, "hostname", "", "", "Configure host to use")
@@ -145,7 +151,14 @@ func runDownload(opts *Options) error {
 	}
 
 	// Apply predicate type filter to returned attestations
-	if opts.PredicateType != "" {
+	if opts.PredicateType == predicateTypeSBOM {
+		filteredAttestations, err := filterSBOMAttestations(attestations)
+		if err != nil {
+			return fmt.Errorf("failed to filter attestations: %v", err)
+		}
+
+		attestations = filteredAttestations
+	} else if opts.PredicateType != "" {
 		filteredAttestations, err := api.FilterAttestations(opts.PredicateType, attestations)
 		if err != nil {
 			return fmt.Errorf("failed to filter attestations: %v", err)
@@ -166,5 +179,11 @@ func runDownload(opts *Options) error {
 		),
 	)
 
+	if opts.Extract != "" {
+		if err := extractPredicate(opts, attestations); err != nil {
+			return fmt.Errorf("failed to extract predicate: %v", err)
+		}
+	}
+
 	return nil
 }
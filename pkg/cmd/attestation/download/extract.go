@@ -0,0 +1,93 @@
+package download
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+)
+
+// Predicate types that carry a software bill of materials. The special
+// `sbom` value for --predicate-type matches either format.
+const (
+	predicateTypeSBOM      = "sbom"
+	predicateTypeSPDX      = "https://spdx.dev/Document"
+	predicateTypeCycloneDX = "https://cyclonedx.org/bom"
+)
+
+// intotoStatementWithPredicate is the portion of an in-toto statement needed
+// to extract the predicate payload from an attestation.
+type intotoStatementWithPredicate struct {
+	PredicateType string          `json:"predicateType"`
+	Predicate     json.RawMessage `json:"predicate"`
+}
+
+// filterSBOMAttestations keeps attestations whose predicate is an SBOM in
+// either SPDX or CycloneDX form.
+func filterSBOMAttestations(attestations []*api.Attestation) ([]*api.Attestation, error) {
+	spdx, spdxErr := api.FilterAttestations(predicateTypeSPDX, attestations)
+	cyclonedx, cyclonedxErr := api.FilterAttestations(predicateTypeCycloneDX, attestations)
+	if spdxErr != nil && cyclonedxErr != nil {
+		return nil, fmt.Errorf("no attestations found with an SBOM predicate (%s or %s)", predicateTypeSPDX, predicateTypeCycloneDX)
+	}
+	return append(spdx, cyclonedx...), nil
+}
+
+// extractPredicate writes the predicate of the first attestation to the
+// path given with --extract and summarizes what was written, including a
+// package count when the predicate is an SBOM.
+func extractPredicate(opts *Options, attestations []*api.Attestation) error {
+	statement, err := statementFromAttestation(attestations[0])
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(opts.Extract, statement.Predicate, 0644); err != nil {
+		return fmt.Errorf("failed to write predicate to %s: %v", opts.Extract, err)
+	}
+
+	summary := fmt.Sprintf("Wrote %s predicate to file %s", statement.PredicateType, opts.Extract)
+	if count, ok := sbomPackageCount(statement.Predicate); ok {
+		summary += fmt.Sprintf(" (%d packages)", count)
+	}
+	fmt.Fprintf(opts.Logger.IO.Out, "%s\n", summary)
+
+	if len(attestations) > 1 {
+		fmt.Fprintf(opts.Logger.IO.Out, "%d other matching attestations were not extracted; use --predicate-type to narrow the selection\n", len(attestations)-1)
+	}
+	return nil
+}
+
+func statementFromAttestation(attestation *api.Attestation) (*intotoStatementWithPredicate, error) {
+	dsseEnvelope := attestation.Bundle.GetDsseEnvelope()
+	if dsseEnvelope == nil {
+		return nil, errors.New("attestation does not contain a DSSE envelope")
+	}
+	var statement intotoStatementWithPredicate
+	if err := json.Unmarshal([]byte(dsseEnvelope.Payload), &statement); err != nil {
+		return nil, fmt.Errorf("failed to parse in-toto statement: %v", err)
+	}
+	return &statement, nil
+}
+
+// sbomPackageCount counts the packages of an SPDX document or the components
+// of a CycloneDX BOM. The second return value reports whether the predicate
+// looked like an SBOM at all.
+func sbomPackageCount(predicate []byte) (int, bool) {
+	var doc struct {
+		Packages   []json.RawMessage `json:"packages"`
+		Components []json.RawMessage `json:"components"`
+	}
+	if err := json.Unmarshal(predicate, &doc); err != nil {
+		return 0, false
+	}
+	if doc.Packages != nil {
+		return len(doc.Packages), true
+	}
+	if doc.Components != nil {
+		return len(doc.Components), true
+	}
+	return 0, false
+}
@@ -0,0 +1,81 @@
+package download
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtractPredicate(t *testing.T) {
+	attestations, err := api.OnGetByDigestSuccess(api.FetchParams{})
+	require.NoError(t, err)
+
+	extractPath := filepath.Join(t.TempDir(), "predicate.json")
+	opts := &Options{
+		Extract: extractPath,
+		Logger:  io.NewTestHandler(),
+	}
+
+	require.NoError(t, extractPredicate(opts, attestations))
+
+	content, err := os.ReadFile(extractPath)
+	require.NoError(t, err)
+	require.NotEmpty(t, content)
+
+	statement, err := statementFromAttestation(attestations[0])
+	require.NoError(t, err)
+	require.NotEmpty(t, statement.PredicateType)
+	require.JSONEq(t, string(statement.Predicate), string(content))
+}
+
+func TestFilterSBOMAttestations(t *testing.T) {
+	// the test attestations carry provenance predicates, not SBOMs
+	attestations, err := api.OnGetByDigestSuccess(api.FetchParams{})
+	require.NoError(t, err)
+
+	_, err = filterSBOMAttestations(attestations)
+	require.ErrorContains(t, err, "no attestations found with an SBOM predicate")
+}
+
+func TestSBOMPackageCount(t *testing.T) {
+	tests := []struct {
+		name      string
+		predicate string
+		wantCount int
+		wantSBOM  bool
+	}{
+		{
+			name:      "SPDX document",
+			predicate: `{"spdxVersion": "SPDX-2.3", "packages": [{"name": "a"}, {"name": "b"}]}`,
+			wantCount: 2,
+			wantSBOM:  true,
+		},
+		{
+			name:      "CycloneDX BOM",
+			predicate: `{"bomFormat": "CycloneDX", "components": [{"name": "a"}]}`,
+			wantCount: 1,
+			wantSBOM:  true,
+		},
+		{
+			name:      "not an SBOM",
+			predicate: `{"buildType": "something"}`,
+			wantSBOM:  false,
+		},
+		{
+			name:      "not JSON",
+			predicate: `what`,
+			wantSBOM:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, isSBOM := sbomPackageCount([]byte(tt.predicate))
+			require.Equal(t, tt.wantSBOM, isSBOM)
+			require.Equal(t, tt.wantCount, count)
+		})
+	}
+}
@@ -23,6 +23,7 @@ type Options struct {
 	OCIClient       oci.Client
 	Owner           string
 	PredicateType   string
+	Extract         string
 	Repo            string
 	Hostname        string
 }
@@ -25,6 +25,49 @@ func BuildDigestPolicyOption(a artifact.DigestedArtifact) (verify.ArtifactPolicy
 	return verify.WithArtifactDigest(a.Algorithm(), decoded), nil
 }
 
+// BuildCertificateIdentityOption builds a verify.PolicyOption enforcing the
+// certificate identity described by the given criteria
+func BuildCertificateIdentityOption(c EnforcementCriteria) (verify.PolicyOption, error) {
+	sanMatcher, err := verify.NewSANMatcher(c.SAN, c.SANRegex)
+	if err != nil {
+		return nil, err
+	}
+
+	// Accept any issuer, we will verify the issuer as part of the extension verification
+	issuerMatcher, err := verify.NewIssuerMatcher("", ".*")
+	if err != nil {
+		return nil, err
+	}
+
+	extensions := certificate.Extensions{
+		RunnerEnvironment: c.Certificate.RunnerEnvironment,
+	}
+
+	certId, err := verify.NewCertificateIdentity(sanMatcher, issuerMatcher, extensions)
+	if err != nil {
+		return nil, err
+	}
+
+	return verify.WithCertificateIdentity(certId), nil
+}
+
+// BuildSigstoreVerifyPolicy builds a verify.PolicyBuilder enforcing both the
+// given artifact digest and the certificate identity described by the criteria
+func BuildSigstoreVerifyPolicy(c EnforcementCriteria, a artifact.DigestedArtifact) (verify.PolicyBuilder, error) {
+	artifactDigestPolicyOption, err := BuildDigestPolicyOption(a)
+	if err != nil {
+		return verify.PolicyBuilder{}, err
+	}
+
+	certIdOption, err := BuildCertificateIdentityOption(c)
+	if err != nil {
+		return verify.PolicyBuilder{}, err
+	}
+
+	policy := verify.NewPolicy(artifactDigestPolicyOption, certIdOption)
+	return policy, nil
+}
+
 type EnforcementCriteria struct {
 	Certificate   certificate.Summary
 	PredicateType string
@@ -71,7 +71,7 @@ func getAttestations(o *Options, a artifact.DigestedArtifact) ([]*api.Attestatio
 }
 
 func verifyAttestations(art artifact.DigestedArtifact, att []*api.Attestation, sgVerifier verification.SigstoreVerifier, ec verification.EnforcementCriteria) ([]*verification.AttestationProcessingResult, string, error) {
-	sgPolicy, err := buildSigstoreVerifyPolicy(ec, art)
+	sgPolicy, err := verification.BuildSigstoreVerifyPolicy(ec, art)
 	if err != nil {
 		logMsg := "✗ Failed to build Sigstore verification policy"
 		return nil, logMsg, err
@@ -6,10 +6,6 @@ import (
 	"regexp"
 	"strings"
 
-	"github.com/sigstore/sigstore-go/pkg/fulcio/certificate"
-	"github.com/sigstore/sigstore-go/pkg/verify"
-
-	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact"
 	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
 )
 
@@ -107,45 +103,6 @@ func newEnforcementCriteria(opts *Options) (verification.EnforcementCriteria, er
 	return c, nil
 }
 
-func buildCertificateIdentityOption(c verification.EnforcementCriteria) (verify.PolicyOption, error) {
-	sanMatcher, err := verify.NewSANMatcher(c.SAN, c.SANRegex)
-	if err != nil {
-		return nil, err
-	}
-
-	// Accept any issuer, we will verify the issuer as part of the extension verification
-	issuerMatcher, err := verify.NewIssuerMatcher("", ".*")
-	if err != nil {
-		return nil, err
-	}
-
-	extensions := certificate.Extensions{
-		RunnerEnvironment: c.Certificate.RunnerEnvironment,
-	}
-
-	certId, err := verify.NewCertificateIdentity(sanMatcher, issuerMatcher, extensions)
-	if err != nil {
-		return nil, err
-	}
-
-	return verify.WithCertificateIdentity(certId), nil
-}
-
-func buildSigstoreVerifyPolicy(c verification.EnforcementCriteria, a artifact.DigestedArtifact) (verify.PolicyBuilder, error) {
-	artifactDigestPolicyOption, err := verification.BuildDigestPolicyOption(a)
-	if err != nil {
-		return verify.PolicyBuilder{}, err
-	}
-
-	certIdOption, err := buildCertificateIdentityOption(c)
-	if err != nil {
-		return verify.PolicyBuilder{}, err
-	}
-
-	policy := verify.NewPolicy(artifactDigestPolicyOption, certIdOption)
-	return policy, nil
-}
-
 func validateSignerWorkflow(hostname, signerWorkflow string) (string, error) {
 	// we expect a provided workflow argument be in the format [HOST/]/<OWNER>/<REPO>/path/to/workflow.yml
 	// if the provided workflow does not contain a host, set the host
@@ -3,9 +3,12 @@ package logout
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"slices"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/authflow"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/pkg/cmd/auth/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -19,6 +22,11 @@ type LogoutOptions struct {
 	Prompter shared.Prompt
 	Hostname string
 	Username string
+	Revoke   bool
+
+	// RevokeToken revokes an OAuth token against the API. It is a field so
+	// tests can stub out the network call.
+	RevokeToken func(hostname, token string) error
 }
 
 func NewCmdLogout(f *cmdutil.Factory, runF func(*LogoutOptions) error) *cobra.Command {
@@ -26,6 +34,9 @@ func NewCmdLogout(f *cmdutil.Factory, runF func(*LogoutOptions) error) *cobra.Co
 		IO:       f.IOStreams,
 		Config:   f.Config,
 		Prompter: f.Prompter,
+		RevokeToken: func(hostname, token string) error {
+			return authflow.RevokeToken(&http.Client{}, hostname, token)
+		},
 	}
 
 	cmd := &cobra.Command{
@@ -39,7 +50,12 @@ func NewCmdLogout(f *cmdutil.Factory, runF func(*LogoutOptions) error) *cobra.Co
 			for an account. The authentication configuration is only
 			removed locally.
 
-			This command does not revoke authentication tokens.
+			This command does not revoke authentication tokens unless the
+			--revoke flag is given. With --revoke, tokens created by the
+			GitHub CLI OAuth flow are invalidated against the API before the
+			local configuration is removed. Personal access tokens cannot be
+			revoked this way and must be deleted from the host's token
+			settings page.
 
 			To revoke all authentication tokens generated by the GitHub CLI:
 
@@ -60,6 +76,9 @@ func NewCmdLogout(f *cmdutil.Factory, runF func(*LogoutOptions) error) *cobra.Co
 
 			# Log out of a specific host and specific account
 			$ gh auth logout --hostname enterprise.internal --user monalisa
+
+			# Log out and revoke the token so it can no longer be used
+			$ gh auth logout --hostname github.com --user monalisa --revoke
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if runF != nil {
@@ -72,6 +91,7 @@ func NewCmdLogout(f *cmdutil.Factory, runF func(*LogoutOptions) error) *cobra.Co
 
 	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "The hostname of the GitHub instance to log out of")
 	cmd.Flags().StringVarP(&opts.Username, "user", "u", "", "The account to log out of")
+	cmd.Flags().BoolVar(&opts.Revoke, "revoke", false, "Revoke the token against the API before removing it locally")
 
 	return cmd
 }
@@ -150,6 +170,27 @@ func logoutRun(opts *LogoutOptions) error {
 		return cmdutil.SilentError
 	}
 
+	cs := opts.IO.ColorScheme()
+
+	if opts.Revoke {
+		token, _, err := authCfg.TokenForUser(hostname, username)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve token for %s account %s: %w", hostname, username, err)
+		}
+
+		if strings.HasPrefix(token, "gho_") {
+			if err := opts.RevokeToken(hostname, token); err != nil {
+				return fmt.Errorf("failed to revoke token for %s account %s: %w", hostname, username, err)
+			}
+			fmt.Fprintf(opts.IO.ErrOut, "%s Revoked token for %s account %s\n",
+				cs.SuccessIcon(), hostname, cs.Bold(username))
+		} else {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Token for %s account %s was not created by GitHub CLI and cannot be revoked automatically.\n",
+				cs.WarningIcon(), hostname, cs.Bold(username))
+			fmt.Fprintf(opts.IO.ErrOut, "To revoke it, delete the token from https://%s/settings/tokens\n", hostname)
+		}
+	}
+
 	// We can ignore the error here because a host must always have an active user
 	preLogoutActiveUser, _ := authCfg.ActiveUser(hostname)
 
@@ -161,7 +202,6 @@ func logoutRun(opts *LogoutOptions) error {
 	hasSwitchedToNewUser := preLogoutActiveUser != postLogoutActiveUser &&
 		postLogoutActiveUser != ""
 
-	cs := opts.IO.ColorScheme()
 	fmt.Fprintf(opts.IO.ErrOut, "%s Logged out of %s account %s\n",
 		cs.SuccessIcon(), hostname, cs.Bold(username))
 
@@ -80,6 +80,15 @@ func Test_NewCmdLogout(t *testing.T) {
 				Username: "monalisa",
 			},
 		},
+		{
+			name: "with revoke",
+			cli:  "--hostname github.com --user monalisa --revoke",
+			wants: LogoutOptions{
+				Hostname: "github.com",
+				Username: "monalisa",
+				Revoke:   true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -111,6 +120,7 @@ func Test_NewCmdLogout(t *testing.T) {
 			require.NoError(t, err)
 
 			require.Equal(t, tt.wants.Hostname, gotOpts.Hostname)
+			require.Equal(t, tt.wants.Revoke, gotOpts.Revoke)
 		})
 	}
 }
@@ -129,15 +139,16 @@ type tokenAssertion func(t *testing.T, cfg gh.Config)
 
 func Test_logoutRun_tty(t *testing.T) {
 	tests := []struct {
-		name          string
-		opts          *LogoutOptions
-		prompterStubs func(*prompter.PrompterMock)
-		cfgHosts      []hostUsers
-		secureStorage bool
-		wantHosts     string
-		assertToken   tokenAssertion
-		wantErrOut    *regexp.Regexp
-		wantErr       string
+		name              string
+		opts              *LogoutOptions
+		prompterStubs     func(*prompter.PrompterMock)
+		cfgHosts          []hostUsers
+		secureStorage     bool
+		wantHosts         string
+		assertToken       tokenAssertion
+		wantRevokedTokens []string
+		wantErrOut        *regexp.Regexp
+		wantErr           string
 	}{
 		{
 			name: "logs out prompted user when multiple known hosts with one user each",
@@ -307,6 +318,39 @@ func Test_logoutRun_tty(t *testing.T) {
 			assertToken: hasActiveToken("github.com", "monalisa-token"),
 			wantErrOut:  regexp.MustCompile("✓ Switched active account for github.com to monalisa"),
 		},
+		{
+			name: "revokes OAuth token before logging out",
+			opts: &LogoutOptions{
+				Hostname: "github.com",
+				Username: "monalisa",
+				Revoke:   true,
+			},
+			cfgHosts: []hostUsers{
+				{"github.com", []user{
+					{"monalisa", "gho_abc123"},
+				}},
+			},
+			wantHosts:         "{}\n",
+			assertToken:       hasNoToken("github.com"),
+			wantRevokedTokens: []string{"gho_abc123"},
+			wantErrOut:        regexp.MustCompile(`Revoked token for github.com account monalisa`),
+		},
+		{
+			name: "warns that personal access tokens cannot be revoked",
+			opts: &LogoutOptions{
+				Hostname: "github.com",
+				Username: "monalisa",
+				Revoke:   true,
+			},
+			cfgHosts: []hostUsers{
+				{"github.com", []user{
+					{"monalisa", "ghp_abc123"},
+				}},
+			},
+			wantHosts:   "{}\n",
+			assertToken: hasNoToken("github.com"),
+			wantErrOut:  regexp.MustCompile(`was not created by GitHub CLI and cannot be revoked automatically`),
+		},
 	}
 
 	for _, tt := range tests {
@@ -338,6 +382,12 @@ func Test_logoutRun_tty(t *testing.T) {
 			}
 			tt.opts.Prompter = pm
 
+			var revokedTokens []string
+			tt.opts.RevokeToken = func(hostname, token string) error {
+				revokedTokens = append(revokedTokens, token)
+				return nil
+			}
+
 			err := logoutRun(tt.opts)
 			if tt.wantErr != "" {
 				require.EqualError(t, err, tt.wantErr)
@@ -360,6 +410,8 @@ func Test_logoutRun_tty(t *testing.T) {
 			if tt.assertToken != nil {
 				tt.assertToken(t, cfg)
 			}
+
+			require.Equal(t, tt.wantRevokedTokens, revokedTokens)
 		})
 	}
 }
@@ -0,0 +1,127 @@
+package migratestorage
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type MigrateStorageOptions struct {
+	IO     *iostreams.IOStreams
+	Config func() (gh.Config, error)
+
+	Hostname string
+}
+
+func NewCmdMigrateStorage(f *cmdutil.Factory, runF func(*MigrateStorageOptions) error) *cobra.Command {
+	opts := &MigrateStorageOptions{
+		IO:     f.IOStreams,
+		Config: f.Config,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "migrate-storage",
+		Args:  cobra.ExactArgs(0),
+		Short: "Move authentication tokens into secure storage",
+		Long: heredoc.Docf(`
+			Move authentication tokens that are stored in the plain text configuration
+			file into the active secure storage backend.
+
+			By default tokens are stored in the operating system keyring. On headless
+			machines without a keyring, an encrypted file backend can be selected by
+			running %[1]sgh config set keyring_backend file%[1]s. The file is encrypted with a key
+			derived from the %[1]sGH_KEYRING_PASSPHRASE%[1]s environment variable, or from stable
+			machine properties when no passphrase is set.
+
+			Tokens already held in secure storage are left untouched.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Move plain text tokens for all hosts into secure storage
+			$ gh auth migrate-storage
+
+			# Move plain text tokens for a specific host only
+			$ gh auth migrate-storage --hostname enterprise.internal
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return migrateStorageRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "Migrate tokens for a specific hostname only")
+
+	return cmd
+}
+
+func migrateStorageRun(opts *MigrateStorageOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	authCfg := cfg.Authentication()
+
+	hostnames := authCfg.Hosts()
+	if len(hostnames) == 0 {
+		return fmt.Errorf("not logged in to any hosts")
+	}
+
+	if opts.Hostname != "" {
+		if !slices.Contains(hostnames, opts.Hostname) {
+			return fmt.Errorf("not logged in to %s", opts.Hostname)
+		}
+		hostnames = []string{opts.Hostname}
+	}
+
+	cs := opts.IO.ColorScheme()
+	migrated := 0
+
+	for _, hostname := range hostnames {
+		activeUser, _ := authCfg.ActiveUser(hostname)
+
+		// The active user is re-logged-in last so that migrating the other
+		// accounts does not change which account is active for the host.
+		users := authCfg.UsersForHost(hostname)
+		slices.SortStableFunc(users, func(a, b string) int {
+			if a == activeUser {
+				return 1
+			}
+			if b == activeUser {
+				return -1
+			}
+			return 0
+		})
+
+		for _, username := range users {
+			token, source, err := authCfg.TokenForUser(hostname, username)
+			if err != nil || source != "oauth_token" {
+				continue
+			}
+
+			insecureStorageUsed, err := authCfg.Login(hostname, username, token, "", true)
+			if err != nil {
+				return fmt.Errorf("failed to migrate token for %s account %s: %w", hostname, username, err)
+			}
+			if insecureStorageUsed {
+				return fmt.Errorf("failed to migrate token for %s account %s into secure storage", hostname, username)
+			}
+
+			fmt.Fprintf(opts.IO.ErrOut, "%s Migrated token for %s account %s into secure storage\n",
+				cs.SuccessIcon(), hostname, cs.Bold(username))
+			migrated++
+		}
+	}
+
+	if migrated == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No tokens needed to be migrated")
+	}
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package migratestorage
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdMigrateStorage(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		expectedOpts   MigrateStorageOptions
+		expectedErrMsg string
+	}{
+		{
+			name:         "no flags",
+			input:        "",
+			expectedOpts: MigrateStorageOptions{},
+		},
+		{
+			name:  "hostname flag",
+			input: "--hostname github.com",
+			expectedOpts: MigrateStorageOptions{
+				Hostname: "github.com",
+			},
+		},
+		{
+			name:           "positional args is an error",
+			input:          "some-positional-arg",
+			expectedErrMsg: "accepts 0 arg(s), received 1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+			argv, err := shlex.Split(tt.input)
+			require.NoError(t, err)
+
+			var gotOpts *MigrateStorageOptions
+			cmd := NewCmdMigrateStorage(f, func(opts *MigrateStorageOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			// Override the help flag as happens in production to allow -h flag
+			// to be used for hostname.
+			cmd.Flags().BoolP("help", "x", false, "")
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.expectedErrMsg != "" {
+				require.ErrorContains(t, err, tt.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, &tt.expectedOpts, gotOpts)
+		})
+	}
+}
+
+func TestMigrateStorageRun(t *testing.T) {
+	type user struct {
+		name  string
+		token string
+	}
+
+	type hostUsers struct {
+		host  string
+		users []user
+	}
+
+	tests := []struct {
+		name           string
+		opts           MigrateStorageOptions
+		cfgHosts       []hostUsers
+		expectedErrMsg string
+		expectedStderr string
+	}{
+		{
+			name:           "given we're not logged into any hosts, provide an informative error",
+			opts:           MigrateStorageOptions{},
+			expectedErrMsg: "not logged in to any hosts",
+		},
+		{
+			name: "specified hostname doesn't exist",
+			opts: MigrateStorageOptions{
+				Hostname: "ghe.io",
+			},
+			cfgHosts: []hostUsers{
+				{"github.com", []user{
+					{"monalisa", "monalisa-token"},
+				}},
+			},
+			expectedErrMsg: "not logged in to ghe.io",
+		},
+		{
+			name: "insecure tokens are moved into the keyring and the active user is preserved",
+			opts: MigrateStorageOptions{},
+			cfgHosts: []hostUsers{
+				{"github.com", []user{
+					{"inactive-user", "inactive-user-token"},
+					{"active-user", "active-user-token"},
+				}},
+			},
+			expectedStderr: "✓ Migrated token for github.com account",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg, _ := config.NewIsolatedTestConfig(t)
+
+			for _, hostUsers := range tt.cfgHosts {
+				for _, user := range hostUsers.users {
+					// Log in with insecure storage so that there are plain
+					// text tokens to migrate.
+					_, err := cfg.Authentication().Login(
+						hostUsers.host,
+						user.name,
+						user.token, "ssh", false,
+					)
+					require.NoError(t, err)
+				}
+			}
+
+			tt.opts.Config = func() (gh.Config, error) {
+				return cfg, nil
+			}
+
+			ios, _, _, stderr := iostreams.Test()
+			tt.opts.IO = ios
+
+			err := migrateStorageRun(&tt.opts)
+			if tt.expectedErrMsg != "" {
+				require.EqualError(t, err, tt.expectedErrMsg)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Contains(t, stderr.String(), tt.expectedStderr)
+
+			for _, hostUsers := range tt.cfgHosts {
+				activeUser, err := cfg.Authentication().ActiveUser(hostUsers.host)
+				require.NoError(t, err)
+				require.Equal(t, hostUsers.users[len(hostUsers.users)-1].name, activeUser)
+
+				for _, user := range hostUsers.users {
+					token, source, err := cfg.Authentication().TokenForUser(hostUsers.host, user.name)
+					require.NoError(t, err)
+					require.Equal(t, "keyring", source)
+					require.Equal(t, user.token, token)
+				}
+			}
+		})
+	}
+}
@@ -29,11 +29,12 @@ type RefreshOptions struct {
 
 	MainExecutable string
 
-	Hostname     string
-	Scopes       []string
-	RemoveScopes []string
-	ResetScopes  bool
-	AuthFlow     func(*iostreams.IOStreams, string, []string, bool) (token, username, error)
+	Hostname      string
+	Scopes        []string
+	RemoveScopes  []string
+	RequireScopes []string
+	ResetScopes   bool
+	AuthFlow      func(*iostreams.IOStreams, string, []string, bool) (token, username, error)
 
 	Interactive     bool
 	InsecureStorage bool
@@ -67,9 +68,17 @@ func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.
 			want to remove from your gh credentials. Scope removal is idempotent.
 			The minimum set of scopes (%[1]srepo%[1]s, %[1]sread:org%[1]s, and %[1]sgist%[1]s) cannot be removed.
 
+			When scopes are removed, a before/after diff of the token scopes is shown
+			and, when running interactively, confirmation is required before the
+			refresh proceeds.
+
 			The %[1]s--reset-scopes%[1]s flag resets the scopes for your gh credentials to
 			the default set of scopes for your auth flow.
 
+			The %[1]s--require-scopes%[1]s flag accepts a comma separated list of scopes that the
+			refreshed token must have. If any are missing after the refresh, the command
+			exits with a non-zero status, for use in security hardening scripts.
+
 			If you have multiple accounts in %[1]sgh auth status%[1]s and want to refresh the credentials for an
 			inactive account, you will have to use %[1]sgh auth switch%[1]s to that account first before using
 			this command, and then switch back when you are done.
@@ -108,6 +117,7 @@ func NewCmdRefresh(f *cmdutil.Factory, runF func(*RefreshOptions) error) *cobra.
 	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "The GitHub host to use for authentication")
 	cmd.Flags().StringSliceVarP(&opts.Scopes, "scopes", "s", nil, "Additional authentication scopes for gh to have")
 	cmd.Flags().StringSliceVarP(&opts.RemoveScopes, "remove-scopes", "r", nil, "Authentication scopes to remove from gh")
+	cmd.Flags().StringSliceVar(&opts.RequireScopes, "require-scopes", nil, "Authentication scopes that must be granted, otherwise exit with a non-zero status")
 	cmd.Flags().BoolVar(&opts.ResetScopes, "reset-scopes", false, "Reset authentication scopes to the default minimum set of scopes")
 	// secure storage became the default on 2023/4/04; this flag is left as a no-op for backwards compatibility
 	var secureStorage bool
@@ -164,15 +174,12 @@ func refreshRun(opts *RefreshOptions) error {
 
 	additionalScopes := set.NewStringSet()
 
+	var oldScopes []string
 	if !opts.ResetScopes {
 		if oldToken, _ := authCfg.ActiveToken(hostname); oldToken != "" {
-			if oldScopes, err := shared.GetScopes(opts.HttpClient, hostname, oldToken); err == nil {
-				for _, s := range strings.Split(oldScopes, ",") {
-					s = strings.TrimSpace(s)
-					if s != "" {
-						additionalScopes.Add(s)
-					}
-				}
+			if oldScopesHeader, err := shared.GetScopes(opts.HttpClient, hostname, oldToken); err == nil {
+				oldScopes = splitScopes(oldScopesHeader)
+				additionalScopes.AddValues(oldScopes)
 			}
 		}
 	}
@@ -199,6 +206,21 @@ func refreshRun(opts *RefreshOptions) error {
 
 	additionalScopes.RemoveValues(opts.RemoveScopes)
 
+	if len(opts.RemoveScopes) > 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "Token scopes before: %s\n", displayScopes(oldScopes))
+		fmt.Fprintf(opts.IO.ErrOut, "Token scopes after:  %s\n", displayScopes(additionalScopes.ToSlice()))
+
+		if opts.Interactive {
+			confirmed, err := opts.Prompter.Confirm("Continue refreshing credentials with these scopes?", true)
+			if err != nil {
+				return fmt.Errorf("could not prompt: %w", err)
+			}
+			if !confirmed {
+				return cmdutil.CancelError
+			}
+		}
+	}
+
 	authedToken, authedUser, err := opts.AuthFlow(opts.IO, hostname, additionalScopes.ToSlice(), opts.Interactive)
 	if err != nil {
 		return err
@@ -214,6 +236,27 @@ func refreshRun(opts *RefreshOptions) error {
 	cs := opts.IO.ColorScheme()
 	fmt.Fprintf(opts.IO.ErrOut, "%s Authentication complete.\n", cs.SuccessIcon())
 
+	if len(opts.RequireScopes) > 0 {
+		grantedScopesHeader, err := shared.GetScopes(opts.HttpClient, hostname, string(authedToken))
+		if err != nil {
+			return fmt.Errorf("failed to verify scopes of refreshed credentials: %w", err)
+		}
+		granted := set.NewStringSet()
+		granted.AddValues(splitScopes(grantedScopesHeader))
+
+		var missing []string
+		for _, scope := range opts.RequireScopes {
+			if !granted.Contains(scope) {
+				missing = append(missing, scope)
+			}
+		}
+		if len(missing) > 0 {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Refreshed credentials are missing required scopes: %s\n",
+				cs.FailureIcon(), displayScopes(missing))
+			return cmdutil.SilentError
+		}
+	}
+
 	if credentialFlow.ShouldSetup() {
 		username, _ := authCfg.ActiveUser(hostname)
 		password, _ := authCfg.ActiveToken(hostname)
@@ -224,3 +267,25 @@ func refreshRun(opts *RefreshOptions) error {
 
 	return nil
 }
+
+func splitScopes(scopesHeader string) []string {
+	var scopes []string
+	for _, s := range strings.Split(scopesHeader, ",") {
+		s = strings.TrimSpace(s)
+		if s != "" {
+			scopes = append(scopes, s)
+		}
+	}
+	return scopes
+}
+
+func displayScopes(scopes []string) string {
+	if len(scopes) == 0 {
+		return "none"
+	}
+	quoted := make([]string, len(scopes))
+	for i, s := range scopes {
+		quoted[i] = fmt.Sprintf("'%s'", s)
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -130,6 +130,14 @@ func Test_NewCmdRefresh(t *testing.T) {
 				RemoveScopes: []string{"read:public_key"},
 			},
 		},
+		{
+			name: "require scopes",
+			tty:  true,
+			cli:  "--require-scopes workflow,read:public_key",
+			wants: RefreshOptions{
+				RequireScopes: []string{"workflow", "read:public_key"},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -166,6 +174,8 @@ func Test_NewCmdRefresh(t *testing.T) {
 			require.NoError(t, err)
 			require.Equal(t, tt.wants.Hostname, gotOpts.Hostname)
 			require.Equal(t, tt.wants.Scopes, gotOpts.Scopes)
+			require.Equal(t, tt.wants.RemoveScopes, gotOpts.RemoveScopes)
+			require.Equal(t, tt.wants.RequireScopes, gotOpts.RequireScopes)
 		})
 	}
 }
@@ -191,6 +201,7 @@ func Test_refreshRun(t *testing.T) {
 		cfgHosts      []string
 		authOut       authOut
 		oldScopes     string
+		newScopes     string
 		wantErr       string
 		nontty        bool
 		wantAuthArgs  authArgs
@@ -411,6 +422,36 @@ func Test_refreshRun(t *testing.T) {
 				secureStorage: true,
 			},
 		},
+		{
+			name: "require scopes that are granted",
+			cfgHosts: []string{
+				"github.com",
+			},
+			oldScopes: "repo, workflow",
+			newScopes: "repo, workflow",
+			opts: &RefreshOptions{
+				Hostname:      "github.com",
+				RequireScopes: []string{"repo", "workflow"},
+			},
+			wantAuthArgs: authArgs{
+				hostname:      "github.com",
+				scopes:        []string{"repo", "workflow"},
+				secureStorage: true,
+			},
+		},
+		{
+			name: "require scopes that are not granted",
+			cfgHosts: []string{
+				"github.com",
+			},
+			oldScopes: "repo",
+			newScopes: "repo",
+			opts: &RefreshOptions{
+				Hostname:      "github.com",
+				RequireScopes: []string{"repo", "admin:org"},
+			},
+			wantErr: "SilentError",
+		},
 		{
 			name: "errors when active user does not match user returned by auth flow",
 			cfgHosts: []string{
@@ -469,6 +510,27 @@ func Test_refreshRun(t *testing.T) {
 					}, nil
 				},
 			)
+			if tt.newScopes != "" {
+				// Register a second stub for verifying the scopes of the
+				// refreshed token when --require-scopes is used.
+				httpReg.Register(
+					httpmock.REST("GET", ""),
+					func(req *http.Request) (*http.Response, error) {
+						statusCode := 200
+						if req.Header.Get("Authorization") != "token xyz456" {
+							statusCode = 400
+						}
+						return &http.Response{
+							Request:    req,
+							StatusCode: statusCode,
+							Body:       io.NopCloser(strings.NewReader(``)),
+							Header: http.Header{
+								"X-Oauth-Scopes": {tt.newScopes},
+							},
+						}, nil
+					},
+				)
+			}
 			tt.opts.HttpClient = &http.Client{Transport: httpReg}
 
 			pm := &prompter.PrompterMock{}
@@ -1,6 +1,8 @@
 package status
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
@@ -11,6 +13,7 @@ import (
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/pkg/cmd/auth/shared"
@@ -19,14 +22,30 @@ import (
 	"github.com/spf13/cobra"
 )
 
+// jsonEntry is the machine-readable representation of an account's
+// authentication state, emitted by the --json flag.
+type jsonEntry struct {
+	State               string   `json:"state"`
+	Active              bool     `json:"active"`
+	Host                string   `json:"host"`
+	Login               string   `json:"login,omitempty"`
+	TokenSource         string   `json:"tokenSource,omitempty"`
+	Token               string   `json:"token,omitempty"`
+	GitProtocol         string   `json:"gitProtocol,omitempty"`
+	GitCredentialHelper string   `json:"gitCredentialHelper,omitempty"`
+	Scopes              []string `json:"scopes,omitempty"`
+	MissingScopes       []string `json:"missingRequiredScopes,omitempty"`
+}
+
 type validEntry struct {
-	active      bool
-	host        string
-	user        string
-	token       string
-	tokenSource string
-	gitProtocol string
-	scopes      string
+	active              bool
+	host                string
+	user                string
+	token               string
+	tokenSource         string
+	gitProtocol         string
+	gitCredentialHelper string
+	scopes              string
 }
 
 func (e validEntry) String(cs *iostreams.ColorScheme) string {
@@ -38,6 +57,9 @@ func (e validEntry) String(cs *iostreams.ColorScheme) string {
 	activeStr := fmt.Sprintf("%v", e.active)
 	sb.WriteString(fmt.Sprintf("  - Active account: %s\n", cs.Bold(activeStr)))
 	sb.WriteString(fmt.Sprintf("  - Git operations protocol: %s\n", cs.Bold(e.gitProtocol)))
+	if e.gitCredentialHelper != "" {
+		sb.WriteString(fmt.Sprintf("  - Git credential helper: %s\n", cs.Bold(e.gitCredentialHelper)))
+	}
 	sb.WriteString(fmt.Sprintf("  - Token: %s\n", cs.Bold(e.token)))
 
 	if expectScopes(e.token) {
@@ -58,6 +80,27 @@ func (e validEntry) String(cs *iostreams.ColorScheme) string {
 	return sb.String()
 }
 
+func (e validEntry) JSON() jsonEntry {
+	entry := jsonEntry{
+		State:               "valid",
+		Active:              e.active,
+		Host:                e.host,
+		Login:               e.user,
+		TokenSource:         e.tokenSource,
+		Token:               e.token,
+		GitProtocol:         e.gitProtocol,
+		GitCredentialHelper: e.gitCredentialHelper,
+	}
+	if expectScopes(e.token) {
+		entry.Scopes = splitScopes(e.scopes)
+		var missingScopesError *shared.MissingScopesError
+		if err := shared.HeaderHasMinimumScopes(e.scopes); errors.As(err, &missingScopesError) {
+			entry.MissingScopes = missingScopesError.MissingScopes
+		}
+	}
+	return entry
+}
+
 type invalidTokenEntry struct {
 	active           bool
 	host             string
@@ -87,6 +130,16 @@ func (e invalidTokenEntry) String(cs *iostreams.ColorScheme) string {
 	return sb.String()
 }
 
+func (e invalidTokenEntry) JSON() jsonEntry {
+	return jsonEntry{
+		State:       "invalid",
+		Active:      e.active,
+		Host:        e.host,
+		Login:       e.user,
+		TokenSource: e.tokenSource,
+	}
+}
+
 type timeoutErrorEntry struct {
 	active      bool
 	host        string
@@ -108,8 +161,19 @@ func (e timeoutErrorEntry) String(cs *iostreams.ColorScheme) string {
 	return sb.String()
 }
 
+func (e timeoutErrorEntry) JSON() jsonEntry {
+	return jsonEntry{
+		State:       "timeout",
+		Active:      e.active,
+		Host:        e.host,
+		Login:       e.user,
+		TokenSource: e.tokenSource,
+	}
+}
+
 type Entry interface {
 	String(cs *iostreams.ColorScheme) string
+	JSON() jsonEntry
 }
 
 type Entries []Entry
@@ -122,14 +186,24 @@ func (e Entries) Strings(cs *iostreams.ColorScheme) []string {
 	return out
 }
 
+func (e Entries) JSON() []jsonEntry {
+	out := make([]jsonEntry, 0, len(e))
+	for _, entry := range e {
+		out = append(out, entry.JSON())
+	}
+	return out
+}
+
 type StatusOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	Config     func() (gh.Config, error)
+	GitClient  *git.Client
 
 	Hostname  string
 	ShowToken bool
 	Active    bool
+	JSON      bool
 }
 
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
@@ -137,6 +211,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 		HttpClient: f.HttpClient,
 		IO:         f.IOStreams,
 		Config:     f.Config,
+		GitClient:  f.GitClient,
 	}
 
 	cmd := &cobra.Command{
@@ -151,6 +226,10 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 			If an account on any host (or only the one given via %[1]s--hostname%[1]s) has authentication issues,
 			the command will exit with 1 and output to stderr.
 
+			With %[1]s--json%[1]s, the authentication state of every checked account, including token scopes
+			and git credential helper wiring, is written to stdout as JSON for use in compliance tooling.
+			The exit code behavior is unchanged.
+
 			To change the active account for a host, see %[1]sgh auth switch%[1]s.
 		`, "`"),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -165,6 +244,7 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "Check only a specific hostname's auth status")
 	cmd.Flags().BoolVarP(&opts.ShowToken, "show-token", "t", false, "Display the auth token")
 	cmd.Flags().BoolVarP(&opts.Active, "active", "a", false, "Display the active account only")
+	cmd.Flags().BoolVar(&opts.JSON, "json", false, "Output authentication state as JSON")
 
 	return cmd
 }
@@ -207,18 +287,20 @@ func statusRun(opts *StatusOptions) error {
 
 		var activeUser string
 		gitProtocol := cfg.GitProtocol(hostname).Value
+		gitCredentialHelper := gitCredentialHelper(opts.GitClient, hostname)
 		activeUserToken, activeUserTokenSource := authCfg.ActiveToken(hostname)
 		if authTokenWriteable(activeUserTokenSource) {
 			activeUser, _ = authCfg.ActiveUser(hostname)
 		}
 		entry := buildEntry(httpClient, buildEntryOptions{
-			active:      true,
-			gitProtocol: gitProtocol,
-			hostname:    hostname,
-			showToken:   opts.ShowToken,
-			token:       activeUserToken,
-			tokenSource: activeUserTokenSource,
-			username:    activeUser,
+			active:              true,
+			gitProtocol:         gitProtocol,
+			gitCredentialHelper: gitCredentialHelper,
+			hostname:            hostname,
+			showToken:           opts.ShowToken,
+			token:               activeUserToken,
+			tokenSource:         activeUserTokenSource,
+			username:            activeUser,
 		})
 		statuses[hostname] = append(statuses[hostname], entry)
 
@@ -237,13 +319,14 @@ func statusRun(opts *StatusOptions) error {
 			}
 			token, tokenSource, _ := authCfg.TokenForUser(hostname, username)
 			entry := buildEntry(httpClient, buildEntryOptions{
-				active:      false,
-				gitProtocol: gitProtocol,
-				hostname:    hostname,
-				showToken:   opts.ShowToken,
-				token:       token,
-				tokenSource: tokenSource,
-				username:    username,
+				active:              false,
+				gitProtocol:         gitProtocol,
+				gitCredentialHelper: gitCredentialHelper,
+				hostname:            hostname,
+				showToken:           opts.ShowToken,
+				token:               token,
+				tokenSource:         tokenSource,
+				username:            username,
 			})
 			statuses[hostname] = append(statuses[hostname], entry)
 
@@ -253,6 +336,19 @@ func statusRun(opts *StatusOptions) error {
 		}
 	}
 
+	if opts.JSON {
+		out := make(map[string][]jsonEntry, len(statuses))
+		for hostname, entries := range statuses {
+			out[hostname] = entries.JSON()
+		}
+		encoded, marshalErr := json.Marshal(out)
+		if marshalErr != nil {
+			return marshalErr
+		}
+		fmt.Fprintln(stdout, string(encoded))
+		return err
+	}
+
 	prevEntry := false
 	for _, hostname := range hostnames {
 		entries, ok := statuses[hostname]
@@ -304,14 +400,39 @@ func expectScopes(token string) bool {
 	return strings.HasPrefix(token, "ghp_") || strings.HasPrefix(token, "gho_")
 }
 
+func splitScopes(scopes string) []string {
+	if scopes == "" {
+		return nil
+	}
+	list := strings.Split(scopes, ",")
+	for i, s := range list {
+		list[i] = strings.TrimSpace(s)
+	}
+	return list
+}
+
+// gitCredentialHelper reports the effective git credential helper configured
+// for the given host, or an empty string when none is configured.
+func gitCredentialHelper(gitClient *git.Client, hostname string) string {
+	if gitClient == nil {
+		return ""
+	}
+	helper, err := gitClient.Config(context.Background(), fmt.Sprintf("credential.https://%s.helper", hostname))
+	if err != nil {
+		return ""
+	}
+	return helper
+}
+
 type buildEntryOptions struct {
-	active      bool
-	gitProtocol string
-	hostname    string
-	showToken   bool
-	token       string
-	tokenSource string
-	username    string
+	active              bool
+	gitProtocol         string
+	gitCredentialHelper string
+	hostname            string
+	showToken           bool
+	token               string
+	tokenSource         string
+	username            string
 }
 
 func buildEntry(httpClient *http.Client, opts buildEntryOptions) Entry {
@@ -365,13 +486,14 @@ func buildEntry(httpClient *http.Client, opts buildEntryOptions) Entry {
 	}
 
 	return validEntry{
-		active:      opts.active,
-		gitProtocol: opts.gitProtocol,
-		host:        opts.hostname,
-		scopes:      scopesHeader,
-		token:       displayToken(opts.token, opts.showToken),
-		tokenSource: opts.tokenSource,
-		user:        opts.username,
+		active:              opts.active,
+		gitProtocol:         opts.gitProtocol,
+		gitCredentialHelper: opts.gitCredentialHelper,
+		host:                opts.hostname,
+		scopes:              scopesHeader,
+		token:               displayToken(opts.token, opts.showToken),
+		tokenSource:         opts.tokenSource,
+		user:                opts.username,
 	}
 }
 
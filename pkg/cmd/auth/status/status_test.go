@@ -440,6 +440,35 @@ func Test_statusRun(t *testing.T) {
 				  - To forget about this account, run: gh auth logout -h ghe.io -u monalisa-ghe
 			`),
 		},
+		{
+			name: "json output",
+			opts: StatusOptions{
+				Hostname: "ghe.io",
+				JSON:     true,
+			},
+			cfgStubs: func(t *testing.T, c gh.Config) {
+				login(t, c, "ghe.io", "monalisa-ghe", "gho_abc123", "https")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", "api/v3/"), httpmock.ScopesResponder("repo,read:org"))
+			},
+			wantOut: `{"ghe.io":[{"state":"valid","active":true,"host":"ghe.io","login":"monalisa-ghe","tokenSource":"GH_CONFIG_DIR/hosts.yml","token":"gho_******","gitProtocol":"https","scopes":["repo","read:org"]}]}` + "\n",
+		},
+		{
+			name: "json output with invalid token",
+			opts: StatusOptions{
+				Hostname: "ghe.io",
+				JSON:     true,
+			},
+			cfgStubs: func(t *testing.T, c gh.Config) {
+				login(t, c, "ghe.io", "monalisa-ghe", "gho_abc123", "https")
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", "api/v3/"), httpmock.StatusStringResponse(400, "no bueno"))
+			},
+			wantErr: cmdutil.SilentError,
+			wantOut: `{"ghe.io":[{"state":"invalid","active":true,"host":"ghe.io","login":"monalisa-ghe","tokenSource":"GH_CONFIG_DIR/hosts.yml"}]}` + "\n",
+		},
 		{
 			name: "multiple accounts on a host, only active users",
 			opts: StatusOptions{
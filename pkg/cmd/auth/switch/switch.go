@@ -1,11 +1,13 @@
 package authswitch
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"slices"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/pkg/cmd/auth/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -14,18 +16,21 @@ import (
 )
 
 type SwitchOptions struct {
-	IO       *iostreams.IOStreams
-	Config   func() (gh.Config, error)
-	Prompter shared.Prompt
-	Hostname string
-	Username string
+	IO        *iostreams.IOStreams
+	Config    func() (gh.Config, error)
+	GitClient *git.Client
+	Prompter  shared.Prompt
+	Hostname  string
+	Username  string
+	Local     bool
 }
 
 func NewCmdSwitch(f *cmdutil.Factory, runF func(*SwitchOptions) error) *cobra.Command {
 	opts := SwitchOptions{
-		IO:       f.IOStreams,
-		Config:   f.Config,
-		Prompter: f.Prompter,
+		IO:        f.IOStreams,
+		Config:    f.Config,
+		GitClient: f.GitClient,
+		Prompter:  f.Prompter,
 	}
 
 	cmd := &cobra.Command{
@@ -42,6 +47,11 @@ func NewCmdSwitch(f *cmdutil.Factory, runF func(*SwitchOptions) error) *cobra.Co
 			automatically. If there are more than two accounts, disambiguation will be
 			required either through the %[1]s--user%[1]s flag or an interactive prompt.
 
+			With %[1]s--local%[1]s, the chosen account is recorded in the current repository's
+			git config as %[1]sgh.account%[1]s instead of changing the globally active account.
+			Commands run inside that repository will use the bound account. Remove the binding
+			with %[1]sgit config --local --unset gh.account%[1]s.
+
 			For a list of authenticated accounts you can run %[1]sgh auth status%[1]s.
 		`, "`"),
 		Example: heredoc.Doc(`
@@ -50,6 +60,9 @@ func NewCmdSwitch(f *cmdutil.Factory, runF func(*SwitchOptions) error) *cobra.Co
 
 			# Switch the active account on a specific host to a specific user
 			$ gh auth switch --hostname enterprise.internal --user monalisa
+
+			# Bind an account to the current repository only
+			$ gh auth switch --user monalisa-work --local
 		`),
 		RunE: func(c *cobra.Command, args []string) error {
 			if runF != nil {
@@ -62,6 +75,7 @@ func NewCmdSwitch(f *cmdutil.Factory, runF func(*SwitchOptions) error) *cobra.Co
 
 	cmd.Flags().StringVarP(&opts.Hostname, "hostname", "h", "", "The hostname of the GitHub instance to switch account for")
 	cmd.Flags().StringVarP(&opts.Username, "user", "u", "", "The account to switch to")
+	cmd.Flags().BoolVar(&opts.Local, "local", false, "Bind the account to the current repository instead of switching the active account")
 
 	return cmd
 }
@@ -163,6 +177,27 @@ func switchRun(opts *SwitchOptions) error {
 
 	cs := opts.IO.ColorScheme()
 
+	if opts.Local {
+		ctx := context.Background()
+		if isRepo, err := opts.GitClient.IsLocalGitRepo(ctx); err != nil {
+			return err
+		} else if !isRepo {
+			return errors.New("`--local` can only be used from inside a git repository")
+		}
+
+		if err := opts.GitClient.SetLocalConfig(ctx, "gh.account", username); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Failed to bind account for %s to %s in this repository\n",
+				cs.FailureIcon(), hostname, cs.Bold(username))
+
+			return err
+		}
+
+		fmt.Fprintf(opts.IO.ErrOut, "%s Bound account for %s to %s in this repository\n",
+			cs.SuccessIcon(), hostname, cs.Bold(username))
+
+		return nil
+	}
+
 	if err := authCfg.SwitchUser(hostname, username); err != nil {
 		fmt.Fprintf(opts.IO.ErrOut, "%s Failed to switch account for %s to %s\n",
 			cs.FailureIcon(), hostname, cs.Bold(username))
@@ -7,10 +7,12 @@ import (
 	"io"
 	"testing"
 
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/keyring"
 	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
@@ -43,6 +45,13 @@ func TestNewCmdSwitch(t *testing.T) {
 				Username: "monalisa",
 			},
 		},
+		{
+			name:  "local flag",
+			input: "--local",
+			expectedOpts: SwitchOptions{
+				Local: true,
+			},
+		},
 		{
 			name:           "positional args is an error",
 			input:          "some-positional-arg",
@@ -119,6 +128,7 @@ func TestSwitchRun(t *testing.T) {
 		expectedFailure failedExpectation
 
 		prompterStubs func(*prompter.PrompterMock)
+		gitStubs      func(*run.CommandStubber)
 	}{
 		{
 			name: "given one host with two users, switches to the other user",
@@ -353,6 +363,49 @@ func TestSwitchRun(t *testing.T) {
 				stderr:       "✓ Switched active account for github.com to inactive-user",
 			},
 		},
+		{
+			name: "given --local, binds the account in the repository git config without switching the active account",
+			opts: SwitchOptions{
+				Username: "inactive-user",
+				Local:    true,
+			},
+			cfgHosts: []hostUsers{
+				{"github.com", []user{
+					{"inactive-user", "inactive-user-token"},
+					{"active-user", "active-user-token"},
+				}},
+			},
+			gitStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git rev-parse --git-dir`, 0, ".git")
+				cs.Register(`git config --local gh\.account inactive-user`, 0, "")
+			},
+			expectedSuccess: successfulExpectation{
+				switchedHost: "github.com",
+				activeUser:   "active-user",
+				activeToken:  "active-user-token",
+				hostsCfg:     "github.com:\n    git_protocol: ssh\n    users:\n        inactive-user:\n        active-user:\n    user: active-user\n",
+				stderr:       "✓ Bound account for github.com to inactive-user in this repository",
+			},
+		},
+		{
+			name: "given --local outside of a git repository, provide an informative error",
+			opts: SwitchOptions{
+				Username: "inactive-user",
+				Local:    true,
+			},
+			cfgHosts: []hostUsers{
+				{"github.com", []user{
+					{"inactive-user", "inactive-user-token"},
+					{"active-user", "active-user-token"},
+				}},
+			},
+			gitStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git rev-parse --git-dir`, 128, "")
+			},
+			expectedFailure: failedExpectation{
+				err: errors.New("`--local` can only be used from inside a git repository"),
+			},
+		},
 		{
 			name: "when switching fails due to something other than user error, an informative message is printed to explain their new state",
 			opts: SwitchOptions{
@@ -379,6 +432,13 @@ func TestSwitchRun(t *testing.T) {
 				t.Setenv(k, v)
 			}
 
+			if tt.gitStubs != nil {
+				cs, teardown := run.Stub()
+				defer teardown(t)
+				tt.gitStubs(cs)
+				tt.opts.GitClient = &git.Client{GitPath: "some/path/git"}
+			}
+
 			isInteractive := tt.prompterStubs != nil
 			if isInteractive {
 				pm := &prompter.PrompterMock{}
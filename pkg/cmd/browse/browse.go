@@ -2,6 +2,7 @@ package browse
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -39,6 +40,9 @@ type BrowseOptions struct {
 
 	Branch          string
 	Commit          string
+	Ref             string
+	PullRequest     string
+	BlameFlag       bool
 	ProjectsFlag    bool
 	ReleasesFlag    bool
 	SettingsFlag    bool
@@ -95,6 +99,12 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 
 			# Open main.go with the repository at commit 775007cd
 			$ gh browse main.go --commit=77507cd94ccafcf568f8560cfecde965fcfa63
+
+			# Open the blame view of main.go at line 312, permalinked to the current commit
+			$ gh browse main.go:312 --blame
+
+			# Open main.go at line 312 in the "Files changed" view of pull request 217
+			$ gh browse main.go:312 --pr 217
 		`),
 		Annotations: map[string]string{
 			"help:arguments": heredoc.Doc(`
@@ -127,9 +137,11 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 			}
 
 			if err := cmdutil.MutuallyExclusive(
-				"specify only one of `--branch`, `--commit`, `--projects`, `--releases`, `--settings`, or `--wiki`",
+				"specify only one of `--branch`, `--commit`, `--ref`, `--pr`, `--projects`, `--releases`, `--settings`, or `--wiki`",
 				opts.Branch != "",
 				opts.Commit != "",
+				opts.Ref != "",
+				opts.PullRequest != "",
 				opts.ProjectsFlag,
 				opts.ReleasesFlag,
 				opts.SettingsFlag,
@@ -138,8 +150,24 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 				return err
 			}
 
-			if (isNumber(opts.SelectorArg) || isCommit(opts.SelectorArg)) && (opts.Branch != "" || opts.Commit != "") {
-				return cmdutil.FlagErrorf("%q is an invalid argument when using `--branch` or `--commit`", opts.SelectorArg)
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--blame` or `--pr`",
+				opts.BlameFlag,
+				opts.PullRequest != "",
+			); err != nil {
+				return err
+			}
+
+			if (isNumber(opts.SelectorArg) || isCommit(opts.SelectorArg)) && (opts.Branch != "" || opts.Commit != "" || opts.Ref != "") {
+				return cmdutil.FlagErrorf("%q is an invalid argument when using `--branch`, `--commit`, or `--ref`", opts.SelectorArg)
+			}
+
+			if opts.BlameFlag && (opts.SelectorArg == "" || isNumber(opts.SelectorArg) || isCommit(opts.SelectorArg)) {
+				return cmdutil.FlagErrorf("a file argument is required when using `--blame`")
+			}
+
+			if opts.PullRequest != "" && !isNumber(opts.PullRequest) {
+				return cmdutil.FlagErrorf("invalid pull request number: %q", opts.PullRequest)
 			}
 
 			if cmd.Flags().Changed("repo") || os.Getenv("GH_REPO") != "" {
@@ -162,6 +190,9 @@ func NewCmdBrowse(f *cmdutil.Factory, runF func(*BrowseOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&opts.NoBrowserFlag, "no-browser", "n", false, "Print destination URL instead of opening the browser")
 	cmd.Flags().StringVarP(&opts.Commit, "commit", "c", "", "Select another commit by passing in the commit SHA, default is the last commit")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Select another branch by passing in the branch name")
+	cmd.Flags().StringVar(&opts.Ref, "ref", "", "Select a git reference by passing in a branch name, tag, or commit SHA")
+	cmd.Flags().BoolVar(&opts.BlameFlag, "blame", false, "Open the blame view of the selected file")
+	cmd.Flags().StringVar(&opts.PullRequest, "pr", "", "Open the file in the \"Files changed\" view of the pull request `number`")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "branch")
 
@@ -185,6 +216,16 @@ func runBrowse(opts *BrowseOptions) error {
 		opts.Commit = commit.Sha
 	}
 
+	// with --blame, default to the current commit when no ref was given so
+	// that the link is permalinked to a SHA
+	if opts.BlameFlag && opts.Branch == "" && opts.Commit == "" && opts.Ref == "" {
+		commit, err := opts.GitClient.LastCommit()
+		if err != nil {
+			return err
+		}
+		opts.Ref = commit.Sha
+	}
+
 	section, err := parseSection(baseRepo, opts)
 	if err != nil {
 		return err
@@ -225,10 +266,30 @@ func parseSection(baseRepo ghrepo.Interface, opts *BrowseOptions) (string, error
 		return "wiki", nil
 	}
 
+	if opts.PullRequest != "" {
+		filePath, rangeStart, _, err := parseFile(*opts, opts.SelectorArg)
+		if err != nil {
+			return "", err
+		}
+		section := fmt.Sprintf("pull/%s/files", strings.TrimPrefix(opts.PullRequest, "#"))
+		if filePath != "" {
+			// the "Files changed" view anchors each file by the SHA-256 hash of its path
+			anchor := fmt.Sprintf("diff-%x", sha256.Sum256([]byte(filePath)))
+			if rangeStart > 0 {
+				anchor += fmt.Sprintf("R%d", rangeStart)
+			}
+			section += "#" + anchor
+		}
+		return section, nil
+	}
+
 	ref := opts.Branch
 	if opts.Commit != "" {
 		ref = opts.Commit
 	}
+	if opts.Ref != "" {
+		ref = opts.Ref
+	}
 
 	if ref == "" {
 		if opts.SelectorArg == "" {
@@ -266,9 +327,16 @@ func parseSection(baseRepo ghrepo.Interface, opts *BrowseOptions) (string, error
 		} else {
 			rangeFragment = fmt.Sprintf("L%d", rangeStart)
 		}
+		if opts.BlameFlag {
+			return fmt.Sprintf("blame/%s/%s#%s", escapePath(ref), escapePath(filePath), rangeFragment), nil
+		}
 		return fmt.Sprintf("blob/%s/%s?plain=1#%s", escapePath(ref), escapePath(filePath), rangeFragment), nil
 	}
 
+	if opts.BlameFlag {
+		return fmt.Sprintf("blame/%s/%s", escapePath(ref), escapePath(filePath)), nil
+	}
+
 	return strings.TrimSuffix(fmt.Sprintf("tree/%s/%s", escapePath(ref), escapePath(filePath)), "/"), nil
 }
 
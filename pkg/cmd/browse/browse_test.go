@@ -185,6 +185,63 @@ func TestNewCmdBrowse(t *testing.T) {
 			cli:      "de07febc26e19000f8c9e821207f3bc34a3c8038 --commit=12a4",
 			wantsErr: true,
 		},
+		{
+			name: "ref flag with file",
+			cli:  "main.go --ref v1.2.3",
+			wants: BrowseOptions{
+				Ref:         "v1.2.3",
+				SelectorArg: "main.go",
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "passed both branch and ref flags",
+			cli:      "main.go --branch main --ref v1.2.3",
+			wantsErr: true,
+		},
+		{
+			name: "blame flag with file",
+			cli:  "main.go:312 --blame",
+			wants: BrowseOptions{
+				BlameFlag:   true,
+				SelectorArg: "main.go:312",
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "blame flag without file",
+			cli:      "--blame",
+			wantsErr: true,
+		},
+		{
+			name:     "blame flag with number argument",
+			cli:      "217 --blame",
+			wantsErr: true,
+		},
+		{
+			name: "pr flag with file",
+			cli:  "main.go:312 --pr 217",
+			wants: BrowseOptions{
+				PullRequest: "217",
+				SelectorArg: "main.go:312",
+			},
+			wantsErr: false,
+		},
+		{
+			name:     "pr flag with invalid number",
+			cli:      "main.go --pr feature",
+			wantsErr: true,
+		},
+		{
+			name:     "passed both blame and pr flags",
+			cli:      "main.go --blame --pr 217",
+			wantsErr: true,
+		},
+		{
+			name:     "passed both pr and branch flags",
+			cli:      "main.go --pr 217 --branch main",
+			wantsErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -216,6 +273,9 @@ func TestNewCmdBrowse(t *testing.T) {
 			assert.Equal(t, tt.wants.NoBrowserFlag, opts.NoBrowserFlag)
 			assert.Equal(t, tt.wants.SettingsFlag, opts.SettingsFlag)
 			assert.Equal(t, tt.wants.Commit, opts.Commit)
+			assert.Equal(t, tt.wants.Ref, opts.Ref)
+			assert.Equal(t, tt.wants.BlameFlag, opts.BlameFlag)
+			assert.Equal(t, tt.wants.PullRequest, opts.PullRequest)
 		})
 	}
 }
@@ -485,6 +545,57 @@ func Test_runBrowse(t *testing.T) {
 			wantsErr:    false,
 			expectedURL: "https://github.com/yanskun/ILoveGitHub/tree/12a4/main.go",
 		},
+		{
+			name: "ref flag with file and line number",
+			opts: BrowseOptions{
+				Ref:         "v1.2.3",
+				SelectorArg: "main.go:32",
+			},
+			baseRepo:    ghrepo.New("yanskun", "ILoveGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/yanskun/ILoveGitHub/blob/v1.2.3/main.go?plain=1#L32",
+		},
+		{
+			name: "blame with branch and line number",
+			opts: BrowseOptions{
+				Branch:      "trunk",
+				BlameFlag:   true,
+				SelectorArg: "main.go:32",
+			},
+			baseRepo:    ghrepo.New("yanskun", "ILoveGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/yanskun/ILoveGitHub/blame/trunk/main.go#L32",
+		},
+		{
+			name: "blame permalinks to the current commit when no ref is given",
+			opts: BrowseOptions{
+				BlameFlag:   true,
+				SelectorArg: "main.go:32",
+				GitClient:   &testGitClient{},
+			},
+			baseRepo:    ghrepo.New("yanskun", "ILoveGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/yanskun/ILoveGitHub/blame/6f1a2405cace1633d89a79c74c65f22fe78f9659/main.go#L32",
+		},
+		{
+			name: "pr flag with file and line number",
+			opts: BrowseOptions{
+				PullRequest: "217",
+				SelectorArg: "main.go:32",
+			},
+			baseRepo:    ghrepo.New("yanskun", "ILoveGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/yanskun/ILoveGitHub/pull/217/files#diff-2873f79a86c0d8b3335cd7731b0ecf7dd4301eb19a82ef7a1cba7589b5252261R32",
+		},
+		{
+			name: "pr flag without file",
+			opts: BrowseOptions{
+				PullRequest: "217",
+			},
+			baseRepo:    ghrepo.New("yanskun", "ILoveGitHub"),
+			wantsErr:    false,
+			expectedURL: "https://github.com/yanskun/ILoveGitHub/pull/217/files",
+		},
 		{
 			name: "relative path from browse_test.go",
 			opts: BrowseOptions{
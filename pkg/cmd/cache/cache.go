@@ -4,6 +4,8 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/cache/delete"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/cache/list"
+	cmdPrune "github.com/cli/cli/v2/pkg/cmd/cache/prune"
+	cmdUsage "github.com/cli/cli/v2/pkg/cmd/cache/usage"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -24,6 +26,8 @@ func NewCmdCache(f *cmdutil.Factory) *cobra.Command {
 
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdPrune.NewCmdPrune(f, nil))
+	cmd.AddCommand(cmdUsage.NewCmdUsage(f, nil))
 
 	return cmd
 }
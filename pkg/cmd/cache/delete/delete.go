@@ -21,16 +21,24 @@ type DeleteOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
+	Prompter   iprompter
 
 	DeleteAll         bool
 	SucceedOnNoCaches bool
 	Identifier        string
+
+	cmdutil.DestructiveOptions
+}
+
+type iprompter interface {
+	ConfirmDeletion(string) error
 }
 
 func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
 	opts := &DeleteOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Prompter:   f.Prompter,
 	}
 
 	cmd := &cobra.Command{
@@ -40,6 +48,9 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 			Delete GitHub Actions caches.
 
 			Deletion requires authorization with the %[1]srepo%[1]s scope.
+
+			To skip the confirmation prompt, pass %[1]s--yes%[1]s. To preview which caches
+			would be deleted without deleting anything, pass %[1]s--dry-run%[1]s.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Delete a cache by id
@@ -77,6 +88,10 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("must provide either cache id, cache key, or use --all")
 			}
 
+			if !opts.IO.CanPrompt() && !opts.Confirmed && !opts.DryRun {
+				return cmdutil.FlagErrorf("--yes required when not running interactively")
+			}
+
 			if len(args) == 1 {
 				opts.Identifier = args[0]
 			}
@@ -91,6 +106,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 
 	cmd.Flags().BoolVarP(&opts.DeleteAll, "all", "a", false, "Delete all caches")
 	cmd.Flags().BoolVar(&opts.SucceedOnNoCaches, "succeed-on-no-caches", false, "Return exit code 0 if no caches found. Must be used in conjunction with `--all`")
+	cmdutil.AddDestructiveFlags(cmd, &opts.DestructiveOptions)
 
 	return cmd
 }
@@ -107,6 +123,14 @@ func deleteRun(opts *DeleteOptions) error {
 		return fmt.Errorf("failed to determine base repo: %w", err)
 	}
 
+	requiredValue := opts.Identifier
+	if opts.DeleteAll {
+		requiredValue = ghrepo.FullName(repo)
+	}
+	if err := cmdutil.ConfirmDestructive(opts.IO.CanPrompt(), opts.Prompter, requiredValue, opts.DestructiveOptions); err != nil {
+		return err
+	}
+
 	var toDelete []string
 	if opts.DeleteAll {
 		opts.IO.StartProgressIndicator()
@@ -132,6 +156,11 @@ func deleteRun(opts *DeleteOptions) error {
 		toDelete = append(toDelete, opts.Identifier)
 	}
 
+	if opts.DryRun {
+		fmt.Fprintf(opts.IO.Out, "Would have deleted %s from %s\n", text.Pluralize(len(toDelete), "cache"), ghrepo.FullName(repo))
+		return nil
+	}
+
 	return deleteCaches(opts, client, repo, toDelete)
 }
 
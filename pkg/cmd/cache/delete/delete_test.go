@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
@@ -48,6 +49,16 @@ func TestNewCmdDelete(t *testing.T) {
 			cli:   "--all --succeed-on-no-caches",
 			wants: DeleteOptions{DeleteAll: true, SucceedOnNoCaches: true},
 		},
+		{
+			name:  "yes flag",
+			cli:   "123 --yes",
+			wants: DeleteOptions{Identifier: "123", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
+		},
+		{
+			name:  "dry run flag",
+			cli:   "123 --dry-run",
+			wants: DeleteOptions{Identifier: "123", DestructiveOptions: cmdutil.DestructiveOptions{DryRun: true}},
+		},
 		{
 			name:     "succeed-on-no-caches flag",
 			cli:      "--succeed-on-no-caches",
@@ -67,7 +78,10 @@ func TestNewCmdDelete(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			f := &cmdutil.Factory{}
+			ios, _, _, _ := iostreams.Test()
+			ios.SetStdinTTY(true)
+			ios.SetStdoutTTY(true)
+			f := &cmdutil.Factory{IOStreams: ios}
 			argv, err := shlex.Split(tt.cli)
 			assert.NoError(t, err)
 			var gotOpts *DeleteOptions
@@ -89,23 +103,26 @@ func TestNewCmdDelete(t *testing.T) {
 			assert.Equal(t, tt.wants.DeleteAll, gotOpts.DeleteAll)
 			assert.Equal(t, tt.wants.SucceedOnNoCaches, gotOpts.SucceedOnNoCaches)
 			assert.Equal(t, tt.wants.Identifier, gotOpts.Identifier)
+			assert.Equal(t, tt.wants.Confirmed, gotOpts.Confirmed)
+			assert.Equal(t, tt.wants.DryRun, gotOpts.DryRun)
 		})
 	}
 }
 
 func TestDeleteRun(t *testing.T) {
 	tests := []struct {
-		name       string
-		opts       DeleteOptions
-		stubs      func(*httpmock.Registry)
-		tty        bool
-		wantErr    bool
-		wantErrMsg string
-		wantStderr string
-		wantStdout string
+		name          string
+		opts          DeleteOptions
+		stubs         func(*httpmock.Registry)
+		prompterStubs func(*prompter.PrompterMock)
+		tty           bool
+		wantErr       bool
+		wantErrMsg    string
+		wantStderr    string
+		wantStdout    string
 	}{
 		{
-			name: "deletes cache tty",
+			name: "deletes cache after confirmation",
 			opts: DeleteOptions{Identifier: "123"},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
@@ -113,12 +130,38 @@ func TestDeleteRun(t *testing.T) {
 					httpmock.StatusStringResponse(204, ""),
 				)
 			},
+			prompterStubs: func(pm *prompter.PrompterMock) {
+				pm.ConfirmDeletionFunc = func(p string) error {
+					if p == "123" {
+						return nil
+					}
+					return prompter.NoSuchPromptErr(p)
+				}
+			},
+			tty:        true,
+			wantStdout: "✓ Deleted 1 cache from OWNER/REPO\n",
+		},
+		{
+			name:       "dry run",
+			opts:       DeleteOptions{Identifier: "123", DestructiveOptions: cmdutil.DestructiveOptions{DryRun: true}},
+			tty:        true,
+			wantStdout: "Would have deleted 1 cache from OWNER/REPO\n",
+		},
+		{
+			name: "deletes cache tty",
+			opts: DeleteOptions{Identifier: "123", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
+			stubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/123"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+			},
 			tty:        true,
 			wantStdout: "✓ Deleted 1 cache from OWNER/REPO\n",
 		},
 		{
 			name: "deletes cache notty",
-			opts: DeleteOptions{Identifier: "123"},
+			opts: DeleteOptions{Identifier: "123", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/123"),
@@ -130,7 +173,7 @@ func TestDeleteRun(t *testing.T) {
 		},
 		{
 			name: "non-existent cache",
-			opts: DeleteOptions{Identifier: "123"},
+			opts: DeleteOptions{Identifier: "123", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/123"),
@@ -142,7 +185,7 @@ func TestDeleteRun(t *testing.T) {
 		},
 		{
 			name: "deletes all caches",
-			opts: DeleteOptions{DeleteAll: true},
+			opts: DeleteOptions{DeleteAll: true, DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
@@ -178,7 +221,7 @@ func TestDeleteRun(t *testing.T) {
 		},
 		{
 			name: "attempts to delete all caches but api errors",
-			opts: DeleteOptions{DeleteAll: true},
+			opts: DeleteOptions{DeleteAll: true, DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
@@ -191,7 +234,7 @@ func TestDeleteRun(t *testing.T) {
 		},
 		{
 			name: "displays delete error",
-			opts: DeleteOptions{Identifier: "123"},
+			opts: DeleteOptions{Identifier: "123", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/123"),
@@ -203,7 +246,7 @@ func TestDeleteRun(t *testing.T) {
 		},
 		{
 			name: "keys must be percent-encoded before being used as query params",
-			opts: DeleteOptions{Identifier: "a weird＿cache+key"},
+			opts: DeleteOptions{Identifier: "a weird＿cache+key", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.QueryMatcher("DELETE", "repos/OWNER/REPO/actions/caches", url.Values{
@@ -217,7 +260,7 @@ func TestDeleteRun(t *testing.T) {
 		},
 		{
 			name: "no caches to delete when deleting all",
-			opts: DeleteOptions{DeleteAll: true},
+			opts: DeleteOptions{DeleteAll: true, DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
@@ -233,7 +276,7 @@ func TestDeleteRun(t *testing.T) {
 		},
 		{
 			name: "no caches to delete when deleting all but succeed on no cache tty",
-			opts: DeleteOptions{DeleteAll: true, SucceedOnNoCaches: true},
+			opts: DeleteOptions{DeleteAll: true, SucceedOnNoCaches: true, DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
@@ -249,7 +292,7 @@ func TestDeleteRun(t *testing.T) {
 		},
 		{
 			name: "no caches to delete when deleting all but succeed on no cache non-tty",
-			opts: DeleteOptions{DeleteAll: true, SucceedOnNoCaches: true},
+			opts: DeleteOptions{DeleteAll: true, SucceedOnNoCaches: true, DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			stubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
@@ -284,6 +327,12 @@ func TestDeleteRun(t *testing.T) {
 			}
 			defer reg.Verify(t)
 
+			pm := &prompter.PrompterMock{}
+			if tt.prompterStubs != nil {
+				tt.prompterStubs(pm)
+			}
+			tt.opts.Prompter = pm
+
 			err := deleteRun(&tt.opts)
 			if tt.wantErr {
 				if tt.wantErrMsg != "" {
@@ -0,0 +1,248 @@
+package prune
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type PruneOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Now        time.Time
+
+	KeepLast     int
+	OlderThan    time.Duration
+	MaxTotalSize int64
+	DryRun       bool
+}
+
+func NewCmdPrune(f *cmdutil.Factory, runF func(*PruneOptions) error) *cobra.Command {
+	opts := &PruneOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	var olderThan string
+	var maxTotalSize string
+
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Prune GitHub Actions caches by policy",
+		Long: heredoc.Docf(`
+			Delete GitHub Actions caches according to a pruning policy, least recently
+			accessed first.
+
+			%[1]s--older-than%[1]s deletes caches that have not been accessed within the given
+			duration (e.g. %[1]s12h%[1]s, %[1]s7d%[1]s). %[1]s--max-total-size%[1]s deletes caches until the total
+			cache size is at or below the given size (e.g. %[1]s500MB%[1]s, %[1]s8GB%[1]s). %[1]s--keep-last%[1]s
+			protects the given number of most recently accessed caches from deletion,
+			and on its own deletes everything else.
+
+			Deletion requires authorization with the %[1]srepo%[1]s scope.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Delete caches not accessed in the last week
+			$ gh cache prune --older-than 7d
+
+			# Get the total cache size below the 10GB Actions limit, but keep the
+			# five most recently accessed caches no matter what
+			$ gh cache prune --max-total-size 8GB --keep-last 5
+
+			# Show what would be deleted without deleting anything
+			$ gh cache prune --older-than 7d --dry-run
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support -R/--repo flag
+			opts.BaseRepo = f.BaseRepo
+
+			if olderThan == "" && maxTotalSize == "" && opts.KeepLast == 0 {
+				return cmdutil.FlagErrorf("specify at least one of `--keep-last`, `--max-total-size`, or `--older-than`")
+			}
+
+			if opts.KeepLast < 0 {
+				return cmdutil.FlagErrorf("invalid value for `--keep-last`: %d", opts.KeepLast)
+			}
+
+			if olderThan != "" {
+				d, err := parseOlderThan(olderThan)
+				if err != nil {
+					return err
+				}
+				opts.OlderThan = d
+			}
+
+			if maxTotalSize != "" {
+				s, err := parseSize(maxTotalSize)
+				if err != nil {
+					return err
+				}
+				opts.MaxTotalSize = s
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return pruneRun(opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.KeepLast, "keep-last", 0, "Never delete the `number` of most recently accessed caches")
+	cmd.Flags().StringVar(&olderThan, "older-than", "", "Delete caches not accessed within the given `duration` (e.g. \"12h\", \"7d\")")
+	cmd.Flags().StringVar(&maxTotalSize, "max-total-size", "", "Delete caches until the total cache size is at most `size` (e.g. \"500MB\", \"8GB\")")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print what would be deleted without deleting anything")
+
+	return cmd
+}
+
+func pruneRun(opts *PruneOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	result, err := shared.GetCaches(client, repo, shared.GetCachesOptions{Limit: -1})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if opts.Now.IsZero() {
+		opts.Now = time.Now()
+	}
+
+	toPrune := selectCachesToPrune(result.ActionsCaches, opts)
+
+	cs := opts.IO.ColorScheme()
+	repoName := ghrepo.FullName(repo)
+
+	if len(toPrune) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s No caches to prune in %s\n", cs.SuccessIcon(), repoName)
+		}
+		return nil
+	}
+
+	var freed int64
+	for _, cache := range toPrune {
+		freed += cache.SizeInBytes
+	}
+
+	if opts.DryRun {
+		for _, cache := range toPrune {
+			fmt.Fprintf(opts.IO.Out, "%d\t%s\t%s\n", cache.Id, cache.Key, shared.HumanFileSize(cache.SizeInBytes))
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "\nDry run: would delete %s from %s, freeing %s\n", text.Pluralize(len(toPrune), "cache"), repoName, shared.HumanFileSize(freed))
+		}
+		return nil
+	}
+
+	opts.IO.StartProgressIndicator()
+	base := fmt.Sprintf("repos/%s/actions/caches", repoName)
+	for _, cache := range toPrune {
+		path := fmt.Sprintf("%s/%d", base, cache.Id)
+		if err := client.REST(repo.RepoHost(), "DELETE", path, nil, nil); err != nil {
+			opts.IO.StopProgressIndicator()
+			return fmt.Errorf("%s Failed to delete cache %d: %w", cs.FailureIcon(), cache.Id, err)
+		}
+	}
+	opts.IO.StopProgressIndicator()
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Pruned %s from %s, freeing %s\n", cs.SuccessIcon(), text.Pluralize(len(toPrune), "cache"), repoName, shared.HumanFileSize(freed))
+	}
+
+	return nil
+}
+
+// selectCachesToPrune applies the pruning policy and returns the caches to
+// delete, least recently accessed first. The KeepLast most recently accessed
+// caches are never selected.
+func selectCachesToPrune(caches []shared.Cache, opts *PruneOptions) []shared.Cache {
+	sorted := make([]shared.Cache, len(caches))
+	copy(sorted, caches)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].LastAccessedAt.After(sorted[j].LastAccessedAt)
+	})
+
+	var totalSize int64
+	for _, cache := range sorted {
+		totalSize += cache.SizeInBytes
+	}
+
+	var toPrune []shared.Cache
+	for i := len(sorted) - 1; i >= opts.KeepLast; i-- {
+		cache := sorted[i]
+		prune := opts.OlderThan == 0 && opts.MaxTotalSize == 0
+		if opts.OlderThan > 0 && opts.Now.Sub(cache.LastAccessedAt) > opts.OlderThan {
+			prune = true
+		}
+		if opts.MaxTotalSize > 0 && totalSize > opts.MaxTotalSize {
+			prune = true
+		}
+		if prune {
+			toPrune = append(toPrune, cache)
+			totalSize -= cache.SizeInBytes
+		}
+	}
+	return toPrune
+}
+
+// parseOlderThan accepts Go durations plus a "d" suffix for days, which
+// time.ParseDuration does not support.
+func parseOlderThan(s string) (time.Duration, error) {
+	if days, err := strconv.Atoi(strings.TrimSuffix(s, "d")); err == nil && strings.HasSuffix(s, "d") {
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, cmdutil.FlagErrorf("invalid value for `--older-than`: %q", s)
+	}
+	return d, nil
+}
+
+var sizeRE = regexp.MustCompile(`(?i)^(\d+(?:\.\d+)?)\s*(B|KB|MB|GB)?$`)
+
+func parseSize(s string) (int64, error) {
+	m := sizeRE.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, cmdutil.FlagErrorf("invalid value for `--max-total-size`: %q", s)
+	}
+	value, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, cmdutil.FlagErrorf("invalid value for `--max-total-size`: %q", s)
+	}
+	switch strings.ToUpper(m[2]) {
+	case "KB":
+		value *= 1024
+	case "MB":
+		value *= 1024 * 1024
+	case "GB":
+		value *= 1024 * 1024 * 1024
+	}
+	return int64(value), nil
+}
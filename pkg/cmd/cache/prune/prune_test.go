@@ -0,0 +1,242 @@
+package prune
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdPrune(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  PruneOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no policy flags",
+			input:   "",
+			wantErr: true,
+			errMsg:  "specify at least one of `--keep-last`, `--max-total-size`, or `--older-than`",
+		},
+		{
+			name:    "negative keep-last",
+			input:   "--keep-last -1",
+			wantErr: true,
+			errMsg:  "invalid value for `--keep-last`: -1",
+		},
+		{
+			name:    "invalid older-than",
+			input:   "--older-than sometime",
+			wantErr: true,
+			errMsg:  "invalid value for `--older-than`: \"sometime\"",
+		},
+		{
+			name:    "invalid max-total-size",
+			input:   "--max-total-size big",
+			wantErr: true,
+			errMsg:  "invalid value for `--max-total-size`: \"big\"",
+		},
+		{
+			name:   "older-than with day suffix",
+			input:  "--older-than 7d",
+			output: PruneOptions{OlderThan: 7 * 24 * time.Hour},
+		},
+		{
+			name:   "older-than with duration",
+			input:  "--older-than 12h",
+			output: PruneOptions{OlderThan: 12 * time.Hour},
+		},
+		{
+			name:   "max total size",
+			input:  "--max-total-size 8GB",
+			output: PruneOptions{MaxTotalSize: 8 * 1024 * 1024 * 1024},
+		},
+		{
+			name:   "combined policy",
+			input:  "--keep-last 5 --max-total-size 500MB --older-than 7d --dry-run",
+			output: PruneOptions{KeepLast: 5, MaxTotalSize: 500 * 1024 * 1024, OlderThan: 7 * 24 * time.Hour, DryRun: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var gotOpts *PruneOptions
+			cmd := NewCmdPrune(f, func(opts *PruneOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.KeepLast, gotOpts.KeepLast)
+			assert.Equal(t, tt.output.OlderThan, gotOpts.OlderThan)
+			assert.Equal(t, tt.output.MaxTotalSize, gotOpts.MaxTotalSize)
+			assert.Equal(t, tt.output.DryRun, gotOpts.DryRun)
+		})
+	}
+}
+
+func TestPruneRun(t *testing.T) {
+	now := time.Date(2023, 1, 15, 12, 0, 0, 0, time.UTC)
+	cachesPayload := `{"total_count":3,"actions_caches":[
+		{"id":1,"key":"deps-linux-new","size_in_bytes":1024,"last_accessed_at":"2023-01-15T00:00:00Z"},
+		{"id":2,"key":"deps-linux-old","size_in_bytes":2048,"last_accessed_at":"2023-01-01T00:00:00Z"},
+		{"id":3,"key":"build-output","size_in_bytes":4096,"last_accessed_at":"2023-01-14T00:00:00Z"}
+	]}`
+
+	tests := []struct {
+		name       string
+		tty        bool
+		opts       *PruneOptions
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+		wantErrMsg string
+	}{
+		{
+			name: "prunes caches older than duration",
+			tty:  true,
+			opts: &PruneOptions{Now: now, OlderThan: 7 * 24 * time.Hour},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(cachesPayload),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/2"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+			},
+			wantStdout: "✓ Pruned 1 cache from OWNER/REPO, freeing 2.00 KiB\n",
+		},
+		{
+			name: "prunes least recently accessed over size budget",
+			tty:  true,
+			opts: &PruneOptions{Now: now, MaxTotalSize: 5 * 1024},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(cachesPayload),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/2"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+			},
+			wantStdout: "✓ Pruned 1 cache from OWNER/REPO, freeing 2.00 KiB\n",
+		},
+		{
+			name: "keep-last alone prunes the rest",
+			tty:  true,
+			opts: &PruneOptions{Now: now, KeepLast: 1},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(cachesPayload),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/2"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/actions/caches/3"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+			},
+			wantStdout: "✓ Pruned 2 caches from OWNER/REPO, freeing 6.00 KiB\n",
+		},
+		{
+			name: "keep-last protects caches from size policy",
+			tty:  true,
+			opts: &PruneOptions{Now: now, MaxTotalSize: 1, KeepLast: 3},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(cachesPayload),
+				)
+			},
+			wantStdout: "✓ No caches to prune in OWNER/REPO\n",
+		},
+		{
+			name: "dry run",
+			tty:  true,
+			opts: &PruneOptions{Now: now, OlderThan: 7 * 24 * time.Hour, DryRun: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(cachesPayload),
+				)
+			},
+			wantStdout: "2\tdeps-linux-old\t2.00 KiB\n\nDry run: would delete 1 cache from OWNER/REPO, freeing 2.00 KiB\n",
+		},
+		{
+			name: "nothing to prune",
+			tty:  true,
+			opts: &PruneOptions{Now: now, OlderThan: 30 * 24 * time.Hour},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(cachesPayload),
+				)
+			},
+			wantStdout: "✓ No caches to prune in OWNER/REPO\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := pruneRun(tt.opts)
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
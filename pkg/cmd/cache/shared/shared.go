@@ -3,6 +3,7 @@ package shared
 import (
 	"fmt"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/cli/cli/v2/api"
@@ -95,3 +96,28 @@ pagination:
 func (c *Cache) ExportData(fields []string) map[string]interface{} {
 	return cmdutil.StructExportData(c, fields)
 }
+
+func HumanFileSize(s int64) string {
+	if s < 1024 {
+		return fmt.Sprintf("%d B", s)
+	}
+
+	kb := float64(s) / 1024
+	if kb < 1024 {
+		return fmt.Sprintf("%s KiB", floatToString(kb, 2))
+	}
+
+	mb := kb / 1024
+	if mb < 1024 {
+		return fmt.Sprintf("%s MiB", floatToString(mb, 2))
+	}
+
+	gb := mb / 1024
+	return fmt.Sprintf("%s GiB", floatToString(gb, 2))
+}
+
+func floatToString(f float64, p uint8) string {
+	fs := fmt.Sprintf("%#f%0*s", f, p, "")
+	idx := strings.IndexRune(fs, '.')
+	return fs[:idx+int(p)+1]
+}
@@ -0,0 +1,175 @@
+package usage
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type UsageOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Exporter   cmdutil.Exporter
+
+	Delimiter string
+}
+
+var usageFields = []string{
+	"prefix",
+	"count",
+	"sizeInBytes",
+}
+
+type prefixUsage struct {
+	Prefix      string `json:"prefix"`
+	Count       int    `json:"count"`
+	SizeInBytes int64  `json:"sizeInBytes"`
+}
+
+func (u *prefixUsage) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(u, fields)
+}
+
+func NewCmdUsage(f *cmdutil.Factory, runF func(*UsageOptions) error) *cobra.Command {
+	opts := &UsageOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Summarize GitHub Actions cache usage",
+		Long: heredoc.Docf(`
+			Summarize GitHub Actions cache usage per cache key prefix.
+
+			Cache keys are grouped by their first segment, up to the first occurrence of
+			the delimiter (%[1]s-%[1]s by default), which helps locate the workflows consuming
+			the most of the 10GB per-repository cache limit.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Summarize cache usage per key prefix
+			$ gh cache usage
+
+			# Group keys on a different delimiter
+			$ gh cache usage --delimiter "/"
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support -R/--repo flag
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Delimiter == "" {
+				return cmdutil.FlagErrorf("`--delimiter` cannot be empty")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return usageRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Delimiter, "delimiter", "-", "Group cache keys up to the first occurrence of `delimiter`")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, usageFields)
+
+	return cmd
+}
+
+func usageRun(opts *UsageOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	opts.IO.StartProgressIndicator()
+	result, err := shared.GetCaches(client, repo, shared.GetCachesOptions{Limit: -1})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return fmt.Errorf("%s Failed to get caches: %w", opts.IO.ColorScheme().FailureIcon(), err)
+	}
+
+	if len(result.ActionsCaches) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("No caches found in %s", ghrepo.FullName(repo)))
+	}
+
+	usage := aggregateUsage(result.ActionsCaches, opts.Delimiter)
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, usage)
+	}
+
+	var totalSize int64
+	for _, u := range usage {
+		totalSize += u.SizeInBytes
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\n%s used by %s in %s\n\n",
+			shared.HumanFileSize(totalSize),
+			text.Pluralize(result.TotalCount, "cache"),
+			ghrepo.FullName(repo))
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("PREFIX", "CACHES", "SIZE", "SHARE"))
+	for _, u := range usage {
+		tp.AddField(u.Prefix)
+		tp.AddField(fmt.Sprintf("%d", u.Count))
+		tp.AddField(shared.HumanFileSize(u.SizeInBytes))
+		tp.AddField(fmt.Sprintf("%.1f%%", float64(u.SizeInBytes)*100/float64(totalSize)))
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+// aggregateUsage groups caches by key prefix and returns the groups sorted by
+// total size, largest first.
+func aggregateUsage(caches []shared.Cache, delimiter string) []prefixUsage {
+	byPrefix := map[string]*prefixUsage{}
+	for _, cache := range caches {
+		prefix, _, _ := strings.Cut(cache.Key, delimiter)
+		u, ok := byPrefix[prefix]
+		if !ok {
+			u = &prefixUsage{Prefix: prefix}
+			byPrefix[prefix] = u
+		}
+		u.Count++
+		u.SizeInBytes += cache.SizeInBytes
+	}
+
+	usage := make([]prefixUsage, 0, len(byPrefix))
+	for _, u := range byPrefix {
+		usage = append(usage, *u)
+	}
+	sort.Slice(usage, func(i, j int) bool {
+		if usage[i].SizeInBytes != usage[j].SizeInBytes {
+			return usage[i].SizeInBytes > usage[j].SizeInBytes
+		}
+		return usage[i].Prefix < usage[j].Prefix
+	})
+	return usage
+}
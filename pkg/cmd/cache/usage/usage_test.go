@@ -0,0 +1,193 @@
+package usage
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/cache/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdUsage(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  UsageOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:   "no arguments",
+			input:  "",
+			output: UsageOptions{Delimiter: "-"},
+		},
+		{
+			name:   "delimiter",
+			input:  `--delimiter "/"`,
+			output: UsageOptions{Delimiter: "/"},
+		},
+		{
+			name:    "empty delimiter",
+			input:   `--delimiter ""`,
+			wantErr: true,
+			errMsg:  "`--delimiter` cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var gotOpts *UsageOptions
+			cmd := NewCmdUsage(f, func(opts *UsageOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.Delimiter, gotOpts.Delimiter)
+		})
+	}
+}
+
+func TestUsageRun(t *testing.T) {
+	cachesPayload := `{"total_count":3,"actions_caches":[
+		{"id":1,"key":"deps-linux","size_in_bytes":1024},
+		{"id":2,"key":"deps-macos","size_in_bytes":2048},
+		{"id":3,"key":"build-output","size_in_bytes":1024}
+	]}`
+
+	tests := []struct {
+		name       string
+		tty        bool
+		opts       *UsageOptions
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+		wantErrMsg string
+	}{
+		{
+			name: "tty summary",
+			tty:  true,
+			opts: &UsageOptions{Delimiter: "-"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(cachesPayload),
+				)
+			},
+			wantStdout: "\n4.00 KiB used by 3 caches in OWNER/REPO\n\nPREFIX  CACHES  SIZE      SHARE\ndeps    2       3.00 KiB  75.0%\nbuild   1       1.00 KiB  25.0%\n",
+		},
+		{
+			name: "non-tty",
+			tty:  false,
+			opts: &UsageOptions{Delimiter: "-"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(cachesPayload),
+				)
+			},
+			wantStdout: "deps\t2\t3.00 KiB\t75.0%\nbuild\t1\t1.00 KiB\t25.0%\n",
+		},
+		{
+			name: "json export",
+			tty:  false,
+			opts: &UsageOptions{Delimiter: "-"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(cachesPayload),
+				)
+			},
+			wantStdout: "[{\"count\":2,\"prefix\":\"deps\",\"sizeInBytes\":3072},{\"count\":1,\"prefix\":\"build\",\"sizeInBytes\":1024}]\n",
+		},
+		{
+			name: "no caches",
+			tty:  true,
+			opts: &UsageOptions{Delimiter: "-"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/caches"),
+					httpmock.StringResponse(`{"total_count":0,"actions_caches":[]}`),
+				)
+			},
+			wantErrMsg: "No caches found in OWNER/REPO",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			if tt.name == "json export" {
+				exporter := cmdutil.NewJSONExporter()
+				exporter.SetFields(usageFields)
+				tt.opts.Exporter = exporter
+			}
+
+			err := usageRun(tt.opts)
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
+func Test_aggregateUsage(t *testing.T) {
+	caches := []shared.Cache{
+		{Key: "deps-linux", SizeInBytes: 10},
+		{Key: "deps-macos", SizeInBytes: 20},
+		{Key: "nodashkey", SizeInBytes: 30},
+		{Key: "build-output", SizeInBytes: 30},
+	}
+
+	got := aggregateUsage(caches, "-")
+	want := []prefixUsage{
+		{Prefix: "build", Count: 1, SizeInBytes: 30},
+		{Prefix: "deps", Count: 2, SizeInBytes: 30},
+		{Prefix: "nodashkey", Count: 1, SizeInBytes: 30},
+	}
+	assert.Equal(t, want, got)
+}
@@ -6,13 +6,17 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"log"
+	"net"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/codespaces"
 	"github.com/cli/cli/v2/internal/codespaces/api"
+	"github.com/cli/cli/v2/internal/codespaces/connection"
 	"github.com/cli/cli/v2/internal/codespaces/portforwarder"
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -179,12 +183,21 @@ type devContainerResult struct {
 
 type devContainer struct {
 	PortAttributes map[string]portAttribute `json:"portsAttributes"`
+	Customizations customizations           `json:"customizations"`
 }
 
 type portAttribute struct {
 	Label string `json:"label"`
 }
 
+type customizations struct {
+	GH ghCustomizations `json:"gh"`
+}
+
+type ghCustomizations struct {
+	PortForwardProfiles map[string][]string `json:"portForwardProfiles"`
+}
+
 func getDevContainer(ctx context.Context, apiClient apiClient, codespace *api.Codespace) <-chan devContainerResult {
 	ch := make(chan devContainerResult, 1)
 	go func() {
@@ -296,28 +309,83 @@ func (a *App) parsePortVisibilities(args []string) ([]portVisibility, error) {
 	return ports, nil
 }
 
+// forwardOptions control how ports are forwarded to localhost.
+type forwardOptions struct {
+	ports      []string
+	profile    string
+	jsonStatus bool
+}
+
 // NewPortsForwardCmd returns a Cobra "ports forward" subcommand, which forwards a set of
 // port pairs from the codespace to localhost.
 func newPortsForwardCmd(app *App, selector *CodespaceSelector) *cobra.Command {
-	return &cobra.Command{
-		Use:   "forward <remote-port>:<local-port>...",
+	var opts forwardOptions
+
+	cmd := &cobra.Command{
+		Use:   "forward {<remote-port>:<local-port>... | --profile <name>}",
 		Short: "Forward ports",
-		Args:  cobra.MinimumNArgs(1),
+		Long: heredoc.Docf(`
+			Forward ports from the codespace to localhost.
+
+			Instead of listing port pairs, the %[1]s--profile%[1]s flag forwards a named set of
+			ports defined in the devcontainer, for example:
+
+				"customizations": {
+					"gh": {
+						"portForwardProfiles": {
+							"web": ["3000:3000", "8080:8080"]
+						}
+					}
+				}
+
+			Dropped connections are automatically re-established with exponential backoff.
+			The %[1]s--json%[1]s flag emits each status change as a line of JSON for consumption
+			by wrapper tooling.
+		`, "`"),
+		Example: heredoc.Doc(`
+			$ gh codespace ports forward 3000:3000 8080:8080
+			$ gh codespace ports forward --profile web --json
+		`),
+		Args: cobra.ArbitraryArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return app.ForwardPorts(cmd.Context(), selector, args)
+			opts.ports = args
+			if len(args) == 0 && opts.profile == "" {
+				return cmdutil.FlagErrorf("specify one or more port pairs or `--profile`")
+			}
+			if len(args) > 0 && opts.profile != "" {
+				return cmdutil.FlagErrorf("cannot use port pairs in conjunction with `--profile`")
+			}
+			return app.ForwardPorts(cmd.Context(), selector, opts)
 		},
 	}
+
+	cmd.Flags().StringVar(&opts.profile, "profile", "", "Forward the ports of the named `profile` defined in the devcontainer")
+	cmd.Flags().BoolVar(&opts.jsonStatus, "json", false, "Emit forwarding status changes as newline-delimited JSON")
+
+	return cmd
 }
 
-func (a *App) ForwardPorts(ctx context.Context, selector *CodespaceSelector, ports []string) (err error) {
-	portPairs, err := getPortPairs(ports)
+func (a *App) ForwardPorts(ctx context.Context, selector *CodespaceSelector, opts forwardOptions) (err error) {
+	codespace, err := selector.Select(ctx)
 	if err != nil {
-		return fmt.Errorf("get port pairs: %w", err)
+		return err
 	}
 
-	codespace, err := selector.Select(ctx)
+	ports := opts.ports
+	if opts.profile != "" {
+		devContainerResult := <-getDevContainer(ctx, a.apiClient, codespace)
+		if devContainerResult.err != nil {
+			return fmt.Errorf("error resolving profile %q: %w", opts.profile, devContainerResult.err)
+		}
+		ports, err = profilePorts(devContainerResult.devContainer, opts.profile)
+		if err != nil {
+			return err
+		}
+	}
+
+	portPairs, err := getPortPairs(ports)
 	if err != nil {
-		return err
+		return fmt.Errorf("get port pairs: %w", err)
 	}
 
 	codespaceConnection, err := codespaces.GetCodespaceConnection(ctx, a, a.apiClient, codespace)
@@ -325,8 +393,11 @@ func (a *App) ForwardPorts(ctx context.Context, selector *CodespaceSelector, por
 		return fmt.Errorf("error connecting to codespace: %w", err)
 	}
 
+	status := a.newForwardStatusWriter(opts.jsonStatus)
+
 	// Run forwarding of all ports concurrently, aborting all of
 	// them at the first failure, including cancellation of the context.
+	// Dropped connections are re-established with exponential backoff.
 	group, ctx := errgroup.WithContext(ctx)
 	for _, pair := range portPairs {
 		pair := pair
@@ -337,22 +408,130 @@ func (a *App) ForwardPorts(ctx context.Context, selector *CodespaceSelector, por
 			}
 			defer listen.Close()
 
-			a.errLogger.Printf("Forwarding ports: remote %d <=> local %d", pair.remote, pair.local)
-			fwd, err := portforwarder.NewPortForwarder(ctx, codespaceConnection)
-			if err != nil {
-				return fmt.Errorf("failed to create port forwarder: %w", err)
+			backoff := forwardInitialBackoff
+			for attempt := 1; ; attempt++ {
+				status.write(portForwardStatus{
+					Type:       "forwarding",
+					RemotePort: pair.remote,
+					LocalPort:  pair.local,
+					Attempt:    attempt,
+				})
+
+				started := time.Now()
+				err := forwardPair(ctx, codespaceConnection, pair, listen)
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+
+				// a connection that lasted a while was healthy; do not keep
+				// the backoff from its previous drops
+				if time.Since(started) > forwardMaxBackoff {
+					backoff = forwardInitialBackoff
+				}
+
+				forwardStatus := portForwardStatus{
+					Type:           "disconnected",
+					RemotePort:     pair.remote,
+					LocalPort:      pair.local,
+					Attempt:        attempt,
+					RetryInSeconds: backoff.Seconds(),
+				}
+				if err != nil {
+					forwardStatus.Error = err.Error()
+				}
+				status.write(forwardStatus)
+
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(backoff):
+				}
+
+				if backoff *= 2; backoff > forwardMaxBackoff {
+					backoff = forwardMaxBackoff
+				}
 			}
-			defer safeClose(fwd, &err)
-
-			opts := portforwarder.ForwardPortOpts{
-				Port: pair.remote,
-			}
-			return fwd.ForwardPortToListener(ctx, opts, listen)
 		})
 	}
 	return group.Wait() // first error
 }
 
+const (
+	forwardInitialBackoff = time.Second
+	forwardMaxBackoff     = 30 * time.Second
+)
+
+func forwardPair(ctx context.Context, conn *connection.CodespaceConnection, pair portPair, listen *net.TCPListener) (err error) {
+	fwd, err := portforwarder.NewPortForwarder(ctx, conn)
+	if err != nil {
+		return fmt.Errorf("failed to create port forwarder: %w", err)
+	}
+	defer safeClose(fwd, &err)
+
+	opts := portforwarder.ForwardPortOpts{
+		Port: pair.remote,
+	}
+	return fwd.ForwardPortToListener(ctx, opts, listen)
+}
+
+// profilePorts resolves a named port-forward profile from the devcontainer's
+// gh customizations to a list of "remote:local" port pairs.
+func profilePorts(dc *devContainer, name string) ([]string, error) {
+	if dc != nil {
+		if ports, ok := dc.Customizations.GH.PortForwardProfiles[name]; ok {
+			return ports, nil
+		}
+	}
+	return nil, fmt.Errorf("no port-forward profile named %q is defined in the devcontainer", name)
+}
+
+// portForwardStatus describes a change in the state of a forwarded port.
+type portForwardStatus struct {
+	Type           string  `json:"type"`
+	RemotePort     int     `json:"remotePort"`
+	LocalPort      int     `json:"localPort"`
+	Attempt        int     `json:"attempt,omitempty"`
+	Error          string  `json:"error,omitempty"`
+	RetryInSeconds float64 `json:"retryInSeconds,omitempty"`
+}
+
+// forwardStatusWriter reports status changes of forwarded ports, either as
+// human-readable log lines or as newline-delimited JSON on stdout.
+type forwardStatusWriter struct {
+	mu     sync.Mutex
+	enc    *json.Encoder
+	logger *log.Logger
+}
+
+func (a *App) newForwardStatusWriter(jsonStatus bool) *forwardStatusWriter {
+	w := &forwardStatusWriter{logger: a.errLogger}
+	if jsonStatus {
+		w.enc = json.NewEncoder(a.io.Out)
+	}
+	return w
+}
+
+func (w *forwardStatusWriter) write(status portForwardStatus) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if w.enc != nil {
+		_ = w.enc.Encode(status)
+		return
+	}
+
+	switch status.Type {
+	case "forwarding":
+		w.logger.Printf("Forwarding ports: remote %d <=> local %d", status.RemotePort, status.LocalPort)
+	case "disconnected":
+		msg := fmt.Sprintf("Connection for remote port %d dropped", status.RemotePort)
+		if status.Error != "" {
+			msg += fmt.Sprintf(": %s", status.Error)
+		}
+		w.logger.Printf("%s (reconnecting in %gs)", msg, status.RetryInSeconds)
+	}
+}
+
 type portPair struct {
 	remote, local int
 }
@@ -118,7 +118,7 @@ func TestPendingOperationDisallowsForwardPorts(t *testing.T) {
 	app := testingPortsApp()
 	selector := &CodespaceSelector{api: app.apiClient, codespaceName: "disabledCodespace"}
 
-	if err := app.ForwardPorts(context.Background(), selector, nil); err != nil {
+	if err := app.ForwardPorts(context.Background(), selector, forwardOptions{}); err != nil {
 		if err.Error() != "codespace is disabled while it has a pending operation: Some pending operation" {
 			t.Errorf("expected pending operation error, but got: %v", err)
 		}
@@ -127,6 +127,35 @@ func TestPendingOperationDisallowsForwardPorts(t *testing.T) {
 	}
 }
 
+func TestProfilePorts(t *testing.T) {
+	dc := &devContainer{
+		Customizations: customizations{
+			GH: ghCustomizations{
+				PortForwardProfiles: map[string][]string{
+					"web": {"3000:3000", "8080:8080"},
+				},
+			},
+		},
+	}
+
+	ports, err := profilePorts(dc, "web")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if len(ports) != 2 || ports[0] != "3000:3000" || ports[1] != "8080:8080" {
+		t.Errorf("unexpected ports: %v", ports)
+	}
+
+	wantErr := `no port-forward profile named "api" is defined in the devcontainer`
+	if _, err := profilePorts(dc, "api"); err == nil || err.Error() != wantErr {
+		t.Errorf("expected %q, but got: %v", wantErr, err)
+	}
+
+	if _, err := profilePorts(nil, "web"); err == nil {
+		t.Error("expected error for missing devcontainer, but got nothing")
+	}
+}
+
 func GetMockApi(allowOrgPorts bool) *apiClientMock {
 	return &apiClientMock{
 		GetCodespaceFunc: func(ctx context.Context, codespaceName string, includeConnection bool) (*api.Codespace, error) {
@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/config"
 	cmdClearCache "github.com/cli/cli/v2/pkg/cmd/config/clear-cache"
+	cmdDoctor "github.com/cli/cli/v2/pkg/cmd/config/doctor"
 	cmdGet "github.com/cli/cli/v2/pkg/cmd/config/get"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/config/list"
 	cmdSet "github.com/cli/cli/v2/pkg/cmd/config/set"
@@ -16,6 +18,14 @@ import (
 func NewCmdConfig(f *cmdutil.Factory) *cobra.Command {
 	longDoc := strings.Builder{}
 	longDoc.WriteString("Display or change configuration settings for gh.\n\n")
+	longDoc.WriteString(heredoc.Docf(`
+		A %[1]s.gh.yml%[1]s file at the root of a repository overrides these settings for
+		commands run inside it, so teams can check shared defaults into the repository.
+		Only a small set of safe keys such as %[1]sgit_protocol%[1]s and %[1]sprompt%[1]s can be
+		overridden this way; keys that name a program to run or affect credentials
+		always come from the user configuration.
+
+	`, "`"))
 	longDoc.WriteString("Current respected settings:\n\n")
 	for _, co := range config.Options {
 		longDoc.WriteString(fmt.Sprintf("- `%s`: %s", co.Key, co.Description))
@@ -40,6 +50,7 @@ func NewCmdConfig(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdSet.NewCmdConfigSet(f, nil))
 	cmd.AddCommand(cmdList.NewCmdConfigList(f, nil))
 	cmd.AddCommand(cmdClearCache.NewCmdConfigClearCache(f, nil))
+	cmd.AddCommand(cmdDoctor.NewCmdConfigDoctor(f, nil))
 
 	return cmd
 }
@@ -0,0 +1,76 @@
+package doctor
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type DoctorOptions struct {
+	IO *iostreams.IOStreams
+
+	// Diagnose allows tests to supply diagnostics without reading from disk.
+	Diagnose func() ([]config.Diagnostic, error)
+}
+
+func NewCmdConfigDoctor(f *cmdutil.Factory, runF func(*DoctorOptions) error) *cobra.Command {
+	opts := &DoctorOptions{
+		IO:       f.IOStreams,
+		Diagnose: config.DiagnoseConfigFile,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check configuration files for problems",
+		Long: heredoc.Doc(`
+			Check the gh configuration files for unknown keys, deprecated settings,
+			invalid values, and per-host settings that are inconsistent with the
+			global configuration, with a suggested fix for each finding.
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return doctorRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func doctorRun(opts *DoctorOptions) error {
+	diagnostics, err := opts.Diagnose()
+	if err != nil {
+		return fmt.Errorf("failed to read configuration: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	if len(diagnostics) == 0 {
+		fmt.Fprintf(opts.IO.Out, "%s no problems found in gh configuration\n", cs.SuccessIcon())
+		return nil
+	}
+
+	hasErrors := false
+	for _, d := range diagnostics {
+		icon := cs.WarningIcon()
+		if d.Severity == config.DiagnosticError {
+			icon = cs.FailureIcon()
+			hasErrors = true
+		}
+		fmt.Fprintf(opts.IO.Out, "%s %s\n", icon, d.Message)
+		if d.Suggestion != "" {
+			fmt.Fprintf(opts.IO.Out, "  %s\n", cs.Muted(d.Suggestion))
+		}
+	}
+
+	if hasErrors {
+		return cmdutil.SilentError
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package doctor
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_doctorRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		diagnostics []config.Diagnostic
+		wantOut     string
+		wantErr     error
+	}{
+		{
+			name:    "no problems",
+			wantOut: "✓ no problems found in gh configuration\n",
+		},
+		{
+			name: "warning only",
+			diagnostics: []config.Diagnostic{
+				{
+					Severity:   config.DiagnosticWarning,
+					Message:    `"git_protocl" is not a known configuration key`,
+					Suggestion: "remove it from the configuration file; gh will never read it",
+				},
+			},
+			wantOut: "! \"git_protocl\" is not a known configuration key\n  remove it from the configuration file; gh will never read it\n",
+		},
+		{
+			name: "error fails the command",
+			diagnostics: []config.Diagnostic{
+				{
+					Severity: config.DiagnosticError,
+					Message:  `"git_protocol" has invalid value "rsync"`,
+				},
+			},
+			wantOut: "X \"git_protocol\" has invalid value \"rsync\"\n",
+			wantErr: cmdutil.SilentError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			opts := &DoctorOptions{
+				IO: ios,
+				Diagnose: func() ([]config.Diagnostic, error) {
+					return tt.diagnostics, nil
+				},
+			}
+
+			err := doctorRun(opts)
+			if tt.wantErr != nil {
+				require.Equal(t, tt.wantErr, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
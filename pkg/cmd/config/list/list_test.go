@@ -103,7 +103,11 @@ func Test_listRun(t *testing.T) {
 				color_labels=disabled
 				accessible_colors=disabled
 				accessible_prompter=disabled
+				credential_provider=
+				keyring_backend=system
+				remote_priority=
 				spinner=enabled
+				timezone=
 			`),
 		},
 	}
@@ -169,7 +169,7 @@ func NewCmdExtension(f *cmdutil.Factory) *cobra.Command {
 					if webMode {
 						url := searcher.URL(query)
 						if io.IsStdoutTTY() {
-							fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(url))
+							io.Infof("Opening %s in your browser.\n", text.DisplayURL(url))
 						}
 						return browser.Browse(url)
 					}
@@ -0,0 +1,88 @@
+package extension
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"gopkg.in/yaml.v3"
+)
+
+// extManifestName is the name of an optional file at the root of an extension
+// repository in which the author declares installation constraints, such as
+// the minimum gh version the extension works with and the checksums of the
+// precompiled binaries attached to its releases.
+const extManifestName = "gh-extension.yml"
+
+type extManifest struct {
+	// MinGHVersion is the lowest gh version the extension supports, e.g. "2.40.0".
+	MinGHVersion string `yaml:"min_gh_version"`
+	// Binaries maps a platform such as "linux-amd64" to the release asset built for it.
+	Binaries map[string]extManifestBinary `yaml:"binaries"`
+}
+
+type extManifestBinary struct {
+	// Asset is the name of the release asset for this platform.
+	Asset string `yaml:"asset"`
+	// Checksum is the expected SHA-256 digest of the asset, in hex.
+	Checksum string `yaml:"checksum"`
+}
+
+func parseExtManifest(data []byte) (*extManifest, error) {
+	var manifest extManifest
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("could not parse %s: %w", extManifestName, err)
+	}
+	return &manifest, nil
+}
+
+// checkGHVersion reports an error when the manifest declares a minimum gh
+// version that ghVersion does not satisfy. Development builds, and manifests
+// without a constraint, always pass.
+func (m *extManifest) checkGHVersion(ghVersion string) error {
+	if m.MinGHVersion == "" || ghVersion == "" || ghVersion == "DEV" {
+		return nil
+	}
+	minimum, err := version.NewVersion(m.MinGHVersion)
+	if err != nil {
+		return fmt.Errorf("invalid min_gh_version in %s: %w", extManifestName, err)
+	}
+	current, err := version.NewVersion(strings.TrimPrefix(ghVersion, "v"))
+	if err != nil {
+		// an unparsable build version is treated like a development build
+		return nil
+	}
+	if current.LessThan(minimum) {
+		return fmt.Errorf("this extension requires gh %s or newer, but you have %s; upgrade gh and try again", m.MinGHVersion, ghVersion)
+	}
+	return nil
+}
+
+// binaryFor returns the manifest entry for the given platform, if any.
+func (m *extManifest) binaryFor(platform string) (extManifestBinary, bool) {
+	bin, ok := m.Binaries[platform]
+	return bin, ok
+}
+
+// verifyChecksum compares the SHA-256 digest of the file at path against the
+// hex digest expected by the manifest.
+func verifyChecksum(path, expected string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	actual := hex.EncodeToString(h.Sum(nil))
+	if !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("checksum mismatch: expected %s, got %s", expected, actual)
+	}
+	return nil
+}
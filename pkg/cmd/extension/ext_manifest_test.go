@@ -0,0 +1,91 @@
+package extension
+
+import (
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseExtManifest(t *testing.T) {
+	manifest, err := parseExtManifest([]byte(heredoc.Doc(`
+		min_gh_version: 2.40.0
+		binaries:
+		    linux-amd64:
+		        asset: gh-cool-ext-linux-amd64
+		        checksum: abc123
+	`)))
+	require.NoError(t, err)
+
+	assert.Equal(t, "2.40.0", manifest.MinGHVersion)
+	bin, ok := manifest.binaryFor("linux-amd64")
+	require.True(t, ok)
+	assert.Equal(t, "gh-cool-ext-linux-amd64", bin.Asset)
+	assert.Equal(t, "abc123", bin.Checksum)
+
+	_, ok = manifest.binaryFor("windows-amd64")
+	assert.False(t, ok)
+
+	_, err = parseExtManifest([]byte("binaries: [unclosed"))
+	assert.ErrorContains(t, err, "could not parse gh-extension.yml")
+}
+
+func TestExtManifestCheckGHVersion(t *testing.T) {
+	tests := []struct {
+		name         string
+		minGHVersion string
+		ghVersion    string
+		wantErr      string
+	}{
+		{
+			name:         "no constraint",
+			minGHVersion: "",
+			ghVersion:    "2.40.0",
+		},
+		{
+			name:         "development build",
+			minGHVersion: "2.40.0",
+			ghVersion:    "DEV",
+		},
+		{
+			name:         "satisfied",
+			minGHVersion: "2.40.0",
+			ghVersion:    "v2.41.0",
+		},
+		{
+			name:         "exact match",
+			minGHVersion: "2.40.0",
+			ghVersion:    "2.40.0",
+		},
+		{
+			name:         "too old",
+			minGHVersion: "2.40.0",
+			ghVersion:    "2.39.0",
+			wantErr:      "this extension requires gh 2.40.0 or newer, but you have 2.39.0; upgrade gh and try again",
+		},
+		{
+			name:         "invalid constraint",
+			minGHVersion: "not-a-version",
+			ghVersion:    "2.40.0",
+			wantErr:      "invalid min_gh_version in gh-extension.yml",
+		},
+		{
+			name:         "unparsable build version",
+			minGHVersion: "2.40.0",
+			ghVersion:    "some local build",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := &extManifest{MinGHVersion: tt.minGHVersion}
+			err := m.checkGHVersion(tt.ghVersion)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 
 	"github.com/cli/cli/v2/api"
@@ -63,6 +64,44 @@ func hasScript(httpClient *http.Client, repo ghrepo.Interface) (bool, error) {
 	return true, nil
 }
 
+// fetchExtensionManifest downloads the optional gh-extension.yml file from the
+// root of an extension repository at the given ref. A missing file is not an
+// error; extensions without a manifest have no installation constraints.
+func fetchExtensionManifest(httpClient *http.Client, repo ghrepo.Interface, ref string) (*extManifest, error) {
+	path := fmt.Sprintf("repos/%s/%s/contents/%s",
+		repo.RepoOwner(), repo.RepoName(), extManifestName)
+	if ref != "" {
+		path += "?ref=" + url.QueryEscape(ref)
+	}
+	req, err := http.NewRequest("GET", ghinstance.RESTPrefix(repo.RepoHost())+path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/vnd.github.raw")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == 404 {
+		return nil, nil
+	}
+
+	if resp.StatusCode > 299 {
+		return nil, api.HandleHTTPError(resp)
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseExtManifest(b)
+}
+
 type releaseAsset struct {
 	Name   string
 	APIURL string `json:"url"`
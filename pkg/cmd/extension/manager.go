@@ -20,6 +20,7 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/extensions"
 	"github.com/cli/cli/v2/pkg/findsh"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -42,17 +43,19 @@ func (e *ErrExtensionExecutableNotFound) Error() string {
 const darwinAmd64 = "darwin-amd64"
 
 type Manager struct {
-	dataDir    func() string
-	updateDir  func() string
-	lookPath   func(string) (string, error)
-	findSh     func() (string, error)
-	newCommand func(string, ...string) *exec.Cmd
-	platform   func() (string, string)
-	client     *http.Client
-	gitClient  gitClient
-	config     gh.Config
-	io         *iostreams.IOStreams
-	dryRunMode bool
+	dataDir        func() string
+	updateDir      func() string
+	lookPath       func(string) (string, error)
+	findSh         func() (string, error)
+	newCommand     func(string, ...string) *exec.Cmd
+	platform       func() (string, string)
+	client         *http.Client
+	gitClient      gitClient
+	config         gh.Config
+	io             *iostreams.IOStreams
+	version        string
+	contextBuilder func() *cmdutil.ExtensionContext
+	dryRunMode     bool
 }
 
 func NewManager(ios *iostreams.IOStreams, gc *git.Client) *Manager {
@@ -84,6 +87,18 @@ func (m *Manager) SetClient(client *http.Client) {
 	m.client = client
 }
 
+// SetVersion records the version of gh itself so that extension manifests can
+// declare a minimum gh version they require.
+func (m *Manager) SetVersion(version string) {
+	m.version = version
+}
+
+// SetContextBuilder registers a function that assembles the invocation context
+// handed to extensions via the GH_EXTENSION_CONTEXT file.
+func (m *Manager) SetContextBuilder(builder func() *cmdutil.ExtensionContext) {
+	m.contextBuilder = builder
+}
+
 func (m *Manager) EnableDryRunMode() {
 	m.dryRunMode = true
 }
@@ -130,6 +145,21 @@ func (m *Manager) Dispatch(args []string, stdin io.Reader, stdout, stderr io.Wri
 	externalCmd.Stdin = stdin
 	externalCmd.Stdout = stdout
 	externalCmd.Stderr = stderr
+
+	// Hand the extension a structured invocation context so that it does not
+	// have to shell back out to gh to rediscover it. This is best effort: the
+	// extension runs without a context file if one could not be written.
+	if m.contextBuilder != nil {
+		if ctxPath, cleanup, err := cmdutil.WriteExtensionContext(m.contextBuilder()); err == nil {
+			defer cleanup()
+			env := externalCmd.Env
+			if env == nil {
+				env = os.Environ()
+			}
+			externalCmd.Env = append(env, cmdutil.ExtensionContextEnv+"="+ctxPath)
+		}
+	}
+
 	return true, externalCmd.Run()
 }
 
@@ -271,6 +301,16 @@ func (m *Manager) Install(repo ghrepo.Interface, target string) error {
 		return fmt.Errorf("extension is not installable: no usable release artifact or script found in %s", repo)
 	}
 
+	manifest, err := fetchExtensionManifest(m.client, repo, target)
+	if err != nil {
+		return err
+	}
+	if manifest != nil {
+		if err := manifest.checkGHVersion(m.version); err != nil {
+			return err
+		}
+	}
+
 	return m.installGit(repo, target)
 }
 
@@ -287,16 +327,49 @@ func (m *Manager) installBin(repo ghrepo.Interface, target string) error {
 		return err
 	}
 
+	manifest, err := fetchExtensionManifest(m.client, repo, r.Tag)
+	if err != nil {
+		return err
+	}
+	if manifest != nil {
+		if err := manifest.checkGHVersion(m.version); err != nil {
+			return err
+		}
+	}
+
 	platform, ext := m.platform()
 	isMacARM := platform == "darwin-arm64"
 	trueARMBinary := false
 
 	var asset *releaseAsset
-	for _, a := range r.Assets {
-		if strings.HasSuffix(a.Name, platform+ext) {
-			asset = &a
-			trueARMBinary = isMacARM
-			break
+	var wantChecksum string
+
+	// an extension manifest can name the exact asset built for this platform,
+	// along with a checksum to verify the download against
+	if manifest != nil {
+		if bin, ok := manifest.binaryFor(platform); ok {
+			if bin.Asset == "" {
+				wantChecksum = bin.Checksum
+			} else {
+				for _, a := range r.Assets {
+					if a.Name == bin.Asset {
+						asset = &a
+						trueARMBinary = isMacARM
+						wantChecksum = bin.Checksum
+						break
+					}
+				}
+			}
+		}
+	}
+
+	if asset == nil {
+		for _, a := range r.Assets {
+			if strings.HasSuffix(a.Name, platform+ext) {
+				asset = &a
+				trueARMBinary = isMacARM
+				break
+			}
 		}
 	}
 
@@ -350,13 +423,19 @@ func (m *Manager) installBin(repo ghrepo.Interface, target string) error {
 	if err != nil {
 		return fmt.Errorf("failed to download asset %s: %w", asset.Name, err)
 	}
+	if wantChecksum != "" {
+		if err := verifyChecksum(binPath, wantChecksum); err != nil {
+			_ = os.Remove(binPath)
+			return fmt.Errorf("failed to verify downloaded asset %s: %w", asset.Name, err)
+		}
+	}
 	if trueARMBinary {
 		if err := codesignBinary(binPath); err != nil {
 			return fmt.Errorf("failed to codesign downloaded binary: %w", err)
 		}
 	}
 
-	manifest := binManifest{
+	bm := binManifest{
 		Name:     name,
 		Owner:    repo.RepoOwner(),
 		Host:     repo.RepoHost(),
@@ -365,7 +444,7 @@ func (m *Manager) installBin(repo ghrepo.Interface, target string) error {
 		IsPinned: isPinned,
 	}
 
-	bs, err := yaml.Marshal(manifest)
+	bs, err := yaml.Marshal(bm)
 	if err != nil {
 		return fmt.Errorf("failed to serialize manifest: %w", err)
 	}
@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
@@ -16,6 +17,7 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/extensions"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -191,6 +193,46 @@ func TestManager_Dispatch_binary(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func TestManager_Dispatch_context(t *testing.T) {
+	dataDir := t.TempDir()
+	updateDir := t.TempDir()
+	extPath := filepath.Join(dataDir, "extensions", "gh-hello")
+	bm := binManifest{
+		Owner: "owner",
+		Name:  "gh-hello",
+		Host:  "github.com",
+		Tag:   "v1.0.0",
+	}
+	assert.NoError(t, stubBinaryExtension(extPath, bm))
+
+	m := newTestManager(dataDir, updateDir, nil, nil, nil)
+
+	var capturedCmd *exec.Cmd
+	originalNewCommand := m.newCommand
+	m.newCommand = func(exe string, args ...string) *exec.Cmd {
+		capturedCmd = originalNewCommand(exe, args...)
+		return capturedCmd
+	}
+	m.SetContextBuilder(func() *cmdutil.ExtensionContext {
+		return &cmdutil.ExtensionContext{Version: cmdutil.ExtensionContextVersion, Host: "github.com"}
+	})
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	found, err := m.Dispatch([]string{"hello"}, nil, stdout, stderr)
+	assert.NoError(t, err)
+	assert.True(t, found)
+
+	var ctxPath string
+	for _, kv := range capturedCmd.Env {
+		if v, ok := strings.CutPrefix(kv, cmdutil.ExtensionContextEnv+"="); ok {
+			ctxPath = v
+		}
+	}
+	require.NotEmpty(t, ctxPath, "expected %s in extension environment", cmdutil.ExtensionContextEnv)
+	assert.NoFileExists(t, ctxPath, "context file should be cleaned up after dispatch")
+}
+
 func TestManager_Remove(t *testing.T) {
 	dataDir := t.TempDir()
 	updateDir := t.TempDir()
@@ -484,6 +526,10 @@ func TestManager_MigrateToBinaryExtension(t *testing.T) {
 		httpmock.REST("GET", "release/cool"),
 		httpmock.StringResponse("FAKE UPGRADED BINARY"))
 
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/gh-remote/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	err = m.upgradeExtension(ext, false)
 	assert.NoError(t, err)
 
@@ -545,6 +591,10 @@ func TestManager_UpgradeExtension_BinaryExtension(t *testing.T) {
 		httpmock.REST("GET", "release/cool2"),
 		httpmock.StringResponse("FAKE UPGRADED BINARY"))
 
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	exts, err := m.list(false)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(exts))
@@ -611,6 +661,10 @@ func TestManager_UpgradeExtension_BinaryExtension_Pinned_Force(t *testing.T) {
 		httpmock.REST("GET", "release/cool2"),
 		httpmock.StringResponse("FAKE UPGRADED BINARY"))
 
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	exts, err := m.list(false)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(exts))
@@ -671,6 +725,10 @@ func TestManager_UpgradeExtension_BinaryExtension_DryRun(t *testing.T) {
 					},
 				},
 			}))
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	exts, err := m.list(false)
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(exts))
@@ -856,6 +914,10 @@ func TestManager_Install_git(t *testing.T) {
 		httpmock.REST("GET", "repos/owner/gh-some-ext/contents/gh-some-ext"),
 		httpmock.StringResponse("script"))
 
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/gh-some-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	repo := ghrepo.New("owner", fakeExtensionName)
 
 	err := m.Install(repo, "")
@@ -903,6 +965,10 @@ func TestManager_Install_git_pinned(t *testing.T) {
 		httpmock.REST("GET", "repos/owner/gh-cool-ext/contents/gh-cool-ext"),
 		httpmock.StringResponse("script"))
 
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/gh-cool-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	_ = os.MkdirAll(filepath.Join(m.installDir(), "gh-cool-ext"), 0700)
 	repo := ghrepo.New("owner", "gh-cool-ext")
 	err := m.Install(repo, "some-ref")
@@ -946,6 +1012,10 @@ func TestManager_Install_binary_pinned(t *testing.T) {
 		httpmock.REST("GET", "release/cool"),
 		httpmock.StringResponse("FAKE BINARY"))
 
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	ios, _, stdout, stderr := iostreams.Test()
 	dataDir := t.TempDir()
 	updateDir := t.TempDir()
@@ -1011,6 +1081,10 @@ func TestManager_Install_binary_unsupported(t *testing.T) {
 				},
 			}))
 
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	ios, _, stdout, stderr := iostreams.Test()
 	dataDir := t.TempDir()
 	updateDir := t.TempDir()
@@ -1055,6 +1129,10 @@ func TestManager_Install_rosetta_fallback_not_found(t *testing.T) {
 				},
 			}))
 
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	ios, _, stdout, stderr := iostreams.Test()
 	dataDir := t.TempDir()
 	updateDir := t.TempDir()
@@ -1111,6 +1189,10 @@ func TestManager_Install_binary(t *testing.T) {
 		httpmock.REST("GET", "release/cool"),
 		httpmock.StringResponse("FAKE BINARY"))
 
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	ios, _, stdout, stderr := iostreams.Test()
 	dataDir := t.TempDir()
 	updateDir := t.TempDir()
@@ -1182,6 +1264,10 @@ func TestManager_Install_amd64_when_supported(t *testing.T) {
 		httpmock.REST("GET", "release/cool"),
 		httpmock.StringResponse("FAKE BINARY"))
 
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+		httpmock.StatusStringResponse(404, ""))
+
 	ios, _, stdout, stderr := iostreams.Test()
 	dataDir := t.TempDir()
 	updateDir := t.TempDir()
@@ -1223,6 +1309,136 @@ func TestManager_Install_amd64_when_supported(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func TestManager_Install_binary_with_manifest_checksum(t *testing.T) {
+	repo := ghrepo.NewWithHost("owner", "gh-bin-ext", "example.com")
+
+	tests := []struct {
+		name     string
+		checksum string
+		wantErr  string
+	}{
+		{
+			name:     "matching checksum",
+			checksum: "fc27d499376f01c156ba11851d8120db117f5ed5824a1827d07cdef05a593548",
+		},
+		{
+			name:     "mismatched checksum",
+			checksum: "deadbeef",
+			wantErr:  "failed to verify downloaded asset gh-bin-ext-windows-amd64.exe: checksum mismatch: expected deadbeef, got fc27d499376f01c156ba11851d8120db117f5ed5824a1827d07cdef05a593548",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := httpmock.Registry{}
+			defer reg.Verify(t)
+
+			reg.Register(
+				httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+				httpmock.JSONResponse(
+					release{
+						Assets: []releaseAsset{
+							{
+								Name:   "gh-bin-ext-windows-amd64.exe",
+								APIURL: "https://example.com/release/cool",
+							},
+						},
+					}))
+			reg.Register(
+				httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+				httpmock.JSONResponse(
+					release{
+						Tag: "v1.0.1",
+						Assets: []releaseAsset{
+							{
+								Name:   "gh-bin-ext-windows-amd64.exe",
+								APIURL: "https://example.com/release/cool",
+							},
+						},
+					}))
+			reg.Register(
+				httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+				httpmock.StringResponse(heredoc.Docf(`
+					binaries:
+					    windows-amd64:
+					        asset: gh-bin-ext-windows-amd64.exe
+					        checksum: %s
+				`, tt.checksum)))
+			reg.Register(
+				httpmock.REST("GET", "release/cool"),
+				httpmock.StringResponse("FAKE BINARY"))
+
+			ios, _, stdout, stderr := iostreams.Test()
+			dataDir := t.TempDir()
+			updateDir := t.TempDir()
+
+			m := newTestManager(dataDir, updateDir, &http.Client{Transport: &reg}, nil, ios)
+
+			binPath := filepath.Join(dataDir, "extensions/gh-bin-ext/gh-bin-ext.exe")
+			err := m.Install(repo, "")
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				assert.NoFileExists(t, binPath)
+			} else {
+				assert.NoError(t, err)
+				fakeBin, err := os.ReadFile(binPath)
+				assert.NoError(t, err)
+				assert.Equal(t, "FAKE BINARY", string(fakeBin))
+			}
+
+			assert.Equal(t, "", stdout.String())
+			assert.Equal(t, "", stderr.String())
+		})
+	}
+}
+
+func TestManager_Install_binary_with_manifest_min_gh_version(t *testing.T) {
+	repo := ghrepo.NewWithHost("owner", "gh-bin-ext", "example.com")
+
+	reg := httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.JSONResponse(
+			release{
+				Assets: []releaseAsset{
+					{
+						Name:   "gh-bin-ext-windows-amd64.exe",
+						APIURL: "https://example.com/release/cool",
+					},
+				},
+			}))
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/releases/latest"),
+		httpmock.JSONResponse(
+			release{
+				Tag: "v1.0.1",
+				Assets: []releaseAsset{
+					{
+						Name:   "gh-bin-ext-windows-amd64.exe",
+						APIURL: "https://example.com/release/cool",
+					},
+				},
+			}))
+	reg.Register(
+		httpmock.REST("GET", "api/v3/repos/owner/gh-bin-ext/contents/gh-extension.yml"),
+		httpmock.StringResponse("min_gh_version: 99.0.0\n"))
+
+	ios, _, stdout, stderr := iostreams.Test()
+	dataDir := t.TempDir()
+	updateDir := t.TempDir()
+
+	m := newTestManager(dataDir, updateDir, &http.Client{Transport: &reg}, nil, ios)
+	m.SetVersion("2.40.0")
+
+	err := m.Install(repo, "")
+	assert.EqualError(t, err, "this extension requires gh 99.0.0 or newer, but you have 2.40.0; upgrade gh and try again")
+
+	assert.Equal(t, "", stdout.String())
+	assert.Equal(t, "", stderr.String())
+}
+
 func TestManager_repo_not_found(t *testing.T) {
 	repo := ghrepo.NewWithHost("owner", "gh-bin-ext", "example.com")
 
@@ -14,8 +14,10 @@ import (
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/config"
+	fd "github.com/cli/cli/v2/internal/featuredetection"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/metrics"
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmd/extension"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -30,18 +32,21 @@ func New(appVersion string) *cmdutil.Factory {
 	f := &cmdutil.Factory{
 		AppVersion:     appVersion,
 		Config:         configFunc(), // No factory dependencies
+		Context:        context.Background(),
 		ExecutableName: "gh",
 	}
 
 	f.IOStreams = ioStreams(f)                   // Depends on Config
 	f.HttpClient = httpClientFunc(f, appVersion) // Depends on Config, IOStreams, and appVersion
 	f.GitClient = newGitClient(f)                // Depends on IOStreams, and Executable
+	f.Config = localAccountConfigFunc(f)         // Depends on Config, and GitClient
 	f.Remotes = remotesFunc(f)                   // Depends on Config, and GitClient
 	f.BaseRepo = BaseRepoFunc(f)                 // Depends on Remotes
 	f.Prompter = newPrompter(f)                  // Depends on Config and IOStreams
 	f.Browser = newBrowser(f)                    // Depends on Config, and IOStreams
 	f.ExtensionManager = extensionManager(f)     // Depends on Config, HttpClient, and IOStreams
 	f.Branch = branchFunc(f)                     // Depends on GitClient
+	f.Detector = detectorFunc(f)                 // Depends on Config, and HttpClient
 
 	return f
 }
@@ -180,6 +185,9 @@ func remotesFunc(f *cmdutil.Factory) func() (ghContext.Remotes, error) {
 		readRemotes: func() (git.RemoteSet, error) {
 			return f.GitClient.Remotes(context.Background())
 		},
+		readRemotesForHost: func(host string) (git.RemoteSet, error) {
+			return f.GitClient.ConfiguredRemotesForHost(context.Background(), host)
+		},
 		getConfig: f.Config,
 	}
 	return rr.Resolver()
@@ -203,10 +211,62 @@ func httpClientFunc(f *cmdutil.Factory, appVersion string) func() (*http.Client,
 			return nil, err
 		}
 		client.Transport = api.ExtractHeader("X-GitHub-SSO", &ssoHeader)(client.Transport)
+		if metrics.Enabled() {
+			client.Transport = metrics.CountRequests(client.Transport)
+		}
+		for _, middleware := range f.HTTPMiddlewares {
+			client.Transport = middleware(client.Transport)
+		}
+		if cmdutil.IsOffline() {
+			client.Transport = offlineRoundTripper{}
+		}
+		client.Transport = contextRoundTripper{rt: client.Transport, f: f}
 		return client, nil
 	}
 }
 
+// contextRoundTripper attaches the factory context to requests that were
+// created without one of their own, so that in-flight API calls are aborted
+// when the invocation is canceled.
+type contextRoundTripper struct {
+	rt http.RoundTripper
+	f  *cmdutil.Factory
+}
+
+func (crt contextRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if crt.f.Context != nil && req.Context() == context.Background() {
+		req = req.WithContext(crt.f.Context)
+	}
+	return crt.rt.RoundTrip(req)
+}
+
+// offlineRoundTripper rejects every request immediately so that offline mode
+// fails fast instead of waiting for a network timeout.
+type offlineRoundTripper struct{}
+
+func (offlineRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	return nil, fmt.Errorf("%w: %s %s", cmdutil.ErrOffline, req.Method, req.URL.Hostname())
+}
+
+// detectorFunc returns feature detectors whose probe results are cached in the HTTP
+// layer for the process and persisted per host in the cache directory, so repeated
+// invocations do not re-query a host whose capabilities were recently detected.
+func detectorFunc(f *cmdutil.Factory) func(host string) (fd.Detector, error) {
+	return func(host string) (fd.Detector, error) {
+		httpClient, err := f.HttpClient()
+		if err != nil {
+			return nil, err
+		}
+		cfg, err := f.Config()
+		if err != nil {
+			return nil, err
+		}
+		cachedClient := api.NewCachedHTTPClient(httpClient, time.Hour*24)
+		detector := fd.NewDetector(cachedClient, host)
+		return fd.NewCachedDetector(detector, httpClient, host, cfg.CacheDir()), nil
+	}
+}
+
 func newGitClient(f *cmdutil.Factory) *git.Client {
 	io := f.IOStreams
 	ghPath := f.Executable()
@@ -254,6 +314,8 @@ func branchFunc(f *cmdutil.Factory) func() (string, error) {
 
 func extensionManager(f *cmdutil.Factory) *extension.Manager {
 	em := extension.NewManager(f.IOStreams, f.GitClient)
+	em.SetVersion(f.AppVersion)
+	em.SetContextBuilder(extensionContextBuilder(f))
 
 	cfg, err := f.Config()
 	if err != nil {
@@ -271,6 +333,35 @@ func extensionManager(f *cmdutil.Factory) *extension.Manager {
 	return em
 }
 
+// extensionContextBuilder assembles the invocation context that gets handed to
+// an extension process. Everything in it is best effort: fields whose value is
+// not already known to gh are left empty rather than looked up over the network.
+func extensionContextBuilder(f *cmdutil.Factory) func() *cmdutil.ExtensionContext {
+	return func() *cmdutil.ExtensionContext {
+		ec := &cmdutil.ExtensionContext{
+			Version:   cmdutil.ExtensionContextVersion,
+			ConfigDir: config.ConfigDir(),
+			TTY: cmdutil.ExtensionContextTTY{
+				Stdin:  f.IOStreams.IsStdinTTY(),
+				Stdout: f.IOStreams.IsStdoutTTY(),
+				Stderr: f.IOStreams.IsStderrTTY(),
+			},
+		}
+		if cfg, err := f.Config(); err == nil {
+			host, _ := cfg.Authentication().DefaultHost()
+			ec.Host = host
+		}
+		if repo, err := f.BaseRepo(); err == nil {
+			ec.Repository = &cmdutil.ExtensionContextRepository{
+				Owner: repo.RepoOwner(),
+				Name:  repo.RepoName(),
+				Host:  repo.RepoHost(),
+			}
+		}
+		return ec
+	}
+}
+
 func ioStreams(f *cmdutil.Factory) *iostreams.IOStreams {
 	io := iostreams.System()
 	cfg, err := f.Config()
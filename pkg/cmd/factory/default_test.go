@@ -14,6 +14,7 @@ import (
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	o "github.com/cli/cli/v2/pkg/option"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -73,6 +74,9 @@ func Test_BaseRepo(t *testing.T) {
 				},
 				getConfig: func() (gh.Config, error) {
 					cfg := &ghmock.ConfigMock{}
+					cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+						return o.None[gh.ConfigEntry]()
+					}
 					cfg.AuthenticationFunc = func() gh.AuthConfig {
 						authCfg := &config.AuthConfig{}
 						hosts := []string{"nonsense.com"}
@@ -211,6 +215,9 @@ func Test_SmartBaseRepo(t *testing.T) {
 				},
 				getConfig: func() (gh.Config, error) {
 					cfg := &ghmock.ConfigMock{}
+					cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+						return o.None[gh.ConfigEntry]()
+					}
 					cfg.AuthenticationFunc = func() gh.AuthConfig {
 						authCfg := &config.AuthConfig{}
 						hosts := []string{"nonsense.com"}
@@ -785,3 +792,12 @@ func disableColorLabelsConfig() gh.Config {
 func enableColorLabelsConfig() gh.Config {
 	return config.NewFromString("color_labels: enabled")
 }
+
+func TestOfflineRoundTripper(t *testing.T) {
+	req, err := http.NewRequest("GET", "https://api.github.com/user", nil)
+	require.NoError(t, err)
+
+	resp, err := offlineRoundTripper{}.RoundTrip(req)
+	assert.Nil(t, resp)
+	assert.ErrorIs(t, err, cmdutil.ErrOffline)
+}
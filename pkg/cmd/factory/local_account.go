@@ -0,0 +1,72 @@
+package factory
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// localAccountConfigFunc wraps a Config func so that an account bound to the
+// current repository via `gh auth switch --local` (recorded in git config as
+// gh.account) takes precedence over the globally active account.
+func localAccountConfigFunc(f *cmdutil.Factory) func() (gh.Config, error) {
+	base := f.Config
+	var cachedConfig gh.Config
+	var configError error
+	return func() (gh.Config, error) {
+		if cachedConfig != nil || configError != nil {
+			return cachedConfig, configError
+		}
+		cachedConfig, configError = base()
+		if configError != nil {
+			return cachedConfig, configError
+		}
+		// Any failure to read the git config, including not being in a git
+		// repository or git being unavailable, means no account is bound.
+		if account, err := f.GitClient.Config(context.Background(), "gh.account"); err == nil && account != "" {
+			cachedConfig = &localAccountConfig{Config: cachedConfig, account: account}
+		}
+		return cachedConfig, configError
+	}
+}
+
+type localAccountConfig struct {
+	gh.Config
+	account string
+}
+
+func (c *localAccountConfig) Authentication() gh.AuthConfig {
+	return &localAccountAuthConfig{AuthConfig: c.Config.Authentication(), account: c.account}
+}
+
+type localAccountAuthConfig struct {
+	gh.AuthConfig
+	account string
+}
+
+func (c *localAccountAuthConfig) ActiveUser(hostname string) (string, error) {
+	if slices.Contains(c.AuthConfig.UsersForHost(hostname), c.account) {
+		return c.account, nil
+	}
+	return c.AuthConfig.ActiveUser(hostname)
+}
+
+func (c *localAccountAuthConfig) ActiveToken(hostname string) (string, string) {
+	// Tokens from environment variables continue to take precedence over the
+	// repository binding, consistent with `gh auth switch`.
+	if token, source := c.AuthConfig.ActiveToken(hostname); strings.HasSuffix(source, "_TOKEN") {
+		return token, source
+	}
+	if token, source, err := c.AuthConfig.TokenForUser(hostname, c.account); err == nil {
+		return token, source
+	}
+	return c.AuthConfig.ActiveToken(hostname)
+}
+
+func (c *localAccountAuthConfig) HasActiveToken(hostname string) bool {
+	token, _ := c.ActiveToken(hostname)
+	return token != ""
+}
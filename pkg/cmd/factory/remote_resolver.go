@@ -3,7 +3,7 @@ package factory
 import (
 	"errors"
 	"fmt"
-	"sort"
+	"strings"
 
 	"github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/git"
@@ -18,11 +18,15 @@ const (
 )
 
 type remoteResolver struct {
-	readRemotes   func() (git.RemoteSet, error)
-	getConfig     func() (gh.Config, error)
-	urlTranslator context.Translator
-	cachedRemotes context.Remotes
-	remotesError  error
+	readRemotes func() (git.RemoteSet, error)
+	// readRemotesForHost, when set, is used instead of readRemotes whenever a
+	// host is forced through the GH_HOST environment variable so that only the
+	// remotes whose effective URL points at that host are considered.
+	readRemotesForHost func(host string) (git.RemoteSet, error)
+	getConfig          func() (gh.Config, error)
+	urlTranslator      context.Translator
+	cachedRemotes      context.Remotes
+	remotesError       error
 }
 
 func (rr *remoteResolver) Resolver() func() (context.Remotes, error) {
@@ -31,13 +35,35 @@ func (rr *remoteResolver) Resolver() func() (context.Remotes, error) {
 			return rr.cachedRemotes, rr.remotesError
 		}
 
-		gitRemotes, err := rr.readRemotes()
+		cfg, err := rr.getConfig()
+		if err != nil {
+			return nil, err
+		}
+
+		authedHosts := cfg.Authentication().Hosts()
+		if len(authedHosts) == 0 {
+			return nil, errors.New("could not find any host configurations")
+		}
+		defaultHost, src := cfg.Authentication().DefaultHost()
+
+		hostScoped := isHostEnv(src) && rr.readRemotesForHost != nil
+
+		var gitRemotes git.RemoteSet
+		if hostScoped {
+			gitRemotes, err = rr.readRemotesForHost(defaultHost)
+		} else {
+			gitRemotes, err = rr.readRemotes()
+		}
 		if err != nil {
 			rr.remotesError = err
 			return nil, err
 		}
 		if len(gitRemotes) == 0 {
-			rr.remotesError = errors.New("no git remotes found")
+			if hostScoped {
+				rr.remotesError = fmt.Errorf("none of the git remotes configured for this repository correspond to the %s environment variable. Try adding a matching remote or unsetting the variable", src)
+			} else {
+				rr.remotesError = errors.New("no git remotes found")
+			}
 			return nil, rr.remotesError
 		}
 
@@ -47,25 +73,15 @@ func (rr *remoteResolver) Resolver() func() (context.Remotes, error) {
 		}
 		resolvedRemotes := context.TranslateRemotes(gitRemotes, sshTranslate)
 
-		cfg, err := rr.getConfig()
-		if err != nil {
-			return nil, err
-		}
-
-		authedHosts := cfg.Authentication().Hosts()
-		if len(authedHosts) == 0 {
-			return nil, errors.New("could not find any host configurations")
-		}
-		defaultHost, src := cfg.Authentication().DefaultHost()
-
 		// Use set to dedupe list of hosts
 		hostsSet := set.NewStringSet()
 		hostsSet.AddValues(authedHosts)
 		hostsSet.AddValues([]string{defaultHost, ghinstance.Default()})
 		hosts := hostsSet.ToSlice()
 
-		// Sort remotes
-		sort.Sort(resolvedRemotes)
+		// Sort remotes: names pinned via the remote_priority config setting rank
+		// highest, then the default ordering applies.
+		resolvedRemotes.SortByPriority(remotePriority(cfg, defaultHost))
 
 		rr.cachedRemotes = resolvedRemotes.FilterByHosts(hosts)
 
@@ -98,3 +114,16 @@ func (rr *remoteResolver) Resolver() func() (context.Remotes, error) {
 func isHostEnv(src string) bool {
 	return src == GH_HOST
 }
+
+// remotePriority reads the comma-separated remote_priority config setting
+// into the list of remote names to rank highest, most preferred first.
+func remotePriority(cfg gh.Config, hostname string) []string {
+	value := cfg.GetOrDefault(hostname, "remote_priority").UnwrapOrZero().Value
+	var priority []string
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			priority = append(priority, name)
+		}
+	}
+	return priority
+}
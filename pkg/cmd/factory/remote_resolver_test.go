@@ -10,6 +10,7 @@ import (
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
 	ghmock "github.com/cli/cli/v2/internal/gh/mock"
+	o "github.com/cli/cli/v2/pkg/option"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -22,11 +23,12 @@ func (it identityTranslator) Translate(u *url.URL) *url.URL {
 
 func Test_remoteResolver(t *testing.T) {
 	tests := []struct {
-		name     string
-		remotes  func() (git.RemoteSet, error)
-		config   gh.Config
-		output   []string
-		wantsErr bool
+		name           string
+		remotes        func() (git.RemoteSet, error)
+		remotesForHost func(string) (git.RemoteSet, error)
+		config         gh.Config
+		output         []string
+		wantsErr       bool
 	}{
 		{
 			name: "no authenticated hosts",
@@ -37,6 +39,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{})
@@ -54,6 +59,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com"})
@@ -73,6 +81,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com"})
@@ -93,6 +104,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com"})
@@ -112,6 +126,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com"})
@@ -134,6 +151,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com"})
@@ -153,6 +173,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com", "github.com"})
@@ -174,6 +197,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com", "github.com"})
@@ -197,6 +223,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com", "github.com"})
@@ -216,6 +245,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com"})
@@ -236,6 +268,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com"})
@@ -257,6 +292,9 @@ func Test_remoteResolver(t *testing.T) {
 			},
 			config: func() gh.Config {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"example.com", "test.com"})
@@ -267,14 +305,85 @@ func Test_remoteResolver(t *testing.T) {
 			}(),
 			output: []string{"upstream", "origin"},
 		},
+		{
+			name: "remote_priority config pins the ranking",
+			remotes: func() (git.RemoteSet, error) {
+				return git.RemoteSet{
+					git.NewRemote("upstream", "https://example.com/owner/repo.git"),
+					git.NewRemote("origin", "https://example.com/owner/repo.git"),
+					git.NewRemote("fork", "https://example.com/owner/repo.git"),
+				}, nil
+			},
+			config: func() gh.Config {
+				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					if key == "remote_priority" {
+						return o.Some(gh.ConfigEntry{Value: "fork, origin"})
+					}
+					return o.None[gh.ConfigEntry]()
+				}
+				cfg.AuthenticationFunc = func() gh.AuthConfig {
+					authCfg := &config.AuthConfig{}
+					authCfg.SetHosts([]string{"example.com"})
+					authCfg.SetDefaultHost("example.com", "default")
+					return authCfg
+				}
+				return cfg
+			}(),
+			output: []string{"fork", "origin", "upstream"},
+		},
+		{
+			name: "host scoped remote reading with GH_HOST",
+			remotesForHost: func(host string) (git.RemoteSet, error) {
+				assert.Equal(t, "example.com", host)
+				return git.RemoteSet{
+					git.NewRemote("origin", "https://example.com/owner/repo.git"),
+				}, nil
+			},
+			config: func() gh.Config {
+				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
+				cfg.AuthenticationFunc = func() gh.AuthConfig {
+					authCfg := &config.AuthConfig{}
+					authCfg.SetHosts([]string{"example.com", "github.com"})
+					authCfg.SetDefaultHost("example.com", "GH_HOST")
+					return authCfg
+				}
+				return cfg
+			}(),
+			output: []string{"origin"},
+		},
+		{
+			name: "host scoped remote reading with GH_HOST and no matching remotes",
+			remotesForHost: func(host string) (git.RemoteSet, error) {
+				return git.RemoteSet{}, nil
+			},
+			config: func() gh.Config {
+				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
+				cfg.AuthenticationFunc = func() gh.AuthConfig {
+					authCfg := &config.AuthConfig{}
+					authCfg.SetHosts([]string{"example.com"})
+					authCfg.SetDefaultHost("example.com", "GH_HOST")
+					return authCfg
+				}
+				return cfg
+			}(),
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			rr := &remoteResolver{
-				readRemotes:   tt.remotes,
-				getConfig:     func() (gh.Config, error) { return tt.config, nil },
-				urlTranslator: identityTranslator{},
+				readRemotes:        tt.remotes,
+				readRemotesForHost: tt.remotesForHost,
+				getConfig:          func() (gh.Config, error) { return tt.config, nil },
+				urlTranslator:      identityTranslator{},
 			}
 			resolver := rr.Resolver()
 			remotes, err := resolver()
@@ -309,6 +418,9 @@ func Test_remoteResolver_Caching(t *testing.T) {
 			},
 			getConfig: func() (gh.Config, error) {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"github.com"})
@@ -350,6 +462,9 @@ func Test_remoteResolver_Caching(t *testing.T) {
 			},
 			getConfig: func() (gh.Config, error) {
 				cfg := &ghmock.ConfigMock{}
+				cfg.GetOrDefaultFunc = func(hostname, key string) o.Option[gh.ConfigEntry] {
+					return o.None[gh.ConfigEntry]()
+				}
 				cfg.AuthenticationFunc = func() gh.AuthConfig {
 					authCfg := &config.AuthConfig{}
 					authCfg.SetHosts([]string{"github.com"})
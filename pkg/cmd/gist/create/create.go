@@ -12,6 +12,7 @@ import (
 	"regexp"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -32,6 +33,7 @@ type CreateOptions struct {
 	Public           bool
 	Filenames        []string
 	FilenameOverride string
+	Language         string
 	WebMode          bool
 
 	Config     func() (gh.Config, error)
@@ -56,6 +58,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			Gists can be created from one or multiple files. Alternatively, pass %[1]s-%[1]s as
 			filename to read from standard input.
 
+			When standard input is a terminal, passing %[1]s-%[1]s opens a paste buffer: type or
+			paste the content and press Ctrl+D to finish. Empty input is rejected so an
+			accidental invocation does not create an empty gist.
+
 			By default, gists are secret; use %[1]s--public%[1]s to make publicly listed ones.
 		`, "`"),
 		Example: heredoc.Doc(`
@@ -74,6 +80,9 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			# Read from standard input to create a gist
 			$ gh gist create -
 
+			# Read from standard input with a syntax hint for highlighting
+			$ pbpaste | gh gist create - --language python
+
 			# Create a gist from output piped from another command
 			$ cat cool.txt | gh gist create
 		`),
@@ -90,6 +99,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 		RunE: func(c *cobra.Command, args []string) error {
 			opts.Filenames = args
 
+			if opts.Language != "" && opts.FilenameOverride != "" {
+				return cmdutil.FlagErrorf("cannot use `--language` with `--filename`; the filename extension determines the syntax")
+			}
+
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -101,6 +114,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the web browser with created gist")
 	cmd.Flags().BoolVarP(&opts.Public, "public", "p", false, "List the gist publicly (default \"secret\")")
 	cmd.Flags().StringVarP(&opts.FilenameOverride, "filename", "f", "", "Provide a filename to be used when reading from standard input")
+	cmd.Flags().StringVarP(&opts.Language, "language", "l", "", "Hint the syntax `language` for content read from standard input")
 	return cmd
 }
 
@@ -121,7 +135,7 @@ func createRun(opts *CreateOptions) error {
 		filenames = []string{"-"}
 	}
 
-	files, err := processFiles(opts.IO.In, opts.FilenameOverride, filenames)
+	files, err := processFiles(opts.IO, opts.FilenameOverride, opts.Language, filenames)
 	if err != nil {
 		return fmt.Errorf("failed to collect files for posting: %w", err)
 	}
@@ -188,8 +202,9 @@ func createRun(opts *CreateOptions) error {
 	return nil
 }
 
-func processFiles(stdin io.ReadCloser, filenameOverride string, filenames []string) (map[string]*shared.GistFile, error) {
+func processFiles(ios *iostreams.IOStreams, filenameOverride string, language string, filenames []string) (map[string]*shared.GistFile, error) {
 	fs := map[string]*shared.GistFile{}
+	stdin := ios.In
 
 	if len(filenames) == 0 {
 		return nil, errors.New("no files passed")
@@ -204,9 +219,13 @@ func processFiles(stdin io.ReadCloser, filenameOverride string, filenames []stri
 			if filenameOverride != "" {
 				filename = filenameOverride
 			} else {
-				filename = fmt.Sprintf("gistfile%d.txt", i)
+				filename = fmt.Sprintf("gistfile%d.%s", i, extensionForLanguage(language))
+			}
+			if ios.IsStdinTTY() && ios.IsStderrTTY() {
+				content, err = readPasteBuffer(ios)
+			} else {
+				content, err = io.ReadAll(stdin)
 			}
-			content, err = io.ReadAll(stdin)
 			if err != nil {
 				return fs, fmt.Errorf("failed to read from stdin: %w", err)
 			}
@@ -240,11 +259,69 @@ func processFiles(stdin io.ReadCloser, filenameOverride string, filenames []stri
 	return fs, nil
 }
 
+// extensionForLanguage translates a language hint into the file extension
+// that makes gists highlight content with that syntax. Hints that are not
+// aliases of a common language are used as the extension directly.
+func extensionForLanguage(language string) string {
+	if language == "" {
+		return "txt"
+	}
+	aliases := map[string]string{
+		"bash":       "sh",
+		"c#":         "cs",
+		"c++":        "cpp",
+		"javascript": "js",
+		"markdown":   "md",
+		"perl":       "pl",
+		"python":     "py",
+		"ruby":       "rb",
+		"rust":       "rs",
+		"shell":      "sh",
+		"text":       "txt",
+		"typescript": "ts",
+		"yaml":       "yml",
+	}
+	language = strings.ToLower(language)
+	if ext, ok := aliases[language]; ok {
+		return ext
+	}
+	return strings.TrimPrefix(language, ".")
+}
+
+// readPasteBuffer interactively collects gist content from a terminal,
+// showing a live character count until the input is terminated with EOF.
+// Empty input is an error so that an accidental `gh gist create -` does not
+// produce an empty gist.
+func readPasteBuffer(ios *iostreams.IOStreams) ([]byte, error) {
+	fmt.Fprintln(ios.ErrOut, "Type or paste your content, then press Ctrl+D on an empty line to finish.")
+
+	var content []byte
+	buf := make([]byte, 4096)
+	for {
+		n, err := ios.In.Read(buf)
+		content = append(content, buf[:n]...)
+		fmt.Fprintf(ios.ErrOut, "\r%d characters", utf8.RuneCount(content))
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Fprintln(ios.ErrOut)
+			return nil, err
+		}
+	}
+	fmt.Fprintln(ios.ErrOut)
+
+	if len(bytes.TrimSpace(content)) == 0 {
+		return nil, errors.New("no content provided; gist not created")
+	}
+	return content, nil
+}
+
 func guessGistName(files map[string]*shared.GistFile) string {
 	filenames := make([]string, 0, len(files))
 	gistName := ""
 
-	re := regexp.MustCompile(`^gistfile\d+\.txt$`)
+	re := regexp.MustCompile(`^gistfile\d+\.\w+$`)
 	for k := range files {
 		if !re.MatchString(k) {
 			filenames = append(filenames, k)
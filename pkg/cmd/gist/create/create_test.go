@@ -7,7 +7,6 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
-	"strings"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
@@ -24,8 +23,9 @@ import (
 )
 
 func Test_processFiles(t *testing.T) {
-	fakeStdin := strings.NewReader("hey cool how is it going")
-	files, err := processFiles(io.NopCloser(fakeStdin), "", []string{"-"})
+	ios, stdin, _, _ := iostreams.Test()
+	stdin.WriteString("hey cool how is it going")
+	files, err := processFiles(ios, "", "", []string{"-"})
 	if err != nil {
 		t.Fatalf("unexpected error processing files: %s", err)
 	}
@@ -34,6 +34,59 @@ func Test_processFiles(t *testing.T) {
 	assert.Equal(t, "hey cool how is it going", files["gistfile0.txt"].Content)
 }
 
+func Test_processFiles_language(t *testing.T) {
+	ios, stdin, _, _ := iostreams.Test()
+	stdin.WriteString("print('hi')")
+	files, err := processFiles(ios, "", "python", []string{"-"})
+	if err != nil {
+		t.Fatalf("unexpected error processing files: %s", err)
+	}
+
+	assert.Equal(t, 1, len(files))
+	assert.Equal(t, "print('hi')", files["gistfile0.py"].Content)
+}
+
+func Test_processFiles_pasteBuffer(t *testing.T) {
+	ios, stdin, _, stderr := iostreams.Test()
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+	stdin.WriteString("pasted content")
+	files, err := processFiles(ios, "", "", []string{"-"})
+	if err != nil {
+		t.Fatalf("unexpected error processing files: %s", err)
+	}
+
+	assert.Equal(t, 1, len(files))
+	assert.Equal(t, "pasted content", files["gistfile0.txt"].Content)
+	assert.Contains(t, stderr.String(), "Type or paste your content")
+	assert.Contains(t, stderr.String(), "14 characters")
+}
+
+func Test_processFiles_pasteBufferEmpty(t *testing.T) {
+	ios, stdin, _, _ := iostreams.Test()
+	ios.SetStdinTTY(true)
+	ios.SetStderrTTY(true)
+	stdin.WriteString("  \n")
+	_, err := processFiles(ios, "", "", []string{"-"})
+	assert.EqualError(t, err, "failed to read from stdin: no content provided; gist not created")
+}
+
+func Test_extensionForLanguage(t *testing.T) {
+	tests := []struct {
+		language string
+		want     string
+	}{
+		{language: "", want: "txt"},
+		{language: "Python", want: "py"},
+		{language: "shell", want: "sh"},
+		{language: "go", want: "go"},
+		{language: ".toml", want: "toml"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, extensionForLanguage(tt.language))
+	}
+}
+
 func Test_guessGistName_stdin(t *testing.T) {
 	files := map[string]*shared.GistFile{
 		"gistfile0.txt": {Content: "sample content"},
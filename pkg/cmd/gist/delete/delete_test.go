@@ -126,6 +126,7 @@ func Test_deleteRun(t *testing.T) {
 		httpStubs       func(*httpmock.Registry)
 		mockPromptGists bool
 		noGists         bool
+		noConfirm       bool
 		wantErr         bool
 		wantStdout      string
 		wantStderr      string
@@ -239,6 +240,7 @@ func Test_deleteRun(t *testing.T) {
 				reg.Register(httpmock.REST("GET", "gists/1234"),
 					httpmock.StatusStringResponse(404, "{}"))
 			},
+			noConfirm:  true,
 			wantErr:    true,
 			wantStderr: "not found",
 		},
@@ -250,13 +252,14 @@ func Test_deleteRun(t *testing.T) {
 			httpStubs:       func(reg *httpmock.Registry) {},
 			mockPromptGists: true,
 			noGists:         true,
+			noConfirm:       true,
 			wantStdout:      "No gists found.\n",
 		},
 	}
 
 	for _, tt := range tests {
 		pm := prompter.NewMockPrompter(t)
-		if !tt.opts.Confirmed {
+		if !tt.opts.Confirmed && !tt.noConfirm {
 			pm.RegisterConfirm("Delete \"cool.txt\" gist?", func(_ string, _ bool) (bool, error) {
 				return !tt.cancel, nil
 			})
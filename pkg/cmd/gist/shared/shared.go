@@ -233,7 +233,7 @@ func PromptGists(prompter prompter.Prompter, client *http.Client, host string, c
 		opts[i] = fmt.Sprintf("%s %s %s", cs.Bold(gist.Filename()), gist.TruncDescription(), cs.Muted(gistTime))
 	}
 
-	result, err := prompter.Select("Select a gist", "", opts)
+	result, err := prompter.FuzzySelect("Select a gist", "", opts)
 
 	if err != nil {
 		return &Gist{}, err
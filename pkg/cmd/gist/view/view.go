@@ -111,7 +111,7 @@ func viewRun(opts *ViewOptions) error {
 			gistURL = ghinstance.GistPrefix(hostname) + gistID
 		}
 		if opts.IO.IsStderrTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(gistURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(gistURL))
 		}
 		return opts.Browser.Browse(gistURL)
 	}
@@ -14,6 +14,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 	opts := &prShared.CommentableOptions{
 		IO:                        f.IOStreams,
 		HttpClient:                f.HttpClient,
+		Config:                    f.Config,
 		EditSurvey:                prShared.CommentableEditSurvey(f.Config, f.IOStreams),
 		InteractiveEditSurvey:     prShared.CommentableInteractiveEditSurvey(f.Config, f.IOStreams),
 		ConfirmSubmitSurvey:       prShared.CommentableConfirmSubmitSurvey(f.Prompter),
@@ -35,6 +36,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 		`),
 		Example: heredoc.Doc(`
 			$ gh issue comment 12 --body "Hi from GitHub CLI"
+			$ gh issue comment 12 --hide 1122334455 --reason spam
 		`),
 		Args: cobra.ExactArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -67,6 +69,9 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 				if opts.EditLast || opts.DeleteLast {
 					fields = append(fields, "comments")
 				}
+				if opts.Template != "" {
+					fields = append(fields, "number")
+				}
 
 				issue, err := issueShared.FindIssueOrPR(httpClient, baseRepo, issueNumber, fields)
 				if err != nil {
@@ -101,6 +106,10 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*prShared.CommentableOptions) e
 	cmd.Flags().BoolVar(&opts.DeleteLast, "delete-last", false, "Delete the last comment of the current user")
 	cmd.Flags().BoolVar(&opts.DeleteLastConfirmed, "yes", false, "Skip the delete confirmation prompt when --delete-last is provided")
 	cmd.Flags().BoolVar(&opts.CreateIfNone, "create-if-none", false, "Create a new comment if no comments are found. Can be used only with --edit-last")
+	cmd.Flags().StringVar(&opts.HideComment, "hide", "", "Hide (minimize) the comment with the given `id`")
+	cmd.Flags().StringVar(&opts.UnhideComment, "unhide", "", "Unhide the minimized comment with the given `id`")
+	cmdutil.StringEnumFlag(cmd, &opts.HideReason, "reason", "", "outdated", []string{"abuse", "duplicate", "off-topic", "outdated", "resolved", "spam"}, "Reason for hiding a comment")
+	cmd.Flags().StringVar(&opts.Template, "template", "", "Use the saved reply with the given `name` from configuration as the comment body")
 
 	return cmd
 }
@@ -209,7 +209,7 @@ func createRun(opts *CreateOptions) (err error) {
 			openURL = ghrepo.GenerateRepoURL(baseRepo, "issues/new")
 		}
 		if isTerminal {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 		}
 		return opts.Browser.Browse(openURL)
 	}
@@ -262,6 +262,7 @@ func createRun(opts *CreateOptions) (err error) {
 				if template != nil {
 					templateContent = string(template.Body())
 					templateNameForSubmit = template.NameForSubmit()
+					prShared.ApplyTemplateMetadata(&tb, template)
 				} else {
 					templateContent = string(tpl.LegacyBody())
 				}
@@ -316,9 +317,7 @@ func createRun(opts *CreateOptions) (err error) {
 				if err != nil {
 					return
 				}
-				if tb.Title == "" {
-					tb.Title = template.Title()
-				}
+				prShared.ApplyTemplateMetadata(&tb, template)
 				templateNameForSubmit = template.NameForSubmit()
 				tb.Body = string(template.Body())
 			}
@@ -336,7 +335,7 @@ func createRun(opts *CreateOptions) (err error) {
 
 	if action == prShared.PreviewAction {
 		if isTerminal {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 		}
 		return opts.Browser.Browse(openURL)
 	} else if action == prShared.SubmitAction {
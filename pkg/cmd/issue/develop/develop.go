@@ -12,6 +12,7 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/pkg/cmd/issue/shared"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
@@ -50,6 +51,11 @@ func NewCmdDevelop(f *cmdutil.Factory, runF func(*DevelopOptions) error) *cobra.
 			When using the %[1]s--base%[1]s flag, the new development branch will be created from the specified
 			remote branch. The new branch will be configured as the base branch for pull requests created using
 			%[1]sgh pr create%[1]s.
+
+			When no %[1]s--name%[1]s is given, the branch name can come from a template set with the
+			%[1]sgh.branch-name-template%[1]s git configuration key, e.g. %[1]s{type}/{number}-{slug}%[1]s.
+			%[1]s{number}%[1]s is the issue number, %[1]s{slug}%[1]s is the slugified issue title, and %[1]s{type}%[1]s
+			is the slugified name of the first label, or %[1]sissue%[1]s when the issue has no labels.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# List branches for issue 123
@@ -150,8 +156,23 @@ func developRun(opts *DevelopOptions) error {
 		return err
 	}
 
+	fields := []string{"id", "number"}
+
+	// When the branch name comes from a template, the issue title and labels
+	// feed the {slug} and {type} placeholders.
+	var branchTemplate string
+	if !opts.List && opts.Name == "" {
+		branchTemplate, err = prShared.BranchNameTemplate(opts.GitClient)
+		if err != nil {
+			return err
+		}
+		if branchTemplate != "" {
+			fields = append(fields, "title", "labels")
+		}
+	}
+
 	opts.IO.StartProgressIndicator()
-	issue, err := shared.FindIssueOrPR(httpClient, baseRepo, opts.IssueNumber, []string{"id", "number"})
+	issue, err := shared.FindIssueOrPR(httpClient, baseRepo, opts.IssueNumber, fields)
 	opts.IO.StopProgressIndicator()
 	if err != nil {
 		return err
@@ -169,10 +190,10 @@ func developRun(opts *DevelopOptions) error {
 	if opts.List {
 		return developRunList(opts, apiClient, baseRepo, issue)
 	}
-	return developRunCreate(opts, apiClient, baseRepo, issue)
+	return developRunCreate(opts, apiClient, baseRepo, issue, branchTemplate)
 }
 
-func developRunCreate(opts *DevelopOptions, apiClient *api.Client, issueRepo ghrepo.Interface, issue *api.Issue) error {
+func developRunCreate(opts *DevelopOptions, apiClient *api.Client, issueRepo ghrepo.Interface, issue *api.Issue, branchTemplate string) error {
 	branchRepo := issueRepo
 	var repoID string
 	if opts.BranchRepo != "" {
@@ -190,8 +211,16 @@ func developRunCreate(opts *DevelopOptions, apiClient *api.Client, issueRepo ghr
 		return err
 	}
 
+	name := opts.Name
+	if name == "" && branchTemplate != "" {
+		name = prShared.ExpandBranchNameTemplate(branchTemplate, "issue", issue.Labels.Names(), issue.Number, issue.Title)
+		if !opts.GitClient.CheckRefFormat(ctx.Background(), name) {
+			return fmt.Errorf("invalid branch name %q expanded from the configured branch name template", name)
+		}
+	}
+
 	opts.IO.StartProgressIndicator()
-	branchName, err := api.CreateLinkedBranch(apiClient, branchRepo.RepoHost(), repoID, issue.ID, branchID, opts.Name)
+	branchName, err := api.CreateLinkedBranch(apiClient, branchRepo.RepoHost(), repoID, issue.ID, branchID, name)
 	opts.IO.StopProgressIndicator()
 	if err != nil {
 		return err
@@ -281,10 +281,49 @@ func TestDevelopRun(t *testing.T) {
 				)
 			},
 			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.branch-name-template`, 1, "")
 				cs.Register(`git fetch origin \+refs/heads/my-issue-1:refs/remotes/origin/my-issue-1`, 0, "")
 			},
 			expectedOut: "github.com/OWNER/REPO/tree/my-issue-1\n",
 		},
+		{
+			name: "develop new branch with branch name template",
+			opts: &DevelopOptions{
+				IssueNumber: 123,
+			},
+			remotes: map[string]string{
+				"origin": "OWNER/REPO",
+			},
+			httpStubs: func(reg *httpmock.Registry, t *testing.T) {
+				reg.Register(
+					httpmock.GraphQL(`query LinkedBranchFeature\b`),
+					httpmock.StringResponse(featureEnabledPayload),
+				)
+				reg.Register(
+					httpmock.GraphQL(`query IssueByNumber\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"hasIssuesEnabled":true,"issue":{"id": "SOMEID","number":123,"title":"my issue","labels":{"nodes":[{"name":"bug"}]}}}}}`),
+				)
+				reg.Register(
+					httpmock.GraphQL(`query FindRepoBranchID\b`),
+					httpmock.StringResponse(`{"data":{"repository":{"id":"REPOID","defaultBranchRef":{"target":{"oid":"DEFAULTOID"}},"ref":{"target":{"oid":""}}}}}`),
+				)
+				reg.Register(
+					httpmock.GraphQL(`mutation CreateLinkedBranch\b`),
+					httpmock.GraphQLMutation(`{"data":{"createLinkedBranch":{"linkedBranch":{"id":"2","ref":{"name":"bug/123-my-issue"}}}}}`,
+						func(inputs map[string]interface{}) {
+							assert.Equal(t, "REPOID", inputs["repositoryId"])
+							assert.Equal(t, "SOMEID", inputs["issueId"])
+							assert.Equal(t, "bug/123-my-issue", inputs["name"])
+						}),
+				)
+			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.branch-name-template`, 0, "{type}/{number}-{slug}\n")
+				cs.Register(`git check-ref-format --branch bug/123-my-issue`, 0, "")
+				cs.Register(`git fetch origin \+refs/heads/bug/123-my-issue:refs/remotes/origin/bug/123-my-issue`, 0, "")
+			},
+			expectedOut: "github.com/OWNER/REPO/tree/bug/123-my-issue\n",
+		},
 		{
 			name: "develop new branch in different repo than issue",
 			opts: &DevelopOptions{
@@ -327,6 +366,7 @@ func TestDevelopRun(t *testing.T) {
 				)
 			},
 			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.branch-name-template`, 1, "")
 				cs.Register(`git fetch origin \+refs/heads/my-issue-1:refs/remotes/origin/my-issue-1`, 0, "")
 			},
 			expectedOut: "github.com/OWNER2/REPO/tree/my-issue-1\n",
@@ -401,6 +441,9 @@ func TestDevelopRun(t *testing.T) {
 						}),
 				)
 			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.branch-name-template`, 1, "")
+			},
 			expectedOut: "github.com/cli/cli/tree/my-issue-1\n",
 		},
 		{
@@ -509,6 +552,9 @@ func TestDevelopRun(t *testing.T) {
 					httpmock.StringResponse(`{"data":{"repository":{"id":"REPOID","defaultBranchRef":{"target":{"oid":"DEFAULTOID"}},"ref":null}}}`),
 				)
 			},
+			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.branch-name-template`, 1, "")
+			},
 			wantErr: "could not find branch \"does-not-exist-branch\" in OWNER/REPO",
 		},
 	}
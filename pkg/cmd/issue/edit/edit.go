@@ -58,6 +58,10 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(*EditOptions) error) *cobra.Comman
 		Long: heredoc.Docf(`
 			Edit one or more issues within the same repository.
 
+			Without any editing flags, a single issue is edited interactively: choose
+			the fields to change and pick new values with the current metadata
+			pre-selected.
+
 			Editing issues' projects requires authorization with the %[1]sproject%[1]s scope.
 			To authorize, run %[1]sgh auth refresh -s project%[1]s.
 		`, "`"),
@@ -13,11 +13,13 @@ import (
 	fd "github.com/cli/cli/v2/internal/featuredetection"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/internal/text"
 	issueShared "github.com/cli/cli/v2/pkg/cmd/issue/shared"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/set"
 	"github.com/shurcooL/githubv4"
 	"github.com/spf13/cobra"
 )
@@ -38,6 +40,9 @@ type ListOptions struct {
 	Milestone    string
 	Search       string
 	WebMode      bool
+	Summary      bool
+	DateFormat   string
+	PointsLabel  string
 	Exporter     cmdutil.Exporter
 
 	Detector fd.Detector
@@ -69,6 +74,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 			$ gh issue list --author monalisa
 			$ gh issue list --assignee "@me"
 			$ gh issue list --milestone "The big 1.0"
+			$ gh issue list --milestone v2.0 --summary
 			$ gh issue list --search "error no:assignee sort:created-asc"
 			$ gh issue list --state all
 		`),
@@ -90,6 +96,32 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				opts.Author = fmt.Sprintf("app/%s", appAuthor)
 			}
 
+			if opts.Summary {
+				if opts.Milestone == "" {
+					return cmdutil.FlagErrorf("cannot use `--summary` without `--milestone`")
+				}
+				if opts.WebMode {
+					return cmdutil.FlagErrorf("cannot use `--summary` with `--web`")
+				}
+			} else if cmd.Flags().Changed("points-label") {
+				return cmdutil.FlagErrorf("cannot use `--points-label` without `--summary`")
+			}
+
+			if opts.Exporter != nil {
+				summaryFields := set.NewStringSet()
+				summaryFields.AddValues(milestoneSummaryFields)
+				issueFields := set.NewStringSet()
+				issueFields.AddValues(api.IssueFields)
+				for _, field := range opts.Exporter.Fields() {
+					if opts.Summary && !summaryFields.Contains(field) {
+						return cmdutil.FlagErrorf("JSON field %q is not available with `--summary`", field)
+					}
+					if !opts.Summary && !issueFields.Contains(field) {
+						return cmdutil.FlagErrorf("JSON field %q is only available with `--summary`", field)
+					}
+				}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -107,7 +139,20 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVar(&opts.Mention, "mention", "", "Filter by mention")
 	cmd.Flags().StringVarP(&opts.Milestone, "milestone", "m", "", "Filter by milestone number or title")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search issues with `query`")
-	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
+	cmd.Flags().BoolVar(&opts.Summary, "summary", false, "Show a milestone burndown summary instead of the issue list")
+	cmd.Flags().StringVar(&opts.PointsLabel, "points-label", "sp:", "Label `prefix` that encodes story points, e.g. sp: for a label like sp:3")
+	cmdutil.StringEnumFlag(cmd, &opts.DateFormat, "date-format", "", "", []string{"relative", "iso", "local"}, "Format for timestamp columns")
+
+	jsonFields := append([]string{}, api.IssueFields...)
+	for _, field := range milestoneSummaryFields {
+		if field != "milestone" {
+			jsonFields = append(jsonFields, field)
+		}
+	}
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, jsonFields)
+
+	_ = prShared.RegisterLabelCompletionFlags(f, cmd, "label")
+	_ = prShared.RegisterMilestoneCompletionFlags(f, cmd, "milestone")
 
 	return cmd
 }
@@ -172,11 +217,15 @@ func listRun(opts *ListOptions) error {
 		}
 
 		if isTerminal {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 		}
 		return opts.Browser.Browse(openURL)
 	}
 
+	if opts.Summary {
+		return summaryRun(opts, httpClient, baseRepo, filterOptions)
+	}
+
 	if opts.Exporter != nil {
 		filterOptions.Fields = opts.Exporter.Fields()
 	}
@@ -207,7 +256,16 @@ func listRun(opts *ListOptions) error {
 		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", title)
 	}
 
-	issueShared.PrintIssues(opts.IO, opts.Now(), "", len(listResult.Issues), listResult.Issues)
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	location, err := tableprinter.TimeLocationFromConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	issueShared.PrintIssues(opts.IO, opts.Now(), tableprinter.TimeFormat(opts.DateFormat), location, "", len(listResult.Issues), listResult.Issues)
 
 	return nil
 }
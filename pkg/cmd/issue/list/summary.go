@@ -0,0 +1,178 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// milestoneSummaryFields are the JSON fields available with `--summary`.
+var milestoneSummaryFields = []string{
+	"milestone",
+	"totalIssues",
+	"openIssues",
+	"closedIssues",
+	"totalPoints",
+	"openPoints",
+	"closedPoints",
+	"weeks",
+}
+
+// burndownWeek is one week of milestone progress, with what remained open at
+// the end of it.
+type burndownWeek struct {
+	WeekStart       string `json:"weekStart"`
+	Closed          int    `json:"closed"`
+	ClosedPoints    int    `json:"closedPoints"`
+	RemainingIssues int    `json:"remainingIssues"`
+	RemainingPoints int    `json:"remainingPoints"`
+}
+
+// milestoneSummary aggregates the issues of a milestone into open/closed
+// counts, story points, and a week-by-week burndown.
+type milestoneSummary struct {
+	Milestone    string         `json:"milestone"`
+	TotalIssues  int            `json:"totalIssues"`
+	OpenIssues   int            `json:"openIssues"`
+	ClosedIssues int            `json:"closedIssues"`
+	TotalPoints  int            `json:"totalPoints"`
+	OpenPoints   int            `json:"openPoints"`
+	ClosedPoints int            `json:"closedPoints"`
+	Weeks        []burndownWeek `json:"weeks"`
+}
+
+func (s *milestoneSummary) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(s, fields)
+}
+
+func summaryRun(opts *ListOptions, httpClient *http.Client, baseRepo ghrepo.Interface, filters prShared.FilterOptions) error {
+	// A burndown needs both open and closed issues regardless of the state
+	// filter, and only a handful of fields per issue.
+	filters.State = "all"
+	filters.Fields = []string{"number", "state", "labels", "createdAt", "closedAt"}
+
+	listResult, err := issueList(httpClient, baseRepo, filters, opts.LimitResults)
+	if err != nil {
+		return err
+	}
+	if len(listResult.Issues) == 0 && opts.Exporter == nil {
+		return prShared.ListNoResults(ghrepo.FullName(baseRepo), "issue", true)
+	}
+
+	summary := summarizeMilestone(opts.Milestone, listResult.Issues, opts.PointsLabel, opts.Now())
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, summary)
+	}
+
+	printMilestoneSummary(opts.IO, summary, opts.PointsLabel)
+	return nil
+}
+
+func summarizeMilestone(milestone string, issues []api.Issue, pointsPrefix string, now time.Time) *milestoneSummary {
+	summary := &milestoneSummary{Milestone: milestone}
+
+	var firstCreated time.Time
+	for _, issue := range issues {
+		points := issuePoints(issue, pointsPrefix)
+		summary.TotalIssues++
+		summary.TotalPoints += points
+		if issue.State == "CLOSED" {
+			summary.ClosedIssues++
+			summary.ClosedPoints += points
+		} else {
+			summary.OpenIssues++
+			summary.OpenPoints += points
+		}
+		if firstCreated.IsZero() || issue.CreatedAt.Before(firstCreated) {
+			firstCreated = issue.CreatedAt
+		}
+	}
+
+	if firstCreated.IsZero() {
+		return summary
+	}
+
+	lastWeek := weekStart(now)
+	remainingIssues := summary.TotalIssues
+	remainingPoints := summary.TotalPoints
+	for week := weekStart(firstCreated); !week.After(lastWeek); week = week.AddDate(0, 0, 7) {
+		entry := burndownWeek{WeekStart: week.Format("2006-01-02")}
+		weekEnd := week.AddDate(0, 0, 7)
+		for _, issue := range issues {
+			if issue.ClosedAt == nil || issue.State != "CLOSED" {
+				continue
+			}
+			if closed := *issue.ClosedAt; !closed.Before(week) && closed.Before(weekEnd) {
+				entry.Closed++
+				entry.ClosedPoints += issuePoints(issue, pointsPrefix)
+			}
+		}
+		remainingIssues -= entry.Closed
+		remainingPoints -= entry.ClosedPoints
+		entry.RemainingIssues = remainingIssues
+		entry.RemainingPoints = remainingPoints
+		summary.Weeks = append(summary.Weeks, entry)
+	}
+
+	return summary
+}
+
+// issuePoints reads story points from the first label whose name is the
+// configured prefix followed by a number, e.g. `sp:3`.
+func issuePoints(issue api.Issue, prefix string) int {
+	for _, label := range issue.Labels.Nodes {
+		if !strings.HasPrefix(label.Name, prefix) {
+			continue
+		}
+		if points, err := strconv.Atoi(strings.TrimPrefix(label.Name, prefix)); err == nil && points >= 0 {
+			return points
+		}
+	}
+	return 0
+}
+
+// weekStart truncates a time to midnight UTC on the Monday of its week.
+func weekStart(t time.Time) time.Time {
+	t = t.UTC()
+	offset := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, time.UTC)
+}
+
+func printMilestoneSummary(io *iostreams.IOStreams, summary *milestoneSummary, pointsPrefix string) {
+	out := io.Out
+	cs := io.ColorScheme()
+
+	fmt.Fprintf(out, "Milestone %s: %d open, %d closed of %d issues\n",
+		cs.Bold(summary.Milestone), summary.OpenIssues, summary.ClosedIssues, summary.TotalIssues)
+	if summary.TotalPoints > 0 {
+		fmt.Fprintf(out, "Points (%s<n> labels): %d open, %d closed of %d\n",
+			pointsPrefix, summary.OpenPoints, summary.ClosedPoints, summary.TotalPoints)
+	}
+
+	if len(summary.Weeks) == 0 {
+		return
+	}
+
+	// Scale the bars so that the widest week stays readable.
+	const maxWidth = 50
+	scale := 1
+	for _, week := range summary.Weeks {
+		for week.RemainingIssues/scale > maxWidth {
+			scale++
+		}
+	}
+
+	fmt.Fprintf(out, "\nBurndown (issues remaining at end of week):\n")
+	for _, week := range summary.Weeks {
+		fmt.Fprintf(out, "%s  %-*s %d\n", week.WeekStart, maxWidth, strings.Repeat("#", week.RemainingIssues/scale), week.RemainingIssues)
+	}
+}
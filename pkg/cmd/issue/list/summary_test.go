@@ -0,0 +1,85 @@
+package list
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+)
+
+func issueForSummary(state string, createdAt string, closedAt string, labels ...string) api.Issue {
+	issue := api.Issue{State: state}
+	issue.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	if closedAt != "" {
+		closed, _ := time.Parse(time.RFC3339, closedAt)
+		issue.ClosedAt = &closed
+	}
+	for _, label := range labels {
+		issue.Labels.Nodes = append(issue.Labels.Nodes, api.IssueLabel{Name: label})
+	}
+	return issue
+}
+
+func Test_summarizeMilestone(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2026-08-26T12:00:00Z")
+	issues := []api.Issue{
+		issueForSummary("OPEN", "2026-08-10T09:00:00Z", "", "sp:3"),
+		issueForSummary("OPEN", "2026-08-11T09:00:00Z", "", "bug"),
+		issueForSummary("CLOSED", "2026-08-10T10:00:00Z", "2026-08-13T10:00:00Z", "sp:2"),
+		issueForSummary("CLOSED", "2026-08-12T10:00:00Z", "2026-08-20T10:00:00Z", "sp:5"),
+	}
+
+	summary := summarizeMilestone("v2.0", issues, "sp:", now)
+
+	assert.Equal(t, "v2.0", summary.Milestone)
+	assert.Equal(t, 4, summary.TotalIssues)
+	assert.Equal(t, 2, summary.OpenIssues)
+	assert.Equal(t, 2, summary.ClosedIssues)
+	assert.Equal(t, 10, summary.TotalPoints)
+	assert.Equal(t, 3, summary.OpenPoints)
+	assert.Equal(t, 7, summary.ClosedPoints)
+	assert.Equal(t, []burndownWeek{
+		{WeekStart: "2026-08-10", Closed: 1, ClosedPoints: 2, RemainingIssues: 3, RemainingPoints: 8},
+		{WeekStart: "2026-08-17", Closed: 1, ClosedPoints: 5, RemainingIssues: 2, RemainingPoints: 3},
+		{WeekStart: "2026-08-24", Closed: 0, ClosedPoints: 0, RemainingIssues: 2, RemainingPoints: 3},
+	}, summary.Weeks)
+}
+
+func Test_issuePoints(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels []string
+		want   int
+	}{
+		{name: "points label", labels: []string{"bug", "sp:3"}, want: 3},
+		{name: "no points label", labels: []string{"bug"}, want: 0},
+		{name: "malformed points label", labels: []string{"sp:large"}, want: 0},
+		{name: "first valid points label wins", labels: []string{"sp:x", "sp:5", "sp:8"}, want: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, issuePoints(issueForSummary("OPEN", "2026-08-10T09:00:00Z", "", tt.labels...), "sp:"))
+		})
+	}
+}
+
+func Test_printMilestoneSummary(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2026-08-26T12:00:00Z")
+	issues := []api.Issue{
+		issueForSummary("OPEN", "2026-08-18T09:00:00Z", "", "sp:3"),
+		issueForSummary("CLOSED", "2026-08-18T10:00:00Z", "2026-08-20T10:00:00Z", "sp:2"),
+	}
+	summary := summarizeMilestone("v2.0", issues, "sp:", now)
+
+	ios, _, stdout, _ := iostreams.Test()
+	printMilestoneSummary(ios, summary, "sp:")
+
+	output := stdout.String()
+	assert.Contains(t, output, "Milestone v2.0: 1 open, 1 closed of 2 issues")
+	assert.Contains(t, output, "Points (sp:<n> labels): 3 open, 2 closed of 5")
+	assert.Contains(t, output, "Burndown (issues remaining at end of week):")
+	assert.Contains(t, output, "2026-08-17  #")
+	assert.Contains(t, output, "2026-08-24  #")
+}
@@ -13,7 +13,7 @@ import (
 	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
-func PrintIssues(io *iostreams.IOStreams, now time.Time, prefix string, totalCount int, issues []api.Issue) {
+func PrintIssues(io *iostreams.IOStreams, now time.Time, format tableprinter.TimeFormat, location *time.Location, prefix string, totalCount int, issues []api.Issue) {
 	cs := io.ColorScheme()
 	isTTY := io.IsStdoutTTY()
 	headers := []string{"ID"}
@@ -25,7 +25,14 @@ func PrintIssues(io *iostreams.IOStreams, now time.Time, prefix string, totalCou
 		"LABELS",
 		"UPDATED",
 	)
-	table := tableprinter.New(io, tableprinter.WithHeader(headers...))
+	table := tableprinter.New(io, tableprinter.WithHeader(headers...),
+		tableprinter.ColumnPolicy{MinWidth: 6},
+		tableprinter.ColumnPolicy{MinWidth: 20, Priority: 1},
+		tableprinter.ColumnPolicy{MinWidth: 10, Priority: 2},
+		tableprinter.ColumnPolicy{MinWidth: 8},
+	)
+	table.SetTimeFormat(format)
+	table.SetTimeLocation(location)
 	for _, issue := range issues {
 		issueNum := strconv.Itoa(issue.Number)
 		if isTTY {
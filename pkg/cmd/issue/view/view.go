@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
 	"sort"
 	"strings"
 	"time"
@@ -32,10 +33,12 @@ type ViewOptions struct {
 	Browser    browser.Browser
 	Detector   fd.Detector
 
-	IssueNumber int
-	WebMode     bool
-	Comments    bool
-	Exporter    cmdutil.Exporter
+	IssueNumber  int
+	WebMode      bool
+	Comments     bool
+	ExportFormat string
+	OutputFile   string
+	Exporter     cmdutil.Exporter
 
 	Now func() time.Time
 }
@@ -55,9 +58,29 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 			Display the title, body, and other information about an issue.
 
 			With %[1]s--web%[1]s flag, open the issue in a web browser instead.
+
+			With %[1]s--export%[1]s flag, render the issue and its full comment thread into
+			a standalone Markdown or HTML document, suitable for archiving. Use
+			%[1]s--output%[1]s to write the document to a file instead of stdout.
 		`, "`"),
+		Example: heredoc.Doc(`
+			# Archive an issue and its comment thread as Markdown
+			$ gh issue view 123 --export md --output issue-123.md
+		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.OutputFile != "" && opts.ExportFormat == "" {
+				return cmdutil.FlagErrorf("cannot use `--output` without `--export` flag")
+			}
+			if opts.ExportFormat != "" {
+				if opts.WebMode {
+					return cmdutil.FlagErrorf("cannot use `--export` with `--web` flag")
+				}
+				if opts.Exporter != nil {
+					return cmdutil.FlagErrorf("cannot use `--export` with `--json` flag")
+				}
+			}
+
 			issueNumber, baseRepo, err := shared.ParseIssueFromArg(args[0])
 			if err != nil {
 				return err
@@ -84,6 +107,8 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open an issue in the browser")
 	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View issue comments")
+	cmdutil.StringEnumFlag(cmd, &opts.ExportFormat, "export", "", "", []string{"md", "html"}, "Export the issue and its comments to a standalone document")
+	cmd.Flags().StringVar(&opts.OutputFile, "output", "", "Write the exported document to `file` instead of stdout")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.IssueFields)
 
 	return cmd
@@ -112,7 +137,7 @@ func viewRun(opts *ViewOptions) error {
 		lookupFields.Add("url")
 	} else {
 		lookupFields.AddValues(defaultFields)
-		if opts.Comments {
+		if opts.Comments || opts.ExportFormat != "" {
 			lookupFields.Add("comments")
 			lookupFields.Remove("lastComment")
 		}
@@ -163,11 +188,15 @@ func viewRun(opts *ViewOptions) error {
 	if opts.WebMode {
 		openURL := issue.URL
 		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 		}
 		return opts.Browser.Browse(openURL)
 	}
 
+	if opts.ExportFormat != "" {
+		return exportIssue(opts, baseRepo, issue)
+	}
+
 	if err := opts.IO.StartPager(); err != nil {
 		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
 	}
@@ -189,6 +218,50 @@ func viewRun(opts *ViewOptions) error {
 	return printRawIssuePreview(opts.IO.Out, issue)
 }
 
+func exportIssue(opts *ViewOptions, baseRepo ghrepo.Interface, issue *api.Issue) error {
+	var milestoneTitle string
+	if issue.Milestone != nil {
+		milestoneTitle = issue.Milestone.Title
+	}
+
+	doc := prShared.ExportDocument{
+		Repo:      baseRepo,
+		Title:     issue.Title,
+		Number:    issue.Number,
+		State:     issue.State,
+		Author:    issue.Author.Login,
+		CreatedAt: issue.CreatedAt,
+		URL:       issue.URL,
+		Body:      issue.Body,
+		Metadata: [][2]string{
+			{"Labels", issueLabelList(issue, nil)},
+			{"Assignees", issueAssigneeList(*issue)},
+			{"Projects", issueProjectList(*issue)},
+			{"Milestone", milestoneTitle},
+		},
+		Comments: issue.Comments,
+	}
+
+	out := io.Writer(opts.IO.Out)
+	if opts.OutputFile != "" {
+		f, err := os.Create(opts.OutputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if err := doc.Export(out, opts.ExportFormat); err != nil {
+		return err
+	}
+
+	if opts.OutputFile != "" {
+		opts.IO.Infof("Exported %s#%d to %s\n", ghrepo.FullName(baseRepo), issue.Number, opts.OutputFile)
+	}
+	return nil
+}
+
 func printRawIssuePreview(out io.Writer, issue *api.Issue) error {
 	assignees := issueAssigneeList(*issue)
 	labels := issueLabelList(issue, nil)
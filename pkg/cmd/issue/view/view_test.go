@@ -499,6 +499,74 @@ func TestIssueView_nontty_Comments(t *testing.T) {
 	}
 }
 
+func TestIssueView_Export(t *testing.T) {
+	tests := map[string]struct {
+		cli             string
+		fixtures        map[string]string
+		expectedOutputs []string
+		wantsErr        bool
+	}{
+		"export markdown": {
+			cli: "123 --export md",
+			fixtures: map[string]string{
+				"IssueByNumber":    "./fixtures/issueView_previewSingleComment.json",
+				"CommentsForIssue": "./fixtures/issueView_previewFullComments.json",
+			},
+			expectedOutputs: []string{
+				`# some title`,
+				"`OWNER/REPO#123` • OPEN • opened by marseilles on 2020-01-01 12:00 UTC",
+				`## Description`,
+				`some body`,
+				`## 5 Comments`,
+				`### monalisa • 2020-01-01 12:00 UTC • edited`,
+				`Comment 1`,
+				`Comment 5`,
+				`\[View on GitHub\]\(https://github.com/OWNER/REPO/issues/123\)`,
+			},
+		},
+		"export html": {
+			cli: "123 --export html",
+			fixtures: map[string]string{
+				"IssueByNumber":    "./fixtures/issueView_previewSingleComment.json",
+				"CommentsForIssue": "./fixtures/issueView_previewFullComments.json",
+			},
+			expectedOutputs: []string{
+				`<title>some title</title>`,
+				`<h1>some title</h1>`,
+				`<h2>Description</h2>`,
+				`<h2>5 Comments</h2>`,
+			},
+		},
+		"output without export": {
+			cli:      "123 --output issue-123.md",
+			wantsErr: true,
+		},
+		"export with web": {
+			cli:      "123 --export md --web",
+			wantsErr: true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			http := &httpmock.Registry{}
+			defer http.Verify(t)
+			for name, file := range tc.fixtures {
+				name := fmt.Sprintf(`query %s\b`, name)
+				http.Register(httpmock.GraphQL(name), httpmock.FileResponse(file))
+			}
+			output, err := runCommand(http, false, tc.cli)
+			if tc.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "", output.Stderr())
+			//nolint:staticcheck // prefer exact matchers over ExpectLines
+			test.ExpectLines(t, output.String(), tc.expectedOutputs...)
+		})
+	}
+}
+
 // TODO projectsV1Deprecation
 // Remove this test.
 func TestProjectsV1Deprecation(t *testing.T) {
@@ -8,6 +8,7 @@ import (
 	"sync/atomic"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -116,7 +117,10 @@ func cloneLabels(client *http.Client, destination ghrepo.Interface, opts *cloneO
 		return
 	}
 
-	workers := 10
+	// the shared limiter enforces the per-host cap, so the pool can simply
+	// match it
+	client = api.NewLimitedHTTPClient(client)
+	workers := api.DefaultHostConcurrency
 	toCreate := make(chan createOptions)
 
 	wg, ctx := errgroup.WithContext(context.Background())
@@ -21,8 +21,9 @@ type deleteOptions struct {
 	IO         *iostreams.IOStreams
 	Prompter   iprompter
 
-	Name      string
-	Confirmed bool
+	Name string
+
+	cmdutil.DestructiveOptions
 }
 
 func newCmdDelete(f *cmdutil.Factory, runF func(*deleteOptions) error) *cobra.Command {
@@ -41,7 +42,7 @@ func newCmdDelete(f *cmdutil.Factory, runF func(*deleteOptions) error) *cobra.Co
 			opts.BaseRepo = f.BaseRepo
 			opts.Name = args[0]
 
-			if !opts.IO.CanPrompt() && !opts.Confirmed {
+			if !opts.IO.CanPrompt() && !opts.Confirmed && !opts.DryRun {
 				return cmdutil.FlagErrorf("--yes required when not running interactively")
 			}
 
@@ -54,7 +55,7 @@ func newCmdDelete(f *cmdutil.Factory, runF func(*deleteOptions) error) *cobra.Co
 
 	cmd.Flags().BoolVar(&opts.Confirmed, "confirm", false, "Confirm deletion without prompting")
 	_ = cmd.Flags().MarkDeprecated("confirm", "use `--yes` instead")
-	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "Confirm deletion without prompting")
+	cmdutil.AddDestructiveFlags(cmd, &opts.DestructiveOptions)
 
 	return cmd
 }
@@ -70,10 +71,13 @@ func deleteRun(opts *deleteOptions) error {
 		return err
 	}
 
-	if !opts.Confirmed {
-		if err := opts.Prompter.ConfirmDeletion(opts.Name); err != nil {
-			return err
-		}
+	if err := cmdutil.ConfirmDestructive(opts.IO.CanPrompt(), opts.Prompter, opts.Name, opts.DestructiveOptions); err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		fmt.Fprintf(opts.IO.Out, "Would have deleted label %q from %s\n", opts.Name, ghrepo.FullName(baseRepo))
+		return nil
 	}
 
 	opts.IO.StartProgressIndicator()
@@ -38,7 +38,7 @@ func TestNewCmdDelete(t *testing.T) {
 		{
 			name:   "confirm argument",
 			input:  "test --yes",
-			output: deleteOptions{Name: "test", Confirmed: true},
+			output: deleteOptions{Name: "test", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 		},
 		{
 			name:       "confirm no tty",
@@ -112,7 +112,7 @@ func TestDeleteRun(t *testing.T) {
 		{
 			name: "deletes label notty",
 			tty:  false,
-			opts: &deleteOptions{Name: "test", Confirmed: true},
+			opts: &deleteOptions{Name: "test", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			httpStubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO/labels/test"),
@@ -124,7 +124,7 @@ func TestDeleteRun(t *testing.T) {
 		{
 			name: "missing label",
 			tty:  false,
-			opts: &deleteOptions{Name: "missing", Confirmed: true},
+			opts: &deleteOptions{Name: "missing", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			httpStubs: func(reg *httpmock.Registry) {
 				reg.Register(
 					httpmock.REST("DELETE", "repos/OWNER/REPO/labels/missing"),
@@ -0,0 +1,297 @@
+package label
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+type syncOptions struct {
+	BaseRepo   func() (ghrepo.Interface, error)
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	File   string
+	Prune  bool
+	DryRun bool
+}
+
+// labelSpec is the declarative description of a single label as it appears
+// in the file passed to `gh label sync --file`.
+type labelSpec struct {
+	Name        string   `yaml:"name"`
+	Color       string   `yaml:"color"`
+	Description string   `yaml:"description"`
+	Aliases     []string `yaml:"aliases"`
+}
+
+type syncChange struct {
+	create labelSpec
+	update *labelSpec // paired with old
+	old    *label     // set for updates, renames, and deletes
+}
+
+func newCmdSync(f *cmdutil.Factory, runF func(*syncOptions) error) *cobra.Command {
+	opts := syncOptions{
+		HttpClient: f.HttpClient,
+		IO:         f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Sync labels from a file",
+		Long: heredoc.Docf(`
+			Reconcile the labels in a repository against a declarative file.
+
+			The file is a YAML list of labels. Each entry has a %[1]sname%[1]s, and optionally a
+			%[1]scolor%[1]s, a %[1]sdescription%[1]s, and a list of %[1]saliases%[1]s. When a label in the file does
+			not exist in the repository but one of its aliases does, the existing label is
+			renamed instead of being created, preserving its association with issues and
+			pull requests.
+
+			Labels in the repository that are not in the file are left alone unless
+			%[1]s--prune%[1]s is given, in which case they are deleted.
+
+			The planned changes are printed before they are applied. Use %[1]s--dry-run%[1]s to
+			only print them.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Preview the changes needed to match labels.yml
+			$ gh label sync --file labels.yml --dry-run
+
+			# Sync labels, deleting any that are not in the file
+			$ gh label sync --file labels.yml --prune
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(c *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return syncRun(&opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.File, "file", "F", "", "Read the labels from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false, "Delete labels that are not in the file")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the planned changes without applying them")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func syncRun(opts *syncOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	b, err := cmdutil.ReadFile(opts.File, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	specs, err := parseLabelFile(b)
+	if err != nil {
+		return err
+	}
+
+	opts.IO.StartProgressIndicator()
+	existing, _, err := listLabels(httpClient, baseRepo, listQueryOptions{Limit: -1})
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	changes := buildSyncPlan(specs, existing, opts.Prune)
+
+	cs := opts.IO.ColorScheme()
+	isTTY := opts.IO.IsStdoutTTY()
+
+	if len(changes) == 0 {
+		if isTTY {
+			fmt.Fprintf(opts.IO.Out, "%s Labels in %s already match %s\n", cs.SuccessIcon(), ghrepo.FullName(baseRepo), opts.File)
+		}
+		return nil
+	}
+
+	for _, c := range changes {
+		fmt.Fprintln(opts.IO.Out, formatSyncChange(cs, c))
+	}
+
+	if opts.DryRun {
+		if isTTY {
+			fmt.Fprintf(opts.IO.Out, "\nDry run: %s would be applied to %s\n", text.Pluralize(len(changes), "change"), ghrepo.FullName(baseRepo))
+		}
+		return nil
+	}
+
+	opts.IO.StartProgressIndicator()
+	err = applySyncPlan(httpClient, baseRepo, changes)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	if isTTY {
+		fmt.Fprintf(opts.IO.Out, "\n%s Applied %s to %s\n", cs.SuccessIcon(), text.Pluralize(len(changes), "change"), ghrepo.FullName(baseRepo))
+	}
+
+	return nil
+}
+
+func parseLabelFile(b []byte) ([]labelSpec, error) {
+	var specs []labelSpec
+	if err := yaml.Unmarshal(b, &specs); err != nil {
+		return nil, fmt.Errorf("invalid label file: %w", err)
+	}
+
+	seen := map[string]struct{}{}
+	for i := range specs {
+		specs[i].Color = strings.TrimPrefix(specs[i].Color, "#")
+		name := specs[i].Name
+		if name == "" {
+			return nil, fmt.Errorf("invalid label file: entry %d is missing a name", i+1)
+		}
+		key := strings.ToLower(name)
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("invalid label file: duplicate label %q", name)
+		}
+		seen[key] = struct{}{}
+	}
+
+	return specs, nil
+}
+
+// buildSyncPlan diffs the desired labels against the labels that exist in the
+// repository. Deletions come first so that a pruned label cannot collide with
+// one being created or renamed.
+func buildSyncPlan(specs []labelSpec, existing []label, prune bool) []syncChange {
+	byName := map[string]*label{}
+	for i := range existing {
+		byName[strings.ToLower(existing[i].Name)] = &existing[i]
+	}
+
+	claimed := map[string]struct{}{}
+	var changes []syncChange
+
+	for _, spec := range specs {
+		spec := spec
+		if l, ok := byName[strings.ToLower(spec.Name)]; ok {
+			claimed[strings.ToLower(l.Name)] = struct{}{}
+			if labelNeedsUpdate(spec, *l) {
+				changes = append(changes, syncChange{update: &spec, old: l})
+			}
+			continue
+		}
+
+		var renamed bool
+		for _, alias := range spec.Aliases {
+			if l, ok := byName[strings.ToLower(alias)]; ok {
+				if _, taken := claimed[strings.ToLower(l.Name)]; taken {
+					continue
+				}
+				claimed[strings.ToLower(l.Name)] = struct{}{}
+				changes = append(changes, syncChange{update: &spec, old: l})
+				renamed = true
+				break
+			}
+		}
+		if !renamed {
+			changes = append(changes, syncChange{create: spec})
+		}
+	}
+
+	if prune {
+		var deletes []syncChange
+		for i := range existing {
+			if _, ok := claimed[strings.ToLower(existing[i].Name)]; !ok {
+				deletes = append(deletes, syncChange{old: &existing[i]})
+			}
+		}
+		changes = append(deletes, changes...)
+	}
+
+	return changes
+}
+
+func labelNeedsUpdate(spec labelSpec, l label) bool {
+	if spec.Color != "" && !strings.EqualFold(spec.Color, l.Color) {
+		return true
+	}
+	if spec.Description != "" && spec.Description != l.Description {
+		return true
+	}
+	return false
+}
+
+func formatSyncChange(cs *iostreams.ColorScheme, c syncChange) string {
+	switch {
+	case c.update != nil && c.old != nil:
+		var details []string
+		if !strings.EqualFold(c.update.Name, c.old.Name) {
+			details = append(details, fmt.Sprintf("rename from %q", c.old.Name))
+		}
+		if c.update.Color != "" && !strings.EqualFold(c.update.Color, c.old.Color) {
+			details = append(details, fmt.Sprintf("color %s → %s", c.old.Color, c.update.Color))
+		}
+		if c.update.Description != "" && c.update.Description != c.old.Description {
+			details = append(details, "description updated")
+		}
+		return fmt.Sprintf("%s %s (%s)", cs.Yellow("~"), c.update.Name, strings.Join(details, ", "))
+	case c.old != nil:
+		return fmt.Sprintf("%s %s", cs.Red("-"), c.old.Name)
+	default:
+		return fmt.Sprintf("%s %s", cs.Green("+"), c.create.Name)
+	}
+}
+
+func applySyncPlan(client *http.Client, repo ghrepo.Interface, changes []syncChange) error {
+	apiClient := api.NewClientFromHTTP(client)
+
+	for _, c := range changes {
+		var err error
+		switch {
+		case c.update != nil && c.old != nil:
+			editOpts := editOptions{
+				Name:        c.old.Name,
+				Color:       c.update.Color,
+				Description: c.update.Description,
+			}
+			if !strings.EqualFold(c.update.Name, c.old.Name) {
+				editOpts.NewName = c.update.Name
+			}
+			err = updateLabel(apiClient, repo, &editOpts)
+		case c.old != nil:
+			err = deleteLabel(client, repo, c.old.Name)
+		default:
+			createOpts := createOptions{
+				Name:        c.create.Name,
+				Color:       c.create.Color,
+				Description: c.create.Description,
+			}
+			err = createLabel(client, repo, &createOpts)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,288 @@
+package label
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdSync(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  syncOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no file flag",
+			input:   "",
+			wantErr: true,
+			errMsg:  `required flag(s) "file" not set`,
+		},
+		{
+			name:   "file flag",
+			input:  "--file labels.yml",
+			output: syncOptions{File: "labels.yml"},
+		},
+		{
+			name:   "prune and dry-run flags",
+			input:  "--file labels.yml --prune --dry-run",
+			output: syncOptions{File: "labels.yml", Prune: true, DryRun: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var gotOpts *syncOptions
+			cmd := newCmdSync(f, func(opts *syncOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.File, gotOpts.File)
+			assert.Equal(t, tt.output.Prune, gotOpts.Prune)
+			assert.Equal(t, tt.output.DryRun, gotOpts.DryRun)
+		})
+	}
+}
+
+func TestSyncRun(t *testing.T) {
+	listStub := httpmock.StringResponse(`
+	{
+		"data": {
+			"repository": {
+				"labels": {
+					"totalCount": 3,
+					"nodes": [
+						{
+							"name": "bug",
+							"color": "d73a4a",
+							"description": "Something isn't working"
+						},
+						{
+							"name": "feature-request",
+							"color": "a2eeef",
+							"description": "New feature or request"
+						},
+						{
+							"name": "wontfix",
+							"color": "ffffff",
+							"description": "This will not be worked on"
+						}
+					],
+					"pageInfo": {
+						"hasNextPage": false,
+						"endCursor": "abcd1234"
+					}
+				}
+			}
+		}
+	}`)
+
+	labelFile := heredoc.Doc(`
+		- name: bug
+		  color: b60205
+		  description: Something isn't working
+		- name: enhancement
+		  color: a2eeef
+		  aliases:
+		    - feature-request
+		- name: docs
+		  color: 0075ca
+	`)
+
+	tests := []struct {
+		name       string
+		tty        bool
+		opts       *syncOptions
+		file       string
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+		wantErrMsg string
+	}{
+		{
+			name: "dry run prints plan without applying",
+			tty:  true,
+			opts: &syncOptions{Prune: true, DryRun: true},
+			file: labelFile,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.GraphQL(`query LabelList\b`), listStub)
+			},
+			wantStdout: heredoc.Doc(`
+				- wontfix
+				~ bug (color d73a4a → b60205)
+				~ enhancement (rename from "feature-request")
+				+ docs
+
+				Dry run: 4 changes would be applied to OWNER/REPO
+			`),
+		},
+		{
+			name: "applies creates, updates, renames, and deletes",
+			tty:  true,
+			opts: &syncOptions{Prune: true},
+			file: labelFile,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.GraphQL(`query LabelList\b`), listStub)
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO/labels/wontfix"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO/labels/bug"),
+					httpmock.RESTPayload(200, "{}", func(params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"color":       "b60205",
+							"description": "Something isn't working",
+						}, params)
+					}),
+				)
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO/labels/feature-request"),
+					httpmock.RESTPayload(200, "{}", func(params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"color":    "a2eeef",
+							"new_name": "enhancement",
+						}, params)
+					}),
+				)
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/labels"),
+					httpmock.RESTPayload(201, "{}", func(params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"name":        "docs",
+							"color":       "0075ca",
+							"description": "",
+						}, params)
+					}),
+				)
+			},
+			wantStdout: heredoc.Doc(`
+				- wontfix
+				~ bug (color d73a4a → b60205)
+				~ enhancement (rename from "feature-request")
+				+ docs
+
+				✓ Applied 4 changes to OWNER/REPO
+			`),
+		},
+		{
+			name: "no prune leaves extra labels alone",
+			tty:  false,
+			opts: &syncOptions{},
+			file: heredoc.Doc(`
+				- name: docs
+				  color: 0075ca
+			`),
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.GraphQL(`query LabelList\b`), listStub)
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/labels"),
+					httpmock.StatusStringResponse(201, "{}"),
+				)
+			},
+			wantStdout: "+ docs\n",
+		},
+		{
+			name: "already in sync",
+			tty:  true,
+			opts: &syncOptions{Prune: true},
+			file: heredoc.Doc(`
+				- name: bug
+				  color: d73a4a
+				- name: feature-request
+				- name: wontfix
+			`),
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.GraphQL(`query LabelList\b`), listStub)
+			},
+			// wantStdout is completed with the file path in the test body
+			wantStdout: "",
+		},
+		{
+			name:       "entry without a name",
+			tty:        true,
+			opts:       &syncOptions{},
+			file:       "- color: d73a4a\n",
+			wantErrMsg: "invalid label file: entry 1 is missing a name",
+		},
+		{
+			name: "duplicate entries",
+			tty:  true,
+			opts: &syncOptions{},
+			file: heredoc.Doc(`
+				- name: bug
+				- name: BUG
+			`),
+			wantErrMsg: `invalid label file: duplicate label "BUG"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			io, _, stdout, _ := iostreams.Test()
+			io.SetStdoutTTY(tt.tty)
+			io.SetStdinTTY(tt.tty)
+			io.SetStderrTTY(tt.tty)
+			tt.opts.IO = io
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.New("OWNER", "REPO"), nil
+			}
+			tt.opts.File = filepath.Join(t.TempDir(), "labels.yml")
+			assert.NoError(t, os.WriteFile(tt.opts.File, []byte(tt.file), 0600))
+			defer reg.Verify(t)
+
+			err := syncRun(tt.opts)
+
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+
+			wantStdout := tt.wantStdout
+			if tt.name == "already in sync" {
+				wantStdout = "✓ Labels in OWNER/REPO already match " + tt.opts.File + "\n"
+			}
+			assert.Equal(t, wantStdout, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package metrics
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	cmdReport "github.com/cli/cli/v2/pkg/cmd/metrics/report"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdMetrics(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "metrics <command>",
+		Short: "Inspect locally recorded command metrics",
+		Long: heredoc.Docf(`
+			Inspect the local store of command invocation metrics.
+
+			Metrics collection is opt-in: set %[1]sGH_METRICS_ENABLED%[1]s to a truthy value to record
+			the command name, duration, API call count, and exit status of each invocation.
+			Records are kept in a file on this machine and no data ever leaves it.
+		`, "`"),
+	}
+
+	cmd.AddCommand(cmdReport.NewCmdReport(f, nil))
+
+	return cmd
+}
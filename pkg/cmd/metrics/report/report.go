@@ -0,0 +1,136 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/metrics"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ReportOptions struct {
+	IO *iostreams.IOStreams
+
+	Since time.Duration
+
+	// StorePath overrides where metrics are read from; used in tests.
+	StorePath string
+}
+
+func NewCmdReport(f *cmdutil.Factory, runF func(*ReportOptions) error) *cobra.Command {
+	opts := &ReportOptions{
+		IO: f.IOStreams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Summarize locally recorded command metrics",
+		Long: heredoc.Docf(`
+			Summarize the local store of command invocation metrics.
+
+			For each command, the report shows how many times it ran, how many of those
+			runs failed, the average duration, and the total number of API requests made.
+			Recording is opt-in via %[1]sGH_METRICS_ENABLED%[1]s; see %[1]sgh metrics%[1]s.
+		`, "`"),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.StorePath == "" {
+				opts.StorePath = metrics.FilePath()
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return reportRun(opts)
+		},
+	}
+
+	cmd.Flags().DurationVar(&opts.Since, "since", 0, "Only include invocations more recent than the given `duration`, e.g. 72h")
+
+	return cmd
+}
+
+type commandSummary struct {
+	command     string
+	invocations int
+	failures    int
+	totalTime   time.Duration
+	apiCalls    int64
+}
+
+func reportRun(opts *ReportOptions) error {
+	entries, err := metrics.Read(opts.StorePath)
+	if err != nil {
+		return fmt.Errorf("failed to read metrics store: %w", err)
+	}
+
+	if opts.Since > 0 {
+		cutoff := time.Now().Add(-opts.Since)
+		var recent []metrics.Entry
+		for _, entry := range entries {
+			if entry.Timestamp.After(cutoff) {
+				recent = append(recent, entry)
+			}
+		}
+		entries = recent
+	}
+
+	if len(entries) == 0 {
+		return cmdutil.NewNoResultsError("no metrics recorded; set GH_METRICS_ENABLED=1 to start recording")
+	}
+
+	byCommand := map[string]*commandSummary{}
+	for _, entry := range entries {
+		summary, ok := byCommand[entry.Command]
+		if !ok {
+			summary = &commandSummary{command: entry.Command}
+			byCommand[entry.Command] = summary
+		}
+		summary.invocations++
+		if entry.ExitCode != 0 {
+			summary.failures++
+		}
+		summary.totalTime += time.Duration(entry.Duration) * time.Millisecond
+		summary.apiCalls += entry.APICalls
+	}
+
+	summaries := make([]*commandSummary, 0, len(byCommand))
+	for _, summary := range byCommand {
+		summaries = append(summaries, summary)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].invocations != summaries[j].invocations {
+			return summaries[i].invocations > summaries[j].invocations
+		}
+		return summaries[i].command < summaries[j].command
+	})
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("COMMAND", "RUNS", "FAILURES", "AVG TIME", "API CALLS"))
+	for _, summary := range summaries {
+		tp.AddField(summary.command, tableprinter.WithColor(cs.Bold))
+		tp.AddField(fmt.Sprintf("%d", summary.invocations))
+		failures := fmt.Sprintf("%d", summary.failures)
+		if summary.failures > 0 {
+			tp.AddField(failures, tableprinter.WithColor(cs.Red))
+		} else {
+			tp.AddField(failures)
+		}
+		avg := summary.totalTime / time.Duration(summary.invocations)
+		tp.AddField(avg.Round(time.Millisecond).String())
+		tp.AddField(fmt.Sprintf("%d", summary.apiCalls))
+		tp.EndRow()
+	}
+	return tp.Render()
+}
@@ -0,0 +1,63 @@
+package report
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/internal/metrics"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_reportRun(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+	require.NoError(t, metrics.Record(path, "gh pr list", time.Now(), 0))
+	require.NoError(t, metrics.Record(path, "gh pr list", time.Now(), 1))
+	require.NoError(t, metrics.Record(path, "gh issue view", time.Now(), 0))
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &ReportOptions{
+		IO:        ios,
+		StorePath: path,
+	}
+
+	require.NoError(t, reportRun(opts))
+
+	out := stdout.String()
+	assert.Contains(t, out, "gh pr list")
+	assert.Contains(t, out, "gh issue view")
+	// gh pr list ran more often so it sorts first
+	assert.Less(t, strings.Index(out, "gh pr list"), strings.Index(out, "gh issue view"))
+}
+
+func Test_reportRunNoResults(t *testing.T) {
+	ios, _, _, _ := iostreams.Test()
+	opts := &ReportOptions{
+		IO:        ios,
+		StorePath: filepath.Join(t.TempDir(), "missing.jsonl"),
+	}
+
+	err := reportRun(opts)
+	assert.EqualError(t, err, "no metrics recorded; set GH_METRICS_ENABLED=1 to start recording")
+}
+
+func Test_reportRunSince(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.jsonl")
+	require.NoError(t, metrics.Record(path, "gh repo clone", time.Now().Add(-48*time.Hour), 0))
+	require.NoError(t, metrics.Record(path, "gh repo view", time.Now(), 0))
+
+	ios, _, stdout, _ := iostreams.Test()
+	opts := &ReportOptions{
+		IO:        ios,
+		Since:     time.Hour,
+		StorePath: path,
+	}
+
+	require.NoError(t, reportRun(opts))
+
+	assert.Contains(t, stdout.String(), "gh repo view")
+	assert.NotContains(t, stdout.String(), "gh repo clone")
+}
@@ -0,0 +1,114 @@
+package list
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+type MemberList struct {
+	Members    []Member
+	TotalCount int
+}
+
+type Member struct {
+	Login string `json:"login"`
+	Name  string `json:"name"`
+	Role  string `json:"role"`
+}
+
+func (m Member) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(m, fields)
+}
+
+func listMembers(httpClient *http.Client, hostname string, organization string, role string, limit int) (*MemberList, error) {
+	type response struct {
+		Organization struct {
+			MembersWithRole struct {
+				TotalCount int
+				Edges      []struct {
+					Role string
+					Node struct {
+						Login string
+						Name  string
+					}
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"membersWithRole(first: $limit, after: $endCursor)"`
+		}
+	}
+
+	query := `query OrganizationMemberList($org: String!, $limit: Int!, $endCursor: String) {
+		organization(login: $org) {
+			membersWithRole(first: $limit, after: $endCursor) {
+				totalCount
+				edges {
+					role
+					node {
+						login
+						name
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	client := api.NewClientFromHTTP(httpClient)
+
+	listResult := MemberList{}
+	pageLimit := min(limit, 100)
+	variables := map[string]interface{}{
+		"org": organization,
+	}
+
+loop:
+	for {
+		variables["limit"] = pageLimit
+		var data response
+		err := client.GraphQL(hostname, query, variables, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		listResult.TotalCount = data.Organization.MembersWithRole.TotalCount
+
+		for _, edge := range data.Organization.MembersWithRole.Edges {
+			// the API does not support filtering by role, so filter here
+			if role != "" && !strings.EqualFold(edge.Role, role) {
+				continue
+			}
+			listResult.Members = append(listResult.Members, Member{
+				Login: edge.Node.Login,
+				Name:  edge.Node.Name,
+				Role:  strings.ToLower(edge.Role),
+			})
+			if len(listResult.Members) == limit {
+				break loop
+			}
+		}
+
+		if data.Organization.MembersWithRole.PageInfo.HasNextPage {
+			variables["endCursor"] = data.Organization.MembersWithRole.PageInfo.EndCursor
+		} else {
+			break
+		}
+	}
+
+	return &listResult, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,125 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HttpClient func() (*http.Client, error)
+	Exporter   cmdutil.Exporter
+
+	Organization string
+	Role         string
+	Limit        int
+}
+
+var memberFields = []string{
+	"login",
+	"name",
+	"role",
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list <organization>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List members of an organization.",
+		Example: heredoc.Doc(`
+			# List the first 30 members of an organization
+			$ gh org members list my-org
+
+			# List every administrator of an organization as JSON
+			$ gh org members list my-org --role admin --limit 1000 --json login,role
+		`),
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Organization = args[0]
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return listRun(&opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of members to list")
+	cmdutil.StringEnumFlag(cmd, &opts.Role, "role", "", "", []string{"admin", "member"}, "Filter members by role")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, memberFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, _ := cfg.Authentication().DefaultHost()
+
+	listResult, err := listMembers(httpClient, host, opts.Organization, opts.Role, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, listResult.Members)
+	}
+
+	if len(listResult.Members) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no members found in %s", opts.Organization))
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		header := listHeader(opts.Organization, opts.Role, len(listResult.Members), listResult.TotalCount)
+		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", header)
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("LOGIN", "NAME", "ROLE"))
+	for _, member := range listResult.Members {
+		tp.AddField(member.Login)
+		tp.AddField(member.Name)
+		tp.AddField(member.Role)
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
+
+func listHeader(organization string, role string, resultCount, totalCount int) string {
+	if role != "" {
+		return fmt.Sprintf("Showing %s with role %s in %s", text.Pluralize(resultCount, "member"), role, organization)
+	}
+	return fmt.Sprintf("Showing %d of %s in %s", resultCount, text.Pluralize(totalCount, "member"), organization)
+}
@@ -0,0 +1,231 @@
+package list
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    ListOptions
+		wantsErr string
+	}{
+		{
+			name: "organization argument",
+			cli:  "my-org",
+			wants: ListOptions{
+				Organization: "my-org",
+				Limit:        30,
+			},
+		},
+		{
+			name: "with role",
+			cli:  "my-org --role admin",
+			wants: ListOptions{
+				Organization: "my-org",
+				Role:         "admin",
+				Limit:        30,
+			},
+		},
+		{
+			name: "with limit",
+			cli:  "my-org -L 101",
+			wants: ListOptions{
+				Organization: "my-org",
+				Limit:        101,
+			},
+		},
+		{
+			name:     "no arguments",
+			cli:      "",
+			wantsErr: "accepts 1 arg(s), received 0",
+		},
+		{
+			name:     "invalid role",
+			cli:      "my-org --role owner",
+			wantsErr: "invalid argument \"owner\" for \"--role\" flag: valid values are {admin|member}",
+		},
+		{
+			name:     "invalid limit",
+			cli:      "my-org -L 0",
+			wantsErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Organization, gotOpts.Organization)
+			assert.Equal(t, tt.wants.Role, gotOpts.Role)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	membersPayload := `
+		{ "data": { "organization": { "membersWithRole": {
+			"totalCount": 3,
+			"edges": [
+				{ "role": "ADMIN", "node": { "login": "monalisa", "name": "Mona Lisa" } },
+				{ "role": "MEMBER", "node": { "login": "hubot", "name": "" } },
+				{ "role": "ADMIN", "node": { "login": "octocat", "name": "Octo Cat" } }
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "abc" }
+		} } } }`
+
+	tests := []struct {
+		name    string
+		opts    ListOptions
+		isTTY   bool
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "displays members tty",
+			opts: ListOptions{
+				Organization: "my-org",
+				Limit:        30,
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrganizationMemberList\b`),
+						httpmock.StringResponse(membersPayload))
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY: true,
+			wantOut: heredoc.Doc(`
+
+				Showing 3 of 3 members in my-org
+
+				LOGIN     NAME       ROLE
+				monalisa  Mona Lisa  admin
+				hubot                member
+				octocat   Octo Cat   admin
+			`),
+		},
+		{
+			name: "filters members by role",
+			opts: ListOptions{
+				Organization: "my-org",
+				Role:         "admin",
+				Limit:        30,
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrganizationMemberList\b`),
+						httpmock.StringResponse(membersPayload))
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY: true,
+			wantOut: heredoc.Doc(`
+
+				Showing 2 members with role admin in my-org
+
+				LOGIN     NAME       ROLE
+				monalisa  Mona Lisa  admin
+				octocat   Octo Cat   admin
+			`),
+		},
+		{
+			name: "displays members notty",
+			opts: ListOptions{
+				Organization: "my-org",
+				Limit:        30,
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrganizationMemberList\b`),
+						httpmock.StringResponse(membersPayload))
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY: false,
+			wantOut: heredoc.Doc(`
+				monalisa	Mona Lisa	admin
+				hubot		member
+				octocat	Octo Cat	admin
+			`),
+		},
+		{
+			name: "no members found",
+			opts: ListOptions{
+				Organization: "my-org",
+				Limit:        30,
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrganizationMemberList\b`),
+						httpmock.StringResponse(`
+							{ "data": { "organization": { "membersWithRole": {
+								"totalCount": 0,
+								"edges": [],
+								"pageInfo": { "hasNextPage": false, "endCursor": "" }
+							} } } }`))
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY:   true,
+			wantErr: "no members found in my-org",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+
+			ios.SetStdoutTTY(tt.isTTY)
+			ios.SetStdinTTY(tt.isTTY)
+			ios.SetStderrTTY(tt.isTTY)
+
+			tt.opts.IO = ios
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+
+			err := listRun(&tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
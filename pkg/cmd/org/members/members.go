@@ -0,0 +1,22 @@
+package members
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	memberListCmd "github.com/cli/cli/v2/pkg/cmd/org/members/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdMembers(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "members <command>",
+		Short: "Work with organization members",
+		Example: heredoc.Doc(`
+			$ gh org members list my-org
+		`),
+	}
+
+	cmd.AddCommand(memberListCmd.NewCmdList(f, nil))
+
+	return cmd
+}
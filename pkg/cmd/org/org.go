@@ -3,6 +3,8 @@ package org
 import (
 	"github.com/MakeNowJust/heredoc"
 	orgListCmd "github.com/cli/cli/v2/pkg/cmd/org/list"
+	orgMembersCmd "github.com/cli/cli/v2/pkg/cmd/org/members"
+	orgTeamsCmd "github.com/cli/cli/v2/pkg/cmd/org/teams"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
 )
@@ -18,7 +20,11 @@ func NewCmdOrg(f *cmdutil.Factory) *cobra.Command {
 		GroupID: "core",
 	}
 
-	cmdutil.AddGroup(cmd, "General commands", orgListCmd.NewCmdList(f, nil))
+	cmdutil.AddGroup(cmd, "General commands",
+		orgListCmd.NewCmdList(f, nil),
+		orgMembersCmd.NewCmdMembers(f),
+		orgTeamsCmd.NewCmdTeams(f),
+	)
 
 	return cmd
 }
@@ -0,0 +1,127 @@
+package list
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+type TeamList struct {
+	Teams      []Team
+	TotalCount int
+}
+
+type Team struct {
+	Slug        string   `json:"slug"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Privacy     string   `json:"privacy"`
+	Members     []string `json:"members"`
+}
+
+func (t Team) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(t, fields)
+}
+
+func listTeams(httpClient *http.Client, hostname string, organization string, withMembers bool, limit int) (*TeamList, error) {
+	type response struct {
+		Organization struct {
+			Teams struct {
+				TotalCount int
+				Nodes      []struct {
+					Slug        string
+					Name        string
+					Description string
+					Privacy     string
+					Members     struct {
+						Nodes []struct {
+							Login string
+						}
+					} `graphql:"members(first: 100)"`
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			} `graphql:"teams(first: $limit, after: $endCursor)"`
+		}
+	}
+
+	query := `query OrganizationTeamList($org: String!, $limit: Int!, $endCursor: String, $withMembers: Boolean!) {
+		organization(login: $org) {
+			teams(first: $limit, after: $endCursor, orderBy: {field: NAME, direction: ASC}) {
+				totalCount
+				nodes {
+					slug
+					name
+					description
+					privacy
+					members(first: 100) @include(if: $withMembers) {
+						nodes {
+							login
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}`
+
+	client := api.NewClientFromHTTP(httpClient)
+
+	listResult := TeamList{}
+	pageLimit := min(limit, 100)
+	variables := map[string]interface{}{
+		"org":         organization,
+		"withMembers": withMembers,
+	}
+
+loop:
+	for {
+		variables["limit"] = pageLimit
+		var data response
+		err := client.GraphQL(hostname, query, variables, &data)
+		if err != nil {
+			return nil, err
+		}
+
+		listResult.TotalCount = data.Organization.Teams.TotalCount
+
+		for _, node := range data.Organization.Teams.Nodes {
+			team := Team{
+				Slug:        node.Slug,
+				Name:        node.Name,
+				Description: node.Description,
+				Privacy:     strings.ToLower(node.Privacy),
+			}
+			for _, member := range node.Members.Nodes {
+				team.Members = append(team.Members, member.Login)
+			}
+			listResult.Teams = append(listResult.Teams, team)
+			if len(listResult.Teams) == limit {
+				break loop
+			}
+		}
+
+		if data.Organization.Teams.PageInfo.HasNextPage {
+			variables["endCursor"] = data.Organization.Teams.PageInfo.EndCursor
+			pageLimit = min(pageLimit, limit-len(listResult.Teams))
+		} else {
+			break
+		}
+	}
+
+	return &listResult, nil
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,128 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type ListOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HttpClient func() (*http.Client, error)
+	Exporter   cmdutil.Exporter
+
+	Organization string
+	WithMembers  bool
+	Limit        int
+}
+
+var teamFields = []string{
+	"slug",
+	"name",
+	"description",
+	"privacy",
+	"members",
+}
+
+func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Command {
+	opts := ListOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "list <organization>",
+		Args:  cobra.ExactArgs(1),
+		Short: "List teams of an organization.",
+		Example: heredoc.Doc(`
+			# List the first 30 teams of an organization
+			$ gh org teams list my-org
+
+			# List every team of an organization with its members as JSON
+			$ gh org teams list my-org --with-members --limit 1000 --json slug,members
+		`),
+		Aliases: []string{"ls"},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Organization = args[0]
+
+			if opts.Limit < 1 {
+				return cmdutil.FlagErrorf("invalid limit: %v", opts.Limit)
+			}
+
+			if runF != nil {
+				return runF(&opts)
+			}
+			return listRun(&opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", 30, "Maximum number of teams to list")
+	cmd.Flags().BoolVar(&opts.WithMembers, "with-members", false, "Include the members of each team")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, teamFields)
+
+	return cmd
+}
+
+func listRun(opts *ListOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	host, _ := cfg.Authentication().DefaultHost()
+
+	listResult, err := listTeams(httpClient, host, opts.Organization, opts.WithMembers, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	if err := opts.IO.StartPager(); err != nil {
+		fmt.Fprintf(opts.IO.ErrOut, "error starting pager: %v\n", err)
+	}
+	defer opts.IO.StopPager()
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, listResult.Teams)
+	}
+
+	if len(listResult.Teams) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no teams found in %s", opts.Organization))
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		header := fmt.Sprintf("Showing %d of %s in %s", len(listResult.Teams), text.Pluralize(listResult.TotalCount, "team"), opts.Organization)
+		fmt.Fprintf(opts.IO.Out, "\n%s\n\n", header)
+	}
+
+	headers := []string{"SLUG", "NAME", "PRIVACY"}
+	if opts.WithMembers {
+		headers = append(headers, "MEMBERS")
+	}
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader(headers...))
+	for _, team := range listResult.Teams {
+		tp.AddField(team.Slug)
+		tp.AddField(team.Name)
+		tp.AddField(team.Privacy)
+		if opts.WithMembers {
+			tp.AddField(strings.Join(team.Members, ", "))
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,235 @@
+package list
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdList(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    ListOptions
+		wantsErr string
+	}{
+		{
+			name: "organization argument",
+			cli:  "my-org",
+			wants: ListOptions{
+				Organization: "my-org",
+				Limit:        30,
+			},
+		},
+		{
+			name: "with members",
+			cli:  "my-org --with-members",
+			wants: ListOptions{
+				Organization: "my-org",
+				WithMembers:  true,
+				Limit:        30,
+			},
+		},
+		{
+			name: "with limit",
+			cli:  "my-org -L 101",
+			wants: ListOptions{
+				Organization: "my-org",
+				Limit:        101,
+			},
+		},
+		{
+			name:     "no arguments",
+			cli:      "",
+			wantsErr: "accepts 1 arg(s), received 0",
+		},
+		{
+			name:     "invalid limit",
+			cli:      "my-org -L 0",
+			wantsErr: "invalid limit: 0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *ListOptions
+			cmd := NewCmdList(f, func(opts *ListOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Organization, gotOpts.Organization)
+			assert.Equal(t, tt.wants.WithMembers, gotOpts.WithMembers)
+			assert.Equal(t, tt.wants.Limit, gotOpts.Limit)
+		})
+	}
+}
+
+func TestListRun(t *testing.T) {
+	teamsPayload := `
+		{ "data": { "organization": { "teams": {
+			"totalCount": 2,
+			"nodes": [
+				{
+					"slug": "platform",
+					"name": "Platform",
+					"description": "Platform team",
+					"privacy": "VISIBLE",
+					"members": { "nodes": [ { "login": "monalisa" }, { "login": "hubot" } ] }
+				},
+				{
+					"slug": "security",
+					"name": "Security",
+					"description": "",
+					"privacy": "SECRET",
+					"members": { "nodes": [ { "login": "octocat" } ] }
+				}
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "abc" }
+		} } } }`
+
+	tests := []struct {
+		name    string
+		opts    ListOptions
+		isTTY   bool
+		wantOut string
+		wantErr string
+	}{
+		{
+			name: "displays teams tty",
+			opts: ListOptions{
+				Organization: "my-org",
+				Limit:        30,
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrganizationTeamList\b`),
+						httpmock.StringResponse(teamsPayload))
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY: true,
+			wantOut: heredoc.Doc(`
+
+				Showing 2 of 2 teams in my-org
+
+				SLUG      NAME      PRIVACY
+				platform  Platform  visible
+				security  Security  secret
+			`),
+		},
+		{
+			name: "displays teams with members tty",
+			opts: ListOptions{
+				Organization: "my-org",
+				WithMembers:  true,
+				Limit:        30,
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrganizationTeamList\b`),
+						httpmock.StringResponse(teamsPayload))
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY: true,
+			wantOut: heredoc.Doc(`
+
+				Showing 2 of 2 teams in my-org
+
+				SLUG      NAME      PRIVACY  MEMBERS
+				platform  Platform  visible  monalisa, hubot
+				security  Security  secret   octocat
+			`),
+		},
+		{
+			name: "displays teams notty",
+			opts: ListOptions{
+				Organization: "my-org",
+				Limit:        30,
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrganizationTeamList\b`),
+						httpmock.StringResponse(teamsPayload))
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY: false,
+			wantOut: heredoc.Doc(`
+				platform	Platform	visible
+				security	Security	secret
+			`),
+		},
+		{
+			name: "no teams found",
+			opts: ListOptions{
+				Organization: "my-org",
+				Limit:        30,
+				HttpClient: func() (*http.Client, error) {
+					r := &httpmock.Registry{}
+					r.Register(
+						httpmock.GraphQL(`query OrganizationTeamList\b`),
+						httpmock.StringResponse(`
+							{ "data": { "organization": { "teams": {
+								"totalCount": 0,
+								"nodes": [],
+								"pageInfo": { "hasNextPage": false, "endCursor": "" }
+							} } } }`))
+					return &http.Client{Transport: r}, nil
+				},
+			},
+			isTTY:   true,
+			wantErr: "no teams found in my-org",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+
+			ios.SetStdoutTTY(tt.isTTY)
+			ios.SetStdinTTY(tt.isTTY)
+			ios.SetStderrTTY(tt.isTTY)
+
+			tt.opts.IO = ios
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+
+			err := listRun(&tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package teams
+
+import (
+	"github.com/MakeNowJust/heredoc"
+	teamListCmd "github.com/cli/cli/v2/pkg/cmd/org/teams/list"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+)
+
+func NewCmdTeams(f *cmdutil.Factory) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "teams <command>",
+		Short: "Work with organization teams",
+		Example: heredoc.Doc(`
+			$ gh org teams list my-org
+		`),
+	}
+
+	cmd.AddCommand(teamListCmd.NewCmdList(f, nil))
+
+	return cmd
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
@@ -32,9 +33,16 @@ type CheckoutOptions struct {
 	RecurseSubmodules bool
 	Force             bool
 	Detach            bool
+	NoBranch          bool
+	Return            bool
 	BranchName        string
 }
 
+// returnBranchConfig is the git configuration key that records which branch
+// was checked out before a `--no-branch` review, so that `--return` can go
+// back to it.
+const returnBranchConfig = "gh.checkout-return-branch"
+
 func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobra.Command {
 	opts := &CheckoutOptions{
 		IO:         f.IOStreams,
@@ -48,6 +56,15 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 	cmd := &cobra.Command{
 		Use:   "checkout [<number> | <url> | <branch>]",
 		Short: "Check out a pull request in git",
+		Long: heredoc.Docf(`
+			Check out a pull request in git.
+
+			When no %[1]s--branch%[1]s is given, the local branch name can come from a template set
+			with the %[1]sgh.branch-name-template%[1]s git configuration key, e.g.
+			%[1]s{type}/{number}-{slug}%[1]s. %[1]s{number}%[1]s is the pull request number, %[1]s{slug}%[1]s is the
+			slugified pull request title, and %[1]s{type}%[1]s is the slugified name of the first
+			label, or %[1]spr%[1]s when the pull request has no labels.
+		`, "`"),
 		Example: heredoc.Doc(`
 			# Interactively select a PR from the 10 most recent to check out
 			$ gh pr checkout
@@ -56,9 +73,33 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 			$ gh pr checkout 32
 			$ gh pr checkout https://github.com/OWNER/REPO/pull/32
 			$ gh pr checkout feature
+
+			# Review a PR with a detached HEAD, without creating a local branch
+			$ gh pr checkout 32 --no-branch
+
+			# Go back to the branch checked out before the review
+			$ gh pr checkout --return
 		`),
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: shared.PRNumberCompletionFunc(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--detach`, `--no-branch`, or `--return`",
+				opts.Detach, opts.NoBranch, opts.Return,
+			); err != nil {
+				return err
+			}
+
+			if opts.Return {
+				if len(args) > 0 {
+					return cmdutil.FlagErrorf("cannot use `--return` with a pull request argument")
+				}
+				if runF != nil {
+					return runF(opts)
+				}
+				return returnRun(opts)
+			}
+
 			if len(args) > 0 {
 				opts.PRResolver = &specificPRResolver{
 					prFinder: shared.NewFinder(f),
@@ -95,6 +136,8 @@ func NewCmdCheckout(f *cmdutil.Factory, runF func(*CheckoutOptions) error) *cobr
 	cmd.Flags().BoolVarP(&opts.RecurseSubmodules, "recurse-submodules", "", false, "Update all submodules after checkout")
 	cmd.Flags().BoolVarP(&opts.Force, "force", "f", false, "Reset the existing local branch to the latest state of the pull request")
 	cmd.Flags().BoolVarP(&opts.Detach, "detach", "", false, "Checkout PR with a detached HEAD")
+	cmd.Flags().BoolVarP(&opts.NoBranch, "no-branch", "", false, "Check out the PR detached for a read-only review, without creating a local branch")
+	cmd.Flags().BoolVarP(&opts.Return, "return", "", false, "Return to the branch that was checked out before `--no-branch`")
 	cmd.Flags().StringVarP(&opts.BranchName, "branch", "b", "", "Local branch name to use (default [the name of the head branch])")
 
 	return cmd
@@ -106,6 +149,20 @@ func checkoutRun(opts *CheckoutOptions) error {
 		return err
 	}
 
+	if opts.BranchName == "" && !opts.Detach && !opts.NoBranch {
+		template, err := shared.BranchNameTemplate(opts.GitClient)
+		if err != nil {
+			return err
+		}
+		if template != "" {
+			name := shared.ExpandBranchNameTemplate(template, "pr", pr.Labels.Names(), pr.Number, pr.Title)
+			if !opts.GitClient.CheckRefFormat(context.Background(), name) {
+				return fmt.Errorf("invalid branch name %q expanded from the configured branch name template", name)
+			}
+			opts.BranchName = name
+		}
+	}
+
 	cfg, err := opts.Config()
 	if err != nil {
 		return err
@@ -151,6 +208,16 @@ func checkoutRun(opts *CheckoutOptions) error {
 		cmdQueue = append(cmdQueue, cmdsForMissingRemote(pr, baseURLOrName, baseRepo.RepoHost(), defaultBranch, protocol, opts)...)
 	}
 
+	var previousBranch string
+	if opts.NoBranch {
+		// Record where to go back to, unless HEAD is already detached from an
+		// earlier review, in which case the original branch stays recorded.
+		if branch, err := opts.Branch(); err == nil && branch != "" {
+			previousBranch = branch
+			cmdQueue = append(cmdQueue, []string{"config", returnBranchConfig, branch})
+		}
+	}
+
 	if opts.RecurseSubmodules {
 		cmdQueue = append(cmdQueue, []string{"submodule", "sync", "--recursive"})
 		cmdQueue = append(cmdQueue, []string{"submodule", "update", "--init", "--recursive"})
@@ -163,6 +230,43 @@ func checkoutRun(opts *CheckoutOptions) error {
 		return err
 	}
 
+	if opts.NoBranch {
+		cs := opts.IO.ColorScheme()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Checked out pull request %s#%d with a detached HEAD for review\n", cs.SuccessIcon(), ghrepo.FullName(baseRepo), pr.Number)
+		if previousBranch != "" {
+			fmt.Fprintf(opts.IO.ErrOut, "Run %s to go back to %s\n", cs.Cyan("gh pr checkout --return"), cs.Cyan(previousBranch))
+		}
+	}
+
+	return nil
+}
+
+func returnRun(opts *CheckoutOptions) error {
+	ctx := context.Background()
+
+	branch, err := opts.GitClient.Config(ctx, returnBranchConfig)
+	if err != nil || branch == "" {
+		return fmt.Errorf("no return branch is recorded; check out a pull request with `gh pr checkout --no-branch` first")
+	}
+
+	checkoutCmd, err := opts.GitClient.Command(ctx, "checkout", branch)
+	if err != nil {
+		return err
+	}
+	if err := checkoutCmd.Run(); err != nil {
+		return err
+	}
+
+	unsetCmd, err := opts.GitClient.Command(ctx, "config", "--unset", returnBranchConfig)
+	if err != nil {
+		return err
+	}
+	if err := unsetCmd.Run(); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	fmt.Fprintf(opts.IO.ErrOut, "%s Returned to branch %s\n", cs.SuccessIcon(), cs.Cyan(branch))
 	return nil
 }
 
@@ -170,22 +274,32 @@ func cmdsForExistingRemote(remote *cliContext.Remote, pr *api.PullRequest, opts
 	var cmds [][]string
 	remoteBranch := fmt.Sprintf("%s/%s", remote.Name, pr.HeadRefName)
 
+	detach := opts.Detach || opts.NoBranch
+
 	refSpec := fmt.Sprintf("+refs/heads/%s", pr.HeadRefName)
-	if !opts.Detach {
+	if !detach {
 		refSpec += fmt.Sprintf(":refs/remotes/%s", remoteBranch)
 	}
 
-	cmds = append(cmds, []string{"fetch", remote.Name, refSpec, "--no-tags"})
-
 	localBranch := pr.HeadRefName
 	if opts.BranchName != "" {
 		localBranch = opts.BranchName
 	}
 
+	branchExists := !detach && localBranchExists(opts.GitClient, localBranch)
+
+	fetchOpts := git.SingleRefFetchOptions()
+	if branchExists {
+		// Negotiating from the stale local branch tip keeps the fetch small on
+		// repositories with many refs.
+		fetchOpts = git.SingleRefFetchOptions(localBranch)
+	}
+	cmds = append(cmds, append([]string{"fetch", remote.Name, refSpec}, fetchOpts.Args()...))
+
 	switch {
-	case opts.Detach:
+	case detach:
 		cmds = append(cmds, []string{"checkout", "--detach", "FETCH_HEAD"})
-	case localBranchExists(opts.GitClient, localBranch):
+	case branchExists:
 		cmds = append(cmds, []string{"checkout", localBranch})
 		if opts.Force {
 			cmds = append(cmds, []string{"reset", "--hard", fmt.Sprintf("refs/remotes/%s", remoteBranch)})
@@ -197,6 +311,10 @@ func cmdsForExistingRemote(remote *cliContext.Remote, pr *api.PullRequest, opts
 		cmds = append(cmds, []string{"checkout", "-b", localBranch, "--track", remoteBranch})
 	}
 
+	if !detach {
+		cmds = append(cmds, prBranchConfigCmds(localBranch, pr)...)
+	}
+
 	return cmds
 }
 
@@ -204,8 +322,8 @@ func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultB
 	var cmds [][]string
 	ref := fmt.Sprintf("refs/pull/%d/head", pr.Number)
 
-	if opts.Detach {
-		cmds = append(cmds, []string{"fetch", baseURLOrName, ref, "--no-tags"})
+	if opts.Detach || opts.NoBranch {
+		cmds = append(cmds, append([]string{"fetch", baseURLOrName, ref}, git.SingleRefFetchOptions().Args()...))
 		cmds = append(cmds, []string{"checkout", "--detach", "FETCH_HEAD"})
 		return cmds
 	}
@@ -221,7 +339,7 @@ func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultB
 	currentBranch, _ := opts.Branch()
 	if localBranch == currentBranch {
 		// PR head matches currently checked out branch
-		cmds = append(cmds, []string{"fetch", baseURLOrName, ref, "--no-tags"})
+		cmds = append(cmds, append([]string{"fetch", baseURLOrName, ref}, git.SingleRefFetchOptions(currentBranch).Args()...))
 		if opts.Force {
 			cmds = append(cmds, []string{"reset", "--hard", "FETCH_HEAD"})
 		} else {
@@ -230,7 +348,7 @@ func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultB
 		}
 	} else {
 		// TODO: check if non-fast-forward and suggest to use `--force`
-		fetchCmd := []string{"fetch", baseURLOrName, fmt.Sprintf("%s:%s", ref, localBranch), "--no-tags"}
+		fetchCmd := append([]string{"fetch", baseURLOrName, fmt.Sprintf("%s:%s", ref, localBranch)}, git.SingleRefFetchOptions().Args()...)
 		if opts.Force {
 			fetchCmd = append(fetchCmd, "--force")
 		}
@@ -254,9 +372,21 @@ func cmdsForMissingRemote(pr *api.PullRequest, baseURLOrName, repoHost, defaultB
 		cmds = append(cmds, []string{"config", fmt.Sprintf("branch.%s.merge", localBranch), mergeRef})
 	}
 
+	cmds = append(cmds, prBranchConfigCmds(localBranch, pr)...)
+
 	return cmds
 }
 
+// prBranchConfigCmds records which pull request a local branch was checked out
+// from, so that `git branch --edit-description`, other tools, and future gh
+// invocations can show the mapping without API calls.
+func prBranchConfigCmds(localBranch string, pr *api.PullRequest) [][]string {
+	return [][]string{
+		{"config", fmt.Sprintf("branch.%s.description", localBranch), fmt.Sprintf("%s\n\n%s\n", pr.Title, pr.URL)},
+		{"config", fmt.Sprintf("branch.%s.gh-pr-number", localBranch), strconv.Itoa(pr.Number)},
+	}
+}
+
 func missingMergeConfigForBranch(client *git.Client, b string) bool {
 	mc, err := client.Config(context.Background(), fmt.Sprintf("branch.%s.merge", b))
 	return err != nil || mc == ""
@@ -303,6 +433,9 @@ func (r *specificPRResolver) Resolve() (*api.PullRequest, ghrepo.Interface, erro
 		Selector: r.selector,
 		Fields: []string{
 			"number",
+			"title",
+			"url",
+			"labels",
 			"headRefName",
 			"headRepository",
 			"headRepositoryOwner",
@@ -333,8 +466,10 @@ func (r *promptingPRResolver) Resolve() (*api.PullRequest, ghrepo.Interface, err
 		Fields: []string{
 			"number",
 			"title",
+			"url",
 			"state",
 			"isDraft",
+			"labels",
 
 			"headRefName",
 			"headRepository",
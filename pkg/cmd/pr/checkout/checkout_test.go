@@ -61,6 +61,30 @@ func TestNewCmdCheckout(t *testing.T) {
 				BranchName: "test-branch",
 			},
 		},
+		{
+			name: "no branch",
+			args: "--no-branch 123",
+			wantsOpts: CheckoutOptions{
+				NoBranch: true,
+			},
+		},
+		{
+			name: "return",
+			args: "--return",
+			wantsOpts: CheckoutOptions{
+				Return: true,
+			},
+		},
+		{
+			name:    "detach and no branch are mutually exclusive",
+			args:    "--detach --no-branch 123",
+			wantErr: cmdutil.FlagErrorf("specify only one of `--detach`, `--no-branch`, or `--return`"),
+		},
+		{
+			name:    "return does not accept a pull request argument",
+			args:    "--return 123",
+			wantErr: cmdutil.FlagErrorf("cannot use `--return` with a pull request argument"),
+		},
 		{
 			name:    "when there is no selector and no TTY, returns an error",
 			args:    "",
@@ -99,6 +123,8 @@ func TestNewCmdCheckout(t *testing.T) {
 			require.Equal(t, tt.wantsOpts.RecurseSubmodules, spiedOpts.RecurseSubmodules)
 			require.Equal(t, tt.wantsOpts.Force, spiedOpts.Force)
 			require.Equal(t, tt.wantsOpts.Detach, spiedOpts.Detach)
+			require.Equal(t, tt.wantsOpts.NoBranch, spiedOpts.NoBranch)
+			require.Equal(t, tt.wantsOpts.Return, spiedOpts.Return)
 			require.Equal(t, tt.wantsOpts.BranchName, spiedOpts.BranchName)
 		})
 	}
@@ -199,9 +225,12 @@ func Test_checkoutRun(t *testing.T) {
 				"origin": "OWNER/REPO",
 			},
 			runStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.branch-name-template`, 1, "")
 				cs.Register(`git show-ref --verify -- refs/heads/feature`, 1, "")
-				cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags`, 0, "")
+				cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags --prune=false`, 0, "")
 				cs.Register(`git checkout -b feature --track origin/feature`, 0, "")
+				cs.Register(`git config branch\.feature\.description`, 0, "")
+				cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 			},
 		},
 		{
@@ -227,9 +256,12 @@ func Test_checkoutRun(t *testing.T) {
 				"origin": "OWNER/REPO",
 			},
 			runStubs: func(cs *run.CommandStubber) {
-				cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags`, 0, "")
+				cs.Register(`git config gh\.branch-name-template`, 1, "")
+				cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags --prune=false`, 0, "")
 				cs.Register(`git config branch\.feature\.merge`, 1, "")
 				cs.Register(`git checkout feature`, 0, "")
+				cs.Register(`git config branch\.feature\.description`, 0, "")
+				cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 				cs.Register(`git config branch\.feature\.remote origin`, 0, "")
 				cs.Register(`git config branch\.feature\.pushRemote origin`, 0, "")
 				cs.Register(`git config branch\.feature\.merge refs/pull/123/head`, 0, "")
@@ -258,8 +290,10 @@ func Test_checkoutRun(t *testing.T) {
 			},
 			runStubs: func(cs *run.CommandStubber) {
 				cs.Register(`git show-ref --verify -- refs/heads/foobar`, 1, "")
-				cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags`, 0, "")
+				cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags --prune=false`, 0, "")
 				cs.Register(`git checkout -b foobar --track origin/feature`, 0, "")
+				cs.Register(`git config branch\.foobar\.description`, 0, "")
+				cs.Register(`git config branch\.foobar\.gh-pr-number 123`, 0, "")
 			},
 		},
 		{
@@ -286,8 +320,10 @@ func Test_checkoutRun(t *testing.T) {
 			},
 			runStubs: func(cs *run.CommandStubber) {
 				cs.Register(`git config branch\.foobar\.merge`, 1, "")
-				cs.Register(`git fetch origin refs/pull/123/head:foobar --no-tags`, 0, "")
+				cs.Register(`git fetch origin refs/pull/123/head:foobar --no-tags --prune=false`, 0, "")
 				cs.Register(`git checkout foobar`, 0, "")
+				cs.Register(`git config branch\.foobar\.description`, 0, "")
+				cs.Register(`git config branch\.foobar\.gh-pr-number 123`, 0, "")
 				cs.Register(`git config branch\.foobar\.remote https://github.com/hubot/REPO.git`, 0, "")
 				cs.Register(`git config branch\.foobar\.pushRemote https://github.com/hubot/REPO.git`, 0, "")
 				cs.Register(`git config branch\.foobar\.merge refs/heads/feature`, 0, "")
@@ -368,7 +404,7 @@ func TestSpecificPRResolver(t *testing.T) {
 
 		baseRepo, pr := stubPR("OWNER/REPO:master", "OWNER/REPO:feature")
 		mockFinder := shared.NewMockFinder("123", pr, baseRepo)
-		mockFinder.ExpectFields([]string{"number", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
+		mockFinder.ExpectFields([]string{"number", "title", "url", "labels", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
 
 		resolver := &specificPRResolver{
 			prFinder: mockFinder,
@@ -411,7 +447,7 @@ func TestPromptingPRResolver(t *testing.T) {
 			PullRequests: []api.PullRequest{
 				*pr1, *pr2, *pr3,
 			}, SearchCapped: false}, nil)
-		lister.ExpectFields([]string{"number", "title", "state", "isDraft", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
+		lister.ExpectFields([]string{"number", "title", "url", "state", "isDraft", "labels", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
 
 		pm := prompter.NewMockPrompter(t)
 		pm.RegisterSelect("Select a pull request",
@@ -519,14 +555,40 @@ func TestPRCheckout_sameRepo(t *testing.T) {
 
 	baseRepo, pr := stubPR("OWNER/REPO", "OWNER/REPO:feature")
 	finder := shared.StubFinderForRunCommandStyleTests(t, "123", pr, baseRepo)
-	finder.ExpectFields([]string{"number", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
+	finder.ExpectFields([]string{"number", "title", "url", "labels", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
 
-	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags`, 0, "")
+	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags --prune=false`, 0, "")
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 1, "")
 	cs.Register(`git checkout -b feature --track origin/feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
+
+	output, err := runCommand(http, nil, "master", `123`, baseRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "", output.Stderr())
+}
+
+func TestPRCheckout_branchNameTemplate(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, pr := stubPR("OWNER/REPO", "OWNER/REPO:feature")
+	shared.StubFinderForRunCommandStyleTests(t, "123", pr, baseRepo)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git config gh\.branch-name-template`, 0, "{type}/{number}-{slug}\n")
+	cs.Register(`git check-ref-format --branch pr/123-pr-title`, 0, "")
+	cs.Register(`git show-ref --verify -- refs/heads/pr/123-pr-title`, 1, "")
+	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags --prune=false`, 0, "")
+	cs.Register(`git checkout -b pr/123-pr-title --track origin/feature`, 0, "")
+	cs.Register(`git config branch\.pr/123-pr-title\.description`, 0, "")
+	cs.Register(`git config branch\.pr/123-pr-title\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123`, baseRepo)
 	assert.NoError(t, err)
@@ -543,9 +605,12 @@ func TestPRCheckout_existingBranch(t *testing.T) {
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags --prune=false --negotiation-tip=feature`, 0, "")
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 0, "")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 	cs.Register(`git merge --ff-only refs/remotes/origin/feature`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123`, baseRepo)
@@ -571,13 +636,16 @@ func TestPRCheckout_differentRepo_remoteExists(t *testing.T) {
 
 	baseRepo, pr := stubPR("OWNER/REPO", "hubot/REPO:feature")
 	finder := shared.StubFinderForRunCommandStyleTests(t, "123", pr, baseRepo)
-	finder.ExpectFields([]string{"number", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
+	finder.ExpectFields([]string{"number", "title", "url", "labels", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch robot-fork \+refs/heads/feature:refs/remotes/robot-fork/feature --no-tags`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch robot-fork \+refs/heads/feature:refs/remotes/robot-fork/feature --no-tags --prune=false`, 0, "")
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 1, "")
 	cs.Register(`git checkout -b feature --track robot-fork/feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, remotes, "master", `123`, baseRepo)
 	assert.NoError(t, err)
@@ -591,13 +659,16 @@ func TestPRCheckout_differentRepo(t *testing.T) {
 
 	baseRepo, pr := stubPR("OWNER/REPO:master", "hubot/REPO:feature")
 	finder := shared.StubFinderForRunCommandStyleTests(t, "123", pr, baseRepo)
-	finder.ExpectFields([]string{"number", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
+	finder.ExpectFields([]string{"number", "title", "url", "labels", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags --prune=false`, 0, "")
 	cs.Register(`git config branch\.feature\.merge`, 1, "")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 	cs.Register(`git config branch\.feature\.remote origin`, 0, "")
 	cs.Register(`git config branch\.feature\.pushRemote origin`, 0, "")
 	cs.Register(`git config branch\.feature\.merge refs/pull/123/head`, 0, "")
@@ -614,13 +685,16 @@ func TestPRCheckout_differentRepoForce(t *testing.T) {
 
 	baseRepo, pr := stubPR("OWNER/REPO:master", "hubot/REPO:feature")
 	finder := shared.StubFinderForRunCommandStyleTests(t, "123", pr, baseRepo)
-	finder.ExpectFields([]string{"number", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
+	finder.ExpectFields([]string{"number", "title", "url", "labels", "headRefName", "headRepository", "headRepositoryOwner", "isCrossRepository", "maintainerCanModify"})
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags --force`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags --prune=false --force`, 0, "")
 	cs.Register(`git config branch\.feature\.merge`, 1, "")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 	cs.Register(`git config branch\.feature\.remote origin`, 0, "")
 	cs.Register(`git config branch\.feature\.pushRemote origin`, 0, "")
 	cs.Register(`git config branch\.feature\.merge refs/pull/123/head`, 0, "")
@@ -640,9 +714,12 @@ func TestPRCheckout_differentRepo_existingBranch(t *testing.T) {
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags --prune=false`, 0, "")
 	cs.Register(`git config branch\.feature\.merge`, 0, "refs/heads/feature\n")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123`, baseRepo)
 	assert.NoError(t, err)
@@ -659,9 +736,12 @@ func TestPRCheckout_detachedHead(t *testing.T) {
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags --prune=false`, 0, "")
 	cs.Register(`git config branch\.feature\.merge`, 0, "refs/heads/feature\n")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "", `123`, baseRepo)
 	assert.NoError(t, err)
@@ -678,9 +758,12 @@ func TestPRCheckout_differentRepo_currentBranch(t *testing.T) {
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch origin refs/pull/123/head --no-tags`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch origin refs/pull/123/head --no-tags --prune=false --negotiation-tip=feature`, 0, "")
 	cs.Register(`git config branch\.feature\.merge`, 0, "refs/heads/feature\n")
 	cs.Register(`git merge --ff-only FETCH_HEAD`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 
 	output, err := runCommand(http, nil, "feature", `123`, baseRepo)
 	assert.NoError(t, err)
@@ -695,8 +778,9 @@ func TestPRCheckout_differentRepo_invalidBranchName(t *testing.T) {
 	baseRepo, pr := stubPR("OWNER/REPO", "hubot/REPO:-foo")
 	shared.StubFinderForRunCommandStyleTests(t, "123", pr, baseRepo)
 
-	_, cmdTeardown := run.Stub()
+	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
 
 	output, err := runCommand(http, nil, "master", `123`, baseRepo)
 
@@ -715,9 +799,12 @@ func TestPRCheckout_maintainerCanModify(t *testing.T) {
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch origin refs/pull/123/head:feature --no-tags --prune=false`, 0, "")
 	cs.Register(`git config branch\.feature\.merge`, 1, "")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 	cs.Register(`git config branch\.feature\.remote https://github\.com/hubot/REPO\.git`, 0, "")
 	cs.Register(`git config branch\.feature\.pushRemote https://github\.com/hubot/REPO\.git`, 0, "")
 	cs.Register(`git config branch\.feature\.merge refs/heads/feature`, 0, "")
@@ -736,9 +823,12 @@ func TestPRCheckout_recurseSubmodules(t *testing.T) {
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags --prune=false --negotiation-tip=feature`, 0, "")
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 0, "")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 	cs.Register(`git merge --ff-only refs/remotes/origin/feature`, 0, "")
 	cs.Register(`git submodule sync --recursive`, 0, "")
 	cs.Register(`git submodule update --init --recursive`, 0, "")
@@ -757,9 +847,12 @@ func TestPRCheckout_force(t *testing.T) {
 
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
-	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags`, 0, "")
+	cs.Register(`git config gh\.branch-name-template`, 1, "")
+	cs.Register(`git fetch origin \+refs/heads/feature:refs/remotes/origin/feature --no-tags --prune=false --negotiation-tip=feature`, 0, "")
 	cs.Register(`git show-ref --verify -- refs/heads/feature`, 0, "")
 	cs.Register(`git checkout feature`, 0, "")
+	cs.Register(`git config branch\.feature\.description`, 0, "")
+	cs.Register(`git config branch\.feature\.gh-pr-number 123`, 0, "")
 	cs.Register(`git reset --hard refs/remotes/origin/feature`, 0, "")
 
 	output, err := runCommand(http, nil, "master", `123 --force`, baseRepo)
@@ -779,10 +872,77 @@ func TestPRCheckout_detach(t *testing.T) {
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
 	cs.Register(`git checkout --detach FETCH_HEAD`, 0, "")
-	cs.Register(`git fetch origin refs/pull/123/head --no-tags`, 0, "")
+	cs.Register(`git fetch origin refs/pull/123/head --no-tags --prune=false`, 0, "")
 
 	output, err := runCommand(http, nil, "", `123 --detach`, baseRepo)
 	assert.NoError(t, err)
 	assert.Equal(t, "", output.String())
 	assert.Equal(t, "", output.Stderr())
 }
+
+func TestPRCheckout_noBranch(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, pr := stubPR("OWNER/REPO", "OWNER/REPO:feature")
+	shared.StubFinderForRunCommandStyleTests(t, "123", pr, baseRepo)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git fetch origin \+refs/heads/feature --no-tags --prune=false`, 0, "")
+	cs.Register(`git checkout --detach FETCH_HEAD`, 0, "")
+	cs.Register(`git config gh\.checkout-return-branch master`, 0, "")
+
+	output, err := runCommand(http, nil, "master", `123 --no-branch`, baseRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Checked out pull request OWNER/REPO#123 with a detached HEAD for review\nRun gh pr checkout --return to go back to master\n", output.Stderr())
+}
+
+func TestPRCheckout_noBranch_alreadyDetached(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	baseRepo, pr := stubPR("OWNER/REPO", "OWNER/REPO:feature")
+	shared.StubFinderForRunCommandStyleTests(t, "123", pr, baseRepo)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git fetch origin \+refs/heads/feature --no-tags --prune=false`, 0, "")
+	cs.Register(`git checkout --detach FETCH_HEAD`, 0, "")
+
+	output, err := runCommand(http, nil, "", `123 --no-branch`, baseRepo)
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Checked out pull request OWNER/REPO#123 with a detached HEAD for review\n", output.Stderr())
+}
+
+func TestPRCheckout_return(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git config gh\.checkout-return-branch`, 0, "master\n")
+	cs.Register(`git checkout master`, 0, "")
+	cs.Register(`git config --unset gh\.checkout-return-branch`, 0, "")
+
+	output, err := runCommand(http, nil, "", `--return`, ghrepo.New("OWNER", "REPO"))
+	assert.NoError(t, err)
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "✓ Returned to branch master\n", output.Stderr())
+}
+
+func TestPRCheckout_return_noRecordedBranch(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+	cs.Register(`git config gh\.checkout-return-branch`, 1, "")
+
+	output, err := runCommand(http, nil, "", `--return`, ghrepo.New("OWNER", "REPO"))
+	assert.EqualError(t, err, "no return branch is recorded; check out a pull request with `gh pr checkout --no-branch` first")
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, "", output.Stderr())
+}
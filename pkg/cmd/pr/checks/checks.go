@@ -1,6 +1,7 @@
 package checks
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -30,6 +31,7 @@ var prCheckFields = []string{
 	"event",
 	"workflow",
 	"description",
+	"isRequired",
 }
 
 type ChecksOptions struct {
@@ -37,6 +39,7 @@ type ChecksOptions struct {
 	IO         *iostreams.IOStreams
 	Browser    browser.Browser
 	Exporter   cmdutil.Exporter
+	Context    context.Context
 
 	Finder   shared.PRFinder
 	Detector fd.Detector
@@ -47,6 +50,7 @@ type ChecksOptions struct {
 	Watch       bool
 	FailFast    bool
 	Required    bool
+	ExitStatus  bool
 }
 
 func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Command {
@@ -55,6 +59,7 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 		HttpClient: f.HttpClient,
 		IO:         f.IOStreams,
 		Browser:    f.Browser,
+		Context:    f.Context,
 		Interval:   defaultInterval,
 	}
 
@@ -70,9 +75,18 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 			When the %[1]s--json%[1]s flag is used, it includes a %[1]sbucket%[1]s field, which categorizes
 			the %[1]sstate%[1]s field into %[1]spass%[1]s, %[1]sfail%[1]s, %[1]spending%[1]s, %[1]sskipping%[1]s, or %[1]scancel%[1]s.
 
+			With %[1]s--exit-status%[1]s, a single machine-readable summary line is printed in
+			place of the checks table. Combine it with %[1]s--required%[1]s to gate scripts on
+			required checks only: the exit code is 0 when all considered checks pass,
+			1 when any fail, and 8 while any are pending.
+
 			Additional exit codes:
 				8: Checks pending
 		`, "`"),
+		Example: heredoc.Doc(`
+			# Gate a deployment script on the required checks of the current branch's PR
+			$ gh pr checks --required --exit-status
+		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Finder = shared.NewFinder(f)
@@ -89,6 +103,18 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("cannot use `--fail-fast` flag without `--watch` flag")
 			}
 
+			if opts.ExitStatus {
+				if opts.Exporter != nil {
+					return cmdutil.FlagErrorf("cannot use `--exit-status` with `--json` flag")
+				}
+				if opts.WebMode {
+					return cmdutil.FlagErrorf("cannot use `--exit-status` with `--web` flag")
+				}
+				if opts.Watch {
+					return cmdutil.FlagErrorf("cannot use `--exit-status` with `--watch` flag")
+				}
+			}
+
 			intervalChanged := cmd.Flags().Changed("interval")
 			if !opts.Watch && intervalChanged {
 				return cmdutil.FlagErrorf("cannot use `--interval` flag without `--watch` flag")
@@ -119,6 +145,7 @@ func NewCmdChecks(f *cmdutil.Factory, runF func(*ChecksOptions) error) *cobra.Co
 	cmd.Flags().BoolVarP(&opts.FailFast, "fail-fast", "", false, "Exit watch mode on first check failure")
 	cmd.Flags().IntVarP(&interval, "interval", "i", 10, "Refresh interval in seconds when using `--watch` flag")
 	cmd.Flags().BoolVar(&opts.Required, "required", false, "Only show checks that are required")
+	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Print a one-line machine-readable summary and reflect the aggregate check state in the exit code")
 
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, prCheckFields)
 
@@ -139,7 +166,7 @@ func checksRunWebMode(opts *ChecksOptions) error {
 	openURL := ghrepo.GenerateRepoURL(baseRepo, "pull/%d/checks", pr.Number)
 
 	if isTerminal {
-		fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+		opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 	}
 
 	return opts.Browser.Browse(openURL)
@@ -181,80 +208,118 @@ func checksRun(opts *ChecksOptions) error {
 		includeEvent = features.CheckRunEvent
 	}
 
-	checks, counts, err = populateStatusChecks(client, repo, pr, opts.Required, includeEvent)
-	if err != nil {
-		return err
-	}
-
-	if opts.Exporter != nil {
-		return opts.Exporter.Write(opts.IO, checks)
-	}
-
 	if opts.Watch {
-		opts.IO.StartAlternateScreenBuffer()
+		checks, counts, err = watchStatusChecks(opts, client, repo, pr, includeEvent)
+		if err != nil {
+			return err
+		}
 	} else {
-		// Only start pager in non-watch mode
-		if err := opts.IO.StartPager(); err == nil {
-			defer opts.IO.StopPager()
+		checks, counts, err = populateStatusChecks(client, repo, pr, opts.Required, includeEvent)
+		if err != nil {
+			return err
+		}
+
+		if opts.Exporter != nil {
+			return opts.Exporter.Write(opts.IO, checks)
+		}
+
+		if opts.ExitStatus {
+			printExitStatusSummary(opts.IO, counts)
 		} else {
-			fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+			if err := opts.IO.StartPager(); err == nil {
+				defer opts.IO.StopPager()
+			} else {
+				fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+			}
+
+			printSummary(opts.IO, counts)
+			if err := printTable(opts.IO, checks); err != nil {
+				return err
+			}
 		}
 	}
 
-	// Do not return err until we can StopAlternateScreenBuffer()
-	for {
-		if counts.Pending != 0 && opts.Watch {
+	if counts.Failed > 0 {
+		return cmdutil.SilentError
+	} else if counts.Pending > 0 {
+		return cmdutil.PendingError
+	}
+
+	return nil
+}
+
+// watchStatusChecks re-renders the checks table on an alternate screen buffer
+// through the shared pull request watcher until no checks are pending, or
+// until the first failure when fail-fast was requested, then prints the final
+// table to the original buffer.
+func watchStatusChecks(opts *ChecksOptions, client *http.Client, repo ghrepo.Interface, pr *api.PullRequest, includeEvent bool) ([]check, checkCounts, error) {
+	var checks []check
+	var counts checkCounts
+
+	watcher := shared.NewWatcher(func() (*api.PullRequest, error) {
+		contexts, err := fetchStatusCheckContexts(client, repo, pr, includeEvent)
+		if err != nil {
+			return nil, err
+		}
+		checks, counts = aggregateChecks(contexts.Nodes, opts.Required)
+		if len(checks) == 0 && opts.Required {
+			return nil, fmt.Errorf("no required checks reported on the '%s' branch", pr.HeadRefName)
+		}
+		snapshot := *pr
+		snapshot.StatusCheckRollup.Nodes = []api.StatusCheckRollupNode{{
+			Commit: api.StatusCheckRollupCommit{
+				StatusCheckRollup: api.CommitStatusCheckRollup{Contexts: contexts},
+			},
+		}}
+		return &snapshot, nil
+	}, opts.Interval)
+
+	opts.IO.StartAlternateScreenBuffer()
+	_, err := watcher.Watch(opts.Context, func(event shared.WatchEvent) error {
+		if counts.Pending != 0 {
 			opts.IO.RefreshScreen()
 			cs := opts.IO.ColorScheme()
 			fmt.Fprintln(opts.IO.Out, cs.Boldf("Refreshing checks status every %v seconds. Press Ctrl+C to quit.\n", opts.Interval.Seconds()))
 		}
 
 		printSummary(opts.IO, counts)
-		err = printTable(opts.IO, checks)
-		if err != nil {
-			break
-		}
-
-		if counts.Pending == 0 || !opts.Watch {
-			break
+		if err := printTable(opts.IO, checks); err != nil {
+			return err
 		}
 
-		if opts.FailFast && counts.Failed > 0 {
-			break
+		if counts.Pending == 0 || (opts.FailFast && counts.Failed > 0) {
+			return shared.ErrStopWatching
 		}
-
-		time.Sleep(opts.Interval)
-
-		checks, counts, err = populateStatusChecks(client, repo, pr, opts.Required, includeEvent)
-		if err != nil {
-			break
-		}
-	}
-
+		return nil
+	})
 	opts.IO.StopAlternateScreenBuffer()
 	if err != nil {
-		return err
+		return checks, counts, err
 	}
 
-	if opts.Watch {
-		// Print final summary to original screen buffer
-		printSummary(opts.IO, counts)
-		err = printTable(opts.IO, checks)
-		if err != nil {
-			return err
-		}
+	// Print final summary to original screen buffer
+	printSummary(opts.IO, counts)
+	if err := printTable(opts.IO, checks); err != nil {
+		return checks, counts, err
 	}
 
-	if counts.Failed > 0 {
-		return cmdutil.SilentError
-	} else if counts.Pending > 0 {
-		return cmdutil.PendingError
+	return checks, counts, nil
+}
+
+func populateStatusChecks(client *http.Client, repo ghrepo.Interface, pr *api.PullRequest, requiredChecks bool, includeEvent bool) ([]check, checkCounts, error) {
+	statusCheckRollup, err := fetchStatusCheckContexts(client, repo, pr, includeEvent)
+	if err != nil {
+		return nil, checkCounts{}, err
 	}
 
-	return nil
+	checks, counts := aggregateChecks(statusCheckRollup.Nodes, requiredChecks)
+	if len(checks) == 0 && requiredChecks {
+		return checks, counts, fmt.Errorf("no required checks reported on the '%s' branch", pr.HeadRefName)
+	}
+	return checks, counts, nil
 }
 
-func populateStatusChecks(client *http.Client, repo ghrepo.Interface, pr *api.PullRequest, requiredChecks bool, includeEvent bool) ([]check, checkCounts, error) {
+func fetchStatusCheckContexts(client *http.Client, repo ghrepo.Interface, pr *api.PullRequest, includeEvent bool) (api.CheckContexts, error) {
 	apiClient := api.NewClientFromHTTP(client)
 
 	type response struct {
@@ -280,11 +345,11 @@ func populateStatusChecks(client *http.Client, repo ghrepo.Interface, pr *api.Pu
 		var resp response
 		err := apiClient.GraphQL(repo.RepoHost(), query, variables, &resp)
 		if err != nil {
-			return nil, checkCounts{}, err
+			return api.CheckContexts{}, err
 		}
 
 		if len(resp.Node.StatusCheckRollup.Nodes) == 0 {
-			return nil, checkCounts{}, errors.New("no commit found on the pull request")
+			return api.CheckContexts{}, errors.New("no commit found on the pull request")
 		}
 
 		result := resp.Node.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts
@@ -300,12 +365,8 @@ func populateStatusChecks(client *http.Client, repo ghrepo.Interface, pr *api.Pu
 	}
 
 	if len(statusCheckRollup.Nodes) == 0 {
-		return nil, checkCounts{}, fmt.Errorf("no checks reported on the '%s' branch", pr.HeadRefName)
+		return api.CheckContexts{}, fmt.Errorf("no checks reported on the '%s' branch", pr.HeadRefName)
 	}
 
-	checks, counts := aggregateChecks(statusCheckRollup.Nodes, requiredChecks)
-	if len(checks) == 0 && requiredChecks {
-		return checks, counts, fmt.Errorf("no required checks reported on the '%s' branch", pr.HeadRefName)
-	}
-	return checks, counts, nil
+	return statusCheckRollup, nil
 }
@@ -91,6 +91,25 @@ func TestNewCmdChecks(t *testing.T) {
 				Interval: time.Duration(10000000000),
 			},
 		},
+		{
+			name: "exit-status flag",
+			cli:  "--required --exit-status",
+			wants: ChecksOptions{
+				Required:   true,
+				ExitStatus: true,
+				Interval:   time.Duration(10000000000),
+			},
+		},
+		{
+			name:       "exit-status with watch flag",
+			cli:        "--exit-status --watch",
+			wantsError: "cannot use `--exit-status` with `--watch` flag",
+		},
+		{
+			name:       "exit-status with web flag",
+			cli:        "--exit-status --web",
+			wantsError: "cannot use `--exit-status` with `--web` flag",
+		},
 	}
 
 	for _, tt := range tests {
@@ -124,6 +143,7 @@ func TestNewCmdChecks(t *testing.T) {
 			assert.Equal(t, tt.wants.Interval, gotOpts.Interval)
 			assert.Equal(t, tt.wants.Required, gotOpts.Required)
 			assert.Equal(t, tt.wants.FailFast, gotOpts.FailFast)
+			assert.Equal(t, tt.wants.ExitStatus, gotOpts.ExitStatus)
 		})
 	}
 }
@@ -135,6 +155,7 @@ func Test_checksRun(t *testing.T) {
 		watch           bool
 		failFast        bool
 		required        bool
+		exitStatus      bool
 		disableDetector bool
 		httpStubs       func(*httpmock.Registry)
 		wantOut         string
@@ -477,6 +498,43 @@ func Test_checksRun(t *testing.T) {
 			wantOut: "",
 			wantErr: "no required checks reported on the 'trunk' branch",
 		},
+		{
+			name:       "exit status summary with failing checks",
+			exitStatus: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/someFailing.json"),
+				)
+			},
+			wantOut: "state=failing failing=1 pending=1 passing=1 skipped=0 cancelled=0\n",
+			wantErr: "SilentError",
+		},
+		{
+			name:       "exit status summary with pending checks",
+			exitStatus: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/somePending.json"),
+				)
+			},
+			wantOut: "state=pending failing=0 pending=1 passing=2 skipped=0 cancelled=1\n",
+			wantErr: "PendingError",
+		},
+		{
+			name:       "exit status summary with passing required checks",
+			required:   true,
+			exitStatus: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestStatusChecks\b`),
+					httpmock.FileResponse("./fixtures/onlyRequired.json"),
+				)
+			},
+			wantOut: "state=passing failing=0 pending=0 passing=1 skipped=0 cancelled=0\n",
+			wantErr: "",
+		},
 		{
 			name: "descriptions tty",
 			tty:  true,
@@ -602,6 +660,7 @@ func Test_checksRun(t *testing.T) {
 				Watch:       tt.watch,
 				FailFast:    tt.failFast,
 				Required:    tt.required,
+				ExitStatus:  tt.exitStatus,
 			}
 
 			err := checksRun(opts)
@@ -91,6 +91,20 @@ func printSummary(io *iostreams.IOStreams, counts checkCounts) {
 	}
 }
 
+// printExitStatusSummary prints the aggregate state of the checks as a single
+// machine-readable line of key=value pairs, for scripts that gate on the exit
+// code and want context without parsing the table.
+func printExitStatusSummary(io *iostreams.IOStreams, counts checkCounts) {
+	state := "passing"
+	if counts.Failed > 0 {
+		state = "failing"
+	} else if counts.Pending > 0 {
+		state = "pending"
+	}
+	fmt.Fprintf(io.Out, "state=%s failing=%d pending=%d passing=%d skipped=%d cancelled=%d\n",
+		state, counts.Failed, counts.Pending, counts.Passed, counts.Skipping, counts.Canceled)
+}
+
 func printTable(io *iostreams.IOStreams, checks []check) error {
 	var headers []string
 	if io.IsStdoutTTY() {
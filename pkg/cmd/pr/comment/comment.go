@@ -12,6 +12,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 	opts := &shared.CommentableOptions{
 		IO:                        f.IOStreams,
 		HttpClient:                f.HttpClient,
+		Config:                    f.Config,
 		EditSurvey:                shared.CommentableEditSurvey(f.Config, f.IOStreams),
 		InteractiveEditSurvey:     shared.CommentableInteractiveEditSurvey(f.Config, f.IOStreams),
 		ConfirmSubmitSurvey:       shared.CommentableConfirmSubmitSurvey(f.Prompter),
@@ -33,6 +34,7 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 		`),
 		Example: heredoc.Doc(`
 			$ gh pr comment 13 --body "Hi from GitHub CLI"
+			$ gh pr comment 13 --hide 1122334455 --reason outdated
 		`),
 		Args: cobra.MaximumNArgs(1),
 		PreRunE: func(cmd *cobra.Command, args []string) error {
@@ -47,6 +49,9 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 			if opts.EditLast || opts.DeleteLast {
 				fields = append(fields, "comments")
 			}
+			if opts.Template != "" {
+				fields = append(fields, "number")
+			}
 			finder := shared.NewFinder(f)
 			opts.RetrieveCommentable = func() (shared.Commentable, ghrepo.Interface, error) {
 				return finder.Find(shared.FindOptions{
@@ -80,6 +85,10 @@ func NewCmdComment(f *cmdutil.Factory, runF func(*shared.CommentableOptions) err
 	cmd.Flags().BoolVar(&opts.DeleteLast, "delete-last", false, "Delete the last comment of the current user")
 	cmd.Flags().BoolVar(&opts.DeleteLastConfirmed, "yes", false, "Skip the delete confirmation prompt when --delete-last is provided")
 	cmd.Flags().BoolVar(&opts.CreateIfNone, "create-if-none", false, "Create a new comment if no comments are found. Can be used only with --edit-last")
+	cmd.Flags().StringVar(&opts.HideComment, "hide", "", "Hide (minimize) the comment with the given `id`")
+	cmd.Flags().StringVar(&opts.UnhideComment, "unhide", "", "Unhide the minimized comment with the given `id`")
+	cmdutil.StringEnumFlag(cmd, &opts.HideReason, "reason", "", "outdated", []string{"abuse", "duplicate", "off-topic", "outdated", "resolved", "spam"}, "Reason for hiding a comment")
+	cmd.Flags().StringVar(&opts.Template, "template", "", "Use the saved reply with the given `name` from configuration as the comment body")
 
 	return cmd
 }
@@ -11,6 +11,8 @@ import (
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -269,6 +271,66 @@ func TestNewCmdComment(t *testing.T) {
 			isTTY:    true,
 			wantsErr: true,
 		},
+		{
+			name:  "hide flag",
+			input: "1 --hide 1122334455 --reason spam",
+			output: shared.CommentableOptions{
+				HideComment: "1122334455",
+				HideReason:  "spam",
+			},
+			isTTY:    false,
+			wantsErr: false,
+		},
+		{
+			name:  "unhide flag",
+			input: "1 --unhide 1122334455",
+			output: shared.CommentableOptions{
+				UnhideComment: "1122334455",
+				HideReason:    "outdated",
+			},
+			isTTY:    false,
+			wantsErr: false,
+		},
+		{
+			name:     "hide and unhide flags",
+			input:    "1 --hide 11 --unhide 22",
+			output:   shared.CommentableOptions{},
+			isTTY:    true,
+			wantsErr: true,
+		},
+		{
+			name:     "reason without hide flag",
+			input:    "1 --reason spam",
+			output:   shared.CommentableOptions{},
+			isTTY:    true,
+			wantsErr: true,
+		},
+		{
+			name:     "hide flag with body flag",
+			input:    "1 --hide 11 --body hi",
+			output:   shared.CommentableOptions{},
+			isTTY:    true,
+			wantsErr: true,
+		},
+		{
+			name:  "template flag",
+			input: "1 --template thanks",
+			output: shared.CommentableOptions{
+				Interactive: false,
+				InputType:   shared.InputTypeInline,
+				Body:        "",
+				Template:    "thanks",
+			},
+			isTTY:    true,
+			wantsErr: false,
+		},
+		{
+			name:     "template and body flags",
+			input:    "1 --template thanks --body test",
+			output:   shared.CommentableOptions{},
+			isTTY:    true,
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -315,6 +377,12 @@ func TestNewCmdComment(t *testing.T) {
 			assert.Equal(t, tt.output.Body, gotOpts.Body)
 			assert.Equal(t, tt.output.DeleteLast, gotOpts.DeleteLast)
 			assert.Equal(t, tt.output.DeleteLastConfirmed, gotOpts.DeleteLastConfirmed)
+			assert.Equal(t, tt.output.HideComment, gotOpts.HideComment)
+			assert.Equal(t, tt.output.UnhideComment, gotOpts.UnhideComment)
+			assert.Equal(t, tt.output.Template, gotOpts.Template)
+			if tt.output.HideComment != "" || tt.output.UnhideComment != "" {
+				assert.Equal(t, tt.output.HideReason, gotOpts.HideReason)
+			}
 		})
 	}
 }
@@ -539,6 +607,47 @@ func Test_commentRun(t *testing.T) {
 			},
 			stdout: "https://github.com/OWNER/REPO/pull/123#issuecomment-456\n",
 		},
+		{
+			name: "creating new comment from saved reply template",
+			input: &shared.CommentableOptions{
+				Interactive: false,
+				InputType:   shared.InputTypeInline,
+				Template:    "thanks",
+
+				Config: func() (gh.Config, error) {
+					return config.NewFromString("saved_replies:\n  thanks: \"Thanks! Tracking in {{.repo.fullName}}#{{.issue.number}} -- {{.user}}\"\n"), nil
+				},
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query UserCurrent\b`),
+					httpmock.StringResponse(`{"data": {"viewer": {"login": "monalisa"}}}`))
+				reg.Register(
+					httpmock.GraphQL(`mutation CommentCreate\b`),
+					httpmock.GraphQLMutation(`
+					{ "data": { "addComment": { "commentEdge": { "node": {
+						"url": "https://github.com/OWNER/REPO/pull/123#issuecomment-456"
+					} } } } }`,
+						func(inputs map[string]interface{}) {
+							assert.Equal(t, "Thanks! Tracking in OWNER/REPO#123 -- monalisa", inputs["body"])
+						}),
+				)
+			},
+			stdout: "https://github.com/OWNER/REPO/pull/123#issuecomment-456\n",
+		},
+		{
+			name: "creating new comment from unknown saved reply fails",
+			input: &shared.CommentableOptions{
+				Interactive: false,
+				InputType:   shared.InputTypeInline,
+				Template:    "thanks",
+
+				Config: func() (gh.Config, error) {
+					return config.NewFromString("saved_replies:\n  lgtm: \"Looks good to me!\"\n"), nil
+				},
+			},
+			wantsErr: true,
+		},
 		{
 			name: "deleting last comment non-interactively without any comment",
 			input: &shared.CommentableOptions{
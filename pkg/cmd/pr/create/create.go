@@ -63,11 +63,12 @@ type CreateOptions struct {
 	BaseBranch string
 	HeadBranch string
 
-	Reviewers []string
-	Assignees []string
-	Labels    []string
-	Projects  []string
-	Milestone string
+	Reviewers        []string
+	SuggestReviewers bool
+	Assignees        []string
+	Labels           []string
+	Projects         []string
+	Milestone        string
 
 	MaintainerCanModify bool
 	Template            string
@@ -265,6 +266,14 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("the `--reviewer` flag is not supported with `--web`")
 			}
 
+			if opts.SuggestReviewers && opts.WebMode {
+				return cmdutil.FlagErrorf("the `--suggest-reviewers` flag is not supported with `--web`")
+			}
+
+			if !opts.IO.CanPrompt() && opts.SuggestReviewers {
+				return cmdutil.FlagErrorf("`--suggest-reviewers` only supported when running interactively")
+			}
+
 			if cmd.Flags().Changed("no-maintainer-edit") && opts.WebMode {
 				return cmdutil.FlagErrorf("the `--no-maintainer-edit` flag is not supported with `--web`")
 			}
@@ -337,6 +346,7 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	fl.BoolVarP(&opts.Autofill, "fill", "f", false, "Use commit info for title and body")
 	fl.BoolVar(&opts.FillFirst, "fill-first", false, "Use first commit info for title and body")
 	fl.StringSliceVarP(&opts.Reviewers, "reviewer", "r", nil, "Request reviews from people or teams by their `handle`")
+	fl.BoolVar(&opts.SuggestReviewers, "suggest-reviewers", false, "Interactively pick reviewers suggested from CODEOWNERS and recent review activity")
 	fl.StringSliceVarP(&opts.Assignees, "assignee", "a", nil, "Assign people by their `login`. Use \"@me\" to self-assign.")
 	fl.StringSliceVarP(&opts.Labels, "label", "l", nil, "Add labels by `name`")
 	fl.StringSliceVarP(&opts.Projects, "project", "p", nil, "Add the pull request to projects by `title`")
@@ -451,6 +461,12 @@ func createRun(opts *CreateOptions) error {
 			ghrepo.FullName(ctx.PRRefs.BaseRepo()))
 	}
 
+	if opts.SuggestReviewers {
+		if err := suggestReviewers(opts, ctx, state); err != nil {
+			return err
+		}
+	}
+
 	if !opts.EditorMode && (opts.FillVerbose || opts.Autofill || opts.FillFirst || (opts.TitleProvided && opts.BodyProvided)) {
 		err = handlePush(*opts, *ctx)
 		if err != nil {
@@ -480,9 +496,7 @@ func createRun(opts *CreateOptions) error {
 			if err != nil {
 				return err
 			}
-			if state.Title == "" {
-				state.Title = template.Title()
-			}
+			shared.ApplyTemplateMetadata(state, template)
 			state.Body = string(template.Body())
 		}
 
@@ -524,6 +538,7 @@ func createRun(opts *CreateOptions) error {
 
 				if template != nil {
 					templateContent = string(template.Body())
+					shared.ApplyTemplateMetadata(state, template)
 				}
 			}
 
@@ -1102,7 +1117,7 @@ func renderPullRequestTTY(io *iostreams.IOStreams, params map[string]interface{}
 
 func previewPR(opts CreateOptions, openURL string) error {
 	if opts.IO.IsStdinTTY() && opts.IO.IsStdoutTTY() {
-		fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+		opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 	}
 	return opts.Browser.Browse(openURL)
 }
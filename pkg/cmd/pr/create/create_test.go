@@ -986,7 +986,7 @@ func Test_createRun(t *testing.T) {
 					}))
 			},
 			cmdStubs: func(cs *run.CommandStubber) {
-				cs.Register(`git( .+)? log( .+)? origin/master\.\.\.feature`, 0, "d3476a1\u0000commit 0\u0000\u0000\n7a6ea13\u0000commit 1\u0000\u0000")
+				cs.Register(`git( .+)? log( .+)? origin/master\.\.\.feature`, 0, "d3476a1\u0000commit 0\u0000Octo Cat\u0000octo@github.com\u0000\u0000\n7a6ea13\u0000commit 1\u0000Octo Cat\u0000octo@github.com\u0000\u0000")
 			},
 			promptStubs: func(pm *prompter.PrompterMock) {
 				pm.MarkdownEditorFunc = func(p, d string, ba bool) (string, error) {
@@ -1212,7 +1212,7 @@ func Test_createRun(t *testing.T) {
 					}))
 			},
 			cmdStubs: func(cs *run.CommandStubber) {
-				cs.Register(`git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry origin/master...feature`, 0, "")
+				cs.Register(`git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry origin/master...feature`, 0, "")
 				cs.Register(`git rev-parse --show-toplevel`, 0, "")
 			},
 			promptStubs: func(pm *prompter.PrompterMock) {
@@ -1316,6 +1316,93 @@ func Test_createRun(t *testing.T) {
 			expectedOut:    "https://github.com/OWNER/REPO/pull/12\n",
 			expectedErrOut: "\nCreating pull request for feature into master in OWNER/REPO\n\n",
 		},
+		{
+			name: "suggest reviewers",
+			tty:  true,
+			setup: func(opts *CreateOptions, t *testing.T) func() {
+				opts.TitleProvided = true
+				opts.BodyProvided = true
+				opts.Title = "my title"
+				opts.Body = "my body"
+				opts.HeadBranch = "feature"
+				opts.SuggestReviewers = true
+				return func() {}
+			},
+			cmdStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git diff --name-only origin/master\.\.\.feature`, 0, "internal/api/client.go\ndocs/readme.md\n")
+				cs.Register(`git show HEAD:\.github/CODEOWNERS`, 0, "internal/api/ @monalisa\n*.md @OWNER/docs octocat@example.com\n")
+			},
+			promptStubs: func(pm *prompter.PrompterMock) {
+				pm.MultiSelectFunc = func(p string, defaults, opts []string) ([]int, error) {
+					if p != "Suggested reviewers" {
+						return nil, prompter.NoSuchPromptErr(p)
+					}
+					if len(defaults) != 2 || defaults[0] != "monalisa" || defaults[1] != "OWNER/docs" {
+						return nil, fmt.Errorf("unexpected pre-checked reviewers: %v", defaults)
+					}
+					monalisa, err := prompter.IndexFor(opts, "monalisa")
+					if err != nil {
+						return nil, err
+					}
+					hubot, err := prompter.IndexFor(opts, "hubot")
+					if err != nil {
+						return nil, err
+					}
+					return []int{monalisa, hubot}, nil
+				}
+			},
+			httpStubs: func(reg *httpmock.Registry, t *testing.T) {
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestRecentReviewers\b`),
+					httpmock.StringResponse(`
+				{ "data": {
+					"viewer": { "login": "OWNER" },
+					"repository": { "pullRequests": { "nodes": [
+						{ "reviews": { "nodes": [
+							{ "author": { "login": "hubot" } },
+							{ "author": { "login": "OWNER" } }
+						] } },
+						{ "reviews": { "nodes": [
+							{ "author": { "login": "monalisa" } }
+						] } }
+					] } }
+				} }
+				`))
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryResolveMetadataIDs\b`),
+					httpmock.StringResponse(`
+				{ "data": {
+					"u000": { "login": "monalisa", "id": "MONAID" },
+					"u001": { "login": "hubot", "id": "HUBOTID" },
+					"repository": {},
+					"organization": {}
+				} }
+				`))
+				reg.Register(
+					httpmock.GraphQL(`mutation PullRequestCreate\b`),
+					httpmock.GraphQLMutation(`
+				{ "data": { "createPullRequest": { "pullRequest": {
+					"id": "NEWPULLID",
+					"URL": "https://github.com/OWNER/REPO/pull/12"
+				} } } }
+				`, func(input map[string]interface{}) {
+						assert.Equal(t, "my title", input["title"].(string))
+						assert.Equal(t, "my body", input["body"].(string))
+					}))
+				reg.Register(
+					httpmock.GraphQL(`mutation PullRequestCreateRequestReviews\b`),
+					httpmock.GraphQLMutation(`
+				{ "data": { "requestReviews": {
+					"clientMutationId": ""
+				} } }
+			`, func(inputs map[string]interface{}) {
+						assert.Equal(t, "NEWPULLID", inputs["pullRequestId"])
+						assert.Equal(t, []interface{}{"MONAID", "HUBOTID"}, inputs["userIds"])
+					}))
+			},
+			expectedOut:    "https://github.com/OWNER/REPO/pull/12\n",
+			expectedErrOut: "\nCreating pull request for feature into master in OWNER/REPO\n\n",
+		},
 		{
 			name: "web long URL",
 			cmdStubs: func(cs *run.CommandStubber) {
@@ -1340,9 +1427,9 @@ func Test_createRun(t *testing.T) {
 			},
 			cmdStubs: func(cs *run.CommandStubber) {
 				cs.Register(
-					"git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry origin/master...feature",
+					"git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry origin/master...feature",
 					0,
-					"3a9b48085046d156c5acce8f3b3a0532cd706a4a\u0000first commit of pr\u0000first commit description\u0000\n",
+					"3a9b48085046d156c5acce8f3b3a0532cd706a4a\u0000first commit of pr\u0000Octo Cat\u0000octo@github.com\u0000first commit description\u0000\n",
 				)
 				cs.Register(`git rev-parse --show-toplevel`, 0, "")
 			},
@@ -1415,10 +1502,10 @@ func Test_createRun(t *testing.T) {
 			},
 			cmdStubs: func(cs *run.CommandStubber) {
 				cs.Register(
-					"git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry origin/master...feature",
+					"git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry origin/master...feature",
 					0,
-					"56b6f8bb7c9e3a30093cd17e48934ce354148e80\u0000second commit of pr\u0000\u0000\n"+
-						"3a9b48085046d156c5acce8f3b3a0532cd706a4a\u0000first commit of pr\u0000first commit description\u0000\n",
+					"56b6f8bb7c9e3a30093cd17e48934ce354148e80\u0000second commit of pr\u0000Octo Cat\u0000octo@github.com\u0000\u0000\n"+
+						"3a9b48085046d156c5acce8f3b3a0532cd706a4a\u0000first commit of pr\u0000Octo Cat\u0000octo@github.com\u0000first commit description\u0000\n",
 				)
 			},
 			httpStubs: func(reg *httpmock.Registry, t *testing.T) {
@@ -1451,10 +1538,10 @@ func Test_createRun(t *testing.T) {
 			},
 			cmdStubs: func(cs *run.CommandStubber) {
 				cs.Register(
-					"git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry origin/master...feature",
+					"git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry origin/master...feature",
 					0,
-					"56b6f8bb7c9e3a30093cd17e48934ce354148e80\u0000second commit of pr\u0000second commit description\u0000\n"+
-						"3a9b48085046d156c5acce8f3b3a0532cd706a4a\u0000first commit of pr\u0000first commit with super long description, with super long description, with super long description, with super long description.\u0000\n",
+					"56b6f8bb7c9e3a30093cd17e48934ce354148e80\u0000second commit of pr\u0000Octo Cat\u0000octo@github.com\u0000second commit description\u0000\n"+
+						"3a9b48085046d156c5acce8f3b3a0532cd706a4a\u0000first commit of pr\u0000Octo Cat\u0000octo@github.com\u0000first commit with super long description, with super long description, with super long description, with super long description.\u0000\n",
 				)
 			},
 			httpStubs: func(reg *httpmock.Registry, t *testing.T) {
@@ -1500,7 +1587,7 @@ func Test_createRun(t *testing.T) {
 				return func() {}
 			},
 			cmdStubs: func(cs *run.CommandStubber) {
-				cs.Register("git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry origin/master...feature", 0, "")
+				cs.Register("git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry origin/master...feature", 0, "")
 			},
 			expectedOut: "https://github.com/OWNER/REPO/pull/12\n",
 		},
@@ -2258,7 +2345,7 @@ func TestProjectsV1Deprecation(t *testing.T) {
 			defer cmdTeardown(t)
 
 			cs.Register(`git config --get-regexp \^branch\\\..+\\\.\(remote\|merge\|pushremote\|gh-merge-base\)\$`, 0, "")
-			cs.Register("git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry origin/master...feature", 0, "")
+			cs.Register("git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry origin/master...feature", 0, "")
 			cs.Register(`git rev-parse --show-toplevel`, 0, "")
 
 			// When the command is run
@@ -2366,7 +2453,7 @@ func TestProjectsV1Deprecation(t *testing.T) {
 			defer cmdTeardown(t)
 
 			cs.Register(`git config --get-regexp \^branch\\\..+\\\.\(remote\|merge\|pushremote\|gh-merge-base\)\$`, 0, "")
-			cs.Register("git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%b%x00 --cherry origin/master...feature", 0, "")
+			cs.Register("git -c log.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry origin/master...feature", 0, "")
 			cs.Register(`git rev-parse --show-toplevel`, 0, "")
 
 			// When the command is run
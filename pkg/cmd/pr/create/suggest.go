@@ -0,0 +1,143 @@
+package create
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+)
+
+// suggestReviewers offers a pre-checked multi-select of reviewer candidates:
+// owners of the changed files according to CODEOWNERS are checked by default,
+// and reviewers of recently merged pull requests round out the list.
+func suggestReviewers(opts *CreateOptions, ctx *CreateContext, state *shared.IssueMetadataState) error {
+	gitCtx := context.Background()
+
+	changedFiles, err := opts.GitClient.ChangedFiles(gitCtx, ctx.BaseTrackingBranch, ctx.PRRefs.UnqualifiedHeadRef())
+	if err != nil {
+		return fmt.Errorf("could not determine changed files: %w", err)
+	}
+
+	var codeowners *shared.Codeowners
+	for _, location := range shared.CodeownersLocations {
+		content, err := opts.GitClient.ReadFileAtRef(gitCtx, "HEAD", location)
+		if err != nil {
+			continue
+		}
+		codeowners = shared.ParseCodeowners(content)
+		break
+	}
+
+	recent, viewer, err := recentReviewers(ctx.Client, ctx.PRRefs.BaseRepo())
+	if err != nil {
+		return fmt.Errorf("could not fetch recent reviewers: %w", err)
+	}
+
+	seen := map[string]bool{strings.ToLower(viewer): true}
+	for _, r := range state.Reviewers {
+		seen[strings.ToLower(r)] = true
+	}
+	var candidates, preChecked []string
+	add := func(handle string, checked bool) {
+		if seen[strings.ToLower(handle)] {
+			return
+		}
+		seen[strings.ToLower(handle)] = true
+		candidates = append(candidates, handle)
+		if checked {
+			preChecked = append(preChecked, handle)
+		}
+	}
+
+	if codeowners != nil {
+		for _, file := range changedFiles {
+			for _, owner := range codeowners.Owners(file) {
+				// email addresses cannot be requested as reviewers
+				if handle, found := strings.CutPrefix(owner, "@"); found {
+					add(handle, true)
+				}
+			}
+		}
+	}
+	for _, login := range recent {
+		add(login, false)
+	}
+
+	if len(candidates) == 0 {
+		fmt.Fprintln(opts.IO.ErrOut, "No reviewer suggestions found")
+		return nil
+	}
+
+	selected, err := opts.Prompter.MultiSelect("Suggested reviewers", preChecked, candidates)
+	if err != nil {
+		return err
+	}
+	for _, i := range selected {
+		state.Reviewers = append(state.Reviewers, candidates[i])
+	}
+	return nil
+}
+
+// recentReviewers returns the logins of users who reviewed recently merged
+// pull requests in repo, most recent first, along with the viewer's login.
+func recentReviewers(client *api.Client, repo ghrepo.Interface) ([]string, string, error) {
+	var response struct {
+		Viewer struct {
+			Login string
+		}
+		Repository struct {
+			PullRequests struct {
+				Nodes []struct {
+					Reviews struct {
+						Nodes []struct {
+							Author struct {
+								Login string
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+
+	query := `query PullRequestRecentReviewers($owner: String!, $repo: String!) {
+		viewer { login }
+		repository(owner: $owner, name: $repo) {
+			pullRequests(states: MERGED, first: 25, orderBy: {field: UPDATED_AT, direction: DESC}) {
+				nodes {
+					reviews(first: 10) {
+						nodes {
+							author { login }
+						}
+					}
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+	}
+
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &response); err != nil {
+		return nil, "", err
+	}
+
+	seen := map[string]bool{}
+	var logins []string
+	for _, pr := range response.Repository.PullRequests.Nodes {
+		for _, review := range pr.Reviews.Nodes {
+			login := review.Author.Login
+			if login == "" || seen[strings.ToLower(login)] {
+				continue
+			}
+			seen[strings.ToLower(login)] = true
+			logins = append(logins, login)
+		}
+	}
+	return logins, response.Viewer.Login, nil
+}
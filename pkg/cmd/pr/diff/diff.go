@@ -114,7 +114,7 @@ func diffRun(opts *DiffOptions) error {
 	if opts.BrowserMode {
 		openUrl := fmt.Sprintf("%s/files", pr.URL)
 		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openUrl))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openUrl))
 		}
 		return opts.Browser.Browse(openUrl)
 	}
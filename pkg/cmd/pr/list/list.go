@@ -10,6 +10,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/tableprinter"
 	"github.com/cli/cli/v2/internal/text"
@@ -21,12 +22,14 @@ import (
 
 type ListOptions struct {
 	HttpClient func() (*http.Client, error)
+	Config     func() (gh.Config, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
 	Browser    browser.Browser
 
 	WebMode      bool
 	LimitResults int
+	DateFormat   string
 	Exporter     cmdutil.Exporter
 
 	State      string
@@ -45,6 +48,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	opts := &ListOptions{
 		IO:         f.IOStreams,
 		HttpClient: f.HttpClient,
+		Config:     f.Config,
 		Browser:    f.Browser,
 		Now:        time.Now,
 	}
@@ -109,9 +113,11 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.Assignee, "assignee", "a", "", "Filter by assignee")
 	cmd.Flags().StringVarP(&opts.Search, "search", "S", "", "Search pull requests with `query`")
 	cmdutil.NilBoolFlag(cmd, &opts.Draft, "draft", "d", "Filter by draft state")
+	cmdutil.StringEnumFlag(cmd, &opts.DateFormat, "date-format", "", "", []string{"relative", "iso", "local"}, "Format for timestamp columns")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "base", "head")
+	_ = shared.RegisterLabelCompletionFlags(f, cmd, "label")
 
 	return cmd
 }
@@ -167,7 +173,7 @@ func listRun(opts *ListOptions) error {
 		}
 
 		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 		}
 		return opts.Browser.Browse(openURL)
 	}
@@ -211,7 +217,23 @@ func listRun(opts *ListOptions) error {
 	}
 	headers = append(headers, "CREATED AT")
 
-	table := tableprinter.New(opts.IO, tableprinter.WithHeader(headers...))
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	location, err := tableprinter.TimeLocationFromConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	table := tableprinter.New(opts.IO, tableprinter.WithHeader(headers...),
+		tableprinter.ColumnPolicy{MinWidth: 6},
+		tableprinter.ColumnPolicy{MinWidth: 20, Priority: 1},
+		tableprinter.ColumnPolicy{MinWidth: 10, Priority: 2},
+		tableprinter.ColumnPolicy{MinWidth: 8},
+	)
+	table.SetTimeFormat(tableprinter.TimeFormat(opts.DateFormat))
+	table.SetTimeLocation(location)
 	for _, pr := range listResult.PullRequests {
 		prNum := strconv.Itoa(pr.Number)
 		if isTTY {
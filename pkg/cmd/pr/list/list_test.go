@@ -11,6 +11,8 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/browser"
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/run"
 	prShared "github.com/cli/cli/v2/pkg/cmd/pr/shared"
@@ -36,6 +38,9 @@ func runCommand(rt http.RoundTripper, isTTY bool, cli string) (*test.CmdOut, err
 		HttpClient: func() (*http.Client, error) {
 			return &http.Client{Transport: rt}, nil
 		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
 		BaseRepo: func() (ghrepo.Interface, error) {
 			return ghrepo.New("OWNER", "REPO"), nil
 		},
@@ -95,6 +100,29 @@ func TestPRList(t *testing.T) {
 	assert.Equal(t, ``, output.Stderr())
 }
 
+func TestPRList_dateFormatISO(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	http.Register(httpmock.GraphQL(`query PullRequestList\b`), httpmock.FileResponse("./fixtures/prList.json"))
+
+	output, err := runCommand(http, true, "--date-format iso")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, heredoc.Doc(`
+
+		Showing 3 of 3 open pull requests in OWNER/REPO
+
+		ID   TITLE                  BRANCH         CREATED AT
+		#32  New feature            feature        2022-08-24T20:01:12Z
+		#29  Fixed bad bug          hubot:bug-fix  2022-07-20T19:01:12Z
+		#28  Improve documentation  docs           2020-01-26T19:01:12Z
+	`), output.String())
+	assert.Equal(t, ``, output.Stderr())
+}
+
 func TestPRList_nontty(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
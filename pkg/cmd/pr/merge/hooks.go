@@ -0,0 +1,164 @@
+package merge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// PostMergeHookConfig is the multi-valued git configuration key that lists the
+// post-merge hooks for a repository.
+const PostMergeHookConfig = "gh.pr-merge-hook"
+
+type postMergeHookKind string
+
+const (
+	hookDeleteBranch postMergeHookKind = "delete-branch"
+	hookWorkflow     postMergeHookKind = "workflow"
+	hookComment      postMergeHookKind = "comment"
+)
+
+type postMergeHook struct {
+	kind postMergeHookKind
+	arg  string
+}
+
+// parsePostMergeHook parses one configured hook value. Supported forms are
+// "delete-branch", "workflow:<file>", and "comment:<template>".
+func parsePostMergeHook(value string) (postMergeHook, error) {
+	kind, arg, _ := strings.Cut(value, ":")
+	switch postMergeHookKind(kind) {
+	case hookDeleteBranch:
+		if arg != "" {
+			return postMergeHook{}, fmt.Errorf("invalid post-merge hook %q: delete-branch takes no argument", value)
+		}
+		return postMergeHook{kind: hookDeleteBranch}, nil
+	case hookWorkflow:
+		if arg == "" {
+			return postMergeHook{}, fmt.Errorf("invalid post-merge hook %q: expected workflow:<file>", value)
+		}
+		return postMergeHook{kind: hookWorkflow, arg: arg}, nil
+	case hookComment:
+		if arg == "" {
+			return postMergeHook{}, fmt.Errorf("invalid post-merge hook %q: expected comment:<template>", value)
+		}
+		return postMergeHook{kind: hookComment, arg: arg}, nil
+	default:
+		return postMergeHook{}, fmt.Errorf("invalid post-merge hook %q: unknown action %q", value, kind)
+	}
+}
+
+// Load the post-merge hooks configured for the repository. A delete-branch
+// hook feeds the regular branch deletion steps; the remaining hooks are kept
+// for runPostMergeHooks.
+func (m *mergeContext) loadPostMergeHooks() error {
+	// Hooks come from the git config of the local clone, so they do not apply
+	// when --repo points the command somewhere else. They also only fire for a
+	// merge performed by this invocation.
+	if m.opts.SkipHooks || !m.opts.CanDeleteLocalBranch || m.autoMerge || m.merged || m.shouldAddToMergeQueue() {
+		return nil
+	}
+
+	values, err := m.opts.GitClient.ConfigAll(context.Background(), PostMergeHookConfig)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		hook, err := parsePostMergeHook(value)
+		if err != nil {
+			return err
+		}
+		if hook.kind == hookDeleteBranch {
+			// the user's own preference, from the flag or the survey, wins
+			if !m.opts.IsDeleteBranchIndicated && !m.deleteBranchSurveyed {
+				m.deleteBranch = true
+			}
+			continue
+		}
+		m.hooks = append(m.hooks, hook)
+	}
+	return nil
+}
+
+// Run the post-merge hooks that were loaded for the repository.
+func (m *mergeContext) runPostMergeHooks() error {
+	if len(m.hooks) == 0 {
+		return nil
+	}
+
+	apiClient := api.NewClientFromHTTP(m.httpClient)
+	failed := 0
+	for _, hook := range m.hooks {
+		var err error
+		switch hook.kind {
+		case hookWorkflow:
+			err = m.dispatchWorkflowHook(apiClient, hook.arg)
+		case hookComment:
+			err = m.commentHook(apiClient, hook.arg)
+		}
+		if err != nil {
+			failed++
+			_ = m.warnf("%s Post-merge hook %s:%s failed: %v\n", m.cs.FailureIcon(), hook.kind, hook.arg, err)
+		}
+	}
+	if failed > 0 {
+		return cmdutil.SilentError
+	}
+	return nil
+}
+
+// dispatchWorkflowHook triggers a workflow_dispatch event for the given
+// workflow file on the base branch of the merged pull request.
+func (m *mergeContext) dispatchWorkflowHook(apiClient *api.Client, workflow string) error {
+	path := fmt.Sprintf("repos/%s/actions/workflows/%s/dispatches",
+		ghrepo.FullName(m.baseRepo), url.PathEscape(workflow))
+
+	requestByte, err := json.Marshal(map[string]interface{}{
+		"ref": m.pr.BaseRefName,
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := apiClient.REST(m.baseRepo.RepoHost(), "POST", path, bytes.NewReader(requestByte), nil); err != nil {
+		return err
+	}
+
+	return m.infof("%s Post-merge hook dispatched workflow %s on branch %s\n", m.cs.SuccessIconWithColor(m.cs.Green), m.cs.Cyan(workflow), m.cs.Cyan(m.pr.BaseRefName))
+}
+
+// commentHook leaves a comment on the merged pull request, expanding any
+// placeholders in the configured template.
+func (m *mergeContext) commentHook(apiClient *api.Client, template string) error {
+	body := expandHookTemplate(template, m.pr, m.baseRepo)
+	if _, err := api.CommentCreate(apiClient, m.baseRepo.RepoHost(), api.CommentCreateInput{
+		Body:      body,
+		SubjectId: m.pr.ID,
+	}); err != nil {
+		return err
+	}
+
+	return m.infof("%s Post-merge hook commented on pull request %s#%d\n", m.cs.SuccessIconWithColor(m.cs.Green), ghrepo.FullName(m.baseRepo), m.pr.Number)
+}
+
+// expandHookTemplate substitutes pull request placeholders in a comment hook
+// template.
+func expandHookTemplate(template string, pr *api.PullRequest, repo ghrepo.Interface) string {
+	r := strings.NewReplacer(
+		"{number}", strconv.Itoa(pr.Number),
+		"{title}", pr.Title,
+		"{base}", pr.BaseRefName,
+		"{head}", pr.HeadRefName,
+		"{url}", ghrepo.GenerateRepoURL(repo, "pull/%d", pr.Number),
+	)
+	return r.Replace(template)
+}
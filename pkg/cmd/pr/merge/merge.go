@@ -42,16 +42,18 @@ type MergeOptions struct {
 
 	AuthorEmail string
 
-	Body    string
-	BodySet bool
-	Subject string
-	Editor  editor
+	Body                string
+	BodySet             bool
+	Subject             string
+	MessageTemplateFile string
+	Editor              editor
 
 	UseAdmin                bool
 	IsDeleteBranchIndicated bool
 	CanDeleteLocalBranch    bool
 	MergeStrategyEmpty      bool
 	MatchHeadCommit         string
+	SkipHooks               bool
 }
 
 // ErrAlreadyInMergeQueue indicates that the pull request is already in a merge queue
@@ -88,8 +90,25 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 			If required checks have not yet passed, auto-merge will be enabled.
 			If required checks have passed, the pull request will be added to the merge queue.
 			To bypass a merge queue and merge directly, pass the %[1]s--admin%[1]s flag.
+
+			A repository can define post-merge hooks with the multi-valued
+			%[1]sgh.pr-merge-hook%[1]s git configuration key. Supported hooks are
+			%[1]sdelete-branch%[1]s, %[1]sworkflow:<file>%[1]s to dispatch a workflow on the base
+			branch, and %[1]scomment:<template>%[1]s to comment on the pull request, where
+			the template may reference %[1]s{number}%[1]s, %[1]s{title}%[1]s, %[1]s{base}%[1]s, %[1]s{head}%[1]s,
+			and %[1]s{url}%[1]s. Pass %[1]s--no-hooks%[1]s to skip them.
+
+			The commit message for a squash merge can come from a template: the
+			%[1]sgh.squash-message-template%[1]s git configuration key names a file whose
+			expanded contents provide the commit subject (first line) and body. The
+			template may reference %[1]s{number}%[1]s, %[1]s{title}%[1]s, %[1]s{authors}%[1]s, and
+			%[1]s{co-authored-by}%[1]s, the last two derived from the commits being
+			squashed. Pass %[1]s--message-template%[1]s to use a different template for
+			one merge; explicit %[1]s--subject%[1]s and %[1]s--body%[1]s values win over the
+			template.
 		`, "`"),
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: shared.PRNumberCompletionFunc(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Finder = shared.NewFinder(f)
 
@@ -120,6 +139,10 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 				return cmdutil.FlagErrorf("only one of --merge, --rebase, or --squash can be enabled")
 			}
 
+			if opts.MessageTemplateFile != "" && (flagMerge || flagRebase) {
+				return cmdutil.FlagErrorf("--message-template can only be used with --squash")
+			}
+
 			opts.IsDeleteBranchIndicated = cmd.Flags().Changed("delete-branch")
 			opts.CanDeleteLocalBranch = !cmd.Flags().Changed("repo")
 
@@ -176,6 +199,7 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Body `text` for the merge commit")
 	cmd.Flags().StringVarP(&bodyFile, "body-file", "F", "", "Read body text from `file` (use \"-\" to read from standard input)")
 	cmd.Flags().StringVarP(&opts.Subject, "subject", "t", "", "Subject `text` for the merge commit")
+	cmd.Flags().StringVar(&opts.MessageTemplateFile, "message-template", "", "Read the squash commit message template from `file` (use \"-\" to read from standard input)")
 	cmd.Flags().BoolVarP(&flagMerge, "merge", "m", false, "Merge the commits with the base branch")
 	cmd.Flags().BoolVarP(&flagRebase, "rebase", "r", false, "Rebase the commits onto the base branch")
 	cmd.Flags().BoolVarP(&flagSquash, "squash", "s", false, "Squash the commits into one commit and merge it into the base branch")
@@ -183,23 +207,26 @@ func NewCmdMerge(f *cmdutil.Factory, runF func(*MergeOptions) error) *cobra.Comm
 	cmd.Flags().BoolVar(&opts.AutoMergeDisable, "disable-auto", false, "Disable auto-merge for this pull request")
 	cmd.Flags().StringVar(&opts.MatchHeadCommit, "match-head-commit", "", "Commit `SHA` that the pull request head must match to allow merge")
 	cmd.Flags().StringVarP(&opts.AuthorEmail, "author-email", "A", "", "Email `text` for merge commit author")
+	cmd.Flags().BoolVar(&opts.SkipHooks, "no-hooks", false, "Do not run post-merge hooks configured for the repository")
 	return cmd
 }
 
 // mergeContext contains state and dependencies to merge a pull request.
 type mergeContext struct {
-	pr                 *api.PullRequest
-	baseRepo           ghrepo.Interface
-	httpClient         *http.Client
-	opts               *MergeOptions
-	cs                 *iostreams.ColorScheme
-	isTerminal         bool
-	merged             bool
-	localBranchExists  bool
-	autoMerge          bool
-	crossRepoPR        bool
-	deleteBranch       bool
-	mergeQueueRequired bool
+	pr                   *api.PullRequest
+	baseRepo             ghrepo.Interface
+	httpClient           *http.Client
+	opts                 *MergeOptions
+	cs                   *iostreams.ColorScheme
+	isTerminal           bool
+	merged               bool
+	localBranchExists    bool
+	autoMerge            bool
+	crossRepoPR          bool
+	deleteBranch         bool
+	deleteBranchSurveyed bool
+	mergeQueueRequired   bool
+	hooks                []postMergeHook
 }
 
 // Attempt to disable auto merge on the pull request.
@@ -326,6 +353,7 @@ func (m *mergeContext) merge() error {
 			if err != nil {
 				return err
 			}
+			m.deleteBranchSurveyed = true
 
 			allowEditMsg := payload.method != PullRequestMergeMethodRebase
 			for {
@@ -345,6 +373,12 @@ func (m *mergeContext) merge() error {
 		}
 	}
 
+	if payload.method == PullRequestMergeMethodSquash && !m.shouldAddToMergeQueue() {
+		if err := m.applySquashMessageTemplate(&payload); err != nil {
+			return err
+		}
+	}
+
 	err := mergePullRequest(m.httpClient, payload)
 	if err != nil {
 		return err
@@ -547,6 +581,10 @@ func mergeRun(opts *MergeOptions) error {
 		return err
 	}
 
+	if err := ctx.loadPostMergeHooks(); err != nil {
+		return err
+	}
+
 	if err := ctx.deleteLocalBranch(); err != nil {
 		return err
 	}
@@ -555,7 +593,7 @@ func mergeRun(opts *MergeOptions) error {
 		return err
 	}
 
-	return nil
+	return ctx.runPostMergeHooks()
 }
 
 func mergeMethodSurvey(p shared.Prompt, baseRepo *api.Repository) (PullRequestMergeMethod, error) {
@@ -156,6 +156,23 @@ func Test_NewCmdMerge(t *testing.T) {
 				AuthorEmail:             "octocat@github.com",
 			},
 		},
+		{
+			name:  "no hooks",
+			args:  "123 --no-hooks",
+			isTTY: true,
+			want: MergeOptions{
+				SelectorArg:             "123",
+				DeleteBranch:            false,
+				IsDeleteBranchIndicated: false,
+				CanDeleteLocalBranch:    true,
+				MergeMethod:             PullRequestMergeMethodMerge,
+				MergeStrategyEmpty:      true,
+				Body:                    "",
+				BodySet:                 false,
+				AuthorEmail:             "",
+				SkipHooks:               true,
+			},
+		},
 		{
 			name:    "body and body-file flags",
 			args:    "123 --body 'test' --body-file 'test-file.txt'",
@@ -180,6 +197,23 @@ func Test_NewCmdMerge(t *testing.T) {
 			isTTY:   false,
 			wantErr: "only one of --merge, --rebase, or --squash can be enabled",
 		},
+		{
+			name:  "message template",
+			args:  fmt.Sprintf("123 --squash --message-template '%s'", tmpFile),
+			isTTY: true,
+			want: MergeOptions{
+				SelectorArg:          "123",
+				CanDeleteLocalBranch: true,
+				MergeMethod:          PullRequestMergeMethodSquash,
+				MessageTemplateFile:  tmpFile,
+			},
+		},
+		{
+			name:    "message template with merge",
+			args:    "123 --merge --message-template template.md",
+			isTTY:   true,
+			wantErr: "--message-template can only be used with --squash",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -228,6 +262,8 @@ func Test_NewCmdMerge(t *testing.T) {
 			assert.Equal(t, tt.want.BodySet, opts.BodySet)
 			assert.Equal(t, tt.want.MatchHeadCommit, opts.MatchHeadCommit)
 			assert.Equal(t, tt.want.AuthorEmail, opts.AuthorEmail)
+			assert.Equal(t, tt.want.SkipHooks, opts.SkipHooks)
+			assert.Equal(t, tt.want.MessageTemplateFile, opts.MessageTemplateFile)
 		})
 	}
 }
@@ -331,6 +367,7 @@ func TestPrMerge(t *testing.T) {
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "main", true, "pr merge 1 --merge")
 	if err != nil {
@@ -437,6 +474,7 @@ func TestPrMerge_nontty(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "main", false, "pr merge 1 --merge")
 	if err != nil {
@@ -476,6 +514,7 @@ func TestPrMerge_editMessage_nontty(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "main", false, "pr merge 1 --merge -t mytitle -b mybody")
 	if err != nil {
@@ -553,6 +592,7 @@ func TestPrMerge_withMatchCommitHeadFlag(t *testing.T) {
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "main", true, "pr merge 1 --merge --match-head-commit 285ed5ab740f53ff6b0b4b629c59a9df23b9c6db")
 	if err != nil {
@@ -595,6 +635,7 @@ func TestPrMerge_withAuthorFlag(t *testing.T) {
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "main", true, "pr merge 1 --merge --author-email octocat@github.com")
 	if err != nil {
@@ -641,6 +682,7 @@ func TestPrMerge_deleteBranch(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/main`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 	cs.Register(`git checkout main`, 0, "")
 	cs.Register(`git rev-parse --verify refs/heads/blueberries`, 0, "")
 	cs.Register(`git branch -D blueberries`, 0, "")
@@ -715,6 +757,7 @@ func TestPrMerge_deleteBranch_nonDefault(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/fruit`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 	cs.Register(`git checkout fruit`, 0, "")
 	cs.Register(`git rev-parse --verify refs/heads/blueberries`, 0, "")
 	cs.Register(`git branch -D blueberries`, 0, "")
@@ -764,6 +807,7 @@ func TestPrMerge_deleteBranch_onlyLocally(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/main`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 	cs.Register(`git checkout main`, 0, "")
 	cs.Register(`git rev-parse --verify refs/heads/blueberries`, 0, "")
 	cs.Register(`git branch -D blueberries`, 0, "")
@@ -814,6 +858,7 @@ func TestPrMerge_deleteBranch_checkoutNewBranch(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/fruit`, 1, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 	cs.Register(`git checkout -b fruit --track origin/fruit`, 0, "")
 	cs.Register(`git rev-parse --verify refs/heads/blueberries`, 0, "")
 	cs.Register(`git branch -D blueberries`, 0, "")
@@ -864,6 +909,7 @@ func TestPrMerge_deleteNonCurrentBranch(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/blueberries`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 	cs.Register(`git branch -D blueberries`, 0, "")
 
 	output, err := runCommand(http, nil, "main", true, `pr merge --merge --delete-branch blueberries`)
@@ -879,6 +925,183 @@ func TestPrMerge_deleteNonCurrentBranch(t *testing.T) {
 	`), output.Stderr())
 }
 
+func TestPrMerge_postMergeHooks(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.StubFinderForRunCommandStyleTests(t,
+		"1",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           1,
+			State:            "OPEN",
+			Title:            "The title of the PR",
+			MergeStateStatus: "CLEAN",
+			BaseRefName:      "main",
+			HeadRefName:      "feature",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+			assert.Equal(t, "MERGE", input["mergeMethod"].(string))
+		}))
+	http.Register(
+		httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/release.yml/dispatches"),
+		httpmock.RESTPayload(204, "", func(payload map[string]interface{}) {
+			assert.Equal(t, "main", payload["ref"])
+		}))
+	http.Register(
+		httpmock.GraphQL(`mutation CommentCreate\b`),
+		httpmock.GraphQLMutation(`
+		{ "data": { "addComment": { "commentEdge": { "node": {
+			"url": "https://github.com/OWNER/REPO/pull/1#issuecomment-1"
+		} } } } }`, func(input map[string]interface{}) {
+			assert.Equal(t, "Merged https://github.com/OWNER/REPO/pull/1 into main", input["body"])
+			assert.Equal(t, "THE-ID", input["subjectId"])
+		}))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 0, "workflow:release.yml\ncomment:Merged {url} into {base}\n")
+
+	output, err := runCommand(http, nil, "main", true, "pr merge 1 --merge")
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Merged pull request OWNER/REPO#1 (The title of the PR)
+		✓ Post-merge hook dispatched workflow release.yml on branch main
+		✓ Post-merge hook commented on pull request OWNER/REPO#1
+	`), output.Stderr())
+}
+
+func TestPrMerge_postMergeHookDeleteBranch(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.StubFinderForRunCommandStyleTests(t,
+		"blueberries",
+		&api.PullRequest{
+			ID:               "PR_10",
+			Number:           10,
+			State:            "OPEN",
+			Title:            "Blueberries are a good fruit",
+			HeadRefName:      "blueberries",
+			MergeStateStatus: "CLEAN",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "PR_10", input["pullRequestId"].(string))
+			assert.Equal(t, "MERGE", input["mergeMethod"].(string))
+		}))
+	http.Register(
+		httpmock.REST("DELETE", "repos/OWNER/REPO/git/refs/heads/blueberries"),
+		httpmock.StringResponse(`{}`))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git rev-parse --verify refs/heads/blueberries`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 0, "delete-branch\n")
+	cs.Register(`git branch -D blueberries`, 0, "")
+
+	output, err := runCommand(http, nil, "main", true, `pr merge --merge blueberries`)
+	if err != nil {
+		t.Fatalf("Got unexpected error running `pr merge` %s", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Merged pull request OWNER/REPO#10 (Blueberries are a good fruit)
+		✓ Deleted local branch blueberries
+		✓ Deleted remote branch blueberries
+	`), output.Stderr())
+}
+
+func TestPrMerge_postMergeHooksSkipped(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.StubFinderForRunCommandStyleTests(t,
+		"1",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           1,
+			State:            "OPEN",
+			Title:            "The title of the PR",
+			MergeStateStatus: "CLEAN",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+			assert.Equal(t, "MERGE", input["mergeMethod"].(string))
+		}))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	// no git config stub: --no-hooks must skip reading the hook configuration
+	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+
+	output, err := runCommand(http, nil, "main", true, "pr merge 1 --merge --no-hooks")
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Merged pull request OWNER/REPO#1 (The title of the PR)
+	`), output.Stderr())
+}
+
+func TestPrMerge_invalidPostMergeHook(t *testing.T) {
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.StubFinderForRunCommandStyleTests(t,
+		"1",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           1,
+			State:            "OPEN",
+			Title:            "The title of the PR",
+			MergeStateStatus: "CLEAN",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+		}))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 0, "frobnicate\n")
+
+	_, err := runCommand(http, nil, "main", true, "pr merge 1 --merge")
+	assert.EqualError(t, err, `invalid post-merge hook "frobnicate": unknown action "frobnicate"`)
+}
+
 func Test_nonDivergingPullRequest(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -908,6 +1131,7 @@ func Test_nonDivergingPullRequest(t *testing.T) {
 
 	cs.Register(`git .+ show .+ HEAD`, 0, "COMMITSHA1,title")
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "blueberries", true, "pr merge --merge")
 	if err != nil {
@@ -948,6 +1172,7 @@ func Test_divergingPullRequestWarning(t *testing.T) {
 
 	cs.Register(`git .+ show .+ HEAD`, 0, "COMMITSHA2,title")
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "blueberries", true, "pr merge --merge")
 	if err != nil {
@@ -988,6 +1213,7 @@ func Test_pullRequestWithoutCommits(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "blueberries", true, "pr merge --merge")
 	if err != nil {
@@ -1027,6 +1253,7 @@ func TestPrMerge_rebase(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "main", true, "pr merge 2 --rebase")
 	if err != nil {
@@ -1068,6 +1295,112 @@ func TestPrMerge_squash(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config gh\.squash-message-template`, 1, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
+
+	output, err := runCommand(http, nil, "main", true, "pr merge 3 --squash")
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Squashed and merged pull request OWNER/REPO#3 (The title of the PR)
+	`), output.Stderr())
+}
+
+func TestPrMerge_squashMessageTemplate(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "squash.tmpl")
+	err := os.WriteFile(tmpFile, []byte(heredoc.Doc(`
+		{title} (#{number})
+
+		Authored by: {authors}
+		{co-authored-by}
+	`)), 0600)
+	require.NoError(t, err)
+
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.StubFinderForRunCommandStyleTests(t,
+		"3",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           3,
+			Title:            "The title of the PR",
+			State:            "OPEN",
+			MergeStateStatus: "CLEAN",
+			BaseRefName:      "main",
+			HeadRefName:      "feature",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+			assert.Equal(t, "SQUASH", input["mergeMethod"].(string))
+			assert.Equal(t, "The title of the PR (#3)", input["commitHeadline"].(string))
+			assert.Equal(t, "Authored by: Mona Lisa <mona@github.com>\nCo-authored-by: Hubot <hubot@github.com>", input["commitBody"].(string))
+		}))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git -c log\.ShowSignature=false log --pretty=format:%H%x00%s%x00%an%x00%ae%x00%b%x00 --cherry main\.\.\.feature`, 0,
+		"6a6872b918c601a0e730710ad8473938a7516d30\u0000first commit\u0000Mona Lisa\u0000mona@github.com\u0000Co-authored-by: Hubot <hubot@github.com>\u0000\n")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
+
+	output, err := runCommand(http, nil, "main", true, fmt.Sprintf("pr merge 3 --squash --message-template '%s'", tmpFile))
+	if err != nil {
+		t.Fatalf("error running command `pr merge`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Equal(t, heredoc.Doc(`
+		✓ Squashed and merged pull request OWNER/REPO#3 (The title of the PR)
+	`), output.Stderr())
+}
+
+func TestPrMerge_squashMessageTemplateFromConfig(t *testing.T) {
+	tmpFile := filepath.Join(t.TempDir(), "squash.tmpl")
+	err := os.WriteFile(tmpFile, []byte("{title} (#{number})\n"), 0600)
+	require.NoError(t, err)
+
+	http := initFakeHTTP()
+	defer http.Verify(t)
+
+	shared.StubFinderForRunCommandStyleTests(t,
+		"3",
+		&api.PullRequest{
+			ID:               "THE-ID",
+			Number:           3,
+			Title:            "The title of the PR",
+			State:            "OPEN",
+			MergeStateStatus: "CLEAN",
+			BaseRefName:      "main",
+			HeadRefName:      "feature",
+		},
+		baseRepo("OWNER", "REPO", "main"),
+	)
+
+	http.Register(
+		httpmock.GraphQL(`mutation PullRequestMerge\b`),
+		httpmock.GraphQLMutation(`{}`, func(input map[string]interface{}) {
+			assert.Equal(t, "THE-ID", input["pullRequestId"].(string))
+			assert.Equal(t, "SQUASH", input["mergeMethod"].(string))
+			assert.Equal(t, "The title of the PR (#3)", input["commitHeadline"].(string))
+			assert.Equal(t, "", input["commitBody"].(string))
+		}))
+
+	cs, cmdTeardown := run.Stub()
+	defer cmdTeardown(t)
+
+	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config gh\.squash-message-template`, 0, tmpFile+"\n")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "main", true, "pr merge 3 --squash")
 	if err != nil {
@@ -1080,6 +1413,37 @@ func TestPrMerge_squash(t *testing.T) {
 	`), output.Stderr())
 }
 
+func Test_expandSquashMessage(t *testing.T) {
+	pr := &api.PullRequest{Number: 42, Title: "Add the thing"}
+	commits := []*git.Commit{
+		{
+			Sha:         "6a6872b918c601a0e730710ad8473938a7516d30",
+			Title:       "first commit",
+			AuthorName:  "Mona Lisa",
+			AuthorEmail: "mona@github.com",
+			Body:        "Co-authored-by: Hubot <hubot@github.com>",
+		},
+		{
+			Sha:         "7a6872b918c601a0e730710ad8473938a7516d31",
+			Title:       "second commit",
+			AuthorName:  "Mona Lisa",
+			AuthorEmail: "mona@github.com",
+			Body:        "body\n\nco-authored-by: Hubot <hubot@github.com>\nCo-authored-by: Octo Cat <octo@github.com>",
+		},
+	}
+
+	subject, body := expandSquashMessage(heredoc.Doc(`
+		{title} (#{number})
+
+		Authors: {authors}
+
+		{co-authored-by}
+	`), pr, commits)
+
+	assert.Equal(t, "Add the thing (#42)", subject)
+	assert.Equal(t, "Authors: Mona Lisa <mona@github.com>\n\nCo-authored-by: Hubot <hubot@github.com>\nCo-authored-by: Octo Cat <octo@github.com>", body)
+}
+
 func TestPrMerge_alreadyMerged(t *testing.T) {
 	http := initFakeHTTP()
 	defer http.Verify(t)
@@ -1272,6 +1636,7 @@ func TestPRMergeTTY(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/blueberries`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	pm := &prompter.PrompterMock{
 		ConfirmFunc: func(p string, d bool) (bool, error) {
@@ -1344,6 +1709,7 @@ func TestPRMergeTTY_withDeleteBranch(t *testing.T) {
 	defer cmdTeardown(t)
 
 	cs.Register(`git rev-parse --verify refs/heads/main`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 	cs.Register(`git checkout main`, 0, "")
 	cs.Register(`git rev-parse --verify refs/heads/blueberries`, 0, "")
 	cs.Register(`git branch -D blueberries`, 0, "")
@@ -1863,6 +2229,7 @@ func TestPrAddToMergeQueueAdmin(t *testing.T) {
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	pm := &prompter.PrompterMock{
 		ConfirmFunc: func(p string, d bool) (bool, error) {
@@ -1922,6 +2289,7 @@ func TestPrAddToMergeQueueAdminWithMergeStrategy(t *testing.T) {
 	cs, cmdTeardown := run.Stub()
 	defer cmdTeardown(t)
 	cs.Register(`git rev-parse --verify refs/heads/`, 0, "")
+	cs.Register(`git config --get-all gh\.pr-merge-hook`, 1, "")
 
 	output, err := runCommand(http, nil, "blueberries", true, "pr merge 1 --admin --merge")
 	if err != nil {
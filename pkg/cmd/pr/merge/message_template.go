@@ -0,0 +1,147 @@
+package merge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// SquashMessageTemplateConfig is the git configuration key that names a file
+// holding the squash commit message template for a repository.
+const SquashMessageTemplateConfig = "gh.squash-message-template"
+
+// loadSquashMessageTemplate returns the squash commit message template for
+// this invocation: the contents of the --message-template file when given,
+// otherwise the file named by the gh.squash-message-template git
+// configuration key. An empty string means no template is configured.
+func (m *mergeContext) loadSquashMessageTemplate() (string, error) {
+	if m.opts.MessageTemplateFile != "" {
+		b, err := cmdutil.ReadFile(m.opts.MessageTemplateFile, m.opts.IO.In)
+		if err != nil {
+			return "", err
+		}
+		return string(b), nil
+	}
+
+	// The configured template comes from the git config of the local clone, so
+	// it does not apply when --repo points the command somewhere else.
+	if !m.opts.CanDeleteLocalBranch {
+		return "", nil
+	}
+
+	path, err := m.opts.GitClient.Config(context.Background(), SquashMessageTemplateConfig)
+	if err != nil {
+		var gitErr *git.GitError
+		if errors.As(err, &gitErr) && gitErr.ExitCode == 1 {
+			return "", nil
+		}
+		return "", err
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read squash message template: %w", err)
+	}
+	return string(b), nil
+}
+
+// applySquashMessageTemplate fills in the squash commit subject and body from
+// the message template, if one is configured. An explicit --subject or --body
+// value wins over the template.
+func (m *mergeContext) applySquashMessageTemplate(payload *mergePayload) error {
+	template, err := m.loadSquashMessageTemplate()
+	if err != nil || template == "" {
+		return err
+	}
+
+	var commits []*git.Commit
+	if templateNeedsCommits(template) {
+		if !m.opts.CanDeleteLocalBranch {
+			return fmt.Errorf("squash message template references commit authors, which requires running from a local clone")
+		}
+		commits, err = m.opts.GitClient.Commits(context.Background(), m.pr.BaseRefName, m.pr.HeadRefName)
+		if err != nil {
+			return err
+		}
+	}
+
+	subject, body := expandSquashMessage(template, m.pr, commits)
+	if payload.commitSubject == "" {
+		payload.commitSubject = subject
+	}
+	if !payload.setCommitBody {
+		payload.commitBody = body
+		payload.setCommitBody = true
+	}
+	return nil
+}
+
+// templateNeedsCommits reports whether expanding the template requires the
+// commits being squashed, which are only available in a local clone.
+func templateNeedsCommits(template string) bool {
+	return strings.Contains(template, "{authors}") || strings.Contains(template, "{co-authored-by}")
+}
+
+// expandSquashMessage substitutes placeholders in a squash message template
+// and splits the result into a commit subject and body.
+func expandSquashMessage(template string, pr *api.PullRequest, commits []*git.Commit) (string, string) {
+	r := strings.NewReplacer(
+		"{number}", strconv.Itoa(pr.Number),
+		"{title}", pr.Title,
+		"{authors}", strings.Join(commitAuthors(commits), ", "),
+		"{co-authored-by}", strings.Join(coAuthoredByTrailers(commits), "\n"),
+	)
+
+	message := strings.TrimSpace(r.Replace(template))
+	subject, body, _ := strings.Cut(message, "\n")
+	return strings.TrimSpace(subject), strings.TrimSpace(body)
+}
+
+// commitAuthors returns the distinct authors of the given commits in
+// "Name <email>" form, in first-seen order.
+func commitAuthors(commits []*git.Commit) []string {
+	seen := map[string]bool{}
+	var authors []string
+	for _, c := range commits {
+		author := c.AuthorName
+		if c.AuthorEmail != "" {
+			author = fmt.Sprintf("%s <%s>", c.AuthorName, c.AuthorEmail)
+		}
+		if author == "" || seen[strings.ToLower(author)] {
+			continue
+		}
+		seen[strings.ToLower(author)] = true
+		authors = append(authors, author)
+	}
+	return authors
+}
+
+// coAuthoredByTrailers collects the distinct Co-authored-by trailers from the
+// bodies of the given commits.
+func coAuthoredByTrailers(commits []*git.Commit) []string {
+	const prefix = "co-authored-by:"
+	seen := map[string]bool{}
+	var trailers []string
+	for _, c := range commits {
+		for _, line := range strings.Split(c.Body, "\n") {
+			line = strings.TrimSpace(line)
+			if len(line) <= len(prefix) || !strings.EqualFold(line[:len(prefix)], prefix) {
+				continue
+			}
+			trailer := "Co-authored-by: " + strings.TrimSpace(line[len(prefix):])
+			if seen[strings.ToLower(trailer)] {
+				continue
+			}
+			seen[strings.ToLower(trailer)] = true
+			trailers = append(trailers, trailer)
+		}
+	}
+	return trailers
+}
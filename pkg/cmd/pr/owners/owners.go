@@ -0,0 +1,129 @@
+package owners
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type OwnersOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Finder shared.PRFinder
+
+	SelectorArg string
+}
+
+func NewCmdOwners(f *cmdutil.Factory, runF func(*OwnersOptions) error) *cobra.Command {
+	opts := &OwnersOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "owners [<number> | <url> | <branch>]",
+		Short: "Show code owners for a pull request",
+		Long: heredoc.Docf(`
+			Show the code owners of the files changed in a pull request, evaluated
+			from the CODEOWNERS file of the base repository.
+
+			Without an argument, the pull request that belongs to the current branch
+			is selected.
+		`, "`"),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Finder = shared.NewFinder(f)
+
+			if repoOverride, _ := cmd.Flags().GetString("repo"); repoOverride != "" && len(args) == 0 {
+				return cmdutil.FlagErrorf("argument required when using the `--repo` flag")
+			}
+
+			if len(args) > 0 {
+				opts.SelectorArg = args[0]
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return ownersRun(opts)
+		},
+	}
+
+	return cmd
+}
+
+func ownersRun(opts *OwnersOptions) error {
+	findOptions := shared.FindOptions{
+		Selector: opts.SelectorArg,
+		Fields:   []string{"number", "baseRefName"},
+	}
+	pr, baseRepo, err := opts.Finder.Find(findOptions)
+	if err != nil {
+		return err
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	client := api.NewClientFromHTTP(httpClient)
+
+	opts.IO.StartProgressIndicator()
+	codeowners, err := shared.LoadCodeownersForRepo(client, baseRepo, pr.BaseRefName)
+	if err == nil && codeowners == nil {
+		err = fmt.Errorf("no CODEOWNERS file found in %s", ghrepo.FullName(baseRepo))
+	}
+	if err != nil {
+		opts.IO.StopProgressIndicator()
+		return err
+	}
+
+	files, err := shared.ChangedFiles(client, baseRepo, pr.Number)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	sets, unowned := codeowners.OwnerSets(files)
+	if len(sets) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no code owners match the files changed in pull request #%d", pr.Number))
+	}
+
+	if err := opts.IO.StartPager(); err == nil {
+		defer opts.IO.StopPager()
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "failed to start pager: %v\n", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "Showing code owners for the %s changed in pull request #%d\n\n",
+			text.Pluralize(len(files), "file"), pr.Number)
+	}
+
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("OWNER", "FILES"))
+	for _, set := range sets {
+		tp.AddField(set.Owner, tableprinter.WithColor(cs.Bold))
+		tp.AddField(text.Pluralize(len(set.Files), "file"))
+		tp.EndRow()
+	}
+	if err := tp.Render(); err != nil {
+		return err
+	}
+
+	if len(unowned) > 0 && opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\n%s with no code owner\n", cs.Yellow(text.Pluralize(len(unowned), "changed file")))
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+package owners
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/pr/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCmdOwners(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    OwnersOptions
+		wantErr string
+	}{
+		{
+			name: "no argument",
+			args: "",
+			want: OwnersOptions{SelectorArg: ""},
+		},
+		{
+			name: "number argument",
+			args: "123",
+			want: OwnersOptions{SelectorArg: "123"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{IOStreams: ios}
+
+			var opts *OwnersOptions
+			cmd := NewCmdOwners(f, func(o *OwnersOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			require.NoError(t, err)
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
+		})
+	}
+}
+
+func Test_ownersRun(t *testing.T) {
+	codeowners := heredoc.Doc(`
+		*.go @go-dev
+		docs/ @docs-team
+	`)
+
+	tests := []struct {
+		name       string
+		tty        bool
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+		wantErr    string
+	}{
+		{
+			name: "lists owners of changed files",
+			tty:  true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/CODEOWNERS"),
+					httpmock.JSONResponse(map[string]string{
+						"content": base64.StdEncoding.EncodeToString([]byte(codeowners)),
+					}),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/pulls/123/files"),
+					httpmock.JSONResponse([]map[string]string{
+						{"filename": "cmd/main.go"},
+						{"filename": "pkg/api.go"},
+						{"filename": "docs/index.md"},
+						{"filename": "LICENSE"},
+					}),
+				)
+			},
+			wantStdout: heredoc.Doc(`
+				Showing code owners for the 4 files changed in pull request #123
+
+				OWNER       FILES
+				@go-dev     2 files
+				@docs-team  1 file
+
+				1 changed file with no code owner
+			`),
+		},
+		{
+			name: "machine-readable",
+			tty:  false,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/CODEOWNERS"),
+					httpmock.JSONResponse(map[string]string{
+						"content": base64.StdEncoding.EncodeToString([]byte(codeowners)),
+					}),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/pulls/123/files"),
+					httpmock.JSONResponse([]map[string]string{
+						{"filename": "cmd/main.go"},
+					}),
+				)
+			},
+			wantStdout: "@go-dev\t1 file\n",
+		},
+		{
+			name: "no CODEOWNERS file",
+			tty:  true,
+			httpStubs: func(reg *httpmock.Registry) {
+				for _, location := range shared.CodeownersLocations {
+					reg.Register(
+						httpmock.REST("GET", "repos/OWNER/REPO/contents/"+location),
+						httpmock.StatusStringResponse(404, `{}`),
+					)
+				}
+			},
+			wantErr: "no CODEOWNERS file found in OWNER/REPO",
+		},
+		{
+			name: "no matching owners",
+			tty:  true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/CODEOWNERS"),
+					httpmock.JSONResponse(map[string]string{
+						"content": base64.StdEncoding.EncodeToString([]byte(codeowners)),
+					}),
+				)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/pulls/123/files"),
+					httpmock.JSONResponse([]map[string]string{
+						{"filename": "LICENSE"},
+					}),
+				)
+			},
+			wantErr: "no code owners match the files changed in pull request #123",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			pr := &api.PullRequest{Number: 123, BaseRefName: "main"}
+			finder := shared.NewMockFinder("123", pr, ghrepo.New("OWNER", "REPO"))
+			finder.ExpectFields([]string{"number", "baseRefName"})
+
+			opts := &OwnersOptions{
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				IO:          ios,
+				Finder:      finder,
+				SelectorArg: "123",
+			}
+
+			err := ownersRun(opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
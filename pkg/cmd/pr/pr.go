@@ -12,6 +12,7 @@ import (
 	cmdEdit "github.com/cli/cli/v2/pkg/cmd/pr/edit"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/pr/list"
 	cmdMerge "github.com/cli/cli/v2/pkg/cmd/pr/merge"
+	cmdOwners "github.com/cli/cli/v2/pkg/cmd/pr/owners"
 	cmdReady "github.com/cli/cli/v2/pkg/cmd/pr/ready"
 	cmdReopen "github.com/cli/cli/v2/pkg/cmd/pr/reopen"
 	cmdReview "github.com/cli/cli/v2/pkg/cmd/pr/review"
@@ -56,6 +57,7 @@ func NewCmdPR(f *cmdutil.Factory) *cobra.Command {
 		cmdDiff.NewCmdDiff(f, nil),
 		cmdCheckout.NewCmdCheckout(f, nil),
 		cmdChecks.NewCmdChecks(f, nil),
+		cmdOwners.NewCmdOwners(f, nil),
 		cmdReview.NewCmdReview(f, nil),
 		cmdMerge.NewCmdMerge(f, nil),
 		cmdUpdateBranch.NewCmdUpdateBranch(f, nil),
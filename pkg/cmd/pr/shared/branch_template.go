@@ -0,0 +1,68 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/cli/cli/v2/git"
+)
+
+// BranchNameTemplateConfig is the git configuration key that holds a branch
+// name template for new development branches, e.g. "{type}/{number}-{slug}".
+const BranchNameTemplateConfig = "gh.branch-name-template"
+
+// BranchNameTemplate returns the branch name template configured for the
+// local repository, or an empty string when none is set.
+func BranchNameTemplate(gitClient *git.Client) (string, error) {
+	template, err := gitClient.Config(context.Background(), BranchNameTemplateConfig)
+	if err != nil {
+		var gitErr *git.GitError
+		if errors.As(err, &gitErr) && gitErr.ExitCode == 1 {
+			return "", nil
+		}
+		return "", err
+	}
+	return template, nil
+}
+
+// ExpandBranchNameTemplate substitutes placeholders in a branch name
+// template: {number} is the issue or pull request number, {slug} is the
+// slugified title, and {type} is the slugified name of the first label,
+// falling back to fallbackType for unlabeled issues and pull requests.
+func ExpandBranchNameTemplate(template, fallbackType string, labels []string, number int, title string) string {
+	branchType := fallbackType
+	if len(labels) > 0 {
+		if slug := Slugify(labels[0]); slug != "" {
+			branchType = slug
+		}
+	}
+
+	r := strings.NewReplacer(
+		"{type}", branchType,
+		"{number}", strconv.Itoa(number),
+		"{slug}", Slugify(title),
+	)
+	return r.Replace(template)
+}
+
+// Slugify lowercases a string and reduces it to hyphen-separated runs of
+// letters and digits, suitable for use in a branch name.
+func Slugify(s string) string {
+	var b strings.Builder
+	pendingHyphen := false
+	for _, r := range strings.ToLower(s) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if pendingHyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			pendingHyphen = false
+			b.WriteRune(r)
+		default:
+			pendingHyphen = true
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,98 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlugify(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{
+			name:  "simple title",
+			input: "Fix the bug",
+			want:  "fix-the-bug",
+		},
+		{
+			name:  "punctuation and symbols",
+			input: "Add `--watch` flag (v2)!",
+			want:  "add-watch-flag-v2",
+		},
+		{
+			name:  "leading and trailing separators",
+			input: "  [WIP] cleanup  ",
+			want:  "wip-cleanup",
+		},
+		{
+			name:  "uppercase",
+			input: "README Update",
+			want:  "readme-update",
+		},
+		{
+			name:  "no alphanumeric characters",
+			input: "!!!",
+			want:  "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Slugify(tt.input))
+		})
+	}
+}
+
+func TestExpandBranchNameTemplate(t *testing.T) {
+	tests := []struct {
+		name         string
+		template     string
+		fallbackType string
+		labels       []string
+		number       int
+		title        string
+		want         string
+	}{
+		{
+			name:         "all placeholders with a label",
+			template:     "{type}/{number}-{slug}",
+			fallbackType: "issue",
+			labels:       []string{"bug", "help wanted"},
+			number:       123,
+			title:        "Fix the bug",
+			want:         "bug/123-fix-the-bug",
+		},
+		{
+			name:         "fallback type without labels",
+			template:     "{type}/{number}-{slug}",
+			fallbackType: "issue",
+			number:       123,
+			title:        "Fix the bug",
+			want:         "issue/123-fix-the-bug",
+		},
+		{
+			name:         "label is slugified",
+			template:     "{type}/{number}",
+			fallbackType: "pr",
+			labels:       []string{"Good First Issue"},
+			number:       42,
+			want:         "good-first-issue/42",
+		},
+		{
+			name:         "template without placeholders",
+			template:     "static-branch",
+			fallbackType: "issue",
+			number:       1,
+			title:        "ignored",
+			want:         "static-branch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ExpandBranchNameTemplate(tt.template, tt.fallbackType, tt.labels, tt.number, tt.title)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
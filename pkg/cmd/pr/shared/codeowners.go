@@ -0,0 +1,204 @@
+package shared
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+// CodeownersLocations lists the paths where a CODEOWNERS file may live,
+// in the order GitHub searches for them.
+var CodeownersLocations = []string{".github/CODEOWNERS", "CODEOWNERS", "docs/CODEOWNERS"}
+
+// Codeowners is a parsed CODEOWNERS file.
+type Codeowners struct {
+	rules []codeownersRule
+}
+
+type codeownersRule struct {
+	re     *regexp.Regexp
+	owners []string
+}
+
+// ParseCodeowners parses the contents of a CODEOWNERS file. Lines that are
+// blank, comments, or have unusable patterns are skipped.
+func ParseCodeowners(content []byte) *Codeowners {
+	c := &Codeowners{}
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		re, err := codeownersPattern(fields[0])
+		if err != nil {
+			continue
+		}
+		c.rules = append(c.rules, codeownersRule{re: re, owners: fields[1:]})
+	}
+	return c
+}
+
+// Owners returns the owners of path as written in the file, e.g. "@octocat",
+// "@org/team", or an email address. As with gitignore, the last matching
+// rule wins.
+func (c *Codeowners) Owners(path string) []string {
+	for i := len(c.rules) - 1; i >= 0; i-- {
+		if c.rules[i].re.MatchString(path) {
+			return c.rules[i].owners
+		}
+	}
+	return nil
+}
+
+// codeownersPattern translates a gitignore-style CODEOWNERS pattern into a
+// regular expression matched against slash-separated paths relative to the
+// repository root.
+func codeownersPattern(pattern string) (*regexp.Regexp, error) {
+	trailingSlash := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	// a pattern with no slash, such as `*.go`, matches at any depth; any
+	// other pattern is anchored to the repository root
+	anchored := strings.Contains(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	if !anchored {
+		sb.WriteString(`(?:[^/]+/)*`)
+	}
+
+	segments := strings.Split(pattern, "/")
+	lastLiteral := false
+	for i, segment := range segments {
+		last := i == len(segments)-1
+		if segment == "**" {
+			lastLiteral = false
+			if last {
+				sb.WriteString(`.+`)
+			} else {
+				sb.WriteString(`(?:[^/]+/)*`)
+			}
+			continue
+		}
+		lastLiteral = !strings.ContainsAny(segment, "*?")
+		for _, r := range segment {
+			switch r {
+			case '*':
+				sb.WriteString(`[^/]*`)
+			case '?':
+				sb.WriteString(`[^/]`)
+			default:
+				sb.WriteString(regexp.QuoteMeta(string(r)))
+			}
+		}
+		if !last {
+			sb.WriteString("/")
+		}
+	}
+
+	switch {
+	case trailingSlash:
+		// `apps/` owns everything within any directory named apps
+		sb.WriteString(`/.+`)
+	case lastLiteral:
+		// a pattern naming a file or directory, such as `docs`, also owns
+		// everything within it, while `docs/*` owns direct entries only
+		sb.WriteString(`(?:/.*)?`)
+	}
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
+}
+
+// OwnerSet groups the changed files that share a code owner.
+type OwnerSet struct {
+	Owner string
+	Files []string
+}
+
+// OwnerSets groups paths by owner, in order of first appearance, along with
+// the paths that no rule matched.
+func (c *Codeowners) OwnerSets(paths []string) ([]OwnerSet, []string) {
+	index := map[string]int{}
+	var sets []OwnerSet
+	var unowned []string
+	for _, path := range paths {
+		owners := c.Owners(path)
+		if len(owners) == 0 {
+			unowned = append(unowned, path)
+			continue
+		}
+		for _, owner := range owners {
+			i, ok := index[owner]
+			if !ok {
+				i = len(sets)
+				index[owner] = i
+				sets = append(sets, OwnerSet{Owner: owner})
+			}
+			sets[i].Files = append(sets[i].Files, path)
+		}
+	}
+	return sets, unowned
+}
+
+// LoadCodeownersForRepo fetches and parses the CODEOWNERS file of a repository
+// at the given ref, trying each supported location in order. A repository
+// without one yields nil and no error.
+func LoadCodeownersForRepo(client *api.Client, repo ghrepo.Interface, ref string) (*Codeowners, error) {
+	for _, location := range CodeownersLocations {
+		path := fmt.Sprintf("repos/%s/contents/%s", ghrepo.FullName(repo), location)
+		if ref != "" {
+			path += fmt.Sprintf("?ref=%s", url.QueryEscape(ref))
+		}
+
+		var result struct {
+			Content string
+		}
+		if err := client.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+			var httpErr api.HTTPError
+			if errors.As(err, &httpErr) && httpErr.StatusCode == 404 {
+				continue
+			}
+			return nil, err
+		}
+
+		content, err := base64.StdEncoding.DecodeString(result.Content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", location, err)
+		}
+		return ParseCodeowners(content), nil
+	}
+	return nil, nil
+}
+
+// ChangedFiles lists the paths changed by a pull request, following
+// pagination.
+func ChangedFiles(client *api.Client, repo ghrepo.Interface, prNumber int) ([]string, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d/files?per_page=100", ghrepo.FullName(repo), prNumber)
+
+	var files []string
+	for path != "" {
+		var page []struct {
+			Filename string `json:"filename"`
+		}
+		next, err := client.RESTWithNext(repo.RepoHost(), "GET", path, nil, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range page {
+			files = append(files, file.Filename)
+		}
+		path = next
+	}
+	return files, nil
+}
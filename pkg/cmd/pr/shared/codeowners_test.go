@@ -0,0 +1,159 @@
+package shared
+
+import (
+	"encoding/base64"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCodeownersOwners(t *testing.T) {
+	content := []byte(`
+		# repository maintainers own everything by default
+		* @global-owner
+
+		*.go @go-dev golang@example.com
+		/build/logs/ @doctocat
+		/docs/ @doctocat
+		docs/* @docs-user
+		apps/ @octo-org/apps-team
+		internal/**/testdata @fixtures-owner
+	`)
+	c := ParseCodeowners(content)
+
+	tests := []struct {
+		name string
+		path string
+		want []string
+	}{
+		{
+			name: "catch-all",
+			path: "README.md",
+			want: []string{"@global-owner"},
+		},
+		{
+			name: "extension pattern matches at any depth",
+			path: "cmd/gh/main.go",
+			want: []string{"@go-dev", "golang@example.com"},
+		},
+		{
+			name: "last matching rule wins",
+			path: "docs/build-system.md",
+			want: []string{"@docs-user"},
+		},
+		{
+			name: "anchored directory contents",
+			path: "build/logs/output.txt",
+			want: []string{"@doctocat"},
+		},
+		{
+			name: "directory pattern matches at any depth",
+			path: "packages/apps/installer.rb",
+			want: []string{"@octo-org/apps-team"},
+		},
+		{
+			name: "single asterisk does not cross directories",
+			path: "docs/guides/getting-started.md",
+			want: []string{"@doctocat"},
+		},
+		{
+			name: "double asterisk crosses directories",
+			path: "internal/gh/testdata/gold.json",
+			want: []string{"@fixtures-owner"},
+		},
+		{
+			name: "anchored pattern does not match nested path",
+			path: "pkg/build/logs/output.txt",
+			want: []string{"@global-owner"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, c.Owners(tt.path))
+		})
+	}
+}
+
+func TestCodeownersOwnersNoMatch(t *testing.T) {
+	c := ParseCodeowners([]byte("docs/ @doctocat"))
+	assert.Nil(t, c.Owners("cmd/main.go"))
+}
+
+func TestParseCodeownersSkipsUnusableLines(t *testing.T) {
+	c := ParseCodeowners([]byte("# only a comment\n\npattern-without-owners\n"))
+	assert.Nil(t, c.Owners("pattern-without-owners"))
+}
+
+func TestCodeownersOwnerSets(t *testing.T) {
+	c := ParseCodeowners([]byte("*.go @go-dev @org/backend\ndocs/ @docs-team\n"))
+
+	sets, unowned := c.OwnerSets([]string{"cmd/main.go", "docs/index.md", "pkg/api.go", "LICENSE"})
+
+	assert.Equal(t, []OwnerSet{
+		{Owner: "@go-dev", Files: []string{"cmd/main.go", "pkg/api.go"}},
+		{Owner: "@org/backend", Files: []string{"cmd/main.go", "pkg/api.go"}},
+		{Owner: "@docs-team", Files: []string{"docs/index.md"}},
+	}, sets)
+	assert.Equal(t, []string{"LICENSE"}, unowned)
+}
+
+func TestLoadCodeownersForRepo(t *testing.T) {
+	t.Run("falls back through locations", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/CODEOWNERS"),
+			httpmock.StatusStringResponse(404, `{}`),
+		)
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/contents/CODEOWNERS"),
+			httpmock.JSONResponse(map[string]string{
+				"content": base64.StdEncoding.EncodeToString([]byte("*.go @go-dev\n")),
+			}),
+		)
+
+		client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+		c, err := LoadCodeownersForRepo(client, ghrepo.New("OWNER", "REPO"), "main")
+		require.NoError(t, err)
+		require.NotNil(t, c)
+		assert.Equal(t, []string{"@go-dev"}, c.Owners("cmd/main.go"))
+	})
+
+	t.Run("no CODEOWNERS file", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		for _, location := range CodeownersLocations {
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/contents/"+location),
+				httpmock.StatusStringResponse(404, `{}`),
+			)
+		}
+
+		client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+		c, err := LoadCodeownersForRepo(client, ghrepo.New("OWNER", "REPO"), "")
+		require.NoError(t, err)
+		assert.Nil(t, c)
+	})
+}
+
+func TestChangedFiles(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "repos/OWNER/REPO/pulls/123/files"),
+		httpmock.JSONResponse([]map[string]string{
+			{"filename": "cmd/main.go"},
+			{"filename": "docs/index.md"},
+		}),
+	)
+
+	client := api.NewClientFromHTTP(&http.Client{Transport: reg})
+	files, err := ChangedFiles(client, ghrepo.New("OWNER", "REPO"), 123)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cmd/main.go", "docs/index.md"}, files)
+}
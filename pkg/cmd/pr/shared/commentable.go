@@ -6,6 +6,10 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/gh"
@@ -37,6 +41,7 @@ type Commentable interface {
 type CommentableOptions struct {
 	IO                        *iostreams.IOStreams
 	HttpClient                func() (*http.Client, error)
+	Config                    func() (gh.Config, error)
 	RetrieveCommentable       func() (Commentable, ghrepo.Interface, error)
 	EditSurvey                func(string) (string, error)
 	InteractiveEditSurvey     func(string) (string, error)
@@ -53,6 +58,10 @@ type CommentableOptions struct {
 	CreateIfNone              bool
 	Quiet                     bool
 	Host                      string
+	HideComment               string
+	UnhideComment             string
+	HideReason                string
+	Template                  string
 }
 
 func CommentablePreRun(cmd *cobra.Command, opts *CommentableOptions) error {
@@ -73,11 +82,28 @@ func CommentablePreRun(cmd *cobra.Command, opts *CommentableOptions) error {
 		opts.InputType = InputTypeEditor
 		inputFlags++
 	}
+	if cmd.Flags().Changed("template") {
+		opts.InputType = InputTypeInline
+		inputFlags++
+	}
 
 	if opts.CreateIfNone && !opts.EditLast {
 		return cmdutil.FlagErrorf("`--create-if-none` can only be used with `--edit-last`")
 	}
 
+	if opts.HideComment != "" && opts.UnhideComment != "" {
+		return cmdutil.FlagErrorf("specify only one of `--hide` or `--unhide`")
+	}
+	if cmd.Flags().Changed("reason") && opts.HideComment == "" {
+		return cmdutil.FlagErrorf("`--reason` can only be used with `--hide`")
+	}
+	if opts.HideComment != "" || opts.UnhideComment != "" {
+		if inputFlags > 0 || opts.EditLast || opts.DeleteLast {
+			return cmdutil.FlagErrorf("cannot combine `--hide` or `--unhide` with other comment operations")
+		}
+		return nil
+	}
+
 	if opts.DeleteLastConfirmed && !opts.DeleteLast {
 		return cmdutil.FlagErrorf("`--yes` should only be used with `--delete-last`")
 	}
@@ -99,7 +125,7 @@ func CommentablePreRun(cmd *cobra.Command, opts *CommentableOptions) error {
 		}
 		opts.Interactive = true
 	} else if inputFlags > 1 {
-		return cmdutil.FlagErrorf("specify only one of `--body`, `--body-file`, `--editor`, or `--web`")
+		return cmdutil.FlagErrorf("specify only one of `--body`, `--body-file`, `--editor`, `--web`, or `--template`")
 	}
 
 	return nil
@@ -111,6 +137,16 @@ func CommentableRun(opts *CommentableOptions) error {
 		return err
 	}
 	opts.Host = repo.RepoHost()
+	if opts.HideComment != "" || opts.UnhideComment != "" {
+		return minimizeComment(repo, opts)
+	}
+	if opts.Template != "" {
+		body, err := renderSavedReply(commentable, repo, opts)
+		if err != nil {
+			return err
+		}
+		opts.Body = body
+	}
 	if opts.DeleteLast {
 		return deleteComment(commentable, opts)
 	}
@@ -153,7 +189,7 @@ func createComment(commentable Commentable, opts *CommentableOptions) error {
 	case InputTypeWeb:
 		openURL := commentable.Link() + "#issuecomment-new"
 		if opts.IO.IsStdoutTTY() && !opts.Quiet {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 		}
 		return opts.OpenInBrowser(openURL)
 	case InputTypeEditor:
@@ -211,7 +247,7 @@ func updateComment(commentable Commentable, opts *CommentableOptions) error {
 	case InputTypeWeb:
 		openURL := lastComment.Link()
 		if opts.IO.IsStdoutTTY() && !opts.Quiet {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 		}
 		return opts.OpenInBrowser(openURL)
 	case InputTypeEditor:
@@ -258,6 +294,129 @@ func updateComment(commentable Commentable, opts *CommentableOptions) error {
 	return nil
 }
 
+// minimizeComment hides or restores a single comment identified by --hide or
+// --unhide. Comment IDs may be GraphQL node IDs or the numeric IDs that
+// appear in comment URLs.
+func minimizeComment(repo ghrepo.Interface, opts *CommentableOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+	apiClient := api.NewClientFromHTTP(httpClient)
+	cs := opts.IO.ColorScheme()
+
+	if opts.UnhideComment != "" {
+		commentID, err := resolveCommentID(apiClient, repo, opts.UnhideComment)
+		if err != nil {
+			return err
+		}
+		if err := api.CommentUnminimize(apiClient, opts.Host, commentID); err != nil {
+			return err
+		}
+		if !opts.Quiet {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Comment unhidden\n", cs.SuccessIcon())
+		}
+		return nil
+	}
+
+	commentID, err := resolveCommentID(apiClient, repo, opts.HideComment)
+	if err != nil {
+		return err
+	}
+	classifier := strings.ToUpper(strings.ReplaceAll(opts.HideReason, "-", "_"))
+	params := api.CommentMinimizeInput{CommentId: commentID, Classifier: classifier}
+	if err := api.CommentMinimize(apiClient, opts.Host, params); err != nil {
+		return err
+	}
+	if !opts.Quiet {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Comment hidden as %s\n", cs.SuccessIcon(), opts.HideReason)
+	}
+	return nil
+}
+
+// resolveCommentID translates a numeric comment ID into the GraphQL node ID
+// the minimize mutations require; other values are assumed to already be
+// node IDs.
+func resolveCommentID(client *api.Client, repo ghrepo.Interface, id string) (string, error) {
+	if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+		return id, nil
+	}
+	var response struct {
+		NodeID string `json:"node_id"`
+	}
+	path := fmt.Sprintf("repos/%s/issues/comments/%s", ghrepo.FullName(repo), id)
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &response); err != nil {
+		return "", fmt.Errorf("failed to look up comment %s: %w", id, err)
+	}
+	return response.NodeID, nil
+}
+
+// renderSavedReply loads the saved reply named by --template from configuration
+// and expands its template variables against the issue or pull request being
+// commented on.
+func renderSavedReply(commentable Commentable, repo ghrepo.Interface, opts *CommentableOptions) (string, error) {
+	cfg, err := opts.Config()
+	if err != nil {
+		return "", err
+	}
+
+	savedReplies := cfg.SavedReplies()
+	body, err := savedReplies.Get(opts.Template)
+	if err != nil || body == "" {
+		names := []string{}
+		for name := range savedReplies.All() {
+			names = append(names, name)
+		}
+		if len(names) == 0 {
+			return "", fmt.Errorf("no saved reply found for %q; define saved replies under the `saved_replies` configuration key", opts.Template)
+		}
+		sort.Strings(names)
+		return "", fmt.Errorf("no saved reply found for %q; available saved replies: %s", opts.Template, strings.Join(names, ", "))
+	}
+
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return "", err
+	}
+	login, err := api.CurrentLoginName(api.NewClientFromHTTP(httpClient), opts.Host)
+	if err != nil {
+		return "", err
+	}
+
+	var number int
+	switch c := commentable.(type) {
+	case *api.PullRequest:
+		number = c.Number
+	case *api.Issue:
+		number = c.Number
+	}
+
+	subject := map[string]interface{}{
+		"number": number,
+		"url":    commentable.Link(),
+	}
+	data := map[string]interface{}{
+		"repo": map[string]interface{}{
+			"owner":    repo.RepoOwner(),
+			"name":     repo.RepoName(),
+			"fullName": ghrepo.FullName(repo),
+		},
+		"issue": subject,
+		"pr":    subject,
+		"user":  login,
+	}
+
+	tmpl, err := template.New(opts.Template).Option("missingkey=error").Parse(body)
+	if err != nil {
+		return "", fmt.Errorf("invalid saved reply %q: %w", opts.Template, err)
+	}
+	rendered := &strings.Builder{}
+	if err := tmpl.Execute(rendered, data); err != nil {
+		return "", fmt.Errorf("failed to render saved reply %q: %w", opts.Template, err)
+	}
+	return rendered.String(), nil
+}
+
 func deleteComment(commentable Commentable, opts *CommentableOptions) error {
 	comments := commentable.CurrentUserComments()
 	if len(comments) == 0 {
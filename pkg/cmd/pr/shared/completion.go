@@ -9,8 +9,19 @@ import (
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
 )
 
+// completionClient prepares httpClient for completion requests: responses are
+// cached briefly, and a short timeout keeps tab completion responsive when the
+// network is slow.
+func completionClient(httpClient *http.Client) *api.Client {
+	hc := *httpClient
+	hc.Timeout = 3 * time.Second
+	return api.NewClientFromHTTP(api.NewCachedHTTPClient(&hc, time.Minute*2))
+}
+
 func RequestableReviewersForCompletion(httpClient *http.Client, repo ghrepo.Interface) ([]string, error) {
 	client := api.NewClientFromHTTP(api.NewCachedHTTPClient(httpClient, time.Minute*2))
 
@@ -37,3 +48,137 @@ func RequestableReviewersForCompletion(httpClient *http.Client, repo ghrepo.Inte
 	sort.Strings(results)
 	return results, nil
 }
+
+// PRNumbersForCompletion returns recently updated open pull request numbers in
+// repo, each annotated with its title, for use as shell completion candidates.
+func PRNumbersForCompletion(httpClient *http.Client, repo ghrepo.Interface, limit int) ([]string, error) {
+	var response struct {
+		Repository struct {
+			PullRequests struct {
+				Nodes []struct {
+					Number int
+					Title  string
+				}
+			}
+		}
+	}
+
+	query := `query PullRequestNumbersForCompletion($owner: String!, $repo: String!, $limit: Int!) {
+		repository(owner: $owner, name: $repo) {
+			pullRequests(states: OPEN, first: $limit, orderBy: {field: UPDATED_AT, direction: DESC}) {
+				nodes {
+					number
+					title
+				}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner": repo.RepoOwner(),
+		"repo":  repo.RepoName(),
+		"limit": limit,
+	}
+
+	client := completionClient(httpClient)
+	if err := client.GraphQL(repo.RepoHost(), query, variables, &response); err != nil {
+		return nil, err
+	}
+
+	results := []string{}
+	for _, pr := range response.Repository.PullRequests.Nodes {
+		results = append(results, fmt.Sprintf("%d\t%s", pr.Number, pr.Title))
+	}
+	return results, nil
+}
+
+// LabelNamesForCompletion returns the names of the labels defined in repo for
+// use as shell completion candidates.
+func LabelNamesForCompletion(httpClient *http.Client, repo ghrepo.Interface) ([]string, error) {
+	metadata, err := api.RepoMetadata(completionClient(httpClient), repo, api.RepoMetadataInput{Labels: true})
+	if err != nil {
+		return nil, err
+	}
+
+	results := []string{}
+	for _, label := range metadata.Labels {
+		results = append(results, label.Name)
+	}
+	sort.Strings(results)
+	return results, nil
+}
+
+// MilestoneTitlesForCompletion returns the titles of the open milestones in
+// repo for use as shell completion candidates.
+func MilestoneTitlesForCompletion(httpClient *http.Client, repo ghrepo.Interface) ([]string, error) {
+	metadata, err := api.RepoMetadata(completionClient(httpClient), repo, api.RepoMetadataInput{Milestones: true})
+	if err != nil {
+		return nil, err
+	}
+
+	results := []string{}
+	for _, milestone := range metadata.Milestones {
+		results = append(results, milestone.Title)
+	}
+	sort.Strings(results)
+	return results, nil
+}
+
+// PRNumberCompletionFunc builds a ValidArgsFunction that suggests open pull
+// request numbers in the base repository for a command's first argument.
+func PRNumberCompletionFunc(f *cmdutil.Factory) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		httpClient, err := f.HttpClient()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		baseRepo, err := f.BaseRepo()
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		results, err := PRNumbersForCompletion(httpClient, baseRepo, 30)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+		return results, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// RegisterLabelCompletionFlags suggests the labels defined in the base
+// repository when completing the named flags.
+func RegisterLabelCompletionFlags(f *cmdutil.Factory, cmd *cobra.Command, flags ...string) error {
+	return registerMetadataCompletionFlags(f, cmd, LabelNamesForCompletion, flags)
+}
+
+// RegisterMilestoneCompletionFlags suggests the open milestones in the base
+// repository when completing the named flags.
+func RegisterMilestoneCompletionFlags(f *cmdutil.Factory, cmd *cobra.Command, flags ...string) error {
+	return registerMetadataCompletionFlags(f, cmd, MilestoneTitlesForCompletion, flags)
+}
+
+func registerMetadataCompletionFlags(f *cmdutil.Factory, cmd *cobra.Command, complete func(*http.Client, ghrepo.Interface) ([]string, error), flags []string) error {
+	for _, flag := range flags {
+		err := cmd.RegisterFlagCompletionFunc(flag, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			httpClient, err := f.HttpClient()
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			baseRepo, err := f.BaseRepo()
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			results, err := complete(httpClient, baseRepo)
+			if err != nil {
+				return nil, cobra.ShellCompDirectiveError
+			}
+			return results, cobra.ShellCompDirectiveNoFileComp
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package shared
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPRNumbersForCompletion(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query PullRequestNumbersForCompletion\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "pullRequests": { "nodes": [
+			{ "number": 32, "title": "Fix the frobnicator" },
+			{ "number": 12, "title": "Add docs" }
+		] } } } }`))
+
+	results, err := PRNumbersForCompletion(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"), 30)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"32\tFix the frobnicator", "12\tAdd docs"}, results)
+}
+
+func TestLabelNamesForCompletion(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryLabelList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "labels": { "nodes": [
+			{ "name": "help wanted", "id": "HELPWANTEDID" },
+			{ "name": "bug", "id": "BUGID" }
+		], "pageInfo": { "hasNextPage": false } } } } }`))
+
+	results, err := LabelNamesForCompletion(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"bug", "help wanted"}, results)
+}
+
+func TestMilestoneTitlesForCompletion(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	reg.Register(
+		httpmock.GraphQL(`query RepositoryMilestoneList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "repository": { "milestones": { "nodes": [
+			{ "title": "1.x", "id": "MILESTONEID" }
+		], "pageInfo": { "hasNextPage": false } } } } }`))
+
+	results, err := MilestoneTitlesForCompletion(&http.Client{Transport: reg}, ghrepo.New("OWNER", "REPO"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.x"}, results)
+}
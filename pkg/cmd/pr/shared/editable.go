@@ -257,9 +257,13 @@ type EditPrompter interface {
 	Input(string, string) (string, error)
 	MarkdownEditor(string, string, bool) (string, error)
 	MultiSelect(string, []string, []string) ([]int, error)
+	FuzzyMultiSelect(string, []string, []string) ([]int, error)
 	Confirm(string, bool) (bool, error)
 }
 
+// EditFieldsSurvey prompts for new values for every edited field. Metadata is
+// picked through the same fuzzy multi-selects as MetadataSurvey at create
+// time, with the current values pre-selected.
 func EditFieldsSurvey(p EditPrompter, editable *Editable, editorCommand string) error {
 	var err error
 	if editable.Title.Edited {
@@ -275,21 +279,21 @@ func EditFieldsSurvey(p EditPrompter, editable *Editable, editorCommand string)
 		}
 	}
 	if editable.Reviewers.Edited {
-		editable.Reviewers.Value, err = multiSelectSurvey(
+		editable.Reviewers.Value, err = fuzzyMultiSelectSurvey(
 			p, "Reviewers", editable.Reviewers.Default, editable.Reviewers.Options)
 		if err != nil {
 			return err
 		}
 	}
 	if editable.Assignees.Edited {
-		editable.Assignees.Value, err = multiSelectSurvey(
+		editable.Assignees.Value, err = fuzzyMultiSelectSurvey(
 			p, "Assignees", editable.Assignees.Default, editable.Assignees.Options)
 		if err != nil {
 			return err
 		}
 	}
 	if editable.Labels.Edited {
-		editable.Labels.Add, err = multiSelectSurvey(
+		editable.Labels.Add, err = fuzzyMultiSelectSurvey(
 			p, "Labels", editable.Labels.Default, editable.Labels.Options)
 		if err != nil {
 			return err
@@ -308,7 +312,7 @@ func EditFieldsSurvey(p EditPrompter, editable *Editable, editorCommand string)
 		}
 	}
 	if editable.Projects.Edited {
-		editable.Projects.Value, err = multiSelectSurvey(
+		editable.Projects.Value, err = fuzzyMultiSelectSurvey(
 			p, "Projects", editable.Projects.Default, editable.Projects.Options)
 		if err != nil {
 			return err
@@ -442,6 +446,26 @@ func multiSelectSurvey(p EditPrompter, message string, defaults, options []strin
 	return results, err
 }
 
+// fuzzyMultiSelectSurvey is the fuzzy-finding variant used to pick metadata
+// values, keeping defaults pre-selected.
+func fuzzyMultiSelectSurvey(p EditPrompter, message string, defaults, options []string) (results []string, err error) {
+	if len(options) == 0 {
+		return nil, nil
+	}
+
+	var selected []int
+	selected, err = p.FuzzyMultiSelect(message, defaults, options)
+	if err != nil {
+		return
+	}
+
+	for _, i := range selected {
+		results = append(results, options[i])
+	}
+
+	return results, err
+}
+
 func milestoneSurvey(p EditPrompter, title string, opts []string) (result string, err error) {
 	if len(opts) == 0 {
 		return "", nil
@@ -0,0 +1,101 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEditFieldsSurvey(t *testing.T) {
+	editable := Editable{
+		Title: EditableString{
+			Default: "old title",
+			Edited:  true,
+		},
+		Body: EditableString{
+			Default: "old body",
+			Edited:  true,
+		},
+		Assignees: EditableSlice{
+			Default: []string{"monalisa"},
+			Options: []string{"monalisa", "hubot", "octocat"},
+			Edited:  true,
+		},
+		Labels: EditableSlice{
+			Default: []string{"docs", "bug"},
+			Options: []string{"docs", "bug", "feature"},
+			Edited:  true,
+		},
+		Milestone: EditableString{
+			Default: "v1",
+			Options: []string{noMilestone, "v1", "v2"},
+			Edited:  true,
+		},
+	}
+
+	pm := &prompter.PrompterMock{
+		InputFunc: func(prompt, defaultValue string) (string, error) {
+			assert.Equal(t, "Title", prompt)
+			assert.Equal(t, "old title", defaultValue)
+			return "new title", nil
+		},
+		MarkdownEditorFunc: func(prompt, defaultValue string, blankAllowed bool) (string, error) {
+			assert.Equal(t, "Body", prompt)
+			assert.Equal(t, "old body", defaultValue)
+			return "new body", nil
+		},
+		FuzzyMultiSelectFunc: func(prompt string, defaults, options []string) ([]int, error) {
+			// metadata pickers present the current values pre-selected
+			switch prompt {
+			case "Assignees":
+				assert.Equal(t, []string{"monalisa"}, defaults)
+				return []int{1}, nil
+			case "Labels":
+				assert.Equal(t, []string{"docs", "bug"}, defaults)
+				return []int{1, 2}, nil
+			default:
+				return nil, prompter.NoSuchPromptErr(prompt)
+			}
+		},
+		SelectFunc: func(prompt, defaultValue string, options []string) (int, error) {
+			assert.Equal(t, "Milestone", prompt)
+			assert.Equal(t, "v1", defaultValue)
+			return prompter.IndexFor(options, "v2")
+		},
+		ConfirmFunc: func(prompt string, defaultValue bool) (bool, error) {
+			return true, nil
+		},
+	}
+
+	require.NoError(t, EditFieldsSurvey(pm, &editable, "vim"))
+
+	assert.Equal(t, "new title", editable.Title.Value)
+	assert.Equal(t, "new body", editable.Body.Value)
+	assert.Equal(t, []string{"hubot"}, editable.Assignees.Value)
+	assert.Equal(t, []string{"bug", "feature"}, editable.Labels.Add)
+	assert.Equal(t, []string{"docs"}, editable.Labels.Remove)
+	assert.Equal(t, "v2", editable.Milestone.Value)
+}
+
+func TestFieldsToEditSurvey(t *testing.T) {
+	editable := Editable{
+		Reviewers: EditableSlice{Allowed: true},
+	}
+
+	pm := &prompter.PrompterMock{
+		MultiSelectFunc: func(prompt string, defaults, options []string) ([]int, error) {
+			assert.Equal(t, "What would you like to edit?", prompt)
+			assert.Equal(t, []string{"Title", "Body", "Reviewers", "Assignees", "Labels", "Projects", "Milestone"}, options)
+			return []int{1, 4}, nil
+		},
+	}
+
+	require.NoError(t, FieldsToEditSurvey(pm, &editable))
+
+	assert.False(t, editable.Title.Edited)
+	assert.True(t, editable.Body.Edited)
+	assert.True(t, editable.Labels.Edited)
+	assert.False(t, editable.Projects.Edited)
+}
@@ -0,0 +1,130 @@
+package shared
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/yuin/goldmark"
+)
+
+// Export formats understood by ExportDocument.
+const (
+	ExportFormatMarkdown = "md"
+	ExportFormatHTML     = "html"
+)
+
+const exportTimeLayout = "2006-01-02 15:04 MST"
+
+// ExportDocument is a snapshot of an issue or pull request thread that can be
+// rendered into a standalone Markdown or HTML document, for archiving outside
+// of GitHub.
+type ExportDocument struct {
+	Repo      ghrepo.Interface
+	Title     string
+	Number    int
+	State     string
+	Author    string
+	CreatedAt time.Time
+	URL       string
+	Body      string
+	// Metadata holds ordered label/value pairs rendered below the header;
+	// pairs with an empty value are skipped.
+	Metadata [][2]string
+	Comments api.Comments
+	// Reviews is only populated for pull requests and is interleaved with the
+	// comments in chronological order.
+	Reviews api.PullRequestReviews
+}
+
+// Export renders the document to w in the requested format.
+func (d *ExportDocument) Export(w io.Writer, format string) error {
+	md := d.markdown()
+	switch format {
+	case ExportFormatMarkdown:
+		_, err := io.WriteString(w, md)
+		return err
+	case ExportFormatHTML:
+		var body strings.Builder
+		if err := goldmark.Convert([]byte(md), &body); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintf(w, htmlDocumentFormat, html.EscapeString(d.Title), body.String())
+		return err
+	default:
+		return fmt.Errorf("unsupported export format: %q", format)
+	}
+}
+
+const htmlDocumentFormat = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>%s</title>
+</head>
+<body>
+%s</body>
+</html>
+`
+
+func (d *ExportDocument) markdown() string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n\n", d.Title)
+	fmt.Fprintf(&b, "`%s#%d` • %s • opened by %s on %s\n\n",
+		ghrepo.FullName(d.Repo), d.Number, d.State, d.Author, d.CreatedAt.Format(exportTimeLayout))
+
+	var wroteMetadata bool
+	for _, pair := range d.Metadata {
+		if pair[1] == "" {
+			continue
+		}
+		fmt.Fprintf(&b, "- **%s:** %s\n", pair[0], pair[1])
+		wroteMetadata = true
+	}
+	if wroteMetadata {
+		fmt.Fprintln(&b)
+	}
+
+	fmt.Fprintln(&b, "## Description")
+	fmt.Fprintln(&b)
+	if d.Body == "" {
+		fmt.Fprintln(&b, "_No description provided_")
+	} else {
+		fmt.Fprintln(&b, strings.TrimRight(d.Body, "\n"))
+	}
+
+	var comments []Comment
+	for _, comment := range sortComments(d.Comments, d.Reviews) {
+		if comment.IsHidden() {
+			continue
+		}
+		comments = append(comments, comment)
+	}
+
+	if len(comments) > 0 {
+		fmt.Fprintf(&b, "\n## %s\n", text.Pluralize(len(comments), "Comment"))
+		for _, comment := range comments {
+			fmt.Fprintf(&b, "\n### %s", comment.AuthorLogin())
+			if status := formatRawCommentStatus(comment.Status()); comment.Status() != "" && status != "none" {
+				fmt.Fprintf(&b, " (%s)", status)
+			}
+			fmt.Fprintf(&b, " • %s", comment.Created().Format(exportTimeLayout))
+			if comment.IsEdited() {
+				fmt.Fprint(&b, " • edited")
+			}
+			fmt.Fprintln(&b)
+			fmt.Fprintln(&b)
+			fmt.Fprintln(&b, strings.TrimRight(comment.Content(), "\n"))
+		}
+	}
+
+	fmt.Fprintf(&b, "\n---\n\n[View on GitHub](%s)\n", d.URL)
+
+	return b.String()
+}
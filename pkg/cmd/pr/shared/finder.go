@@ -47,6 +47,7 @@ type finder struct {
 	branchFn        func() (string, error)
 	httpClient      func() (*http.Client, error)
 	remotesFn       func() (ghContext.Remotes, error)
+	detectorFn      func(host string) (fd.Detector, error)
 	gitConfigClient GitConfigClient
 	progress        progressIndicator
 
@@ -66,6 +67,7 @@ func NewFinder(factory *cmdutil.Factory) PRFinder {
 		baseRepoFn:      factory.BaseRepo,
 		branchFn:        factory.Branch,
 		httpClient:      factory.HttpClient,
+		detectorFn:      factory.Detector,
 		gitConfigClient: factory.GitClient,
 		remotesFn:       factory.Remotes,
 		progress:        factory.IOStreams,
@@ -106,6 +108,16 @@ type FindOptions struct {
 	Detector fd.Detector
 }
 
+// newDetector builds a feature detector for the base repo's host, preferring the
+// factory's persistent-cached detector when one was supplied.
+func (f *finder) newDetector(httpClient *http.Client) (fd.Detector, error) {
+	if f.detectorFn != nil {
+		return f.detectorFn(f.baseRefRepo.RepoHost())
+	}
+	cachedClient := api.NewCachedHTTPClient(httpClient, time.Hour*24)
+	return fd.NewDetector(cachedClient, f.baseRefRepo.RepoHost()), nil
+}
+
 func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, error) {
 	// If we have a URL, we don't need git stuff
 	if len(opts.Fields) == 0 {
@@ -209,8 +221,9 @@ func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, err
 
 	if fields.Contains("isInMergeQueue") || fields.Contains("isMergeQueueEnabled") {
 		if opts.Detector == nil {
-			cachedClient := api.NewCachedHTTPClient(httpClient, time.Hour*24)
-			opts.Detector = fd.NewDetector(cachedClient, f.baseRefRepo.RepoHost())
+			if opts.Detector, err = f.newDetector(httpClient); err != nil {
+				return nil, nil, err
+			}
 		}
 		prFeatures, err := opts.Detector.PullRequestFeatures()
 		if err != nil {
@@ -233,8 +246,9 @@ func (f *finder) Find(opts FindOptions) (*api.PullRequest, ghrepo.Interface, err
 	// When removing this, remember to remove `projectCards` from the list of default fields in pr/view.go
 	if fields.Contains("projectCards") {
 		if opts.Detector == nil {
-			cachedClient := api.NewCachedHTTPClient(httpClient, time.Hour*24)
-			opts.Detector = fd.NewDetector(cachedClient, f.baseRefRepo.RepoHost())
+			if opts.Detector, err = f.newDetector(httpClient); err != nil {
+				return nil, nil, err
+			}
 		}
 
 		if opts.Detector.ProjectsV1() == gh.ProjectsV1Unsupported {
@@ -538,50 +552,51 @@ func preloadPrChecks(client *http.Client, repo ghrepo.Interface, pr *api.PullReq
 		return nil
 	}
 	statusCheckRollup := &pr.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts
-	if !statusCheckRollup.PageInfo.HasNextPage {
-		return nil
-	}
-
-	endCursor := statusCheckRollup.PageInfo.EndCursor
 
+	// The lookup query cannot ask whether each check is required, since that
+	// GraphQL field needs the pull request ID; now that the ID is known,
+	// re-fetch the contexts so every node carries the required bit, along
+	// with any pages beyond the first.
 	type response struct {
 		Node *api.PullRequest
 	}
 
 	query := fmt.Sprintf(`
-	query PullRequestStatusChecks($id: ID!, $endCursor: String!) {
+	query PullRequestStatusChecks($id: ID!, $endCursor: String) {
 		node(id: $id) {
 			...on PullRequest {
 				%s
 			}
 		}
-	}`, api.StatusCheckRollupGraphQLWithoutCountByState("$endCursor"))
+	}`, api.RequiredStatusCheckRollupGraphQL("$id", "$endCursor", false))
 
 	variables := map[string]interface{}{
 		"id": pr.ID,
 	}
 
+	var nodes []api.CheckContext
 	apiClient := api.NewClientFromHTTP(client)
 	for {
-		variables["endCursor"] = endCursor
 		var resp response
 		err := apiClient.GraphQL(repo.RepoHost(), query, variables, &resp)
 		if err != nil {
 			return err
 		}
 
+		if len(resp.Node.StatusCheckRollup.Nodes) == 0 {
+			break
+		}
+
 		result := resp.Node.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts
-		statusCheckRollup.Nodes = append(
-			statusCheckRollup.Nodes,
-			result.Nodes...,
-		)
+		nodes = append(nodes, result.Nodes...)
 
 		if !result.PageInfo.HasNextPage {
 			break
 		}
-		endCursor = result.PageInfo.EndCursor
+		variables["endCursor"] = result.PageInfo.EndCursor
 	}
 
+	statusCheckRollup.Nodes = nodes
 	statusCheckRollup.PageInfo.HasNextPage = false
 	return nil
 }
@@ -39,6 +39,7 @@ type Prompt interface {
 	MarkdownEditor(string, string, bool) (string, error)
 	Confirm(string, bool) (bool, error)
 	MultiSelect(string, []string, []string) ([]int, error)
+	FuzzyMultiSelect(string, []string, []string) ([]int, error)
 }
 
 func ConfirmIssueSubmission(p Prompt, allowPreview bool, allowMetadata bool) (Action, error) {
@@ -229,7 +230,7 @@ func MetadataSurvey(p Prompt, io *iostreams.IOStreams, baseRepo ghrepo.Interface
 
 	if isChosen("Reviewers") {
 		if len(reviewers) > 0 {
-			selected, err := p.MultiSelect("Reviewers", state.Reviewers, reviewers)
+			selected, err := p.FuzzyMultiSelect("Reviewers", state.Reviewers, reviewers)
 			if err != nil {
 				return err
 			}
@@ -242,7 +243,7 @@ func MetadataSurvey(p Prompt, io *iostreams.IOStreams, baseRepo ghrepo.Interface
 	}
 	if isChosen("Assignees") {
 		if len(assignees) > 0 {
-			selected, err := p.MultiSelect("Assignees", state.Assignees, assignees)
+			selected, err := p.FuzzyMultiSelect("Assignees", state.Assignees, assignees)
 			if err != nil {
 				return err
 			}
@@ -255,7 +256,7 @@ func MetadataSurvey(p Prompt, io *iostreams.IOStreams, baseRepo ghrepo.Interface
 	}
 	if isChosen("Labels") {
 		if len(labels) > 0 {
-			selected, err := p.MultiSelect("Labels", state.Labels, labels)
+			selected, err := p.FuzzyMultiSelect("Labels", state.Labels, labels)
 			if err != nil {
 				return err
 			}
@@ -268,7 +269,7 @@ func MetadataSurvey(p Prompt, io *iostreams.IOStreams, baseRepo ghrepo.Interface
 	}
 	if isChosen("Projects") {
 		if len(projects) > 0 {
-			selected, err := p.MultiSelect("Projects", state.ProjectTitles, projects)
+			selected, err := p.FuzzyMultiSelect("Projects", state.ProjectTitles, projects)
 			if err != nil {
 				return err
 			}
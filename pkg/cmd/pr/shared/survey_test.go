@@ -142,10 +142,6 @@ func TestMetadataSurveyProjectV1Deprecation(t *testing.T) {
 			require.NoError(t, err)
 			return []int{i}, nil
 		})
-		pm.RegisterMultiSelect("Projects", []string{}, []string{"Huge Refactoring"}, func(_ string, _, _ []string) ([]int, error) {
-			return []int{0}, nil
-		})
-
 		err := MetadataSurvey(pm, ios, repo, fetcher, &IssueMetadataState{}, gh.ProjectsV1Supported)
 		require.ErrorContains(t, err, "expected test error")
 
@@ -163,10 +159,6 @@ func TestMetadataSurveyProjectV1Deprecation(t *testing.T) {
 			require.NoError(t, err)
 			return []int{i}, nil
 		})
-		pm.RegisterMultiSelect("Projects", []string{}, []string{"Huge Refactoring"}, func(_ string, _, _ []string) ([]int, error) {
-			return []int{0}, nil
-		})
-
 		err := MetadataSurvey(pm, ios, repo, fetcher, &IssueMetadataState{}, gh.ProjectsV1Unsupported)
 		require.ErrorContains(t, err, "expected test error")
 
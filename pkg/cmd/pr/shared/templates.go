@@ -16,9 +16,19 @@ import (
 )
 
 type issueTemplate struct {
-	Gname  string `graphql:"name"`
-	Gbody  string `graphql:"body"`
-	Gtitle string `graphql:"title"`
+	Gname      string `graphql:"name"`
+	Gbody      string `graphql:"body"`
+	Gtitle     string `graphql:"title"`
+	Gassignees struct {
+		Nodes []struct {
+			Login string
+		}
+	} `graphql:"assignees(first: 10)"`
+	Glabels struct {
+		Nodes []struct {
+			Name string
+		}
+	} `graphql:"labels(first: 20)"`
 }
 
 type pullRequestTemplate struct {
@@ -42,6 +52,22 @@ func (t *issueTemplate) Title() string {
 	return t.Gtitle
 }
 
+func (t *issueTemplate) Labels() []string {
+	labels := make([]string, 0, len(t.Glabels.Nodes))
+	for _, l := range t.Glabels.Nodes {
+		labels = append(labels, l.Name)
+	}
+	return labels
+}
+
+func (t *issueTemplate) Assignees() []string {
+	assignees := make([]string, 0, len(t.Gassignees.Nodes))
+	for _, a := range t.Gassignees.Nodes {
+		assignees = append(assignees, a.Login)
+	}
+	return assignees
+}
+
 func (t *pullRequestTemplate) Name() string {
 	return t.Gname
 }
@@ -58,6 +84,14 @@ func (t *pullRequestTemplate) Title() string {
 	return ""
 }
 
+func (t *pullRequestTemplate) Labels() []string {
+	return nil
+}
+
+func (t *pullRequestTemplate) Assignees() []string {
+	return nil
+}
+
 func listIssueTemplates(httpClient *http.Client, repo ghrepo.Interface) ([]Template, error) {
 	var query struct {
 		Repository struct {
@@ -119,6 +153,26 @@ type Template interface {
 	NameForSubmit() string
 	Body() []byte
 	Title() string
+	Labels() []string
+	Assignees() []string
+}
+
+// ApplyTemplateMetadata copies default metadata declared by a template, e.g.
+// in its YAML front matter, onto the draft state. Values that were already
+// supplied through flags take precedence.
+func ApplyTemplateMetadata(state *IssueMetadataState, template Template) {
+	if template == nil {
+		return
+	}
+	if state.Title == "" {
+		state.Title = template.Title()
+	}
+	if len(state.Labels) == 0 {
+		state.Labels = template.Labels()
+	}
+	if len(state.Assignees) == 0 {
+		state.Assignees = template.Assignees()
+	}
 }
 
 type iprompter interface {
@@ -308,3 +362,11 @@ func (t *filesystemTemplate) Body() []byte {
 func (t *filesystemTemplate) Title() string {
 	return githubtemplate.ExtractTitle(t.path)
 }
+
+func (t *filesystemTemplate) Labels() []string {
+	return githubtemplate.ExtractLabels(t.path)
+}
+
+func (t *filesystemTemplate) Assignees() []string {
+	return githubtemplate.ExtractAssignees(t.path)
+}
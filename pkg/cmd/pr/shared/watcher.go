@@ -0,0 +1,150 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+)
+
+// WatchEventKind identifies which aspect of a pull request changed between
+// two polls of a Watcher.
+type WatchEventKind string
+
+const (
+	// WatchEventInitial is emitted once for the first snapshot of the pull
+	// request, before any changes have been observed.
+	WatchEventInitial WatchEventKind = "initial"
+	// WatchEventStateChanged is emitted when the pull request is merged,
+	// closed, or reopened.
+	WatchEventStateChanged WatchEventKind = "state"
+	// WatchEventChecksChanged is emitted when the outcome counts of the status
+	// checks on the head commit change.
+	WatchEventChecksChanged WatchEventKind = "checks"
+	// WatchEventReviewsChanged is emitted when the review decision or the set
+	// of submitted reviews changes.
+	WatchEventReviewsChanged WatchEventKind = "reviews"
+)
+
+// WatchEvent is one observed change to a watched pull request, along with the
+// snapshot that produced it.
+type WatchEvent struct {
+	Kind        WatchEventKind
+	PullRequest *api.PullRequest
+}
+
+// ErrStopWatching can be returned from a watch handler to end the watch loop
+// early without surfacing an error.
+var ErrStopWatching = errors.New("stop watching")
+
+// Watcher polls a pull request and emits a typed event for every change to
+// its state, status checks, or reviews. It is the shared polling loop behind
+// watch-style commands such as `pr view --watch` and `pr checks --watch`.
+type Watcher struct {
+	// Fetch returns a fresh snapshot of the watched pull request.
+	Fetch func() (*api.PullRequest, error)
+	// Interval is how long to sleep between polls.
+	Interval time.Duration
+
+	// sleep is swapped out in tests to avoid real delays.
+	sleep func(time.Duration)
+}
+
+func NewWatcher(fetch func() (*api.PullRequest, error), interval time.Duration) *Watcher {
+	return &Watcher{
+		Fetch:    fetch,
+		Interval: interval,
+	}
+}
+
+// Watch polls until the pull request is merged or closed, invoking handler
+// for every emitted event. The first poll always emits WatchEventInitial so
+// that callers can render the starting snapshot. Handlers may return
+// ErrStopWatching to end the loop early; any other handler or fetch error
+// aborts the watch, as does cancellation of ctx. The most recent snapshot is
+// returned in either case.
+func (w *Watcher) Watch(ctx context.Context, handler func(WatchEvent) error) (*api.PullRequest, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	sleep := w.sleep
+	if sleep == nil {
+		sleep = func(d time.Duration) {
+			timer := time.NewTimer(d)
+			defer timer.Stop()
+			select {
+			case <-ctx.Done():
+			case <-timer.C:
+			}
+		}
+	}
+
+	var previous *api.PullRequest
+	for {
+		if err := ctx.Err(); err != nil {
+			return previous, err
+		}
+
+		pr, err := w.Fetch()
+		if err != nil {
+			return previous, err
+		}
+
+		for _, event := range diffPullRequest(previous, pr) {
+			if err := handler(event); err != nil {
+				if errors.Is(err, ErrStopWatching) {
+					return pr, nil
+				}
+				return pr, err
+			}
+		}
+		previous = pr
+
+		if pr.State == "MERGED" || pr.State == "CLOSED" {
+			return pr, nil
+		}
+
+		sleep(w.Interval)
+	}
+}
+
+// diffPullRequest compares two snapshots of a pull request and reports every
+// change as an event. A nil previous snapshot yields the initial event.
+func diffPullRequest(previous, current *api.PullRequest) []WatchEvent {
+	if previous == nil {
+		return []WatchEvent{{Kind: WatchEventInitial, PullRequest: current}}
+	}
+
+	var events []WatchEvent
+	if previous.State != current.State {
+		events = append(events, WatchEvent{Kind: WatchEventStateChanged, PullRequest: current})
+	}
+	if checksFingerprint(previous) != checksFingerprint(current) {
+		events = append(events, WatchEvent{Kind: WatchEventChecksChanged, PullRequest: current})
+	}
+	if reviewsFingerprint(previous) != reviewsFingerprint(current) {
+		events = append(events, WatchEvent{Kind: WatchEventReviewsChanged, PullRequest: current})
+	}
+	return events
+}
+
+func checksFingerprint(pr *api.PullRequest) CheckCounts {
+	if len(pr.StatusCheckRollup.Nodes) == 0 {
+		return CheckCounts{}
+	}
+	_, counts := AggregateChecks(pr.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes, false)
+	return counts
+}
+
+type reviewsSummary struct {
+	decision string
+	reviews  int
+}
+
+func reviewsFingerprint(pr *api.PullRequest) reviewsSummary {
+	return reviewsSummary{
+		decision: pr.ReviewDecision,
+		reviews:  len(pr.Reviews.Nodes),
+	}
+}
@@ -0,0 +1,130 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatcher(t *testing.T) {
+	openPr := func() *api.PullRequest {
+		return &api.PullRequest{Number: 123, State: "OPEN"}
+	}
+
+	t.Run("emits initial event and stops when the pull request is merged", func(t *testing.T) {
+		merged := openPr()
+		merged.State = "MERGED"
+		snapshots := []*api.PullRequest{openPr(), openPr(), merged}
+
+		watcher := NewWatcher(func() (*api.PullRequest, error) {
+			pr := snapshots[0]
+			snapshots = snapshots[1:]
+			return pr, nil
+		}, time.Second)
+		var slept []time.Duration
+		watcher.sleep = func(d time.Duration) { slept = append(slept, d) }
+
+		var kinds []WatchEventKind
+		pr, err := watcher.Watch(context.Background(), func(event WatchEvent) error {
+			kinds = append(kinds, event.Kind)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "MERGED", pr.State)
+		assert.Equal(t, []WatchEventKind{WatchEventInitial, WatchEventStateChanged}, kinds)
+		assert.Equal(t, []time.Duration{time.Second, time.Second}, slept)
+	})
+
+	t.Run("emits events for check and review changes", func(t *testing.T) {
+		passed := openPr()
+		passed.ReviewDecision = "APPROVED"
+		passed.Reviews.Nodes = []api.PullRequestReview{{State: "APPROVED"}}
+		passed.StatusCheckRollup.Nodes = []api.StatusCheckRollupNode{{
+			Commit: api.StatusCheckRollupCommit{
+				StatusCheckRollup: api.CommitStatusCheckRollup{
+					Contexts: api.CheckContexts{Nodes: []api.CheckContext{
+						{Name: "build", Status: "COMPLETED", Conclusion: "SUCCESS"},
+					}},
+				},
+			},
+		}}
+		merged := *passed
+		merged.State = "MERGED"
+		snapshots := []*api.PullRequest{openPr(), passed, &merged}
+
+		watcher := NewWatcher(func() (*api.PullRequest, error) {
+			pr := snapshots[0]
+			snapshots = snapshots[1:]
+			return pr, nil
+		}, time.Second)
+		watcher.sleep = func(time.Duration) {}
+
+		var kinds []WatchEventKind
+		_, err := watcher.Watch(context.Background(), func(event WatchEvent) error {
+			kinds = append(kinds, event.Kind)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []WatchEventKind{
+			WatchEventInitial,
+			WatchEventChecksChanged,
+			WatchEventReviewsChanged,
+			WatchEventStateChanged,
+		}, kinds)
+	})
+
+	t.Run("handler can stop the watch early", func(t *testing.T) {
+		watcher := NewWatcher(func() (*api.PullRequest, error) {
+			return openPr(), nil
+		}, time.Second)
+		watcher.sleep = func(time.Duration) {}
+
+		pr, err := watcher.Watch(context.Background(), func(event WatchEvent) error {
+			return ErrStopWatching
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, 123, pr.Number)
+	})
+
+	t.Run("fetch errors abort the watch", func(t *testing.T) {
+		watcher := NewWatcher(func() (*api.PullRequest, error) {
+			return nil, errors.New("fetch failed")
+		}, time.Second)
+		watcher.sleep = func(time.Duration) {}
+
+		_, err := watcher.Watch(context.Background(), func(event WatchEvent) error {
+			return nil
+		})
+		assert.EqualError(t, err, "fetch failed")
+	})
+
+	t.Run("context cancellation aborts the watch", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		watcher := NewWatcher(func() (*api.PullRequest, error) {
+			return openPr(), nil
+		}, time.Second)
+		watcher.sleep = func(time.Duration) { cancel() }
+
+		pr, err := watcher.Watch(ctx, func(event WatchEvent) error {
+			return nil
+		})
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 123, pr.Number)
+	})
+
+	t.Run("handler errors are surfaced", func(t *testing.T) {
+		watcher := NewWatcher(func() (*api.PullRequest, error) {
+			return openPr(), nil
+		}, time.Second)
+		watcher.sleep = func(time.Duration) {}
+
+		_, err := watcher.Watch(context.Background(), func(event WatchEvent) error {
+			return errors.New("render failed")
+		})
+		assert.EqualError(t, err, "render failed")
+	})
+}
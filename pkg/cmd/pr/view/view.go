@@ -1,7 +1,10 @@
 package view
 
 import (
+	"context"
 	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
@@ -23,6 +26,7 @@ import (
 type ViewOptions struct {
 	IO      *iostreams.IOStreams
 	Browser browser.Browser
+	Context context.Context
 	// TODO projectsV1Deprecation
 	// Remove this detector since it is only used for test validation.
 	Detector fd.Detector
@@ -30,19 +34,28 @@ type ViewOptions struct {
 	Finder   shared.PRFinder
 	Exporter cmdutil.Exporter
 
-	SelectorArg string
-	BrowserMode bool
-	Comments    bool
+	SelectorArg  string
+	BrowserMode  bool
+	Comments     bool
+	ExportFormat string
+	OutputFile   string
+	Watch        bool
+	Interval     time.Duration
 
 	Now func() time.Time
 }
 
+const defaultInterval = time.Second * 10
+
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
 	opts := &ViewOptions{
-		IO:      f.IOStreams,
-		Browser: f.Browser,
-		Now:     time.Now,
+		IO:       f.IOStreams,
+		Browser:  f.Browser,
+		Context:  f.Context,
+		Interval: defaultInterval,
+		Now:      time.Now,
 	}
+	var interval int
 
 	cmd := &cobra.Command{
 		Use:   "view [<number> | <url> | <branch>]",
@@ -54,8 +67,16 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 			is displayed.
 
 			With %[1]s--web%[1]s flag, open the pull request in a web browser instead.
+
+			With %[1]s--watch%[1]s flag, keep refreshing the view until the pull request is
+			merged or closed.
+
+			With %[1]s--export%[1]s flag, render the pull request and its full review and
+			comment thread into a standalone Markdown or HTML document, suitable for
+			archiving. Use %[1]s--output%[1]s to write the document to a file instead of stdout.
 		`, "`"),
-		Args: cobra.MaximumNArgs(1),
+		Args:              cobra.MaximumNArgs(1),
+		ValidArgsFunction: shared.PRNumberCompletionFunc(f),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Finder = shared.NewFinder(f)
 
@@ -63,6 +84,43 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				return cmdutil.FlagErrorf("argument required when using the --repo flag")
 			}
 
+			if opts.Watch {
+				if opts.Exporter != nil {
+					return cmdutil.FlagErrorf("cannot use `--watch` with `--json` flag")
+				}
+				if opts.BrowserMode {
+					return cmdutil.FlagErrorf("cannot use `--watch` with `--web` flag")
+				}
+			}
+
+			if opts.OutputFile != "" && opts.ExportFormat == "" {
+				return cmdutil.FlagErrorf("cannot use `--output` without `--export` flag")
+			}
+			if opts.ExportFormat != "" {
+				if opts.BrowserMode {
+					return cmdutil.FlagErrorf("cannot use `--export` with `--web` flag")
+				}
+				if opts.Watch {
+					return cmdutil.FlagErrorf("cannot use `--export` with `--watch` flag")
+				}
+				if opts.Exporter != nil {
+					return cmdutil.FlagErrorf("cannot use `--export` with `--json` flag")
+				}
+			}
+
+			intervalChanged := cmd.Flags().Changed("interval")
+			if !opts.Watch && intervalChanged {
+				return cmdutil.FlagErrorf("cannot use `--interval` flag without `--watch` flag")
+			}
+
+			if intervalChanged {
+				var err error
+				opts.Interval, err = time.ParseDuration(fmt.Sprintf("%ds", interval))
+				if err != nil {
+					return cmdutil.FlagErrorf("could not parse `--interval` flag: %w", err)
+				}
+			}
+
 			if len(args) > 0 {
 				opts.SelectorArg = args[0]
 			}
@@ -76,6 +134,10 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().BoolVarP(&opts.BrowserMode, "web", "w", false, "Open a pull request in the browser")
 	cmd.Flags().BoolVarP(&opts.Comments, "comments", "c", false, "View pull request comments")
+	cmdutil.StringEnumFlag(cmd, &opts.ExportFormat, "export", "", "", []string{"md", "html"}, "Export the pull request and its comments to a standalone document")
+	cmd.Flags().StringVar(&opts.OutputFile, "output", "", "Write the exported document to `file` instead of stdout")
+	cmd.Flags().BoolVarP(&opts.Watch, "watch", "", false, "Watch the pull request until it is merged or closed")
+	cmd.Flags().IntVarP(&interval, "interval", "i", 10, "Refresh interval in seconds when using `--watch` flag")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.PullRequestFields)
 
 	return cmd
@@ -110,12 +172,21 @@ func viewRun(opts *ViewOptions) error {
 	if opts.BrowserMode {
 		openURL := pr.URL
 		if connectedToTerminal {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 		}
 		return opts.Browser.Browse(openURL)
 	}
 
 	opts.IO.DetectTerminalTheme()
+
+	if opts.ExportFormat != "" {
+		return exportPr(opts, baseRepo, pr)
+	}
+
+	if opts.Watch {
+		return watchPrView(opts, baseRepo, pr, findOptions)
+	}
+
 	if err := opts.IO.StartPager(); err == nil {
 		defer opts.IO.StopPager()
 	} else {
@@ -138,6 +209,89 @@ func viewRun(opts *ViewOptions) error {
 	return printRawPrPreview(opts.IO, pr)
 }
 
+func exportPr(opts *ViewOptions, baseRepo ghrepo.Interface, pr *api.PullRequest) error {
+	reviewerStates := parseReviewers(*pr)
+	sortReviewerStates(reviewerStates)
+	reviewers := make([]string, 0, len(reviewerStates))
+	for _, reviewer := range reviewerStates {
+		state := text.Title(strings.ReplaceAll(strings.ToLower(reviewer.State), "_", " "))
+		reviewers = append(reviewers, fmt.Sprintf("%s (%s)", reviewer.Name, state))
+	}
+
+	var milestoneTitle string
+	if pr.Milestone != nil {
+		milestoneTitle = pr.Milestone.Title
+	}
+
+	doc := shared.ExportDocument{
+		Repo:      baseRepo,
+		Title:     pr.Title,
+		Number:    pr.Number,
+		State:     prStateWithDraft(pr),
+		Author:    pr.Author.Login,
+		CreatedAt: pr.CreatedAt,
+		URL:       pr.URL,
+		Body:      pr.Body,
+		Metadata: [][2]string{
+			{"Branches", fmt.Sprintf("%s → %s", pr.HeadRefName, pr.BaseRefName)},
+			{"Reviewers", strings.Join(reviewers, ", ")},
+			{"Assignees", prAssigneeList(*pr)},
+			{"Labels", prLabelList(*pr, nil)},
+			{"Projects", prProjectList(*pr)},
+			{"Milestone", milestoneTitle},
+		},
+		Comments: pr.Comments,
+		Reviews:  pr.DisplayableReviews(),
+	}
+
+	out := io.Writer(opts.IO.Out)
+	if opts.OutputFile != "" {
+		f, err := os.Create(opts.OutputFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	if err := doc.Export(out, opts.ExportFormat); err != nil {
+		return err
+	}
+
+	if opts.OutputFile != "" {
+		opts.IO.Infof("Exported %s#%d to %s\n", ghrepo.FullName(baseRepo), pr.Number, opts.OutputFile)
+	}
+	return nil
+}
+
+// watchPrView refreshes the pull request preview on an alternate screen
+// buffer until the pull request is merged or closed, then prints the final
+// state to the original buffer.
+func watchPrView(opts *ViewOptions, baseRepo ghrepo.Interface, pr *api.PullRequest, findOptions shared.FindOptions) error {
+	// Follow the pull request by number so that the watch stays on the same
+	// pull request even if the current branch changes.
+	findOptions.Selector = strconv.Itoa(pr.Number)
+	watcher := shared.NewWatcher(func() (*api.PullRequest, error) {
+		pr, _, err := opts.Finder.Find(findOptions)
+		return pr, err
+	}, opts.Interval)
+
+	cs := opts.IO.ColorScheme()
+	opts.IO.StartAlternateScreenBuffer()
+	finalPr, err := watcher.Watch(opts.Context, func(event shared.WatchEvent) error {
+		opts.IO.RefreshScreen()
+		fmt.Fprintln(opts.IO.Out, cs.Boldf("Refreshing pull request every %v seconds. Press Ctrl+C to quit.\n", opts.Interval.Seconds()))
+		return printHumanPrPreview(opts, baseRepo, event.PullRequest)
+	})
+	opts.IO.StopAlternateScreenBuffer()
+	if err != nil {
+		return err
+	}
+
+	// Print the final state to the original screen buffer.
+	return printHumanPrPreview(opts, baseRepo, finalPr)
+}
+
 func printRawPrPreview(io *iostreams.IOStreams, pr *api.PullRequest) error {
 	out := io.Out
 	cs := io.ColorScheme()
@@ -238,6 +392,11 @@ func printHumanPrPreview(opts *ViewOptions, baseRepo ghrepo.Interface, pr *api.P
 		fmt.Fprintln(out, pr.Milestone.Title)
 	}
 
+	// Checks summary
+	if checksSection := prChecksSection(cs, pr); checksSection != "" {
+		fmt.Fprint(out, checksSection)
+	}
+
 	// Auto-Merge status
 	autoMerge := pr.AutoMergeRequest
 	if autoMerge != nil {
@@ -292,6 +451,60 @@ func printHumanPrPreview(opts *ViewOptions, baseRepo ghrepo.Interface, pr *api.P
 	return nil
 }
 
+// prChecksSection renders a compact summary of the checks on the head commit:
+// a tally per bucket, with the failing checks listed underneath and the
+// required ones among them first.
+func prChecksSection(cs *iostreams.ColorScheme, pr *api.PullRequest) string {
+	if len(pr.StatusCheckRollup.Nodes) == 0 {
+		return ""
+	}
+	checks, counts := shared.AggregateChecks(pr.StatusCheckRollup.Nodes[0].Commit.StatusCheckRollup.Contexts.Nodes, false)
+	if len(checks) == 0 {
+		return ""
+	}
+
+	tallies := make([]string, 0, 3)
+	if counts.Failed > 0 {
+		tallies = append(tallies, cs.Red(fmt.Sprintf("%d failing", counts.Failed)))
+	}
+	if counts.Passed > 0 {
+		tallies = append(tallies, cs.Green(fmt.Sprintf("%d passing", counts.Passed)))
+	}
+	if counts.Pending > 0 {
+		tallies = append(tallies, cs.Yellow(fmt.Sprintf("%d pending", counts.Pending)))
+	}
+	if counts.Skipping > 0 {
+		tallies = append(tallies, cs.Muted(fmt.Sprintf("%d skipped", counts.Skipping)))
+	}
+	if counts.Canceled > 0 {
+		tallies = append(tallies, cs.Muted(fmt.Sprintf("%d cancelled", counts.Canceled)))
+	}
+
+	var sb strings.Builder
+	sb.WriteString(cs.Bold("Checks: "))
+	sb.WriteString(strings.Join(tallies, ", "))
+	sb.WriteString("\n")
+
+	failing := make([]shared.Check, 0, counts.Failed)
+	for _, c := range checks {
+		if c.Bucket == "fail" {
+			failing = append(failing, c)
+		}
+	}
+	sort.SliceStable(failing, func(i, j int) bool {
+		return failing[i].IsRequired && !failing[j].IsRequired
+	})
+	for _, c := range failing {
+		name := c.Name
+		if c.IsRequired {
+			name = fmt.Sprintf("%s (required)", name)
+		}
+		sb.WriteString(fmt.Sprintf("%s %s\n", cs.FailureIcon(), name))
+	}
+
+	return sb.String()
+}
+
 const (
 	requestedReviewState        = "REQUESTED" // This is our own state for review request
 	approvedReviewState         = "APPROVED"
@@ -428,7 +641,11 @@ func prLabelList(pr api.PullRequest, cs *iostreams.ColorScheme) string {
 
 	labelNames := make([]string, 0, len(pr.Labels.Nodes))
 	for _, label := range pr.Labels.Nodes {
-		labelNames = append(labelNames, cs.Label(label.Color, label.Name))
+		if cs == nil {
+			labelNames = append(labelNames, label.Name)
+		} else {
+			labelNames = append(labelNames, cs.Label(label.Color, label.Name))
+		}
 	}
 
 	list := strings.Join(labelNames, ", ")
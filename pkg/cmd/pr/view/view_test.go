@@ -127,6 +127,48 @@ func Test_NewCmdView(t *testing.T) {
 				Comments:    true,
 			},
 		},
+		{
+			name:  "watch",
+			args:  "123 --watch",
+			isTTY: true,
+			want: ViewOptions{
+				SelectorArg: "123",
+				Watch:       true,
+			},
+		},
+		{
+			name:    "watch with web mode",
+			args:    "123 --watch -w",
+			isTTY:   true,
+			wantErr: "cannot use `--watch` with `--web` flag",
+		},
+		{
+			name:    "interval without watch",
+			args:    "123 --interval 5",
+			isTTY:   true,
+			wantErr: "cannot use `--interval` flag without `--watch` flag",
+		},
+		{
+			name:  "export",
+			args:  "123 --export md",
+			isTTY: true,
+			want: ViewOptions{
+				SelectorArg:  "123",
+				ExportFormat: "md",
+			},
+		},
+		{
+			name:    "output without export",
+			args:    "123 --output pr-123.md",
+			isTTY:   true,
+			wantErr: "cannot use `--output` without `--export` flag",
+		},
+		{
+			name:    "export with web mode",
+			args:    "123 --export md -w",
+			isTTY:   true,
+			wantErr: "cannot use `--export` with `--web` flag",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -163,6 +205,8 @@ func Test_NewCmdView(t *testing.T) {
 			}
 
 			assert.Equal(t, tt.want.SelectorArg, opts.SelectorArg)
+			assert.Equal(t, tt.want.Watch, opts.Watch)
+			assert.Equal(t, tt.want.ExportFormat, opts.ExportFormat)
 		})
 	}
 }
@@ -529,6 +573,7 @@ func TestPRView_Preview(t *testing.T) {
 				`Blueberries are from a fork OWNER/REPO#12`,
 				`Open.*nobody wants to merge 12 commits into master from blueberries . about X years ago`,
 				`.+100.-10 • ✓ Checks passing`,
+				`Checks: 3 passing`,
 				`blueberries taste good`,
 				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
 			},
@@ -557,6 +602,8 @@ func TestPRView_Preview(t *testing.T) {
 				`Blueberries are from a fork OWNER/REPO#12`,
 				`Open.*nobody wants to merge 12 commits into master from blueberries . about X years ago`,
 				`.+100.-10 • × 1/2 checks failing`,
+				`Checks: 1 failing, 1 passing`,
+				`X sad tests \(required\)`,
 				`blueberries taste good`,
 				`View this pull request on GitHub: https://github.com/OWNER/REPO/pull/12`,
 			},
@@ -631,6 +678,32 @@ func TestPRView_Preview(t *testing.T) {
 	}
 }
 
+func TestPRView_Export(t *testing.T) {
+	http := &httpmock.Registry{}
+	defer http.Verify(t)
+
+	pr, err := prFromFixtures(map[string]string{
+		"PullRequestByNumber": "./fixtures/prViewPreview.json",
+	})
+	require.NoError(t, err)
+	shared.StubFinderForRunCommandStyleTests(t, "12", pr, ghrepo.New("OWNER", "REPO"))
+
+	output, err := runCommand(http, "master", false, "12 --export md")
+	require.NoError(t, err)
+
+	assert.Equal(t, "", output.Stderr())
+
+	//nolint:staticcheck // prefer exact matchers over ExpectLines
+	test.ExpectLines(t, output.String(),
+		`# Blueberries are from a fork`,
+		"`OWNER/REPO#12` • OPEN • opened by nobody on",
+		`\*\*Branches:\*\* blueberries → master`,
+		`## Description`,
+		`\*\*blueberries taste good\*\*`,
+		`\[View on GitHub\]\(https://github.com/OWNER/REPO/pull/12\)`,
+	)
+}
+
 func TestPRView_web_currentBranch(t *testing.T) {
 	http := &httpmock.Registry{}
 	defer http.Verify(t)
@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/client"
 	"github.com/cli/cli/v2/pkg/cmd/project/shared/queries"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -17,6 +18,8 @@ type addItemOpts struct {
 	owner     string
 	number    int32
 	itemURL   string
+	search    string
+	limit     int
 	projectID string
 	itemID    string
 	exporter  cmdutil.Exporter
@@ -42,6 +45,9 @@ func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *c
 		Example: heredoc.Doc(`
 			# Add an item to monalisa's project "1"
 			$ gh project item-add 1 --owner monalisa --url https://github.com/monalisa/myproject/issues/23
+
+			# Add every issue or pull request matching a search query to the org's project "5"
+			$ gh project item-add 5 --owner org --search "repo:org/app label:bug state:open"
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -58,6 +64,13 @@ func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *c
 				opts.number = int32(num)
 			}
 
+			if err := cmdutil.MutuallyExclusive("specify only one of `--url` or `--search`", opts.itemURL != "", opts.search != ""); err != nil {
+				return err
+			}
+			if opts.itemURL == "" && opts.search == "" {
+				return cmdutil.FlagErrorf("specify one of `--url` or `--search`")
+			}
+
 			config := addItemConfig{
 				client: client,
 				opts:   opts,
@@ -74,10 +87,10 @@ func NewCmdAddItem(f *cmdutil.Factory, runF func(config addItemConfig) error) *c
 
 	addItemCmd.Flags().StringVar(&opts.owner, "owner", "", "Login of the owner. Use \"@me\" for the current user.")
 	addItemCmd.Flags().StringVar(&opts.itemURL, "url", "", "URL of the issue or pull request to add to the project")
+	addItemCmd.Flags().StringVar(&opts.search, "search", "", "Add every issue and pull request matching a search `query`")
+	addItemCmd.Flags().IntVarP(&opts.limit, "limit", "L", queries.LimitDefault, "Maximum number of matching items to add")
 	cmdutil.AddFormatFlags(addItemCmd, &opts.exporter)
 
-	_ = addItemCmd.MarkFlagRequired("url")
-
 	return addItemCmd
 }
 
@@ -94,6 +107,10 @@ func runAddItem(config addItemConfig) error {
 	}
 	config.opts.projectID = project.ID
 
+	if config.opts.search != "" {
+		return addItemsFromSearch(config)
+	}
+
 	itemID, err := config.client.IssueOrPullRequestID(config.opts.itemURL)
 	if err != nil {
 		return err
@@ -114,6 +131,40 @@ func runAddItem(config addItemConfig) error {
 
 }
 
+func addItemsFromSearch(config addItemConfig) error {
+	itemIDs, err := config.client.SearchIssueOrPullRequestIDs(config.opts.search, config.opts.limit)
+	if err != nil {
+		return err
+	}
+	if len(itemIDs) == 0 {
+		return fmt.Errorf("no issues or pull requests matched search %q", config.opts.search)
+	}
+
+	items := make([]queries.ProjectItem, 0, len(itemIDs))
+	for i, itemID := range itemIDs {
+		config.opts.itemID = itemID
+		query, variables := addItemArgs(config)
+		config.io.StartProgressIndicatorWithLabel(fmt.Sprintf("Adding item %d of %d", i+1, len(itemIDs)))
+		err := config.client.Mutate("AddItem", query, variables)
+		config.io.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+		items = append(items, query.CreateProjectItem.ProjectV2Item)
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, items)
+	}
+
+	if !config.io.IsStdoutTTY() {
+		return nil
+	}
+
+	_, err = fmt.Fprintf(config.io.Out, "Added %s\n", text.Pluralize(len(items), "item"))
+	return err
+}
+
 func addItemArgs(config addItemConfig) (*addProjectItemMutation, map[string]interface{}) {
 	return &addProjectItemMutation{}, map[string]interface{}{
 		"input": githubv4.AddProjectV2ItemByIdInput{
@@ -21,10 +21,16 @@ func TestNewCmdaddItem(t *testing.T) {
 		wantsExporter bool
 	}{
 		{
-			name:        "missing-url",
+			name:        "missing-url-and-search",
 			cli:         "",
 			wantsErr:    true,
-			wantsErrMsg: "required flag(s) \"url\" not set",
+			wantsErrMsg: "specify one of `--url` or `--search`",
+		},
+		{
+			name:        "url-and-search",
+			cli:         "--url github.com/cli/cli --search \"label:bug\"",
+			wantsErr:    true,
+			wantsErrMsg: "specify only one of `--url` or `--search`",
 		},
 		{
 			name:        "not-a-number",
@@ -39,6 +45,22 @@ func TestNewCmdaddItem(t *testing.T) {
 				itemURL: "github.com/cli/cli",
 			},
 		},
+		{
+			name: "search",
+			cli:  "--search \"repo:cli/cli label:bug\"",
+			wants: addItemOpts{
+				search: "repo:cli/cli label:bug",
+				limit:  30,
+			},
+		},
+		{
+			name: "search-with-limit",
+			cli:  "--search \"repo:cli/cli label:bug\" --limit 50",
+			wants: addItemOpts{
+				search: "repo:cli/cli label:bug",
+				limit:  50,
+			},
+		},
 		{
 			name: "number",
 			cli:  "123 --url github.com/cli/cli",
@@ -95,6 +117,7 @@ func TestNewCmdaddItem(t *testing.T) {
 			assert.Equal(t, tt.wants.number, gotOpts.number)
 			assert.Equal(t, tt.wants.owner, gotOpts.owner)
 			assert.Equal(t, tt.wants.itemURL, gotOpts.itemURL)
+			assert.Equal(t, tt.wants.search, gotOpts.search)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 		})
 	}
@@ -539,3 +562,239 @@ func TestRunAddItem_JSON(t *testing.T) {
 		`{"id":"item ID","title":"a title","body":"","type":"Issue"}`,
 		stdout.String())
 }
+
+func TestRunAddItem_Search(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// search for matching items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query SearchIssuesOrPullRequests.*",
+			"variables": map[string]interface{}{
+				"query": "repo:cli/go-gh label:bug",
+				"first": 30,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"search": map[string]interface{}{
+					"pageInfo": map[string]interface{}{
+						"hasNextPage": false,
+					},
+					"nodes": []interface{}{
+						map[string]interface{}{
+							"__typename": "Issue",
+							"id":         "issue ID",
+						},
+						map[string]interface{}{
+							"__typename": "PullRequest",
+							"id":         "pr ID",
+						},
+					},
+				},
+			},
+		})
+
+	// create first item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"an ID","contentId":"issue ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "issue item ID",
+					},
+				},
+			},
+		})
+
+	// create second item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation AddItem.*","variables":{"input":{"projectId":"an ID","contentId":"pr ID"}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"addProjectV2ItemById": map[string]interface{}{
+					"item": map[string]interface{}{
+						"id": "pr item ID",
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := addItemConfig{
+		opts: addItemOpts{
+			owner:  "monalisa",
+			number: 1,
+			search: "repo:cli/go-gh label:bug",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runAddItem(config)
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		"Added 2 items\n",
+		stdout.String())
+}
+
+func TestRunAddItem_SearchNoMatches(t *testing.T) {
+	defer gock.Off()
+	gock.Observe(gock.DumpRequest)
+
+	// get user ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserOrgOwner.*",
+			"variables": map[string]interface{}{
+				"login": "monalisa",
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"id": "an ID",
+				},
+			},
+			"errors": []interface{}{
+				map[string]interface{}{
+					"type": "NOT_FOUND",
+					"path": []string{"organization"},
+				},
+			},
+		})
+
+	// get project ID
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query UserProject.*",
+			"variables": map[string]interface{}{
+				"login":       "monalisa",
+				"number":      1,
+				"firstItems":  0,
+				"afterItems":  nil,
+				"firstFields": 0,
+				"afterFields": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"user": map[string]interface{}{
+					"projectV2": map[string]interface{}{
+						"id": "an ID",
+					},
+				},
+			},
+		})
+
+	// search for matching items
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query SearchIssuesOrPullRequests.*",
+			"variables": map[string]interface{}{
+				"query": "repo:cli/go-gh label:bug",
+				"first": 30,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"search": map[string]interface{}{
+					"pageInfo": map[string]interface{}{
+						"hasNextPage": false,
+					},
+					"nodes": []interface{}{},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := addItemConfig{
+		opts: addItemOpts{
+			owner:  "monalisa",
+			number: 1,
+			search: "repo:cli/go-gh label:bug",
+		},
+		client: client,
+		io:     ios,
+	}
+
+	err := runAddItem(config)
+	assert.EqualError(t, err, `no issues or pull requests matched search "repo:cli/go-gh label:bug"`)
+}
@@ -2,6 +2,7 @@ package itemedit
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,6 +21,7 @@ type editItemOpts struct {
 	body   string
 	itemID string
 	// updateItem
+	fields               []string
 	fieldID              string
 	projectID            string
 	text                 string
@@ -67,12 +69,20 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 
 			For non-draft issues, the ID of the project is also required, and only a single field value can be updated per invocation.
 
+			Field values can be set by name with the %[1]s--field%[1]s flag, which accepts one or more
+			%[1]s<name>=<value>%[1]s pairs. The value is validated and converted according to the type of the
+			field: single select options and iterations are looked up by name, and number and date
+			values are checked before the edit is made.
+
 			Remove project item field value using %[1]s--clear%[1]s flag.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Edit an item's text field value
 			$ gh project item-edit --id <item-id> --field-id <field-id> --project-id <project-id> --text "new text"
 
+			# Edit an item's field values by name
+			$ gh project item-edit --id <item-id> --project-id <project-id> --field Status=Done --field Points=3
+
 			# Clear an item's field value
 			$ gh project item-edit --id <item-id> --field-id <field-id> --project-id <project-id> --clear
 		`),
@@ -97,6 +107,14 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 				return err
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"cannot use `--field` in conjunction with `--field-id`, `--text`, `--number`, `--date`, `--single-select-option-id`, `--iteration-id` or `--clear`",
+				len(opts.fields) > 0,
+				opts.fieldID != "" || opts.text != "" || opts.numberChanged || opts.date != "" || opts.singleSelectOptionID != "" || opts.iterationID != "" || opts.clear,
+			); err != nil {
+				return err
+			}
+
 			client, err := client.New(f)
 			if err != nil {
 				return err
@@ -122,6 +140,7 @@ func NewCmdEditItem(f *cmdutil.Factory, runF func(config editItemConfig) error)
 	editItemCmd.Flags().StringVar(&opts.title, "title", "", "Title of the draft issue item")
 	editItemCmd.Flags().StringVar(&opts.body, "body", "", "Body of the draft issue item")
 
+	editItemCmd.Flags().StringArrayVar(&opts.fields, "field", nil, "Set a field value by `name=value`, may be specified multiple times")
 	editItemCmd.Flags().StringVar(&opts.fieldID, "field-id", "", "ID of the field to update")
 	editItemCmd.Flags().StringVar(&opts.projectID, "project-id", "", "ID of the project to which the field belongs to")
 	editItemCmd.Flags().StringVar(&opts.text, "text", "", "Text value for the field")
@@ -147,6 +166,11 @@ func runEditItem(config editItemConfig) error {
 		return updateDraftIssue(config)
 	}
 
+	// update item values by field name
+	if len(config.opts.fields) > 0 {
+		return updateNamedFields(config)
+	}
+
 	// update item values
 	if config.opts.text != "" || config.opts.numberChanged || config.opts.date != "" || config.opts.singleSelectOptionID != "" || config.opts.iterationID != "" {
 		return updateItemValues(config)
@@ -291,6 +315,137 @@ func updateItemValues(config editItemConfig) error {
 	return printItemResults(config, &query.Update.Item)
 }
 
+func updateNamedFields(config editItemConfig) error {
+	if config.opts.projectID == "" {
+		// TODO: offer to fetch interactively
+		return cmdutil.FlagErrorf("project-id must be provided")
+	}
+
+	resolver := fieldResolver{
+		client:    config.client,
+		projectID: config.opts.projectID,
+	}
+
+	var item *queries.ProjectItem
+	for _, assignment := range config.opts.fields {
+		name, rawValue, ok := strings.Cut(assignment, "=")
+		if !ok {
+			return cmdutil.FlagErrorf("invalid `--field` value %q, expected `<name>=<value>`", assignment)
+		}
+
+		field, err := resolver.resolve(name)
+		if err != nil {
+			return err
+		}
+
+		value, err := fieldValueFor(field, rawValue)
+		if err != nil {
+			return err
+		}
+
+		query := &UpdateProjectV2FieldValue{}
+		variables := map[string]interface{}{
+			"input": githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(config.opts.projectID),
+				ItemID:    githubv4.ID(config.opts.itemID),
+				FieldID:   githubv4.ID(field.ID()),
+				Value:     value,
+			},
+		}
+		if err := config.client.Mutate("UpdateItemValues", query, variables); err != nil {
+			return err
+		}
+		item = &query.Update.Item
+	}
+
+	if config.opts.exporter != nil {
+		return config.opts.exporter.Write(config.io, item)
+	}
+
+	return printItemResults(config, item)
+}
+
+// fieldResolver looks up project fields by name, fetching the field schemas of
+// the project at most once per invocation.
+type fieldResolver struct {
+	client    *queries.Client
+	projectID string
+	byName    map[string]queries.FieldSchema
+}
+
+func (r *fieldResolver) resolve(name string) (queries.FieldSchema, error) {
+	if r.byName == nil {
+		fields, err := r.client.ProjectFieldSchemas(r.projectID)
+		if err != nil {
+			return queries.FieldSchema{}, err
+		}
+		r.byName = make(map[string]queries.FieldSchema, len(fields))
+		for _, field := range fields {
+			r.byName[strings.ToLower(field.Name())] = field
+		}
+	}
+
+	field, ok := r.byName[strings.ToLower(name)]
+	if !ok {
+		return queries.FieldSchema{}, fmt.Errorf("project has no field named %q", name)
+	}
+	return field, nil
+}
+
+// fieldValueFor validates a raw field value against the schema of the field and
+// converts it to the value type the field expects.
+func fieldValueFor(field queries.FieldSchema, raw string) (githubv4.ProjectV2FieldValue, error) {
+	switch field.TypeName {
+	case "ProjectV2Field":
+		switch field.Field.DataType {
+		case "TEXT":
+			return githubv4.ProjectV2FieldValue{
+				Text: githubv4.NewString(githubv4.String(raw)),
+			}, nil
+		case "NUMBER":
+			number, err := strconv.ParseFloat(raw, 64)
+			if err != nil {
+				return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid number value %q for field %q", raw, field.Field.Name)
+			}
+			return githubv4.ProjectV2FieldValue{
+				Number: githubv4.NewFloat(githubv4.Float(number)),
+			}, nil
+		case "DATE":
+			date, err := time.Parse("2006-01-02", raw)
+			if err != nil {
+				return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid date value %q for field %q, expected YYYY-MM-DD", raw, field.Field.Name)
+			}
+			return githubv4.ProjectV2FieldValue{
+				Date: githubv4.NewDate(githubv4.Date{Time: date}),
+			}, nil
+		}
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("field %q of type %s cannot be edited", field.Field.Name, field.Field.DataType)
+	case "ProjectV2SingleSelectField":
+		names := make([]string, 0, len(field.SingleSelectField.Options))
+		for _, option := range field.SingleSelectField.Options {
+			if strings.EqualFold(option.Name, raw) {
+				return githubv4.ProjectV2FieldValue{
+					SingleSelectOptionID: githubv4.NewString(githubv4.String(option.ID)),
+				}, nil
+			}
+			names = append(names, option.Name)
+		}
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid option %q for field %q, valid options are: %s", raw, field.SingleSelectField.Name, strings.Join(names, ", "))
+	case "ProjectV2IterationField":
+		titles := make([]string, 0, len(field.IterationField.Configuration.Iterations))
+		for _, iteration := range field.IterationField.Configuration.Iterations {
+			if strings.EqualFold(iteration.Title, raw) {
+				return githubv4.ProjectV2FieldValue{
+					IterationID: githubv4.NewString(githubv4.String(iteration.Id)),
+				}, nil
+			}
+			titles = append(titles, iteration.Title)
+		}
+		return githubv4.ProjectV2FieldValue{}, fmt.Errorf("invalid iteration %q for field %q, valid iterations are: %s", raw, field.IterationField.Name, strings.Join(titles, ", "))
+	}
+	return githubv4.ProjectV2FieldValue{}, fmt.Errorf("unsupported field type %q", field.TypeName)
+}
+
 func fieldIdAndProjectIdPresence(config editItemConfig) error {
 	if config.opts.fieldID == "" && config.opts.projectID == "" {
 		return cmdutil.FlagErrorf("field-id and project-id must be provided")
@@ -121,6 +121,26 @@ func TestNewCmdeditItem(t *testing.T) {
 				clear:     true,
 			},
 		},
+		{
+			name: "field",
+			cli:  "--field Status=Done --field Points=3 --id 123",
+			wants: editItemOpts{
+				fields: []string{"Status=Done", "Points=3"},
+				itemID: "123",
+			},
+		},
+		{
+			name:        "field-and-field-id",
+			cli:         "--field Status=Done --field-id FIELD_ID --id 123",
+			wantsErr:    true,
+			wantsErrMsg: "cannot use `--field` in conjunction with `--field-id`, `--text`, `--number`, `--date`, `--single-select-option-id`, `--iteration-id` or `--clear`",
+		},
+		{
+			name:        "field-and-clear",
+			cli:         "--field Status=Done --clear --id 123",
+			wantsErr:    true,
+			wantsErrMsg: "cannot use `--field` in conjunction with `--field-id`, `--text`, `--number`, `--date`, `--single-select-option-id`, `--iteration-id` or `--clear`",
+		},
 		{
 			name: "json",
 			cli:  "--format json --id 123",
@@ -160,6 +180,7 @@ func TestNewCmdeditItem(t *testing.T) {
 
 			assert.Equal(t, tt.wants.number, gotOpts.number)
 			assert.Equal(t, tt.wants.itemID, gotOpts.itemID)
+			assert.Equal(t, tt.wants.fields, gotOpts.fields)
 			assert.Equal(t, tt.wantsExporter, gotOpts.exporter != nil)
 			assert.Equal(t, tt.wants.title, gotOpts.title)
 			assert.Equal(t, tt.wants.fieldID, gotOpts.fieldID)
@@ -516,6 +537,223 @@ func TestRunItemEdit_Iteration(t *testing.T) {
 		stdout.String())
 }
 
+func TestRunItemEdit_FieldByName(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get field schemas
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectFieldSchemas.*",
+			"variables": map[string]interface{}{
+				"id":    "project_id",
+				"first": 100,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"fields": map[string]interface{}{
+						"pageInfo": map[string]interface{}{
+							"hasNextPage": false,
+						},
+						"nodes": []interface{}{
+							map[string]interface{}{
+								"__typename": "ProjectV2SingleSelectField",
+								"id":         "status_field_id",
+								"name":       "Status",
+								"dataType":   "SINGLE_SELECT",
+								"options": []interface{}{
+									map[string]interface{}{
+										"id":   "todo_option_id",
+										"name": "Todo",
+									},
+									map[string]interface{}{
+										"id":   "done_option_id",
+										"name": "Done",
+									},
+								},
+							},
+							map[string]interface{}{
+								"__typename": "ProjectV2Field",
+								"id":         "points_field_id",
+								"name":       "Points",
+								"dataType":   "NUMBER",
+							},
+						},
+					},
+				},
+			},
+		})
+
+	// edit item
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"project_id","itemId":"item_id","fieldId":"status_field_id","value":{"singleSelectOptionId":"done_option_id"}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"ID": "item_id",
+					},
+				},
+			},
+		})
+
+	// edit item again
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		BodyString(`{"query":"mutation UpdateItemValues.*","variables":{"input":{"projectId":"project_id","itemId":"item_id","fieldId":"points_field_id","value":{"number":3}}}}`).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"updateProjectV2ItemFieldValue": map[string]interface{}{
+					"projectV2Item": map[string]interface{}{
+						"ID": "item_id",
+						"content": map[string]interface{}{
+							"__typename": "Issue",
+							"title":      "title",
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			fields:    []string{"status=Done", "Points=3"},
+			itemID:    "item_id",
+			projectID: "project_id",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.NoError(t, err)
+	assert.Equal(t, "Edited item \"title\"\n", stdout.String())
+}
+
+func TestRunItemEdit_FieldByNameInvalidOption(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get field schemas
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectFieldSchemas.*",
+			"variables": map[string]interface{}{
+				"id":    "project_id",
+				"first": 100,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"fields": map[string]interface{}{
+						"pageInfo": map[string]interface{}{
+							"hasNextPage": false,
+						},
+						"nodes": []interface{}{
+							map[string]interface{}{
+								"__typename": "ProjectV2SingleSelectField",
+								"id":         "status_field_id",
+								"name":       "Status",
+								"dataType":   "SINGLE_SELECT",
+								"options": []interface{}{
+									map[string]interface{}{
+										"id":   "todo_option_id",
+										"name": "Todo",
+									},
+									map[string]interface{}{
+										"id":   "done_option_id",
+										"name": "Done",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			fields:    []string{"Status=Donezo"},
+			itemID:    "item_id",
+			projectID: "project_id",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.EqualError(t, err, `invalid option "Donezo" for field "Status", valid options are: Todo, Done`)
+}
+
+func TestRunItemEdit_FieldByNameUnknownField(t *testing.T) {
+	defer gock.Off()
+	// gock.Observe(gock.DumpRequest)
+
+	// get field schemas
+	gock.New("https://api.github.com").
+		Post("/graphql").
+		MatchType("json").
+		JSON(map[string]interface{}{
+			"query": "query ProjectFieldSchemas.*",
+			"variables": map[string]interface{}{
+				"id":    "project_id",
+				"first": 100,
+				"after": nil,
+			},
+		}).
+		Reply(200).
+		JSON(map[string]interface{}{
+			"data": map[string]interface{}{
+				"node": map[string]interface{}{
+					"fields": map[string]interface{}{
+						"pageInfo": map[string]interface{}{
+							"hasNextPage": false,
+						},
+						"nodes": []interface{}{},
+					},
+				},
+			},
+		})
+
+	client := queries.NewTestClient()
+
+	ios, _, _, _ := iostreams.Test()
+	config := editItemConfig{
+		io: ios,
+		opts: editItemOpts{
+			fields:    []string{"Status=Done"},
+			itemID:    "item_id",
+			projectID: "project_id",
+		},
+		client: client,
+	}
+
+	err := runEditItem(config)
+	assert.EqualError(t, err, `project has no field named "Status"`)
+}
+
 func TestRunItemEdit_NoChanges(t *testing.T) {
 	defer gock.Off()
 	// gock.Observe(gock.DumpRequest)
@@ -785,6 +785,97 @@ func (p ProjectField) Options() []SingleSelectFieldOptions {
 	return nil
 }
 
+// FieldSchema describes a project field together with the values it accepts:
+// the data type for plain fields, the options of a single select field, and
+// the iterations of an iteration field.
+type FieldSchema struct {
+	TypeName string `graphql:"__typename"`
+	Field    struct {
+		ID       string
+		Name     string
+		DataType string
+	} `graphql:"... on ProjectV2Field"`
+	IterationField struct {
+		ID            string
+		Name          string
+		DataType      string
+		Configuration struct {
+			Iterations []Iteration
+		}
+	} `graphql:"... on ProjectV2IterationField"`
+	SingleSelectField struct {
+		ID       string
+		Name     string
+		DataType string
+		Options  []SingleSelectFieldOptions
+	} `graphql:"... on ProjectV2SingleSelectField"`
+}
+
+// Iteration is a ProjectV2IterationFieldIteration GraphQL object https://docs.github.com/en/graphql/reference/objects#projectv2iterationfielditeration.
+type Iteration struct {
+	Id    string
+	Title string
+}
+
+// ID is the ID of the project field.
+func (f FieldSchema) ID() string {
+	if f.TypeName == "ProjectV2Field" {
+		return f.Field.ID
+	} else if f.TypeName == "ProjectV2IterationField" {
+		return f.IterationField.ID
+	} else if f.TypeName == "ProjectV2SingleSelectField" {
+		return f.SingleSelectField.ID
+	}
+	return ""
+}
+
+// Name is the name of the project field.
+func (f FieldSchema) Name() string {
+	if f.TypeName == "ProjectV2Field" {
+		return f.Field.Name
+	} else if f.TypeName == "ProjectV2IterationField" {
+		return f.IterationField.Name
+	} else if f.TypeName == "ProjectV2SingleSelectField" {
+		return f.SingleSelectField.Name
+	}
+	return ""
+}
+
+// projectFieldSchemas is used to query the field schemas of a project by its ID.
+type projectFieldSchemas struct {
+	Node struct {
+		ProjectV2 struct {
+			Fields struct {
+				PageInfo PageInfo
+				Nodes    []FieldSchema
+			} `graphql:"fields(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ProjectFieldSchemas returns the schemas of all fields of the project with the given ID.
+func (c *Client) ProjectFieldSchemas(projectID string) ([]FieldSchema, error) {
+	variables := map[string]interface{}{
+		"id":    githubv4.ID(projectID),
+		"first": githubv4.Int(LimitMax),
+		"after": (*githubv4.String)(nil),
+	}
+
+	var fields []FieldSchema
+	for {
+		var query projectFieldSchemas
+		err := c.doQueryWithProgressIndicator("ProjectFieldSchemas", &query, variables)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, query.Node.ProjectV2.Fields.Nodes...)
+		if !query.Node.ProjectV2.Fields.PageInfo.HasNextPage {
+			return fields, nil
+		}
+		variables["after"] = githubv4.NewString(query.Node.ProjectV2.Fields.PageInfo.EndCursor)
+	}
+}
+
 func (p ProjectField) ExportData(_ []string) map[string]interface{} {
 	v := map[string]interface{}{
 		"id":   p.ID(),
@@ -1056,6 +1147,69 @@ func (c *Client) IssueOrPullRequestID(rawURL string) (string, error) {
 	return "", errors.New("resource not found, please check the URL")
 }
 
+// searchIssuesOrPullRequests is used to query the global ids of the issues and pull requests matching a search query.
+type searchIssuesOrPullRequests struct {
+	Search struct {
+		PageInfo PageInfo
+		Nodes    []struct {
+			Typename string `graphql:"__typename"`
+			Issue    struct {
+				ID string
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				ID string
+			} `graphql:"... on PullRequest"`
+		}
+	} `graphql:"search(query: $query, type: ISSUE, first: $first, after: $after)"`
+}
+
+// SearchIssueOrPullRequestIDs returns the IDs of the issues and pull requests matching a search query, up to limit.
+func (c *Client) SearchIssueOrPullRequestIDs(searchQuery string, limit int) ([]string, error) {
+	if limit == 0 {
+		limit = LimitDefault
+	}
+
+	// set first to the min of limit and LimitMax
+	first := LimitMax
+	if limit < first {
+		first = limit
+	}
+
+	variables := map[string]interface{}{
+		"query": githubv4.String(searchQuery),
+		"first": githubv4.Int(first),
+		"after": (*githubv4.String)(nil),
+	}
+
+	var ids []string
+	for {
+		var query searchIssuesOrPullRequests
+		err := c.doQueryWithProgressIndicator("SearchIssuesOrPullRequests", &query, variables)
+		if err != nil {
+			return nil, err
+		}
+		for _, node := range query.Search.Nodes {
+			if node.Typename == "Issue" {
+				ids = append(ids, node.Issue.ID)
+			} else if node.Typename == "PullRequest" {
+				ids = append(ids, node.PullRequest.ID)
+			}
+		}
+		if len(ids) >= limit || !query.Search.PageInfo.HasNextPage {
+			break
+		}
+		if len(ids)+LimitMax > limit {
+			variables["first"] = githubv4.Int(limit - len(ids))
+		}
+		variables["after"] = githubv4.NewString(query.Search.PageInfo.EndCursor)
+	}
+
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+	return ids, nil
+}
+
 // userProjects queries the $first projects of a user.
 type userProjects struct {
 	Owner struct {
@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strings"
+	"sync"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/git"
@@ -55,11 +57,13 @@ type CreateOptions struct {
 	RepoOverride string
 	// maximum number of simultaneous uploads
 	Concurrency        int
+	GenerateChecksums  bool
 	DiscussionCategory string
 	GenerateNotes      bool
 	NotesStartTag      string
 	VerifyTag          bool
 	NotesFromTag       bool
+	NotesFromCommits   string
 	FailOnNoCommits    bool
 }
 
@@ -97,6 +101,11 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			Use %[1]s--notes-from-tag%[1]s to automatically generate the release notes
 			from the annotated git tag.
 
+			Use %[1]s--notes-from-commits BASE..HEAD%[1]s to build release notes locally from
+			the commits in the given range, grouped into sections by conventional commit
+			type. This does not rely on the server-side notes generation endpoint, which
+			older GitHub Enterprise Server versions lack.
+
 			When using automatically generated release notes, a release title will also be automatically
 			generated unless a title was explicitly passed. Additional release notes can be prepended to
 			automatically generated notes by using the %[1]s--notes%[1]s flag.
@@ -105,6 +114,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			This may result in the same or duplicate release which may not be desirable in some cases.
 			Use %[1]s--fail-on-no-commits%[1]s to fail if no new commits are available. This flag has no
 			effect if there are no existing releases or this is the very first release.
+
+			Asset files are uploaded concurrently; use %[1]s--concurrency%[1]s to adjust how many
+			uploads run at once. Use %[1]s--generate-checksums%[1]s to also upload a %[1]sSHA256SUMS%[1]s
+			manifest listing the SHA-256 digest of every asset.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Interactively create a release
@@ -125,12 +138,18 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 			# Use annotated tag notes
 			$ gh release create v1.2.3 --notes-from-tag
 
+			# Generate categorized notes from a local commit range
+			$ gh release create v1.2.3 --notes-from-commits v1.2.2..HEAD
+
 			# Don't mark the release as latest
 			$ gh release create v1.2.3 --latest=false
 
 			# Upload all tarballs in a directory as release assets
 			$ gh release create v1.2.3 ./dist/*.tgz
 
+			# Upload release assets along with a SHA256SUMS checksums manifest
+			$ gh release create v1.2.3 ./dist/*.tgz --generate-checksums
+
 			# Upload a release asset with a display label
 			$ gh release create v1.2.3 '/path/to/asset.zip#My display label'
 
@@ -172,9 +191,27 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("using `--notes-from-tag` with `--repo` is not supported")
 			}
 
-			opts.Concurrency = 5
+			if opts.NotesFromCommits != "" {
+				if opts.GenerateNotes || opts.NotesStartTag != "" || opts.NotesFromTag {
+					return cmdutil.FlagErrorf("using `--notes-from-commits` with `--generate-notes`, `--notes-start-tag`, or `--notes-from-tag` is not supported")
+				}
+				if opts.RepoOverride != "" {
+					return cmdutil.FlagErrorf("using `--notes-from-commits` with `--repo` is not supported")
+				}
+				if !strings.Contains(opts.NotesFromCommits, "..") {
+					return cmdutil.FlagErrorf("invalid value for `--notes-from-commits`: expected a commit range like BASE..HEAD")
+				}
+			}
+
+			if opts.Concurrency < 1 {
+				return cmdutil.FlagErrorf("invalid value for `--concurrency`: %d", opts.Concurrency)
+			}
+
+			if opts.GenerateChecksums && len(opts.Assets) == 0 {
+				return cmdutil.FlagErrorf("`--generate-checksums` requires at least one asset file")
+			}
 
-			opts.BodyProvided = cmd.Flags().Changed("notes") || opts.GenerateNotes || opts.NotesFromTag
+			opts.BodyProvided = cmd.Flags().Changed("notes") || opts.GenerateNotes || opts.NotesFromTag || opts.NotesFromCommits != ""
 			if notesFile != "" {
 				b, err := cmdutil.ReadFile(notesFile, opts.IO.In)
 				if err != nil {
@@ -203,7 +240,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmdutil.NilBoolFlag(cmd, &opts.IsLatest, "latest", "", "Mark this release as \"Latest\" (default [automatic based on date and version]). --latest=false to explicitly NOT set as latest")
 	cmd.Flags().BoolVarP(&opts.VerifyTag, "verify-tag", "", false, "Abort in case the git tag doesn't already exist in the remote repository")
 	cmd.Flags().BoolVarP(&opts.NotesFromTag, "notes-from-tag", "", false, "Automatically generate notes from annotated tag")
+	cmd.Flags().StringVar(&opts.NotesFromCommits, "notes-from-commits", "", "Generate categorized notes from the local commits in `range` (BASE..HEAD)")
 	cmd.Flags().BoolVar(&opts.FailOnNoCommits, "fail-on-no-commits", false, "Fail if there are no commits since the last release (no impact on the first release)")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 5, "Maximum `number` of concurrent asset uploads")
+	cmd.Flags().BoolVar(&opts.GenerateChecksums, "generate-checksums", false, "Upload a SHA256SUMS manifest listing the SHA-256 digest of every asset")
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "target")
 
@@ -478,6 +518,26 @@ func createRun(opts *CreateOptions) error {
 			params["body"] = fmt.Sprintf("%s\n%s", opts.Body, tagDescription)
 		}
 	}
+	if opts.NotesFromCommits != "" {
+		commits, err := changelogForRange(opts.GitClient, opts.NotesFromCommits)
+		if err != nil {
+			return fmt.Errorf("cannot generate release notes from commit range %s: %w", opts.NotesFromCommits, err)
+		}
+		notes := categorizedChangelog(commits)
+		if opts.Body == "" {
+			params["body"] = notes
+		} else {
+			params["body"] = fmt.Sprintf("%s\n%s", opts.Body, notes)
+		}
+	}
+
+	if opts.GenerateChecksums && len(opts.Assets) > 0 {
+		checksums, err := shared.ChecksumsAsset(opts.Assets)
+		if err != nil {
+			return err
+		}
+		opts.Assets = append(opts.Assets, checksums)
+	}
 
 	hasAssets := len(opts.Assets) > 0
 	draftWhileUploading := false
@@ -532,8 +592,19 @@ func createRun(opts *CreateOptions) error {
 			uploadURL = uploadURL[:idx]
 		}
 
-		opts.IO.StartProgressIndicator()
-		err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets)
+		var uploaded int
+		var progressMu sync.Mutex
+		progressLabel := func() string {
+			return fmt.Sprintf("Uploading assets (%d/%d)", uploaded, len(opts.Assets))
+		}
+
+		opts.IO.StartProgressIndicatorWithLabel(progressLabel())
+		err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets, func(*shared.AssetForUpload) {
+			progressMu.Lock()
+			defer progressMu.Unlock()
+			uploaded++
+			opts.IO.StartProgressIndicatorWithLabel(progressLabel())
+		})
 		opts.IO.StopProgressIndicator()
 		if err != nil {
 			return cleanupDraftRelease(err)
@@ -634,6 +705,53 @@ func changelogForRange(client *git.Client, refRange string) ([]logEntry, error)
 	return entries, nil
 }
 
+var conventionalCommitRE = regexp.MustCompile(`^(\w+)(\([^)]*\))?!?:\s*(.+)`)
+
+// changelogSections maps conventional commit types to release notes headings,
+// in the order the sections appear in the generated notes. Commits that do not
+// match any listed type end up in the final section.
+var changelogSections = []struct {
+	Heading string
+	Types   []string
+}{
+	{"Features", []string{"feat"}},
+	{"Bug fixes", []string{"fix"}},
+	{"Performance", []string{"perf"}},
+	{"Documentation", []string{"docs"}},
+	{"Other changes", nil},
+}
+
+func categorizedChangelog(commits []logEntry) string {
+	grouped := map[string][]string{}
+	for _, c := range commits {
+		heading := "Other changes"
+		subject := c.Subject
+		if m := conventionalCommitRE.FindStringSubmatch(c.Subject); m != nil {
+			for _, section := range changelogSections {
+				for _, typ := range section.Types {
+					if typ != m[1] {
+						continue
+					}
+					heading = section.Heading
+					subject = m[3]
+					if scope := strings.Trim(m[2], "()"); scope != "" {
+						subject = fmt.Sprintf("%s: %s", scope, subject)
+					}
+				}
+			}
+		}
+		grouped[heading] = append(grouped[heading], fmt.Sprintf("* %s", subject))
+	}
+
+	var parts []string
+	for _, section := range changelogSections {
+		if lines, ok := grouped[section.Heading]; ok {
+			parts = append(parts, fmt.Sprintf("## %s\n\n%s", section.Heading, strings.Join(lines, "\n")))
+		}
+	}
+	return strings.Join(parts, "\n\n")
+}
+
 func generateChangelog(commits []logEntry) string {
 	var parts []string
 	for _, c := range commits {
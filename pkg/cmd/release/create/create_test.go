@@ -343,6 +343,30 @@ func Test_NewCmdCreate(t *testing.T) {
 			isTTY:   false,
 			wantErr: "using `--notes-from-tag` with `--generate-notes` or `--notes-start-tag` is not supported",
 		},
+		{
+			name:  "with --notes-from-commits",
+			args:  "v1.2.3 --notes-from-commits v1.2.2..HEAD",
+			isTTY: true,
+			want: CreateOptions{
+				TagName:          "v1.2.3",
+				BodyProvided:     true,
+				Concurrency:      5,
+				Assets:           []*shared.AssetForUpload(nil),
+				NotesFromCommits: "v1.2.2..HEAD",
+			},
+		},
+		{
+			name:    "with --notes-from-commits and --generate-notes",
+			args:    "v1.2.3 --notes-from-commits v1.2.2..HEAD --generate-notes",
+			isTTY:   true,
+			wantErr: "using `--notes-from-commits` with `--generate-notes`, `--notes-start-tag`, or `--notes-from-tag` is not supported",
+		},
+		{
+			name:    "with --notes-from-commits and an invalid range",
+			args:    "v1.2.3 --notes-from-commits v1.2.2",
+			isTTY:   true,
+			wantErr: "invalid value for `--notes-from-commits`: expected a commit range like BASE..HEAD",
+		},
 		{
 			name:    "with --notes-from-tag and --notes-start-tag",
 			args:    "v1.2.3 --notes-from-tag --notes-start-tag v1.2.3",
@@ -362,6 +386,49 @@ func Test_NewCmdCreate(t *testing.T) {
 				FailOnNoCommits: true,
 			},
 		},
+		{
+			name:  "generate checksums",
+			args:  fmt.Sprintf("v1.2.3 --generate-checksums '%s'", af1.Name()),
+			isTTY: true,
+			want: CreateOptions{
+				TagName:           "v1.2.3",
+				Concurrency:       5,
+				GenerateChecksums: true,
+				Assets: []*shared.AssetForUpload{
+					{
+						Name:  "windows.zip",
+						Label: "",
+					},
+				},
+			},
+		},
+		{
+			name:    "generate checksums without assets",
+			args:    "v1.2.3 --generate-checksums",
+			isTTY:   true,
+			wantErr: "`--generate-checksums` requires at least one asset file",
+		},
+		{
+			name:  "custom concurrency",
+			args:  fmt.Sprintf("v1.2.3 --concurrency 10 '%s'", af1.Name()),
+			isTTY: true,
+			want: CreateOptions{
+				TagName:     "v1.2.3",
+				Concurrency: 10,
+				Assets: []*shared.AssetForUpload{
+					{
+						Name:  "windows.zip",
+						Label: "",
+					},
+				},
+			},
+		},
+		{
+			name:    "invalid concurrency",
+			args:    "v1.2.3 --concurrency 0",
+			isTTY:   true,
+			wantErr: "invalid value for `--concurrency`: 0",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -410,6 +477,7 @@ func Test_NewCmdCreate(t *testing.T) {
 			assert.Equal(t, tt.want.Draft, opts.Draft)
 			assert.Equal(t, tt.want.Prerelease, opts.Prerelease)
 			assert.Equal(t, tt.want.Concurrency, opts.Concurrency)
+			assert.Equal(t, tt.want.GenerateChecksums, opts.GenerateChecksums)
 			assert.Equal(t, tt.want.RepoOverride, opts.RepoOverride)
 			assert.Equal(t, tt.want.DiscussionCategory, opts.DiscussionCategory)
 			assert.Equal(t, tt.want.GenerateNotes, opts.GenerateNotes)
@@ -417,6 +485,7 @@ func Test_NewCmdCreate(t *testing.T) {
 			assert.Equal(t, tt.want.IsLatest, opts.IsLatest)
 			assert.Equal(t, tt.want.VerifyTag, opts.VerifyTag)
 			assert.Equal(t, tt.want.NotesFromTag, opts.NotesFromTag)
+			assert.Equal(t, tt.want.NotesFromCommits, opts.NotesFromCommits)
 			assert.Equal(t, tt.want.FailOnNoCommits, opts.FailOnNoCommits)
 
 			require.Equal(t, len(tt.want.Assets), len(opts.Assets))
@@ -1180,6 +1249,41 @@ func Test_createRun(t *testing.T) {
 			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
 			wantStderr: "",
 		},
+		{
+			name:  "with generate notes from commits",
+			isTTY: false,
+			opts: CreateOptions{
+				TagName:          "v1.2.3",
+				BodyProvided:     true,
+				Concurrency:      5,
+				Assets:           []*shared.AssetForUpload(nil),
+				NotesFromCommits: "v1.2.2..v1.2.3",
+			},
+			runStubs: func(rs *run.CommandStubber) {
+				rs.Register(contentCmd, 0, "")
+				rs.Register(signatureCmd, 0, "")
+				rs.Register(`git -c log.ShowSignature=false log --first-parent --reverse`, 0,
+					"feat: add feature\x00fix(api): squash bug\x00update readme\x00")
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/releases"),
+					httpmock.RESTPayload(201, `{
+						"url": "https://api.github.com/releases/123",
+						"upload_url": "https://api.github.com/assets/upload",
+						"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+					}`, func(payload map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"tag_name":   "v1.2.3",
+							"draft":      false,
+							"prerelease": false,
+							"body":       "## Features\n\n* add feature\n\n## Bug fixes\n\n* api: squash bug\n\n## Other changes\n\n* update readme",
+						}, payload)
+					}))
+			},
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+			wantStderr: "",
+		},
 		{
 			name:  "with generate notes from tag and tag does not exist",
 			isTTY: false,
@@ -16,7 +16,7 @@ import (
 )
 
 type iprompter interface {
-	Confirm(string, bool) (bool, error)
+	ConfirmDeletion(string) error
 }
 
 type DeleteOptions struct {
@@ -27,9 +27,10 @@ type DeleteOptions struct {
 	RepoOverride string
 	Prompter     iprompter
 
-	TagName     string
-	SkipConfirm bool
-	CleanupTag  bool
+	TagName    string
+	CleanupTag bool
+
+	cmdutil.DestructiveOptions
 }
 
 func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
@@ -58,7 +59,8 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 		},
 	}
 
-	cmd.Flags().BoolVarP(&opts.SkipConfirm, "yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().BoolVarP(&opts.Confirmed, "yes", "y", false, "Skip the confirmation prompt")
+	cmdutil.AddDryRunFlag(cmd, &opts.DryRun)
 	cmd.Flags().BoolVar(&opts.CleanupTag, "cleanup-tag", false, "Delete the specified tag in addition to its release")
 
 	return cmd
@@ -80,16 +82,13 @@ func deleteRun(opts *DeleteOptions) error {
 		return err
 	}
 
-	if !opts.SkipConfirm && opts.IO.CanPrompt() {
-		confirmed, err := opts.Prompter.Confirm(
-			fmt.Sprintf("Delete release %s in %s?", release.TagName, ghrepo.FullName(baseRepo)), true)
-		if err != nil {
-			return err
-		}
+	if err := cmdutil.ConfirmDestructive(opts.IO.CanPrompt(), opts.Prompter, release.TagName, opts.DestructiveOptions); err != nil {
+		return err
+	}
 
-		if !confirmed {
-			return cmdutil.CancelError
-		}
+	if opts.DryRun {
+		fmt.Fprintf(opts.IO.Out, "Would have deleted release %s in %s\n", release.TagName, ghrepo.FullName(baseRepo))
+		return nil
 	}
 
 	err = deleteRelease(httpClient, release.APIURL)
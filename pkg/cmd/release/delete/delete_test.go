@@ -33,9 +33,8 @@ func Test_NewCmdDelete(t *testing.T) {
 			args:  "v1.2.3",
 			isTTY: true,
 			want: DeleteOptions{
-				TagName:     "v1.2.3",
-				SkipConfirm: false,
-				CleanupTag:  false,
+				TagName:    "v1.2.3",
+				CleanupTag: false,
 			},
 		},
 		{
@@ -43,9 +42,18 @@ func Test_NewCmdDelete(t *testing.T) {
 			args:  "v1.2.3 -y",
 			isTTY: true,
 			want: DeleteOptions{
-				TagName:     "v1.2.3",
-				SkipConfirm: true,
-				CleanupTag:  false,
+				TagName:            "v1.2.3",
+				CleanupTag:         false,
+				DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true},
+			},
+		},
+		{
+			name:  "dry run",
+			args:  "v1.2.3 --dry-run",
+			isTTY: true,
+			want: DeleteOptions{
+				TagName:            "v1.2.3",
+				DestructiveOptions: cmdutil.DestructiveOptions{DryRun: true},
 			},
 		},
 		{
@@ -53,9 +61,8 @@ func Test_NewCmdDelete(t *testing.T) {
 			args:  "v1.2.3 --cleanup-tag",
 			isTTY: true,
 			want: DeleteOptions{
-				TagName:     "v1.2.3",
-				SkipConfirm: false,
-				CleanupTag:  true,
+				TagName:    "v1.2.3",
+				CleanupTag: true,
 			},
 		},
 		{
@@ -100,7 +107,8 @@ func Test_NewCmdDelete(t *testing.T) {
 			}
 
 			assert.Equal(t, tt.want.TagName, opts.TagName)
-			assert.Equal(t, tt.want.SkipConfirm, opts.SkipConfirm)
+			assert.Equal(t, tt.want.Confirmed, opts.Confirmed)
+			assert.Equal(t, tt.want.DryRun, opts.DryRun)
 			assert.Equal(t, tt.want.CleanupTag, opts.CleanupTag)
 		})
 	}
@@ -126,11 +134,11 @@ func Test_deleteRun(t *testing.T) {
 			wantStdout: "",
 			wantStderr: "✓ Deleted release v1.2.3\n! Note that the v1.2.3 git tag still remains in the repository\n",
 			prompterStubs: func(pm *prompter.PrompterMock) {
-				pm.ConfirmFunc = func(p string, d bool) (bool, error) {
-					if p == "Delete release v1.2.3 in OWNER/REPO?" {
-						return true, nil
+				pm.ConfirmDeletionFunc = func(p string) error {
+					if p == "v1.2.3" {
+						return nil
 					}
-					return false, prompter.NoSuchPromptErr(p)
+					return prompter.NoSuchPromptErr(p)
 				}
 			},
 		},
@@ -138,9 +146,9 @@ func Test_deleteRun(t *testing.T) {
 			name:  "skipping confirmation",
 			isTTY: true,
 			opts: DeleteOptions{
-				TagName:     "v1.2.3",
-				SkipConfirm: true,
-				CleanupTag:  false,
+				TagName:            "v1.2.3",
+				CleanupTag:         false,
+				DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true},
 			},
 			wantStdout: ``,
 			wantStderr: heredoc.Doc(`
@@ -152,20 +160,38 @@ func Test_deleteRun(t *testing.T) {
 			name:  "non-interactive",
 			isTTY: false,
 			opts: DeleteOptions{
-				TagName:     "v1.2.3",
-				SkipConfirm: false,
-				CleanupTag:  false,
+				TagName:            "v1.2.3",
+				CleanupTag:         false,
+				DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true},
 			},
 			wantStdout: ``,
 			wantStderr: ``,
 		},
+		{
+			name:  "non-interactive, no confirmation",
+			isTTY: false,
+			opts: DeleteOptions{
+				TagName: "v1.2.3",
+			},
+			wantErr: "--yes required when not running interactively",
+		},
+		{
+			name:  "dry run",
+			isTTY: true,
+			opts: DeleteOptions{
+				TagName:            "v1.2.3",
+				DestructiveOptions: cmdutil.DestructiveOptions{DryRun: true},
+			},
+			wantStdout: "Would have deleted release v1.2.3 in OWNER/REPO\n",
+			wantStderr: ``,
+		},
 		{
 			name:  "cleanup-tag & skipping confirmation",
 			isTTY: true,
 			opts: DeleteOptions{
-				TagName:     "v1.2.3",
-				SkipConfirm: true,
-				CleanupTag:  true,
+				TagName:            "v1.2.3",
+				CleanupTag:         true,
+				DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true},
 			},
 			runStubs: func(rs *run.CommandStubber) {
 				rs.Register(`git tag -d v1.2.3`, 0, "")
@@ -179,9 +205,9 @@ func Test_deleteRun(t *testing.T) {
 			name:  "cleanup-tag",
 			isTTY: false,
 			opts: DeleteOptions{
-				TagName:     "v1.2.3",
-				SkipConfirm: false,
-				CleanupTag:  true,
+				TagName:            "v1.2.3",
+				CleanupTag:         true,
+				DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true},
 			},
 			runStubs: func(rs *run.CommandStubber) {
 				rs.Register(`git tag -d v1.2.3`, 0, "")
@@ -17,6 +17,9 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	attestapi "github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	attestio "github.com/cli/cli/v2/pkg/cmd/attestation/io"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
 	"github.com/cli/cli/v2/pkg/cmd/release/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -38,6 +41,11 @@ type DownloadOptions struct {
 	Concurrency int
 
 	ArchiveType string
+
+	// for verifying assets against their attestations
+	VerifyAttestations bool
+	AttestationClient  attestapi.Client
+	SigstoreVerifier   verification.SigstoreVerifier
 }
 
 func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobra.Command {
@@ -55,6 +63,10 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 			Without an explicit tag name argument, assets are downloaded from the
 			latest release in the project. In this case, %[1]s--pattern%[1]s or %[1]s--archive%[1]s
 			is required.
+
+			With %[1]s--verify%[1]s, the attestations for each downloaded asset are fetched
+			by digest and their provenance is verified. The command fails if any asset
+			lacks a valid attestation from a workflow in this repository.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Download all assets from a specific release
@@ -68,6 +80,9 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 			# Download the archive of the source code for a release
 			$ gh release download v1.2.3 --archive=zip
+
+			# Download all assets and verify them against their attestations
+			$ gh release download v1.2.3 --verify
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -95,11 +110,39 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 				return err
 			}
 
+			if opts.VerifyAttestations {
+				if opts.ArchiveType != "" {
+					return cmdutil.FlagErrorf("`--verify` cannot be used with `--archive`")
+				}
+				if opts.OutputFile == "-" {
+					return cmdutil.FlagErrorf("`--verify` cannot be used when writing to standard output")
+				}
+			}
+
 			opts.Concurrency = 5
 
 			if runF != nil {
 				return runF(opts)
 			}
+
+			if opts.VerifyAttestations {
+				httpClient, err := opts.HttpClient()
+				if err != nil {
+					return err
+				}
+				baseRepo, err := opts.BaseRepo()
+				if err != nil {
+					return err
+				}
+				logger := attestio.NewHandler(opts.IO)
+				opts.AttestationClient = attestapi.NewLiveClient(httpClient, baseRepo.RepoHost(), logger)
+				sigstoreVerifier, err := verification.NewLiveSigstoreVerifier(verification.SigstoreConfig{Logger: logger})
+				if err != nil {
+					return fmt.Errorf("error creating Sigstore verifier: %w", err)
+				}
+				opts.SigstoreVerifier = sigstoreVerifier
+			}
+
 			return downloadRun(opts)
 		},
 	}
@@ -110,6 +153,7 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 	cmd.Flags().StringVarP(&opts.ArchiveType, "archive", "A", "", "Download the source code archive in the specified `format` (zip or tar.gz)")
 	cmd.Flags().BoolVar(&opts.OverwriteExisting, "clobber", false, "Overwrite existing files of the same name")
 	cmd.Flags().BoolVar(&opts.SkipExisting, "skip-existing", false, "Skip downloading when files of the same name exist")
+	cmd.Flags().BoolVar(&opts.VerifyAttestations, "verify", false, "Verify downloaded assets against their attestations")
 
 	return cmd
 }
@@ -220,7 +264,15 @@ func downloadRun(opts *DownloadOptions) error {
 		stdout:       opts.IO.Out,
 	}
 
-	return downloadAssets(&dest, httpClient, toDownload, opts.Concurrency, isArchive, opts.IO)
+	if err := downloadAssets(&dest, httpClient, toDownload, opts.Concurrency, isArchive, opts.IO); err != nil {
+		return err
+	}
+
+	if opts.VerifyAttestations {
+		return verifyAssets(opts, baseRepo, &dest, toDownload)
+	}
+
+	return nil
 }
 
 func matchAny(patterns []string, name string) bool {
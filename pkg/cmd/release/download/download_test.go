@@ -7,9 +7,12 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
+	attestapi "github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
 	"github.com/cli/cli/v2/pkg/cmd/release/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
@@ -130,6 +133,30 @@ func Test_NewCmdDownload(t *testing.T) {
 			isTTY:   true,
 			wantErr: "specify only one of `--dir` or `--output`",
 		},
+		{
+			name:  "verify flag",
+			args:  "v1.2.3 --verify",
+			isTTY: true,
+			want: DownloadOptions{
+				TagName:            "v1.2.3",
+				FilePatterns:       []string(nil),
+				Destination:        ".",
+				Concurrency:        5,
+				VerifyAttestations: true,
+			},
+		},
+		{
+			name:    "verify with archive",
+			args:    "v1.2.3 --verify -A zip",
+			isTTY:   true,
+			wantErr: "`--verify` cannot be used with `--archive`",
+		},
+		{
+			name:    "verify writing to stdout",
+			args:    "v1.2.3 --verify -O -",
+			isTTY:   true,
+			wantErr: "`--verify` cannot be used when writing to standard output",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -169,6 +196,7 @@ func Test_NewCmdDownload(t *testing.T) {
 			assert.Equal(t, tt.want.Destination, opts.Destination)
 			assert.Equal(t, tt.want.Concurrency, opts.Concurrency)
 			assert.Equal(t, tt.want.OutputFile, opts.OutputFile)
+			assert.Equal(t, tt.want.VerifyAttestations, opts.VerifyAttestations)
 		})
 	}
 }
@@ -738,6 +766,119 @@ func Test_downloadRun_cloberAndSkip(t *testing.T) {
 	}
 }
 
+func Test_downloadRun_verify(t *testing.T) {
+	oldwd, err := os.Getwd()
+	if err != nil {
+		t.Fatalf("could not determine working directory: %v", err)
+	}
+
+	matchingResult := verification.BuildMockResult(nil,
+		"https://github.com/OWNER/REPO/.github/workflows/release.yml@refs/heads/main",
+		"https://github.com/OWNER/REPO/.github/workflows/release.yml@refs/heads/main",
+		"https://github.com/OWNER",
+		"https://github.com/OWNER/REPO",
+		verification.GitHubOIDCIssuer)
+
+	tests := []struct {
+		name             string
+		attestationStubs func(t *testing.T) attestapi.Client
+		sigstoreVerifier verification.SigstoreVerifier
+		wantErr          string
+		wantStdout       []string
+	}{
+		{
+			name: "all assets verified",
+			attestationStubs: func(t *testing.T) attestapi.Client {
+				return &attestapi.MockClient{
+					OnGetByDigest: func(params attestapi.FetchParams) ([]*attestapi.Attestation, error) {
+						assert.Equal(t, "OWNER/REPO", params.Repo)
+						assert.True(t, strings.HasPrefix(params.Digest, "sha256:"))
+						return []*attestapi.Attestation{{}}, nil
+					},
+				}
+			},
+			sigstoreVerifier: verification.NewMockSigstoreVerifierWithMockResults(t,
+				[]*verification.AttestationProcessingResult{&matchingResult}),
+			wantStdout: []string{"asset.zip", "verified", "1 attestation"},
+		},
+		{
+			name: "asset without attestations",
+			attestationStubs: func(t *testing.T) attestapi.Client {
+				return &attestapi.MockClient{
+					OnGetByDigest: func(params attestapi.FetchParams) ([]*attestapi.Attestation, error) {
+						return nil, attestapi.ErrNoAttestationsFound
+					},
+				}
+			},
+			sigstoreVerifier: verification.NewMockSigstoreVerifierWithMockResults(t,
+				[]*verification.AttestationProcessingResult{&matchingResult}),
+			wantErr:    "1 asset failed attestation verification",
+			wantStdout: []string{"asset.zip", "failed", "no attestations found"},
+		},
+		{
+			name: "sigstore verification fails",
+			attestationStubs: func(t *testing.T) attestapi.Client {
+				return &attestapi.MockClient{
+					OnGetByDigest: func(params attestapi.FetchParams) ([]*attestapi.Attestation, error) {
+						return []*attestapi.Attestation{{}}, nil
+					},
+				}
+			},
+			sigstoreVerifier: &verification.FailSigstoreVerifier{},
+			wantErr:          "1 asset failed attestation verification",
+			wantStdout:       []string{"asset.zip", "failed", "failed to verify attestations"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			if err := os.Chdir(tempDir); err == nil {
+				t.Cleanup(func() { _ = os.Chdir(oldwd) })
+			} else {
+				t.Fatal(err)
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+
+			fakeHTTP := &httpmock.Registry{}
+			defer fakeHTTP.Verify(t)
+			shared.StubFetchRelease(t, fakeHTTP, "OWNER", "REPO", "v1.2.3", `{
+				"assets": [
+					{ "name": "asset.zip", "size": 4,
+					"url": "https://api.github.com/assets/1234" }
+				]
+			}`)
+			fakeHTTP.Register(httpmock.REST("GET", "assets/1234"), httpmock.StringResponse(`1234`))
+
+			opts := DownloadOptions{
+				TagName:            "v1.2.3",
+				Destination:        ".",
+				Concurrency:        2,
+				VerifyAttestations: true,
+				AttestationClient:  tt.attestationStubs(t),
+				SigstoreVerifier:   tt.sigstoreVerifier,
+				IO:                 ios,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: fakeHTTP}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.FromFullName("OWNER/REPO")
+				},
+			}
+
+			err := downloadRun(&opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			for _, want := range tt.wantStdout {
+				assert.Contains(t, stdout.String(), want)
+			}
+		})
+	}
+}
+
 func Test_downloadRun_windowsReservedFilename(t *testing.T) {
 	if runtime.GOOS != "windows" {
 		t.SkipNow()
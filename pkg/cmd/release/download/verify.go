@@ -0,0 +1,110 @@
+package download
+
+import (
+	"fmt"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
+	attestapi "github.com/cli/cli/v2/pkg/cmd/attestation/api"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/artifact"
+	"github.com/cli/cli/v2/pkg/cmd/attestation/verification"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+)
+
+// verifyAssets checks every downloaded asset against its attestations and
+// prints a per-asset verification table. It returns an error if any asset
+// lacks a valid attestation.
+func verifyAssets(opts *DownloadOptions, baseRepo ghrepo.Interface, dest *destinationWriter, assets []shared.ReleaseAsset) error {
+	type verifyResult struct {
+		name         string
+		attestations int
+		err          error
+	}
+
+	criteria := assetEnforcementCriteria(baseRepo)
+	nwo := ghrepo.FullName(baseRepo)
+
+	opts.IO.StartProgressIndicatorWithLabel("Verifying attestations")
+	results := make([]verifyResult, 0, len(assets))
+	for _, a := range assets {
+		count, err := verifyAsset(opts.AttestationClient, opts.SigstoreVerifier, criteria, dest.makePath(a.Name), nwo)
+		results = append(results, verifyResult{name: a.Name, attestations: count, err: err})
+	}
+	opts.IO.StopProgressIndicator()
+
+	cs := opts.IO.ColorScheme()
+	t := tableprinter.New(opts.IO, tableprinter.WithHeader("ASSET", "STATUS", "DETAILS"))
+	failed := 0
+	for _, r := range results {
+		t.AddField(r.name)
+		if r.err != nil {
+			failed++
+			t.AddField("failed", tableprinter.WithColor(cs.Red))
+			t.AddField(r.err.Error())
+		} else {
+			t.AddField("verified", tableprinter.WithColor(cs.Green))
+			t.AddField(text.Pluralize(r.attestations, "attestation"))
+		}
+		t.EndRow()
+	}
+	if err := t.Render(); err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%s failed attestation verification", text.Pluralize(failed, "asset"))
+	}
+	return nil
+}
+
+// verifyAsset fetches the attestations for the asset at path by digest and
+// verifies them, returning how many attestations matched the policy.
+func verifyAsset(client attestapi.Client, verifier verification.SigstoreVerifier, criteria verification.EnforcementCriteria, path, nwo string) (int, error) {
+	art, err := artifact.NewDigestedArtifact(nil, path, "sha256")
+	if err != nil {
+		return 0, err
+	}
+
+	attestations, err := client.GetByDigest(attestapi.FetchParams{
+		Digest:        art.DigestWithAlg(),
+		Limit:         attestapi.DefaultLimit,
+		PredicateType: criteria.PredicateType,
+		Repo:          nwo,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	policy, err := verification.BuildSigstoreVerifyPolicy(criteria, *art)
+	if err != nil {
+		return 0, err
+	}
+
+	verified, err := verifier.Verify(attestations, policy)
+	if err != nil {
+		return 0, err
+	}
+
+	verified, err = verification.VerifyCertExtensions(verified, criteria)
+	if err != nil {
+		return 0, err
+	}
+
+	return len(verified), nil
+}
+
+// assetEnforcementCriteria describes the policy release assets must satisfy:
+// a SLSA provenance attestation produced by a workflow in the repository the
+// release belongs to.
+func assetEnforcementCriteria(repo ghrepo.Interface) verification.EnforcementCriteria {
+	repoURL := fmt.Sprintf("https://%s/%s", repo.RepoHost(), ghrepo.FullName(repo))
+	criteria := verification.EnforcementCriteria{
+		PredicateType: verification.SLSAPredicateV1,
+		SANRegex:      fmt.Sprintf("(?i)^%s/", repoURL),
+	}
+	criteria.Certificate.Issuer = verification.GitHubOIDCIssuer
+	criteria.Certificate.SourceRepositoryURI = repoURL
+	criteria.Certificate.SourceRepositoryOwnerURI = fmt.Sprintf("https://%s/%s", repo.RepoHost(), repo.RepoOwner())
+	return criteria
+}
@@ -0,0 +1,115 @@
+package promote
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type PromoteOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	BaseRepo   func() (ghrepo.Interface, error)
+
+	TagName  string
+	IsLatest *bool
+	Exporter cmdutil.Exporter
+}
+
+func NewCmdPromote(f *cmdutil.Factory, runF func(*PromoteOptions) error) *cobra.Command {
+	opts := &PromoteOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "promote <tag>",
+		Short: "Promote a prerelease to a full release",
+		Long: heredoc.Docf(`
+			Promote a prerelease to a full release without re-creating it.
+
+			Use %[1]s--latest%[1]s to also mark the promoted release as "Latest". To move the
+			"Latest" pointer away from an already published release, use
+			%[1]sgh release edit <tag> --latest=false%[1]s instead.
+
+			With %[1]s--json%[1]s, the state of the release before the promotion is output, so
+			automation can record or restore it.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Promote a prerelease to a full release
+			$ gh release promote v1.2.3-rc.1
+
+			# Promote a prerelease and mark it as latest
+			$ gh release promote v1.2.3-rc.1 --latest
+		`),
+		Args: cmdutil.ExactArgs(1, "release tag required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+			opts.TagName = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return promoteRun(opts)
+		},
+	}
+
+	cmdutil.NilBoolFlag(cmd, &opts.IsLatest, "latest", "", "Mark the promoted release as \"Latest\"")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.ReleaseFields)
+
+	return cmd
+}
+
+func promoteRun(opts *PromoteOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return err
+	}
+
+	release, err := shared.FetchRelease(context.Background(), httpClient, baseRepo, opts.TagName)
+	if err != nil {
+		return err
+	}
+
+	if release.IsDraft {
+		return fmt.Errorf("release %s is a draft; publish it with `gh release edit %s --draft=false` first", release.TagName, release.TagName)
+	}
+	if !release.IsPrerelease && opts.IsLatest == nil {
+		return fmt.Errorf("release %s is not a prerelease", release.TagName)
+	}
+
+	params := map[string]interface{}{
+		"prerelease": false,
+	}
+	if opts.IsLatest != nil {
+		// valid values: true/false/legacy
+		params["make_latest"] = fmt.Sprintf("%v", *opts.IsLatest)
+	}
+
+	promotedRelease, err := editRelease(httpClient, baseRepo, release.DatabaseID, params)
+	if err != nil {
+		return err
+	}
+
+	// Output the state of the release as it was before the promotion.
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, release)
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s\n", promotedRelease.URL)
+
+	return nil
+}
@@ -0,0 +1,239 @@
+package promote
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/release/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+func TestNewCmdPromote(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    string
+		want    PromoteOptions
+		wantErr string
+	}{
+		{
+			name: "tag argument",
+			args: "v1.2.3-rc.1",
+			want: PromoteOptions{
+				TagName:  "v1.2.3-rc.1",
+				IsLatest: nil,
+			},
+		},
+		{
+			name: "with latest",
+			args: "v1.2.3-rc.1 --latest",
+			want: PromoteOptions{
+				TagName:  "v1.2.3-rc.1",
+				IsLatest: boolPtr(true),
+			},
+		},
+		{
+			name: "with latest false",
+			args: "v1.2.3-rc.1 --latest=false",
+			want: PromoteOptions{
+				TagName:  "v1.2.3-rc.1",
+				IsLatest: boolPtr(false),
+			},
+		},
+		{
+			name:    "no arguments",
+			args:    "",
+			wantErr: "release tag required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			var opts *PromoteOptions
+			cmd := NewCmdPromote(f, func(o *PromoteOptions) error {
+				opts = o
+				return nil
+			})
+			cmd.PersistentFlags().StringP("repo", "R", "", "")
+
+			argv, err := shlex.Split(tt.args)
+			assert.NoError(t, err)
+			cmd.SetArgs(argv)
+
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.TagName, opts.TagName)
+			assert.Equal(t, tt.want.IsLatest, opts.IsLatest)
+		})
+	}
+}
+
+func Test_promoteRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		isTTY      bool
+		opts       PromoteOptions
+		httpStubs  func(t *testing.T, reg *httpmock.Registry)
+		wantErr    string
+		wantStdout string
+	}{
+		{
+			name:  "promote prerelease",
+			isTTY: true,
+			opts: PromoteOptions{
+				TagName: "v1.2.3",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				shared.StubFetchRelease(t, reg, "OWNER", "REPO", "v1.2.3", `{
+					"id": 12345,
+					"tag_name": "v1.2.3",
+					"prerelease": true
+				}`)
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO/releases/12345"),
+					httpmock.RESTPayload(200, `{
+						"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+					}`, func(params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"prerelease": false,
+						}, params)
+					}))
+			},
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+		},
+		{
+			name:  "promote prerelease and mark latest",
+			isTTY: true,
+			opts: PromoteOptions{
+				TagName:  "v1.2.3",
+				IsLatest: boolPtr(true),
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				shared.StubFetchRelease(t, reg, "OWNER", "REPO", "v1.2.3", `{
+					"id": 12345,
+					"tag_name": "v1.2.3",
+					"prerelease": true
+				}`)
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO/releases/12345"),
+					httpmock.RESTPayload(200, `{
+						"html_url": "https://github.com/OWNER/REPO/releases/tag/v1.2.3"
+					}`, func(params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"prerelease":  false,
+							"make_latest": "true",
+						}, params)
+					}))
+			},
+			wantStdout: "https://github.com/OWNER/REPO/releases/tag/v1.2.3\n",
+		},
+		{
+			name:  "not a prerelease",
+			isTTY: true,
+			opts: PromoteOptions{
+				TagName: "v1.2.3",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				shared.StubFetchRelease(t, reg, "OWNER", "REPO", "v1.2.3", `{
+					"id": 12345,
+					"tag_name": "v1.2.3",
+					"prerelease": false
+				}`)
+			},
+			wantErr: "release v1.2.3 is not a prerelease",
+		},
+		{
+			name:  "draft release",
+			isTTY: true,
+			opts: PromoteOptions{
+				TagName: "v1.2.3",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				shared.StubFetchRelease(t, reg, "OWNER", "REPO", "v1.2.3", `{
+					"id": 12345,
+					"tag_name": "v1.2.3",
+					"prerelease": true,
+					"draft": true
+				}`)
+			},
+			wantErr: "release v1.2.3 is a draft; publish it with `gh release edit v1.2.3 --draft=false` first",
+		},
+		{
+			name:  "json output of previous state",
+			isTTY: false,
+			opts: PromoteOptions{
+				TagName: "v1.2.3",
+			},
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				shared.StubFetchRelease(t, reg, "OWNER", "REPO", "v1.2.3", `{
+					"id": 12345,
+					"tag_name": "v1.2.3",
+					"prerelease": true
+				}`)
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO/releases/12345"),
+					httpmock.StatusStringResponse(200, `{}`))
+			},
+			wantStdout: "{\"isPrerelease\":true,\"tagName\":\"v1.2.3\"}\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.isTTY)
+			ios.SetStdinTTY(tt.isTTY)
+			ios.SetStderrTTY(tt.isTTY)
+
+			fakeHTTP := &httpmock.Registry{}
+			defer fakeHTTP.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, fakeHTTP)
+			}
+
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: fakeHTTP}, nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+			if tt.name == "json output of previous state" {
+				exporter := cmdutil.NewJSONExporter()
+				exporter.SetFields([]string{"isPrerelease", "tagName"})
+				tt.opts.Exporter = exporter
+			}
+
+			err := promoteRun(&tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	cmdDownload "github.com/cli/cli/v2/pkg/cmd/release/download"
 	cmdUpdate "github.com/cli/cli/v2/pkg/cmd/release/edit"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/release/list"
+	cmdPromote "github.com/cli/cli/v2/pkg/cmd/release/promote"
 	cmdUpload "github.com/cli/cli/v2/pkg/cmd/release/upload"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/release/view"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -30,6 +31,7 @@ func NewCmdRelease(f *cmdutil.Factory) *cobra.Command {
 	cmdutil.AddGroup(cmd, "Targeted commands",
 		cmdView.NewCmdView(f, nil),
 		cmdUpdate.NewCmdEdit(f, nil),
+		cmdPromote.NewCmdPromote(f, nil),
 		cmdUpload.NewCmdUpload(f, nil),
 		cmdDownload.NewCmdDownload(f, nil),
 		cmdDelete.NewCmdDelete(f, nil),
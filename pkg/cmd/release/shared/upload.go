@@ -2,19 +2,23 @@ package shared
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io"
 	"mime"
 	"net/http"
 	"net/url"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"golang.org/x/sync/errgroup"
 )
@@ -111,7 +115,44 @@ func fileExt(fn string) string {
 	return path.Ext(fn)
 }
 
-func ConcurrentUpload(httpClient httpDoer, uploadURL string, numWorkers int, assets []*AssetForUpload) error {
+// ChecksumsAssetName is the file name under which a generated checksums
+// manifest gets uploaded.
+const ChecksumsAssetName = "SHA256SUMS"
+
+// ChecksumsAsset produces a checksums manifest listing the SHA-256 digest of
+// every given asset, in the format understood by `sha256sum --check`.
+func ChecksumsAsset(assets []*AssetForUpload) (*AssetForUpload, error) {
+	sums := make([]string, 0, len(assets))
+	for _, a := range assets {
+		f, err := a.Open()
+		if err != nil {
+			return nil, err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		f.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error calculating checksum for %s: %w", a.Name, err)
+		}
+		sums = append(sums, fmt.Sprintf("%x  %s\n", h.Sum(nil), a.Name))
+	}
+	sort.Strings(sums)
+	content := strings.Join(sums, "")
+
+	return &AssetForUpload{
+		Name:     ChecksumsAssetName,
+		Size:     int64(len(content)),
+		MIMEType: "text/plain",
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(strings.NewReader(content)), nil
+		},
+	}, nil
+}
+
+// ConcurrentUpload uploads assets to uploadURL using up to numWorkers
+// simultaneous requests. The uploaded callback, if non-nil, is invoked after
+// each successful upload and may be called from concurrent goroutines.
+func ConcurrentUpload(httpClient httpDoer, uploadURL string, numWorkers int, assets []*AssetForUpload, uploaded func(*AssetForUpload)) error {
 	if numWorkers == 0 {
 		return errors.New("the number of concurrent workers needs to be greater than 0")
 	}
@@ -123,7 +164,13 @@ func ConcurrentUpload(httpClient httpDoer, uploadURL string, numWorkers int, ass
 	for _, a := range assets {
 		asset := *a
 		g.Go(func() error {
-			return uploadWithDelete(gctx, httpClient, uploadURL, asset)
+			if err := uploadWithDelete(gctx, httpClient, uploadURL, asset); err != nil {
+				return err
+			}
+			if uploaded != nil {
+				uploaded(a)
+			}
+			return nil
 		})
 	}
 
@@ -154,10 +201,81 @@ func uploadWithDelete(ctx context.Context, httpClient httpDoer, uploadURL string
 		if err == nil || shouldRetry(err) {
 			return err
 		}
+		// An interrupted earlier attempt can leave the asset behind in a
+		// partially uploaded state, which the API reports as a name conflict.
+		// Delete the stale asset so the retry can upload in its place.
+		var httpError api.HTTPError
+		if errors.As(err, &httpError) && httpError.StatusCode == 422 {
+			if staleURL, findErr := findExistingAsset(ctx, httpClient, uploadURL, a.Name); findErr == nil && staleURL != "" {
+				if delErr := deleteAsset(ctx, httpClient, staleURL); delErr == nil {
+					return err
+				}
+			}
+		}
 		return backoff.Permanent(err)
 	}, backoff.WithContext(backoff.WithMaxRetries(bo, 3), ctx))
 }
 
+// findExistingAsset looks up an asset by name on the release that uploadURL
+// belongs to and returns its API URL, or an empty string if no such asset
+// exists.
+func findExistingAsset(ctx context.Context, httpClient httpDoer, uploadURL, name string) (string, error) {
+	listURL, err := assetsAPIURL(uploadURL)
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", listURL+"?per_page=100", nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	var assets []ReleaseAsset
+	dec := json.NewDecoder(resp.Body)
+	if err := dec.Decode(&assets); err != nil {
+		return "", err
+	}
+
+	for _, a := range assets {
+		if a.Name == name {
+			return a.APIURL, nil
+		}
+	}
+	return "", nil
+}
+
+// assetsAPIURL derives the REST endpoint for listing a release's assets from
+// the corresponding upload URL.
+func assetsAPIURL(uploadURL string) (string, error) {
+	u, err := url.Parse(uploadURL)
+	if err != nil {
+		return "", err
+	}
+	if u.Host == "uploads.github.com" {
+		u.Host = "api.github.com"
+	} else if after, found := strings.CutPrefix(u.Host, "uploads."); found && ghinstance.IsTenancy(after) {
+		// data-residency tenants serve uploads from uploads.<tenant>.ghe.com and
+		// the REST API from api.<tenant>.ghe.com
+		u.Host = "api." + after
+	} else {
+		// GitHub Enterprise Server serves uploads under `/api/uploads/` on the
+		// same host as the `/api/v3/` REST endpoints.
+		u.Path = strings.Replace(u.Path, "/api/uploads/", "/api/v3/", 1)
+	}
+	u.RawQuery = ""
+	return u.String(), nil
+}
+
 func uploadAsset(ctx context.Context, httpClient httpDoer, uploadURL string, asset AssetForUpload) (*ReleaseAsset, error) {
 	u, err := url.Parse(uploadURL)
 	if err != nil {
@@ -114,6 +114,147 @@ func Test_uploadWithDelete_retry(t *testing.T) {
 	}
 }
 
+func Test_uploadWithDelete_staleAsset(t *testing.T) {
+	retryInterval = 0
+	ctx := context.Background()
+
+	var requests []string
+	client := funcClient(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.String())
+		switch {
+		case req.Method == "POST" && len(requests) == 1:
+			return &http.Response{
+				Request:    req,
+				StatusCode: 422,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"errors":[{"code":"already_exists"}]}`)),
+			}, nil
+		case req.Method == "GET":
+			return &http.Response{
+				Request:    req,
+				StatusCode: 200,
+				Body:       io.NopCloser(bytes.NewBufferString(`[{"name":"asset","state":"starter","url":"http://api.github.com/assets/123"}]`)),
+			}, nil
+		case req.Method == "DELETE":
+			return &http.Response{
+				Request:    req,
+				StatusCode: 204,
+				Body:       io.NopCloser(bytes.NewBufferString(``)),
+			}, nil
+		}
+		return &http.Response{
+			Request:    req,
+			StatusCode: 200,
+			Body:       io.NopCloser(bytes.NewBufferString(`{}`)),
+		}, nil
+	})
+	err := uploadWithDelete(ctx, client, "https://uploads.github.com/repos/OWNER/REPO/releases/12/assets", AssetForUpload{
+		Name: "asset",
+		Size: 8,
+		Open: func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewBufferString(`somebody`)), nil
+		},
+		MIMEType: "application/octet-stream",
+	})
+	if err != nil {
+		t.Errorf("uploadWithDelete() error: %v", err)
+	}
+	want := []string{
+		"POST https://uploads.github.com/repos/OWNER/REPO/releases/12/assets?label=&name=asset",
+		"GET https://api.github.com/repos/OWNER/REPO/releases/12/assets?per_page=100",
+		"DELETE http://api.github.com/assets/123",
+		"POST https://uploads.github.com/repos/OWNER/REPO/releases/12/assets?label=&name=asset",
+	}
+	if len(requests) != len(want) {
+		t.Fatalf("requests = %v, expected %v", requests, want)
+	}
+	for i := range want {
+		if requests[i] != want[i] {
+			t.Errorf("request %d = %q, expected %q", i, requests[i], want[i])
+		}
+	}
+}
+
+func Test_assetsAPIURL(t *testing.T) {
+	tests := []struct {
+		name      string
+		uploadURL string
+		want      string
+	}{
+		{
+			name:      "github.com",
+			uploadURL: "https://uploads.github.com/repos/OWNER/REPO/releases/12/assets",
+			want:      "https://api.github.com/repos/OWNER/REPO/releases/12/assets",
+		},
+		{
+			name:      "enterprise",
+			uploadURL: "https://ghe.io/api/uploads/repos/OWNER/REPO/releases/12/assets",
+			want:      "https://ghe.io/api/v3/repos/OWNER/REPO/releases/12/assets",
+		},
+		{
+			name:      "tenant",
+			uploadURL: "https://uploads.tenant.ghe.com/repos/OWNER/REPO/releases/12/assets",
+			want:      "https://api.tenant.ghe.com/repos/OWNER/REPO/releases/12/assets",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := assetsAPIURL(tt.uploadURL)
+			if err != nil {
+				t.Fatalf("assetsAPIURL() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("assetsAPIURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ChecksumsAsset(t *testing.T) {
+	assets := []*AssetForUpload{
+		{
+			Name: "windows.zip",
+			Open: func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewBufferString("windows content")), nil
+			},
+		},
+		{
+			Name: "linux.tgz",
+			Open: func() (io.ReadCloser, error) {
+				return io.NopCloser(bytes.NewBufferString("linux content")), nil
+			},
+		},
+	}
+
+	checksums, err := ChecksumsAsset(assets)
+	if err != nil {
+		t.Fatalf("ChecksumsAsset() error: %v", err)
+	}
+	if checksums.Name != "SHA256SUMS" {
+		t.Errorf("Name = %q, expected %q", checksums.Name, "SHA256SUMS")
+	}
+	if checksums.MIMEType != "text/plain" {
+		t.Errorf("MIMEType = %q, expected %q", checksums.MIMEType, "text/plain")
+	}
+
+	f, err := checksums.Open()
+	if err != nil {
+		t.Fatalf("Open() error: %v", err)
+	}
+	defer f.Close()
+	b, err := io.ReadAll(f)
+	if err != nil {
+		t.Fatalf("ReadAll() error: %v", err)
+	}
+	want := "1566895d3843f661a40e1d61adfded36e3aa5b365de33d127e793919ac1897e1  linux.tgz\n" +
+		"edd041b0eed89ae7d3ce9ccc5ee35c59f2061148abd68671a18a70fd1d8f4bcd  windows.zip\n"
+	if string(b) != want {
+		t.Errorf("checksums content = %q, expected %q", string(b), want)
+	}
+	if checksums.Size != int64(len(want)) {
+		t.Errorf("Size = %d, expected %d", checksums.Size, len(want))
+	}
+}
+
 type funcClient func(*http.Request) (*http.Response, error)
 
 func (f funcClient) Do(req *http.Request) (*http.Response, error) {
@@ -3,6 +3,7 @@ package upload
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"regexp"
 	"strings"
@@ -108,9 +109,23 @@ func uploadRun(opts *UploadOptions) error {
 		return fmt.Errorf("asset under the same name already exists: %v", existingNames)
 	}
 
-	opts.IO.StartProgressIndicator()
-	err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets)
-	opts.IO.StopProgressIndicator()
+	progress := opts.IO.NewMultiProgress()
+	for _, a := range opts.Assets {
+		bar := progress.AddBar(a.Name, a.Size)
+		open := a.Open
+		a.Open = func() (io.ReadCloser, error) {
+			f, err := open()
+			if err != nil {
+				return nil, err
+			}
+			// the asset is reopened when an upload is retried
+			bar.Reset()
+			return bar.ProxyReader(f), nil
+		}
+	}
+
+	err = shared.ConcurrentUpload(httpClient, uploadURL, opts.Concurrency, opts.Assets, nil)
+	progress.Finish()
 	if err != nil {
 		return err
 	}
@@ -100,7 +100,7 @@ func viewRun(opts *ViewOptions) error {
 
 	if opts.WebMode {
 		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(release.URL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(release.URL))
 		}
 		return opts.Browser.Browse(release.URL)
 	}
@@ -21,6 +21,7 @@ type ArchiveOptions struct {
 	Config     func() (gh.Config, error)
 	BaseRepo   func() (ghrepo.Interface, error)
 	Confirmed  bool
+	DryRun     bool
 	IO         *iostreams.IOStreams
 	RepoArg    string
 	Prompter   prompter.Prompter
@@ -40,14 +41,18 @@ func NewCmdArchive(f *cmdutil.Factory, runF func(*ArchiveOptions) error) *cobra.
 		Short: "Archive a repository",
 		Long: heredoc.Doc(`Archive a GitHub repository.
 
-With no argument, archives the current repository.`),
+With no argument, archives the current repository.
+
+Archiving makes the repository read-only. Confirm the operation by typing the
+full name of the repository, or pass --yes to skip confirmation. Use --dry-run
+to see what archiving would do without changing anything.`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.RepoArg = args[0]
 			}
 
-			if !opts.Confirmed && !opts.IO.CanPrompt() {
+			if !opts.Confirmed && !opts.DryRun && !opts.IO.CanPrompt() {
 				return cmdutil.FlagErrorf("--yes required when not running interactively")
 			}
 
@@ -62,9 +67,21 @@ With no argument, archives the current repository.`),
 	cmd.Flags().BoolVar(&opts.Confirmed, "confirm", false, "Skip the confirmation prompt")
 	_ = cmd.Flags().MarkDeprecated("confirm", "use `--yes` instead")
 	cmd.Flags().BoolVarP(&opts.Confirmed, "yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the consequences of archiving without making changes")
 	return cmd
 }
 
+// archiveConsequences describes what archiving does to a repository. It is
+// shown before the confirmation prompt and as part of `--dry-run` output.
+func archiveConsequences() string {
+	return heredoc.Doc(`
+		Archiving makes the repository read-only:
+		- Issues, pull requests, and wikis can no longer be created or edited
+		- Scheduled workflows are disabled
+		- The repository can be unarchived at any time with gh repo unarchive
+	`)
+}
+
 func archiveRun(opts *ArchiveOptions) error {
 	cs := opts.IO.ColorScheme()
 	httpClient, err := opts.HttpClient()
@@ -115,12 +132,19 @@ func archiveRun(opts *ArchiveOptions) error {
 		return nil
 	}
 
+	if opts.DryRun {
+		fmt.Fprintf(opts.IO.Out, "Would have archived repository %s\n", fullName)
+		fmt.Fprint(opts.IO.Out, archiveConsequences())
+		return nil
+	}
+
 	if !opts.Confirmed {
-		confirmed, err := opts.Prompter.Confirm(fmt.Sprintf("Archive %s?", fullName), false)
+		fmt.Fprint(opts.IO.ErrOut, archiveConsequences())
+		answer, err := opts.Prompter.Input(fmt.Sprintf("Type %s to confirm archiving this repository", fullName), "")
 		if err != nil {
 			return fmt.Errorf("failed to prompt: %w", err)
 		}
-		if !confirmed {
+		if !strings.EqualFold(answer, fullName) {
 			return cmdutil.CancelError
 		}
 	}
@@ -34,6 +34,11 @@ func TestNewCmdArchive(t *testing.T) {
 			input:  "OWNER/REPO --confirm",
 			output: ArchiveOptions{RepoArg: "OWNER/REPO", Confirmed: true},
 		},
+		{
+			name:   "dry run no tty",
+			input:  "OWNER/REPO --dry-run",
+			output: ArchiveOptions{RepoArg: "OWNER/REPO", DryRun: true},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -61,6 +66,7 @@ func TestNewCmdArchive(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, tt.output.RepoArg, gotOpts.RepoArg)
 			assert.Equal(t, tt.output.Confirmed, gotOpts.Confirmed)
+			assert.Equal(t, tt.output.DryRun, gotOpts.DryRun)
 		})
 	}
 }
@@ -75,13 +81,15 @@ func Test_ArchiveRun(t *testing.T) {
 		isTTY         bool
 		wantStdout    string
 		wantStderr    string
+		wantErr       error
 	}{
 		{
 			name:       "unarchived repo tty",
 			wantStdout: "✓ Archived repository OWNER/REPO\n",
+			wantStderr: archiveConsequences(),
 			prompterStubs: func(pm *prompter.MockPrompter) {
-				pm.RegisterConfirm("Archive OWNER/REPO?", func(_ string, _ bool) (bool, error) {
-					return true, nil
+				pm.RegisterInput("Type OWNER/REPO to confirm archiving this repository", func(_, _ string) (string, error) {
+					return "OWNER/REPO", nil
 				})
 			},
 			isTTY: true,
@@ -98,10 +106,11 @@ func Test_ArchiveRun(t *testing.T) {
 		{
 			name:       "infer base repo",
 			wantStdout: "✓ Archived repository OWNER/REPO\n",
+			wantStderr: archiveConsequences(),
 			opts:       ArchiveOptions{},
 			prompterStubs: func(pm *prompter.MockPrompter) {
-				pm.RegisterConfirm("Archive OWNER/REPO?", func(_ string, _ bool) (bool, error) {
-					return true, nil
+				pm.RegisterInput("Type OWNER/REPO to confirm archiving this repository", func(_, _ string) (string, error) {
+					return "OWNER/REPO", nil
 				})
 			},
 			isTTY: true,
@@ -114,6 +123,34 @@ func Test_ArchiveRun(t *testing.T) {
 					httpmock.StringResponse(`{}`))
 			},
 		},
+		{
+			name:       "typed confirmation does not match",
+			wantStderr: archiveConsequences(),
+			wantErr:    cmdutil.CancelError,
+			prompterStubs: func(pm *prompter.MockPrompter) {
+				pm.RegisterInput("Type OWNER/REPO to confirm archiving this repository", func(_, _ string) (string, error) {
+					return "OWNER/OTHER", nil
+				})
+			},
+			isTTY: true,
+			opts:  ArchiveOptions{RepoArg: "OWNER/REPO"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(fmt.Sprintf(queryResponse, "false")))
+			},
+		},
+		{
+			name:       "dry run",
+			wantStdout: "Would have archived repository OWNER/REPO\n" + archiveConsequences(),
+			isTTY:      true,
+			opts:       ArchiveOptions{RepoArg: "OWNER/REPO", DryRun: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(fmt.Sprintf(queryResponse, "false")))
+			},
+		},
 		{
 			name:       "archived repo tty",
 			wantStderr: "! Repository OWNER/REPO is already archived\n",
@@ -154,7 +191,11 @@ func Test_ArchiveRun(t *testing.T) {
 			ios.SetStderrTTY(tt.isTTY)
 
 			err := archiveRun(&tt.opts)
-			assert.NoError(t, err)
+			if tt.wantErr != nil {
+				assert.Equal(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
 			assert.Equal(t, tt.wantStdout, stdout.String())
 			assert.Equal(t, tt.wantStderr, stderr.String())
 		})
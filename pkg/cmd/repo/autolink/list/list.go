@@ -78,7 +78,7 @@ func listRun(opts *listOptions) error {
 		autolinksListURL := ghrepo.GenerateRepoURL(repo, "settings/key_links")
 
 		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(autolinksListURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(autolinksListURL))
 		}
 
 		return opts.Browser.Browse(autolinksListURL)
@@ -23,10 +23,21 @@ type CloneOptions struct {
 	GitClient  *git.Client
 	Config     func() (gh.Config, error)
 	IO         *iostreams.IOStreams
+	Prompter   iprompter
+	Context    context.Context
 
 	GitArgs      []string
 	Repository   string
 	UpstreamName string
+
+	FromFile     string
+	Organization string
+	Match        string
+	Concurrency  int
+}
+
+type iprompter interface {
+	Confirm(string, bool) (bool, error)
 }
 
 func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Command {
@@ -35,13 +46,15 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 		HttpClient: f.HttpClient,
 		GitClient:  f.GitClient,
 		Config:     f.Config,
+		Prompter:   f.Prompter,
+		Context:    f.Context,
 	}
 
 	cmd := &cobra.Command{
 		DisableFlagsInUseLine: true,
 
 		Use:   "clone <repository> [<directory>] [-- <gitflags>...]",
-		Args:  cmdutil.MinimumArgs(1, "cannot clone: repository argument required"),
+		Args:  cobra.ArbitraryArgs,
 		Short: "Clone a repository locally",
 		Long: heredoc.Docf(`
 			Clone a GitHub repository locally. Pass additional %[1]sgit clone%[1]s flags by listing
@@ -60,6 +73,17 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 			the remote after the owner of the parent repository.
 
 			If the repository is a fork, its parent repository will be set as the default remote repository.
+
+			Many repositories can be cloned at once by passing %[1]s--from-file%[1]s with a file
+			listing one repository per line, or %[1]s--org%[1]s to clone every repository of an
+			organization, optionally narrowed with %[1]s--match%[1]s. Repositories are cloned
+			concurrently into the current directory and existing clones are skipped, so an
+			interrupted run can be resumed by running the same command again.
+
+			After a single repository is cloned, a bootstrap command configured for its
+			owner via the %[1]sgh.<owner>.clone-hook%[1]s git configuration key, e.g. %[1]smake bootstrap%[1]s,
+			runs inside the new clone. A hook only runs when its command is also listed
+			under the %[1]sgh.clone-hook-allowlist%[1]s key and after explicit confirmation.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Clone a repository from a specific org
@@ -77,8 +101,41 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 
 			# Clone a repository with additional git clone flags
 			$ gh repo clone cli/cli -- --depth=1
+
+			# Clone every repository listed in a file, one per line
+			$ gh repo clone --from-file repos.txt
+
+			# Clone every repository of an organization whose name matches a pattern
+			$ gh repo clone --org myorg --match 'service-*'
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--from-file` or `--org`",
+				opts.FromFile != "",
+				opts.Organization != "",
+			); err != nil {
+				return err
+			}
+			if opts.Match != "" && opts.Organization == "" {
+				return cmdutil.FlagErrorf("`--match` requires `--org`")
+			}
+
+			if opts.FromFile != "" || opts.Organization != "" {
+				if dash := cmd.ArgsLenAtDash(); (dash == -1 && len(args) > 0) || dash > 0 {
+					return cmdutil.FlagErrorf("repository arguments are not allowed with `--from-file` or `--org`")
+				}
+				opts.GitArgs = args
+
+				if runF != nil {
+					return runF(opts)
+				}
+
+				return multiCloneRun(opts)
+			}
+
+			if len(args) == 0 {
+				return cmdutil.FlagErrorf("cannot clone: repository argument required")
+			}
 			opts.Repository = args[0]
 			opts.GitArgs = args[1:]
 
@@ -91,6 +148,10 @@ func NewCmdClone(f *cmdutil.Factory, runF func(*CloneOptions) error) *cobra.Comm
 	}
 
 	cmd.Flags().StringVarP(&opts.UpstreamName, "upstream-remote-name", "u", "upstream", "Upstream remote name when cloning a fork")
+	cmd.Flags().StringVar(&opts.FromFile, "from-file", "", "Clone all repositories listed in `file`, one per line")
+	cmd.Flags().StringVar(&opts.Organization, "org", "", "Clone all repositories of the specified organization")
+	cmd.Flags().StringVar(&opts.Match, "match", "", "Only clone repositories whose name matches a glob `pattern`")
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", 4, "Number of repositories to clone in parallel")
 	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
 		if err == pflag.ErrHelp {
 			return err
@@ -179,7 +240,10 @@ func cloneRun(opts *CloneOptions) error {
 	}
 
 	gitClient := opts.GitClient
-	ctx := context.Background()
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
 	cloneDir, err := gitClient.Clone(ctx, canonicalCloneURL, opts.GitArgs)
 	if err != nil {
 		return err
@@ -220,7 +284,8 @@ func cloneRun(opts *CloneOptions) error {
 			fmt.Fprintf(opts.IO.ErrOut, "%s Repository %s set as the default repository. To learn more about the default repository, run: gh repo set-default --help\n", cs.WarningIcon(), cs.Bold(ghrepo.FullName(canonicalRepo.Parent)))
 		}
 	}
-	return nil
+
+	return runPostCloneHook(ctx, opts, canonicalRepo, cloneDir)
 }
 
 // simplifyURL strips given URL of extra parts like extra path segments (i.e.,
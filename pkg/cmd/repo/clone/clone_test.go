@@ -3,11 +3,15 @@ package clone
 import (
 	"net/http"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
@@ -59,6 +63,46 @@ func TestNewCmdClone(t *testing.T) {
 			args:    "OWNER/REPO --depth 1",
 			wantErr: "unknown flag: --depth\nSeparate git clone flags with '--'.",
 		},
+		{
+			name: "from-file argument",
+			args: "--from-file repos.txt",
+			wantOpts: CloneOptions{
+				FromFile: "repos.txt",
+				GitArgs:  []string{},
+			},
+		},
+		{
+			name: "org with match",
+			args: "--org myorg --match service-*",
+			wantOpts: CloneOptions{
+				Organization: "myorg",
+				Match:        "service-*",
+				GitArgs:      []string{},
+			},
+		},
+		{
+			name: "org with git clone arguments",
+			args: "--org myorg -- --depth 1",
+			wantOpts: CloneOptions{
+				Organization: "myorg",
+				GitArgs:      []string{"--depth", "1"},
+			},
+		},
+		{
+			name:    "from-file and org",
+			args:    "--from-file repos.txt --org myorg",
+			wantErr: "specify only one of `--from-file` or `--org`",
+		},
+		{
+			name:    "match without org",
+			args:    "--match service-*",
+			wantErr: "`--match` requires `--org`",
+		},
+		{
+			name:    "repository argument with org",
+			args:    "OWNER/REPO --org myorg",
+			wantErr: "repository arguments are not allowed with `--from-file` or `--org`",
+		},
 	}
 	for _, tt := range testCases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -92,6 +136,9 @@ func TestNewCmdClone(t *testing.T) {
 
 			assert.Equal(t, tt.wantOpts.Repository, opts.Repository)
 			assert.Equal(t, tt.wantOpts.GitArgs, opts.GitArgs)
+			assert.Equal(t, tt.wantOpts.FromFile, opts.FromFile)
+			assert.Equal(t, tt.wantOpts.Organization, opts.Organization)
+			assert.Equal(t, tt.wantOpts.Match, opts.Match)
 		})
 	}
 }
@@ -217,6 +264,7 @@ func Test_RepoClone(t *testing.T) {
 			cs, restore := run.Stub()
 			defer restore(t)
 			cs.Register(tt.want, 0, "")
+			cs.Register(`git config gh\..+\.clone-hook`, 1, "")
 
 			output, err := runCloneCommand(httpClient, tt.args)
 			if err != nil {
@@ -262,6 +310,7 @@ func Test_RepoClone_hasParent(t *testing.T) {
 	cs.Register(`git -C REPO fetch upstream`, 0, "")
 	cs.Register(`git -C REPO remote set-branches upstream *`, 0, "")
 	cs.Register(`git -C REPO config --add remote.upstream.gh-resolved base`, 0, "")
+	cs.Register(`git config gh\.OWNER\.clone-hook`, 1, "")
 
 	_, err := runCloneCommand(httpClient, "OWNER/REPO")
 	if err != nil {
@@ -302,6 +351,7 @@ func Test_RepoClone_hasParent_upstreamRemoteName(t *testing.T) {
 	cs.Register(`git -C REPO fetch test`, 0, "")
 	cs.Register(`git -C REPO remote set-branches test *`, 0, "")
 	cs.Register(`git -C REPO config --add remote.test.gh-resolved base`, 0, "")
+	cs.Register(`git config gh\.OWNER\.clone-hook`, 1, "")
 
 	_, err := runCloneCommand(httpClient, "OWNER/REPO --upstream-remote-name test")
 	if err != nil {
@@ -334,6 +384,7 @@ func Test_RepoClone_withoutUsername(t *testing.T) {
 	cs, restore := run.Stub()
 	defer restore(t)
 	cs.Register(`git clone https://github\.com/OWNER/REPO\.git`, 0, "")
+	cs.Register(`git config gh\.OWNER\.clone-hook`, 1, "")
 
 	output, err := runCloneCommand(httpClient, "REPO")
 	if err != nil {
@@ -344,6 +395,214 @@ func Test_RepoClone_withoutUsername(t *testing.T) {
 	assert.Equal(t, "", output.Stderr())
 }
 
+func Test_RepoClone_fromFile(t *testing.T) {
+	dir := t.TempDir()
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() {
+		_ = os.Chdir(oldWd)
+	})
+
+	// REPO2 is already cloned and must be skipped.
+	require.NoError(t, os.Mkdir("REPO2", 0755))
+
+	listFile := filepath.Join(dir, "repos.txt")
+	require.NoError(t, os.WriteFile(listFile, []byte(heredoc.Doc(`
+		# comment lines and blanks are ignored
+
+		OWNER/REPO1
+		OWNER/REPO2
+		OWNER/REPO3
+	`)), 0600))
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone https://github\.com/OWNER/REPO1\.git`, 0, "")
+	cs.Register(`git clone https://github\.com/OWNER/REPO3\.git`, 0, "")
+
+	output, err := runCloneCommand(httpClient, "--from-file repos.txt --concurrency 1")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Contains(t, output.Stderr(), "✓ Cloned OWNER/REPO1\n")
+	assert.Contains(t, output.Stderr(), "✓ Cloned OWNER/REPO3\n")
+	assert.Contains(t, output.Stderr(), "! Skipped 1 repository (already cloned)\n")
+	assert.Contains(t, output.Stderr(), "✓ Cloned 2 of 3 repositories\n")
+}
+
+func Test_RepoClone_org(t *testing.T) {
+	dir := t.TempDir()
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() {
+		_ = os.Chdir(oldWd)
+	})
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.GraphQL(`query OrganizationRepositoryList\b`),
+		httpmock.StringResponse(`
+		{ "data": { "organization": { "repositories": {
+			"nodes": [
+				{ "name": "service-api", "owner": { "login": "myorg" } },
+				{ "name": "service-web", "owner": { "login": "myorg" } },
+				{ "name": "docs", "owner": { "login": "myorg" } }
+			],
+			"pageInfo": { "hasNextPage": false, "endCursor": "" }
+		} } } }`))
+
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone https://github\.com/myorg/service-api\.git`, 0, "")
+	cs.Register(`git clone https://github\.com/myorg/service-web\.git`, 0, "")
+
+	output, err := runCloneCommand(httpClient, "--org myorg --match service-* --concurrency 1")
+	if err != nil {
+		t.Fatalf("error running command `repo clone`: %v", err)
+	}
+
+	assert.Equal(t, "", output.String())
+	assert.Contains(t, output.Stderr(), "✓ Cloned 2 of 2 repositories\n")
+}
+
+func Test_RepoClone_multiError(t *testing.T) {
+	dir := t.TempDir()
+	oldWd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(dir))
+	t.Cleanup(func() {
+		_ = os.Chdir(oldWd)
+	})
+
+	listFile := filepath.Join(dir, "repos.txt")
+	require.NoError(t, os.WriteFile(listFile, []byte("OWNER/REPO1\nOWNER/REPO2\n"), 0600))
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	httpClient := &http.Client{Transport: reg}
+
+	cs, restore := run.Stub()
+	defer restore(t)
+	cs.Register(`git clone https://github\.com/OWNER/REPO1\.git`, 0, "")
+	cs.Register(`git clone https://github\.com/OWNER/REPO2\.git`, 1, "")
+
+	_, err = runCloneCommand(httpClient, "--from-file repos.txt --concurrency 1")
+	assert.Equal(t, cmdutil.SilentError, err)
+}
+
+func Test_RepoClone_postCloneHook(t *testing.T) {
+	tests := []struct {
+		name       string
+		tty        bool
+		confirmed  bool
+		stubs      func(*run.CommandStubber)
+		wantStderr string
+	}{
+		{
+			name:      "hook runs after confirmation",
+			tty:       true,
+			confirmed: true,
+			stubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.OWNER\.clone-hook`, 0, "make bootstrap\n")
+				cs.Register(`git config --get-all gh\.clone-hook-allowlist`, 0, "make bootstrap\n")
+				cs.Register(`sh -c make bootstrap`, 0, "")
+			},
+			wantStderr: "✓ Ran post-clone hook \"make bootstrap\" in REPO\n",
+		},
+		{
+			name: "hook not allowlisted",
+			tty:  true,
+			stubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.OWNER\.clone-hook`, 0, "make bootstrap\n")
+				cs.Register(`git config --get-all gh\.clone-hook-allowlist`, 0, "scripts/setup\n")
+			},
+			wantStderr: "! Skipped post-clone hook \"make bootstrap\": not allowlisted. To allow it, run: git config --global --add gh.clone-hook-allowlist \"make bootstrap\"\n",
+		},
+		{
+			name: "hook declined",
+			tty:  true,
+			stubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.OWNER\.clone-hook`, 0, "make bootstrap\n")
+				cs.Register(`git config --get-all gh\.clone-hook-allowlist`, 0, "make bootstrap\n")
+			},
+		},
+		{
+			name: "non-interactive skips hook",
+			stubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config gh\.OWNER\.clone-hook`, 0, "make bootstrap\n")
+				cs.Register(`git config --get-all gh\.clone-hook-allowlist`, 0, "make bootstrap\n")
+			},
+			wantStderr: "! Skipped post-clone hook \"make bootstrap\": confirming a hook requires an interactive session\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.GraphQL(`query RepositoryInfo\b`),
+				httpmock.StringResponse(`
+				{ "data": { "repository": {
+					"name": "REPO",
+					"owner": {
+						"login": "OWNER"
+					}
+				} } }
+				`))
+
+			cs, restore := run.Stub()
+			defer restore(t)
+			cs.Register(`git clone https://github\.com/OWNER/REPO\.git`, 0, "")
+			tt.stubs(cs)
+
+			ios, _, stdout, stderr := iostreams.Test()
+			ios.SetStdinTTY(tt.tty)
+			ios.SetStdoutTTY(tt.tty)
+
+			pm := &prompter.PrompterMock{}
+			pm.ConfirmFunc = func(p string, _ bool) (bool, error) {
+				if p == `Run post-clone hook "make bootstrap" in REPO?` {
+					return tt.confirmed, nil
+				}
+				return false, prompter.NoSuchPromptErr(p)
+			}
+
+			opts := &CloneOptions{
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				Config: func() (gh.Config, error) {
+					return config.NewBlankConfig(), nil
+				},
+				GitClient: &git.Client{
+					GhPath:  "some/path/gh",
+					GitPath: "some/path/git",
+				},
+				IO:         ios,
+				Prompter:   pm,
+				Repository: "OWNER/REPO",
+				GitArgs:    []string{},
+			}
+
+			err := cloneRun(opts)
+			require.NoError(t, err)
+			assert.Equal(t, "", stdout.String())
+			assert.Equal(t, tt.wantStderr, stderr.String())
+		})
+	}
+}
+
 func TestSimplifyURL(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -0,0 +1,73 @@
+package clone
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"slices"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/findsh"
+)
+
+// postCloneHookConfigFormat is the git configuration key, keyed by repository
+// owner, that holds the bootstrap command to run inside a fresh clone of one
+// of the owner's repositories.
+const postCloneHookConfigFormat = "gh.%s.clone-hook"
+
+// postCloneHookAllowlistConfig is the multi-valued git configuration key that
+// lists the hook commands gh is allowed to run.
+const postCloneHookAllowlistConfig = "gh.clone-hook-allowlist"
+
+// runPostCloneHook runs the post-clone hook configured for the owner of the
+// freshly cloned repository, if any. A hook only runs when its command is also
+// listed in the allowlist and the user explicitly confirms it; otherwise it is
+// skipped with a note explaining why.
+func runPostCloneHook(ctx context.Context, opts *CloneOptions, repo ghrepo.Interface, cloneDir string) error {
+	// the key not being set is the common case, not an error
+	hook, err := opts.GitClient.Config(ctx, fmt.Sprintf(postCloneHookConfigFormat, repo.RepoOwner()))
+	if err != nil || hook == "" {
+		return nil
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	allowlist, err := opts.GitClient.ConfigAll(ctx, postCloneHookAllowlistConfig)
+	if err != nil {
+		return err
+	}
+	if !slices.Contains(allowlist, hook) {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Skipped post-clone hook %q: not allowlisted. To allow it, run: git config --global --add %s %q\n",
+			cs.WarningIcon(), hook, postCloneHookAllowlistConfig, hook)
+		return nil
+	}
+
+	if !opts.IO.CanPrompt() {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Skipped post-clone hook %q: confirming a hook requires an interactive session\n", cs.WarningIcon(), hook)
+		return nil
+	}
+	confirmed, err := opts.Prompter.Confirm(fmt.Sprintf("Run post-clone hook %q in %s?", hook, cloneDir), false)
+	if err != nil {
+		return err
+	}
+	if !confirmed {
+		return nil
+	}
+
+	shPath, err := findsh.Find()
+	if err != nil {
+		return fmt.Errorf("unable to locate sh to execute the post-clone hook with: %w", err)
+	}
+	cmd := exec.Command(shPath, "-c", hook)
+	cmd.Dir = cloneDir
+	cmd.Stdin = opts.IO.In
+	cmd.Stdout = opts.IO.Out
+	cmd.Stderr = opts.IO.ErrOut
+	if err := run.PrepareCmd(cmd).Run(); err != nil {
+		return fmt.Errorf("post-clone hook %q failed: %w", hook, err)
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Ran post-clone hook %q in %s\n", cs.SuccessIconWithColor(cs.Green), hook, cloneDir)
+	return nil
+}
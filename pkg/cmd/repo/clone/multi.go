@@ -0,0 +1,227 @@
+package clone
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/shurcooL/githubv4"
+)
+
+type cloneResult struct {
+	repo    ghrepo.Interface
+	skipped bool
+	err     error
+}
+
+func multiCloneRun(opts *CloneOptions) error {
+	httpClient, err := opts.HttpClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	var repos []ghrepo.Interface
+	if opts.FromFile != "" {
+		defaultHost, _ := cfg.Authentication().DefaultHost()
+		repos, err = reposFromFile(opts.FromFile, defaultHost)
+	} else {
+		host, _ := cfg.Authentication().DefaultHost()
+		repos, err = listOrgRepos(httpClient, host, opts.Organization, opts.Match)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(repos) == 0 {
+		return errors.New("no repositories to clone")
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Cloning %d repositories", len(repos)))
+
+	jobs := make(chan ghrepo.Interface)
+	results := make(chan cloneResult, len(repos))
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for repo := range jobs {
+				results <- cloneOne(opts, cfg.GitProtocol(repo.RepoHost()).Value, repo)
+			}
+		}()
+	}
+
+	go func() {
+		for _, repo := range repos {
+			jobs <- repo
+		}
+		close(jobs)
+		wg.Wait()
+		close(results)
+	}()
+
+	cs := opts.IO.ColorScheme()
+	var cloned, skipped int
+	var failures []cloneResult
+	for res := range results {
+		if res.err != nil {
+			failures = append(failures, res)
+			continue
+		}
+		if res.skipped {
+			skipped++
+			continue
+		}
+		cloned++
+		opts.IO.StopProgressIndicator()
+		fmt.Fprintf(opts.IO.ErrOut, "%s Cloned %s\n", cs.SuccessIcon(), ghrepo.FullName(res.repo))
+		opts.IO.StartProgressIndicatorWithLabel(fmt.Sprintf("Cloning %d repositories", len(repos)))
+	}
+	opts.IO.StopProgressIndicator()
+
+	if skipped > 0 {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Skipped %s (already cloned)\n",
+			cs.WarningIcon(), text.Pluralize(skipped, "repository"))
+	}
+
+	if len(failures) > 0 {
+		sort.Slice(failures, func(i, j int) bool {
+			return ghrepo.FullName(failures[i].repo) < ghrepo.FullName(failures[j].repo)
+		})
+		fmt.Fprintf(opts.IO.ErrOut, "%s Failed to clone %d of %d repositories:\n",
+			cs.FailureIcon(), len(failures), len(repos))
+		for _, f := range failures {
+			fmt.Fprintf(opts.IO.ErrOut, "  %s: %v\n", ghrepo.FullName(f.repo), f.err)
+		}
+		return cmdutil.SilentError
+	}
+
+	fmt.Fprintf(opts.IO.ErrOut, "%s Cloned %d of %d repositories\n", cs.SuccessIcon(), cloned, len(repos))
+	return nil
+}
+
+func cloneOne(opts *CloneOptions, protocol string, repo ghrepo.Interface) cloneResult {
+	if _, err := os.Stat(repo.RepoName()); err == nil {
+		return cloneResult{repo: repo, skipped: true}
+	}
+
+	cloneURL := ghrepo.FormatRemoteURL(repo, protocol)
+	ctx := opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	_, err := opts.GitClient.Clone(ctx, cloneURL, opts.GitArgs)
+	return cloneResult{repo: repo, err: err}
+}
+
+// reposFromFile reads one repository per line, ignoring blank lines and
+// lines starting with "#".
+func reposFromFile(filename, defaultHost string) ([]ghrepo.Interface, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var repos []ghrepo.Interface
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		repo, err := ghrepo.FromFullNameWithHost(line, defaultHost)
+		if err != nil {
+			return nil, fmt.Errorf("invalid repository %q: %w", line, err)
+		}
+		repos = append(repos, repo)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return repos, nil
+}
+
+func listOrgRepos(httpClient *http.Client, hostname, org, match string) ([]ghrepo.Interface, error) {
+	type result struct {
+		Organization struct {
+			Repositories struct {
+				Nodes []struct {
+					Name  string
+					Owner struct {
+						Login string
+					}
+				}
+				PageInfo struct {
+					HasNextPage bool
+					EndCursor   string
+				}
+			}
+		}
+	}
+
+	query := `query OrganizationRepositoryList($owner:String!,$perPage:Int!,$endCursor:String) {
+		organization(login: $owner) {
+			repositories(first: $perPage, after: $endCursor, orderBy: { field: NAME, direction: ASC }) {
+				nodes{name,owner{login}}
+				pageInfo{hasNextPage,endCursor}
+			}
+		}
+	}`
+
+	variables := map[string]interface{}{
+		"owner":   githubv4.String(org),
+		"perPage": githubv4.Int(100),
+	}
+
+	apiClient := api.NewClientFromHTTP(httpClient)
+	var repos []ghrepo.Interface
+	for {
+		var res result
+		if err := apiClient.GraphQL(hostname, query, variables, &res); err != nil {
+			return nil, err
+		}
+
+		for _, node := range res.Organization.Repositories.Nodes {
+			if match != "" {
+				matched, err := path.Match(match, node.Name)
+				if err != nil {
+					return nil, fmt.Errorf("invalid pattern %q: %w", match, err)
+				}
+				if !matched {
+					continue
+				}
+			}
+			repos = append(repos, ghrepo.NewWithHost(node.Owner.Login, node.Name, hostname))
+		}
+
+		if !res.Organization.Repositories.PageInfo.HasNextPage {
+			break
+		}
+		variables["endCursor"] = githubv4.String(res.Organization.Repositories.PageInfo.EndCursor)
+	}
+
+	return repos, nil
+}
@@ -63,6 +63,8 @@ type CreateOptions struct {
 	Interactive        bool
 	IncludeAllBranches bool
 	AddReadme          bool
+	DefaultBranch      string
+	Topics             []string
 }
 
 func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
@@ -185,6 +187,10 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 				return cmdutil.FlagErrorf("the `--include-all-branches` option is only supported when using `--template`")
 			}
 
+			if opts.DefaultBranch != "" && (opts.Source == "" || !opts.Push) {
+				return cmdutil.FlagErrorf("the `--default-branch` option can only be used with `--source` and `--push`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -209,6 +215,8 @@ func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Co
 	cmd.Flags().BoolVar(&opts.DisableWiki, "disable-wiki", false, "Disable wiki in the new repository")
 	cmd.Flags().BoolVar(&opts.IncludeAllBranches, "include-all-branches", false, "Include all branches from template repository")
 	cmd.Flags().BoolVar(&opts.AddReadme, "add-readme", false, "Add a README file to the new repository")
+	cmd.Flags().StringSliceVar(&opts.Topics, "topic", nil, "Add a `topic` to the new repository (can be specified multiple times)")
+	cmd.Flags().StringVar(&opts.DefaultBranch, "default-branch", "", "Set the default `branch` for the new repository (with --source and --push)")
 
 	// deprecated flags
 	cmd.Flags().BoolP("confirm", "y", false, "Skip the confirmation prompt")
@@ -408,6 +416,12 @@ func createFromScratch(opts *CreateOptions) error {
 		fmt.Fprintln(opts.IO.Out, repo.URL)
 	}
 
+	if len(opts.Topics) > 0 {
+		if err := setRepoTopics(httpClient, repo, opts.Topics); err != nil {
+			return err
+		}
+	}
+
 	if opts.Interactive {
 		var err error
 		opts.Clone, err = opts.Prompter.Confirm("Clone the new repository locally?", true)
@@ -512,6 +526,12 @@ func createFromTemplate(opts *CreateOptions) error {
 		host,
 		repo.URL)
 
+	if len(opts.Topics) > 0 {
+		if err := setRepoTopics(httpClient, repo, opts.Topics); err != nil {
+			return err
+		}
+	}
+
 	opts.Clone, err = opts.Prompter.Confirm("Clone the new repository locally?", true)
 	if err != nil {
 		return err
@@ -642,6 +662,12 @@ func createFromLocal(opts *CreateOptions) error {
 		fmt.Fprintln(stdout, repo.URL)
 	}
 
+	if len(opts.Topics) > 0 {
+		if err := setRepoTopics(httpClient, repo, opts.Topics); err != nil {
+			return err
+		}
+	}
+
 	protocol := cfg.GitProtocol(repo.RepoHost()).Value
 	remoteURL := ghrepo.FormatRemoteURL(repo, protocol)
 
@@ -681,7 +707,7 @@ func createFromLocal(opts *CreateOptions) error {
 	if opts.Push && repoType == working {
 		err := opts.GitClient.Push(context.Background(), baseRemote, "HEAD")
 		if err != nil {
-			return err
+			return rollbackRepoCreate(httpClient, opts.IO, repo, err)
 		}
 
 		if isTTY {
@@ -695,7 +721,7 @@ func createFromLocal(opts *CreateOptions) error {
 			return err
 		}
 		if err = cmd.Run(); err != nil {
-			return err
+			return rollbackRepoCreate(httpClient, opts.IO, repo, err)
 		}
 
 		if isTTY {
@@ -703,9 +729,32 @@ func createFromLocal(opts *CreateOptions) error {
 		}
 	}
 
+	if opts.Push && opts.DefaultBranch != "" {
+		if err := updateDefaultBranch(httpClient, repo, opts.DefaultBranch); err != nil {
+			return err
+		}
+
+		if isTTY {
+			fmt.Fprintf(stdout, "%s Set the default branch to %s\n", cs.SuccessIcon(), opts.DefaultBranch)
+		}
+	}
+
 	return nil
 }
 
+// rollbackRepoCreate deletes a repository that was created earlier in the run
+// after the initial push into it failed, so a failed `gh repo create --push`
+// does not leave a half-set-up repository behind.
+func rollbackRepoCreate(client *http.Client, io *iostreams.IOStreams, repo ghrepo.Interface, pushErr error) error {
+	cs := io.ColorScheme()
+	if err := deleteRepo(client, repo); err != nil {
+		fmt.Fprintf(io.ErrOut, "%s Failed to delete %s after the initial push failed: %v\n", cs.FailureIcon(), ghrepo.FullName(repo), err)
+	} else {
+		fmt.Fprintf(io.ErrOut, "%s Deleted repository %s because the initial push failed\n", cs.WarningIcon(), ghrepo.FullName(repo))
+	}
+	return pushErr
+}
+
 func cloneWithRetry(opts *CreateOptions, remoteURL, branch string) error {
 	// Allow injecting alternative BackOff in tests.
 	if opts.BackOff == nil {
@@ -804,12 +853,7 @@ func localRepoType(gitClient *git.Client) (repoType, error) {
 // clone the checkout branch to specified path
 func localInit(gitClient *git.Client, remoteURL, path string) error {
 	ctx := context.Background()
-	gitInit, err := gitClient.Command(ctx, "init", path)
-	if err != nil {
-		return err
-	}
-	_, err = gitInit.Output()
-	if err != nil {
+	if err := gitClient.Init(ctx, path, ""); err != nil {
 		return err
 	}
 
@@ -134,6 +134,29 @@ func TestNewCmdCreate(t *testing.T) {
 			wantsErr: true,
 			errMsg:   ".gitignore and license templates are not added when template is provided",
 		},
+		{
+			name: "new remote from local with topics and default branch",
+			cli:  "--source=/path/to/repo --push --public --default-branch main --topic go --topic cli",
+			wantsOpts: CreateOptions{
+				Public:        true,
+				Source:        "/path/to/repo",
+				Push:          true,
+				DefaultBranch: "main",
+				Topics:        []string{"go", "cli"},
+			},
+		},
+		{
+			name:     "default branch without source",
+			cli:      "NEWREPO --public --default-branch main",
+			wantsErr: true,
+			errMsg:   "the `--default-branch` option can only be used with `--source` and `--push`",
+		},
+		{
+			name:     "default branch without push",
+			cli:      "--source=/path/to/repo --public --default-branch main",
+			wantsErr: true,
+			errMsg:   "the `--default-branch` option can only be used with `--source` and `--push`",
+		},
 	}
 
 	for _, tt := range tests {
@@ -182,6 +205,8 @@ func TestNewCmdCreate(t *testing.T) {
 			assert.Equal(t, tt.wantsOpts.Internal, opts.Internal)
 			assert.Equal(t, tt.wantsOpts.Private, opts.Private)
 			assert.Equal(t, tt.wantsOpts.Clone, opts.Clone)
+			assert.Equal(t, tt.wantsOpts.DefaultBranch, opts.DefaultBranch)
+			assert.Equal(t, tt.wantsOpts.Topics, opts.Topics)
 		})
 	}
 }
@@ -800,6 +825,93 @@ func Test_createRun(t *testing.T) {
 			},
 			wantStdout: "https://github.com/OWNER/REPO\n",
 		},
+		{
+			name: "noninteractive create from source with topics default branch and push",
+			opts: &CreateOptions{
+				Interactive:   false,
+				Source:        ".",
+				Push:          true,
+				Name:          "REPO",
+				Visibility:    "PRIVATE",
+				DefaultBranch: "trunk",
+				Topics:        []string{"go", "cli"},
+			},
+			tty: false,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`mutation RepositoryCreate\b`),
+					httpmock.StringResponse(`
+						{
+							"data": {
+								"createRepository": {
+									"repository": {
+										"id": "REPOID",
+										"name": "REPO",
+										"owner": {"login":"OWNER"},
+										"url": "https://github.com/OWNER/REPO"
+									}
+								}
+							}
+						}`))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/topics"),
+					httpmock.RESTPayload(200, "{}", func(payload map[string]interface{}) {
+						assert.Equal(t, []interface{}{"go", "cli"}, payload["names"])
+					}))
+				reg.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.RESTPayload(200, "{}", func(payload map[string]interface{}) {
+						assert.Equal(t, "trunk", payload["default_branch"])
+					}))
+			},
+			execStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git -C . rev-parse --git-dir`, 0, ".git")
+				cs.Register(`git -C . rev-parse HEAD`, 0, "commithash")
+				cs.Register(`git -C . remote add origin https://github.com/OWNER/REPO`, 0, "")
+				cs.Register(`git -C . push --set-upstream origin HEAD`, 0, "")
+			},
+			wantStdout: "https://github.com/OWNER/REPO\n",
+		},
+		{
+			name: "noninteractive create from source rolls back when push fails",
+			opts: &CreateOptions{
+				Interactive: false,
+				Source:      ".",
+				Push:        true,
+				Name:        "REPO",
+				Visibility:  "PRIVATE",
+			},
+			tty: false,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`mutation RepositoryCreate\b`),
+					httpmock.StringResponse(`
+						{
+							"data": {
+								"createRepository": {
+									"repository": {
+										"id": "REPOID",
+										"name": "REPO",
+										"owner": {"login":"OWNER"},
+										"url": "https://github.com/OWNER/REPO"
+									}
+								}
+							}
+						}`))
+				reg.Register(
+					httpmock.REST("DELETE", "repos/OWNER/REPO"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+			execStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git -C . rev-parse --git-dir`, 0, ".git")
+				cs.Register(`git -C . rev-parse HEAD`, 0, "commithash")
+				cs.Register(`git -C . remote add origin https://github.com/OWNER/REPO`, 0, "")
+				cs.Register(`git -C . push --set-upstream origin HEAD`, 1, "")
+			},
+			wantStdout: "https://github.com/OWNER/REPO\n",
+			wantErr:    true,
+			errMsg:     "push --set-upstream origin HEAD exited with status 1",
+		},
 		{
 			name: "noninteractive create from cwd that isn't a git repo",
 			opts: &CreateOptions{
@@ -8,6 +8,8 @@ import (
 	"strings"
 
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/shurcooL/githubv4"
 )
 
@@ -243,6 +245,66 @@ func repoCreate(client *http.Client, hostname string, input repoCreateInput) (*a
 	return api.InitRepoHostname(&response.CreateRepository.Repository, hostname), nil
 }
 
+// setRepoTopics replaces the topics of a repository
+func setRepoTopics(client *http.Client, repo ghrepo.Interface, topics []string) error {
+	payload := struct {
+		Names []string `json:"names"`
+	}{
+		Names: topics,
+	}
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(&payload); err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s/topics", ghrepo.FullName(repo))
+	return apiClient.REST(repo.RepoHost(), "PUT", path, body, nil)
+}
+
+// updateDefaultBranch changes the default branch of a repository. The branch
+// must already exist on the remote.
+func updateDefaultBranch(client *http.Client, repo ghrepo.Interface, branch string) error {
+	payload := struct {
+		DefaultBranch string `json:"default_branch"`
+	}{
+		DefaultBranch: branch,
+	}
+	body := &bytes.Buffer{}
+	if err := json.NewEncoder(body).Encode(&payload); err != nil {
+		return err
+	}
+
+	apiClient := api.NewClientFromHTTP(client)
+	path := fmt.Sprintf("repos/%s", ghrepo.FullName(repo))
+	return apiClient.REST(repo.RepoHost(), "PATCH", path, body, nil)
+}
+
+// deleteRepo deletes a repository, used to roll back repository creation when
+// the initial push fails
+func deleteRepo(client *http.Client, repo ghrepo.Interface) error {
+	url := fmt.Sprintf("%srepos/%s",
+		ghinstance.RESTPrefix(repo.RepoHost()),
+		ghrepo.FullName(repo))
+
+	request, err := http.NewRequest("DELETE", url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode > 299 {
+		return api.HandleHTTPError(api.EndpointNeedsScopes(resp, "delete_repo"))
+	}
+
+	return nil
+}
+
 type ownerResponse struct {
 	NodeID string `json:"node_id"`
 	Type   string `json:"type"`
@@ -25,7 +25,8 @@ type DeleteOptions struct {
 	Prompter   iprompter
 	IO         *iostreams.IOStreams
 	RepoArg    string
-	Confirmed  bool
+
+	cmdutil.DestructiveOptions
 }
 
 func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Command {
@@ -53,7 +54,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 				opts.RepoArg = args[0]
 			}
 
-			if !opts.IO.CanPrompt() && !opts.Confirmed {
+			if !opts.IO.CanPrompt() && !opts.Confirmed && !opts.DryRun {
 				return cmdutil.FlagErrorf("--yes required when not running interactively")
 			}
 
@@ -67,7 +68,7 @@ func NewCmdDelete(f *cmdutil.Factory, runF func(*DeleteOptions) error) *cobra.Co
 
 	cmd.Flags().BoolVar(&opts.Confirmed, "confirm", false, "Confirm deletion without prompting")
 	_ = cmd.Flags().MarkDeprecated("confirm", "use `--yes` instead")
-	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "Confirm deletion without prompting")
+	cmdutil.AddDestructiveFlags(cmd, &opts.DestructiveOptions)
 	return cmd
 }
 
@@ -102,10 +103,13 @@ func deleteRun(opts *DeleteOptions) error {
 	}
 	fullName := ghrepo.FullName(toDelete)
 
-	if !opts.Confirmed {
-		if err := opts.Prompter.ConfirmDeletion(fullName); err != nil {
-			return err
-		}
+	if err := cmdutil.ConfirmDestructive(opts.IO.CanPrompt(), opts.Prompter, fullName, opts.DestructiveOptions); err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		fmt.Fprintf(opts.IO.Out, "Would have deleted repository %s\n", fullName)
+		return nil
 	}
 
 	err = deleteRepo(httpClient, toDelete)
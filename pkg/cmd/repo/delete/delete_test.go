@@ -27,13 +27,13 @@ func TestNewCmdDelete(t *testing.T) {
 			name:   "confirm flag",
 			tty:    true,
 			input:  "OWNER/REPO --confirm",
-			output: DeleteOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+			output: DeleteOptions{RepoArg: "OWNER/REPO", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 		},
 		{
 			name:   "yes flag",
 			tty:    true,
 			input:  "OWNER/REPO --yes",
-			output: DeleteOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+			output: DeleteOptions{RepoArg: "OWNER/REPO", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 		},
 		{
 			name:    "no confirmation notty",
@@ -128,8 +128,8 @@ func Test_deleteRun(t *testing.T) {
 		{
 			name: "confirmation no tty",
 			opts: &DeleteOptions{
-				RepoArg:   "OWNER/REPO",
-				Confirmed: true,
+				RepoArg:            "OWNER/REPO",
+				DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true},
 			},
 			httpStubs: func(reg *httpmock.Registry) {
 				reg.Register(
@@ -158,7 +158,7 @@ func Test_deleteRun(t *testing.T) {
 		},
 		{
 			name:       "repo transferred ownership",
-			opts:       &DeleteOptions{RepoArg: "OWNER/REPO", Confirmed: true},
+			opts:       &DeleteOptions{RepoArg: "OWNER/REPO", DestructiveOptions: cmdutil.DestructiveOptions{Confirmed: true}},
 			wantErr:    true,
 			errMsg:     "SilentError",
 			wantStderr: "X Failed to delete repository: OWNER/REPO has changed name or transferred ownership\n",
@@ -17,12 +17,21 @@ type AddOptions struct {
 	IO         *iostreams.IOStreams
 	HTTPClient func() (*http.Client, error)
 	BaseRepo   func() (ghrepo.Interface, error)
+	Exporter   cmdutil.Exporter
 
 	KeyFile    string
 	Title      string
 	AllowWrite bool
 }
 
+var deployKeyFields = []string{
+	"id",
+	"key",
+	"title",
+	"createdAt",
+	"readOnly",
+}
+
 func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command {
 	opts := &AddOptions{
 		HTTPClient: f.HttpClient,
@@ -58,6 +67,7 @@ func NewCmdAdd(f *cmdutil.Factory, runF func(*AddOptions) error) *cobra.Command
 
 	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title of the new key")
 	cmd.Flags().BoolVarP(&opts.AllowWrite, "allow-write", "w", false, "Allow write access for the key")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, deployKeyFields)
 	return cmd
 }
 
@@ -85,10 +95,15 @@ func addRun(opts *AddOptions) error {
 		return err
 	}
 
-	if err := uploadDeployKey(httpClient, repo, keyReader, opts.Title, opts.AllowWrite); err != nil {
+	key, err := uploadDeployKey(httpClient, repo, keyReader, opts.Title, opts.AllowWrite)
+	if err != nil {
 		return err
 	}
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, key)
+	}
+
 	if !opts.IO.IsStdoutTTY() {
 		return nil
 	}
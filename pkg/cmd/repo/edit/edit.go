@@ -20,6 +20,7 @@ import (
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/set"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -57,6 +58,8 @@ type EditOptions struct {
 	AddTopics                          []string
 	RemoveTopics                       []string
 	AcceptVisibilityChangeConsequences bool
+	DryRun                             bool
+	FromFile                           string
 	InteractiveMode                    bool
 	Detector                           fd.Detector
 	Prompter                           iprompter
@@ -119,7 +122,16 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(options *EditOptions) error) *cobr
 
 			When the %[1]s--visibility%[1]s flag is used, %[1]s--accept-visibility-change-consequences%[1]s flag is required.
 
+			Use %[1]s--dry-run%[1]s to list the consequences of an edit, such as the number of
+			stars and watchers lost by a visibility change, without applying it.
+
 			For information on all the potential consequences, see <https://gh.io/setting-repository-visibility>.
+
+			With %[1]s--from-file%[1]s, topics, the default branch, merge settings, and basic
+			branch protection are reconciled against a declarative YAML file. Only the keys
+			present in the file are applied, a diff of the resulting changes is printed, and
+			repeated runs with an unchanged file make no further edits. Combine it with
+			%[1]s--dry-run%[1]s to preview the changes without applying them.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		Example: heredoc.Doc(`
@@ -128,6 +140,9 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(options *EditOptions) error) *cobr
 
 			# Disable projects
 			$ gh repo edit --enable-projects=false
+
+			# Reconcile settings against a declarative file
+			$ gh repo edit --from-file repo-settings.yaml
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -150,6 +165,18 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(options *EditOptions) error) *cobr
 				return err
 			}
 
+			if opts.FromFile != "" {
+				var conflicting string
+				cmd.Flags().Visit(func(f *pflag.Flag) {
+					if conflicting == "" && f.Name != "from-file" && f.Name != "dry-run" {
+						conflicting = f.Name
+					}
+				})
+				if conflicting != "" {
+					return cmdutil.FlagErrorf("cannot combine `--from-file` with `--%s`", conflicting)
+				}
+			}
+
 			if cmd.Flags().NFlag() == 0 {
 				opts.InteractiveMode = true
 			}
@@ -158,7 +185,7 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(options *EditOptions) error) *cobr
 				return cmdutil.FlagErrorf("specify properties to edit when not running interactively")
 			}
 
-			if opts.Edits.Visibility != nil && !opts.AcceptVisibilityChangeConsequences {
+			if opts.Edits.Visibility != nil && !opts.AcceptVisibilityChangeConsequences && !opts.DryRun {
 				return cmdutil.FlagErrorf("use of --visibility flag requires --accept-visibility-change-consequences flag")
 			}
 
@@ -169,6 +196,9 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(options *EditOptions) error) *cobr
 			if runF != nil {
 				return runF(opts)
 			}
+			if opts.FromFile != "" {
+				return fromFileRun(cmd.Context(), opts)
+			}
 			return editRun(cmd.Context(), opts)
 		},
 	}
@@ -195,6 +225,8 @@ func NewCmdEdit(f *cmdutil.Factory, runF func(options *EditOptions) error) *cobr
 	cmd.Flags().StringSliceVar(&opts.AddTopics, "add-topic", nil, "Add repository topic")
 	cmd.Flags().StringSliceVar(&opts.RemoveTopics, "remove-topic", nil, "Remove repository topic")
 	cmd.Flags().BoolVar(&opts.AcceptVisibilityChangeConsequences, "accept-visibility-change-consequences", false, "Accept the consequences of changing the repository visibility")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the consequences of the edit without applying it")
+	cmd.Flags().StringVar(&opts.FromFile, "from-file", "", "Reconcile topics, merge settings, and basic branch protection against a YAML `file` (use \"-\" to read from standard input)")
 
 	return cmd
 }
@@ -263,6 +295,10 @@ func editRun(ctx context.Context, opts *EditOptions) error {
 		}
 	}
 
+	if opts.DryRun {
+		return dryRunRepoEdit(opts)
+	}
+
 	apiPath := fmt.Sprintf("repos/%s/%s", repo.RepoOwner(), repo.RepoName())
 
 	body := &bytes.Buffer{}
@@ -322,6 +358,28 @@ func editRun(ctx context.Context, opts *EditOptions) error {
 	return nil
 }
 
+func dryRunRepoEdit(opts *EditOptions) error {
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	fmt.Fprintf(out, "Would have edited repository %s\n", ghrepo.FullName(opts.Repository))
+
+	if opts.Edits.Visibility != nil {
+		apiClient := api.NewClientFromHTTP(opts.HTTPClient)
+		fetchedRepo, err := api.FetchRepository(apiClient, opts.Repository, []string{"stargazerCount", "watchers"})
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "%s Changing the repository visibility to %s will cause permanent loss of %s and %s.\n",
+			cs.WarningIcon(),
+			*opts.Edits.Visibility,
+			text.Pluralize(fetchedRepo.StargazerCount, "star"),
+			text.Pluralize(fetchedRepo.Watchers.TotalCount, "watcher"))
+	}
+
+	return nil
+}
+
 func interactiveChoice(p iprompter, r *api.Repository) ([]string, error) {
 	options := []string{
 		optionDefaultBranchName,
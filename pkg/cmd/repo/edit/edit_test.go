@@ -91,6 +91,34 @@ func TestNewCmdEdit(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "from-file",
+			args: "--from-file settings.yaml --dry-run",
+			wantOpts: EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				Edits:      EditRepositoryInput{},
+				FromFile:   "settings.yaml",
+			},
+		},
+		{
+			name: "deny from-file combined with other edit flags",
+			args: "--from-file settings.yaml --description hi",
+			wantOpts: EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				Edits:      EditRepositoryInput{},
+			},
+			wantErr: "cannot combine `--from-file` with `--description`",
+		},
+		{
+			name: "allow visibility change with dry run",
+			args: "--visibility private --dry-run",
+			wantOpts: EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				Edits: EditRepositoryInput{
+					Visibility: sp("private"),
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -134,6 +162,7 @@ func TestNewCmdEdit(t *testing.T) {
 
 			assert.Equal(t, ghrepo.FullName(tt.wantOpts.Repository), ghrepo.FullName(gotOpts.Repository))
 			assert.Equal(t, tt.wantOpts.Edits, gotOpts.Edits)
+			assert.Equal(t, tt.wantOpts.FromFile, gotOpts.FromFile)
 		})
 	}
 }
@@ -143,6 +172,7 @@ func Test_editRun(t *testing.T) {
 		name        string
 		opts        EditOptions
 		httpStubs   func(*testing.T, *httpmock.Registry)
+		wantsStdout string
 		wantsStderr string
 		wantsErr    string
 	}{
@@ -235,6 +265,22 @@ func Test_editRun(t *testing.T) {
 					}))
 			},
 		},
+		{
+			name: "dry run visibility change",
+			opts: EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				Edits: EditRepositoryInput{
+					Visibility: sp("private"),
+				},
+				DryRun: true,
+			},
+			httpStubs: func(t *testing.T, r *httpmock.Registry) {
+				r.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(`{"data": { "repository": { "stargazerCount": 10, "watchers": { "totalCount": 3 } } } }`))
+			},
+			wantsStdout: "Would have edited repository OWNER/REPO\n! Changing the repository visibility to private will cause permanent loss of 10 stars and 3 watchers.\n",
+		},
 		{
 			name: "does not have sufficient permissions for security edits",
 			opts: EditOptions{
@@ -264,7 +310,7 @@ func Test_editRun(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ios, _, _, _ := iostreams.Test()
+			ios, _, stdout, _ := iostreams.Test()
 			ios.SetStdoutTTY(true)
 			ios.SetStdinTTY(true)
 			ios.SetStderrTTY(true)
@@ -285,6 +331,9 @@ func Test_editRun(t *testing.T) {
 				assert.EqualError(t, err, tt.wantsErr)
 				return
 			}
+			if tt.wantsStdout != "" {
+				assert.Equal(t, tt.wantsStdout, stdout.String())
+			}
 		})
 	}
 }
@@ -0,0 +1,344 @@
+package edit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/set"
+	"gopkg.in/yaml.v3"
+)
+
+// repoSettings is the declarative representation of repository settings
+// accepted by `gh repo edit --from-file`. Only the keys present in the file
+// are reconciled; everything else is left untouched.
+type repoSettings struct {
+	Topics              *[]string              `yaml:"topics"`
+	DefaultBranch       *string                `yaml:"default-branch"`
+	AllowMergeCommit    *bool                  `yaml:"allow-merge-commit"`
+	AllowSquashMerge    *bool                  `yaml:"allow-squash-merge"`
+	AllowRebaseMerge    *bool                  `yaml:"allow-rebase-merge"`
+	AllowAutoMerge      *bool                  `yaml:"allow-auto-merge"`
+	DeleteBranchOnMerge *bool                  `yaml:"delete-branch-on-merge"`
+	BranchProtection    []branchProtectionRule `yaml:"branch-protection"`
+}
+
+type branchProtectionRule struct {
+	Branch                   string    `yaml:"branch"`
+	RequiredApprovingReviews *int      `yaml:"required-approving-reviews"`
+	RequiredStatusChecks     *[]string `yaml:"required-status-checks"`
+	EnforceAdmins            *bool     `yaml:"enforce-admins"`
+}
+
+func parseRepoSettings(b []byte) (*repoSettings, error) {
+	var settings repoSettings
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(&settings); err != nil {
+		return nil, err
+	}
+	for _, rule := range settings.BranchProtection {
+		if rule.Branch == "" {
+			return nil, fmt.Errorf("branch protection entries require a branch name")
+		}
+	}
+	return &settings, nil
+}
+
+func fromFileRun(ctx context.Context, opts *EditOptions) error {
+	fileContents, err := cmdutil.ReadFile(opts.FromFile, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	settings, err := parseRepoSettings(fileContents)
+	if err != nil {
+		return fmt.Errorf("invalid settings file: %w", err)
+	}
+
+	repo := opts.Repository
+	apiClient := api.NewClientFromHTTP(opts.HTTPClient)
+
+	fieldsToRetrieve := []string{
+		"defaultBranchRef",
+		"deleteBranchOnMerge",
+		"mergeCommitAllowed",
+		"rebaseMergeAllowed",
+		"repositoryTopics",
+		"squashMergeAllowed",
+	}
+	if settings.AllowAutoMerge != nil {
+		fieldsToRetrieve = append(fieldsToRetrieve, "autoMergeAllowed")
+	}
+
+	opts.IO.StartProgressIndicator()
+	fetchedRepo, err := api.FetchRepository(apiClient, repo, fieldsToRetrieve)
+	opts.IO.StopProgressIndicator()
+	if err != nil {
+		return err
+	}
+
+	var changes []string
+	edits := EditRepositoryInput{}
+
+	if settings.DefaultBranch != nil && *settings.DefaultBranch != fetchedRepo.DefaultBranchRef.Name {
+		changes = append(changes, fmt.Sprintf("default branch %q → %q", fetchedRepo.DefaultBranchRef.Name, *settings.DefaultBranch))
+		edits.DefaultBranch = settings.DefaultBranch
+	}
+
+	boolSettings := []struct {
+		name    string
+		current bool
+		desired *bool
+		target  **bool
+	}{
+		{"allow merge commits", fetchedRepo.MergeCommitAllowed, settings.AllowMergeCommit, &edits.EnableMergeCommit},
+		{"allow squash merging", fetchedRepo.SquashMergeAllowed, settings.AllowSquashMerge, &edits.EnableSquashMerge},
+		{"allow rebase merging", fetchedRepo.RebaseMergeAllowed, settings.AllowRebaseMerge, &edits.EnableRebaseMerge},
+		{"allow auto-merge", fetchedRepo.AutoMergeAllowed, settings.AllowAutoMerge, &edits.EnableAutoMerge},
+		{"delete head branches on merge", fetchedRepo.DeleteBranchOnMerge, settings.DeleteBranchOnMerge, &edits.DeleteBranchOnMerge},
+	}
+	for _, s := range boolSettings {
+		if s.desired == nil || *s.desired == s.current {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s %t → %t", s.name, s.current, *s.desired))
+		*s.target = s.desired
+	}
+
+	var newTopics []string
+	topicsChanged := false
+	if settings.Topics != nil {
+		oldTopics := set.NewStringSet()
+		for _, v := range fetchedRepo.RepositoryTopics.Nodes {
+			oldTopics.Add(v.Topic.Name)
+		}
+		desiredTopics := set.NewStringSet()
+		desiredTopics.AddValues(*settings.Topics)
+		if !oldTopics.Equal(desiredTopics) {
+			changes = append(changes, fmt.Sprintf("topics [%s] → [%s]", strings.Join(oldTopics.ToSlice(), ", "), strings.Join(desiredTopics.ToSlice(), ", ")))
+			newTopics = desiredTopics.ToSlice()
+			topicsChanged = true
+		}
+	}
+
+	type protectionUpdate struct {
+		branch string
+		input  branchProtectionInput
+	}
+	var protectionUpdates []protectionUpdate
+	for _, rule := range settings.BranchProtection {
+		current, err := getBranchProtection(ctx, opts.HTTPClient, repo, rule.Branch)
+		if err != nil {
+			return err
+		}
+		ruleChanges, input := diffBranchProtection(rule, current)
+		if len(ruleChanges) > 0 {
+			changes = append(changes, ruleChanges...)
+			protectionUpdates = append(protectionUpdates, protectionUpdate{branch: rule.Branch, input: input})
+		}
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	if len(changes) == 0 {
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(out, "%s Repository %s already matches the settings file\n", cs.SuccessIcon(), ghrepo.FullName(repo))
+		}
+		return nil
+	}
+
+	for _, c := range changes {
+		fmt.Fprintf(out, "%s %s\n", cs.Yellow("~"), c)
+	}
+
+	if opts.DryRun {
+		fmt.Fprintf(out, "Would have edited repository %s\n", ghrepo.FullName(repo))
+		return nil
+	}
+
+	body := &bytes.Buffer{}
+	enc := json.NewEncoder(body)
+	if err := enc.Encode(edits); err != nil {
+		return err
+	}
+	if body.Len() > 3 {
+		apiPath := fmt.Sprintf("repos/%s/%s", repo.RepoOwner(), repo.RepoName())
+		if _, err := api.CreateRepoTransformToV4(apiClient, repo.RepoHost(), "PATCH", apiPath, body); err != nil {
+			return err
+		}
+	}
+
+	if topicsChanged {
+		if err := setTopics(ctx, opts.HTTPClient, repo, newTopics); err != nil {
+			return err
+		}
+	}
+
+	for _, u := range protectionUpdates {
+		if err := updateBranchProtection(ctx, opts.HTTPClient, repo, u.branch, u.input); err != nil {
+			return err
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(out,
+			"%s Edited repository %s (%s)\n",
+			cs.SuccessIcon(),
+			ghrepo.FullName(repo),
+			text.Pluralize(len(changes), "change"))
+	}
+
+	return nil
+}
+
+type branchProtection struct {
+	RequiredStatusChecks *struct {
+		Strict   bool     `json:"strict"`
+		Contexts []string `json:"contexts"`
+	} `json:"required_status_checks"`
+	EnforceAdmins *struct {
+		Enabled bool `json:"enabled"`
+	} `json:"enforce_admins"`
+	RequiredPullRequestReviews *struct {
+		RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+	} `json:"required_pull_request_reviews"`
+}
+
+type branchProtectionInput struct {
+	RequiredStatusChecks       *requiredStatusChecksInput `json:"required_status_checks"`
+	EnforceAdmins              *bool                      `json:"enforce_admins"`
+	RequiredPullRequestReviews *requiredReviewsInput      `json:"required_pull_request_reviews"`
+	Restrictions               *struct{}                  `json:"restrictions"`
+}
+
+type requiredStatusChecksInput struct {
+	Strict   bool     `json:"strict"`
+	Contexts []string `json:"contexts"`
+}
+
+type requiredReviewsInput struct {
+	RequiredApprovingReviewCount int `json:"required_approving_review_count"`
+}
+
+// diffBranchProtection compares one branch protection rule from the settings
+// file against the branch's current protection (nil when the branch is not
+// protected). It returns human-readable change descriptions along with the
+// full payload to PUT; settings the file does not mention carry over from the
+// current protection since the update endpoint replaces the whole rule.
+func diffBranchProtection(rule branchProtectionRule, current *branchProtection) ([]string, branchProtectionInput) {
+	var changes []string
+	input := branchProtectionInput{}
+
+	curReviews := 0
+	if current != nil && current.RequiredPullRequestReviews != nil {
+		curReviews = current.RequiredPullRequestReviews.RequiredApprovingReviewCount
+	}
+	newReviews := curReviews
+	if rule.RequiredApprovingReviews != nil && *rule.RequiredApprovingReviews != curReviews {
+		newReviews = *rule.RequiredApprovingReviews
+		changes = append(changes, fmt.Sprintf("branch protection for %q: required approving reviews %d → %d", rule.Branch, curReviews, newReviews))
+	}
+	if newReviews > 0 {
+		input.RequiredPullRequestReviews = &requiredReviewsInput{RequiredApprovingReviewCount: newReviews}
+	}
+
+	var curChecks []string
+	curStrict := false
+	if current != nil && current.RequiredStatusChecks != nil {
+		curChecks = current.RequiredStatusChecks.Contexts
+		curStrict = current.RequiredStatusChecks.Strict
+	}
+	newChecks := curChecks
+	if rule.RequiredStatusChecks != nil && !sameStringSet(curChecks, *rule.RequiredStatusChecks) {
+		newChecks = *rule.RequiredStatusChecks
+		changes = append(changes, fmt.Sprintf("branch protection for %q: required status checks [%s] → [%s]", rule.Branch, strings.Join(curChecks, ", "), strings.Join(newChecks, ", ")))
+	}
+	if len(newChecks) > 0 {
+		input.RequiredStatusChecks = &requiredStatusChecksInput{Strict: curStrict, Contexts: newChecks}
+	}
+
+	curEnforce := current != nil && current.EnforceAdmins != nil && current.EnforceAdmins.Enabled
+	newEnforce := curEnforce
+	if rule.EnforceAdmins != nil && *rule.EnforceAdmins != curEnforce {
+		newEnforce = *rule.EnforceAdmins
+		changes = append(changes, fmt.Sprintf("branch protection for %q: enforce for admins %t → %t", rule.Branch, curEnforce, newEnforce))
+	}
+	input.EnforceAdmins = &newEnforce
+
+	return changes, input
+}
+
+func sameStringSet(a, b []string) bool {
+	as := set.NewStringSet()
+	as.AddValues(a)
+	bs := set.NewStringSet()
+	bs.AddValues(b)
+	return as.Equal(bs)
+}
+
+func getBranchProtection(ctx context.Context, httpClient *http.Client, repo ghrepo.Interface, branch string) (*branchProtection, error) {
+	apiPath := fmt.Sprintf("repos/%s/%s/branches/%s/protection", repo.RepoOwner(), repo.RepoName(), branch)
+	req, err := http.NewRequestWithContext(ctx, "GET", ghinstance.RESTPrefix(repo.RepoHost())+apiPath, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotFound {
+		// the branch is not protected yet
+		_, _ = io.Copy(io.Discard, res.Body)
+		return nil, nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return nil, api.HandleHTTPError(res)
+	}
+
+	var protection branchProtection
+	dec := json.NewDecoder(res.Body)
+	err = dec.Decode(&protection)
+	return &protection, err
+}
+
+func updateBranchProtection(ctx context.Context, httpClient *http.Client, repo ghrepo.Interface, branch string, input branchProtectionInput) error {
+	body := &bytes.Buffer{}
+	enc := json.NewEncoder(body)
+	if err := enc.Encode(&input); err != nil {
+		return err
+	}
+
+	apiPath := fmt.Sprintf("repos/%s/%s/branches/%s/protection", repo.RepoOwner(), repo.RepoName(), branch)
+	req, err := http.NewRequestWithContext(ctx, "PUT", ghinstance.RESTPrefix(repo.RepoHost())+apiPath, body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-type", "application/json")
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return api.HandleHTTPError(res)
+	}
+
+	if res.Body != nil {
+		_, _ = io.Copy(io.Discard, res.Body)
+	}
+
+	return nil
+}
@@ -0,0 +1,169 @@
+package edit
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_fromFileRun(t *testing.T) {
+	repoInfoResponse := heredoc.Doc(`
+		{ "data": { "repository": {
+			"defaultBranchRef": { "name": "master" },
+			"deleteBranchOnMerge": false,
+			"mergeCommitAllowed": true,
+			"rebaseMergeAllowed": true,
+			"squashMergeAllowed": true,
+			"repositoryTopics": { "nodes": [{ "topic": { "name": "cli" } }] }
+		} } }
+	`)
+
+	tests := []struct {
+		name        string
+		settings    string
+		dryRun      bool
+		httpStubs   func(*testing.T, *httpmock.Registry)
+		wantsStdout string
+		wantsErr    string
+	}{
+		{
+			name: "applies changes",
+			settings: heredoc.Doc(`
+				default-branch: main
+				topics:
+				  - cli
+				  - go
+				allow-merge-commit: false
+				delete-branch-on-merge: true
+				branch-protection:
+				  - branch: main
+				    required-approving-reviews: 2
+				    required-status-checks: [ci]
+				    enforce-admins: true
+			`),
+			httpStubs: func(t *testing.T, r *httpmock.Registry) {
+				r.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(repoInfoResponse))
+				r.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.StatusStringResponse(404, `{"message": "Branch not protected"}`))
+				r.Register(
+					httpmock.REST("PATCH", "repos/OWNER/REPO"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, 3, len(payload))
+						assert.Equal(t, "main", payload["default_branch"])
+						assert.Equal(t, false, payload["allow_merge_commit"])
+						assert.Equal(t, true, payload["delete_branch_on_merge"])
+					}))
+				r.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/topics"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, []interface{}{"cli", "go"}, payload["names"])
+					}))
+				r.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/branches/main/protection"),
+					httpmock.RESTPayload(200, `{}`, func(payload map[string]interface{}) {
+						assert.Equal(t, true, payload["enforce_admins"])
+						assert.Nil(t, payload["restrictions"])
+						reviews := payload["required_pull_request_reviews"].(map[string]interface{})
+						assert.Equal(t, float64(2), reviews["required_approving_review_count"])
+						checks := payload["required_status_checks"].(map[string]interface{})
+						assert.Equal(t, []interface{}{"ci"}, checks["contexts"])
+					}))
+			},
+			wantsStdout: heredoc.Doc(`
+				~ default branch "master" → "main"
+				~ allow merge commits true → false
+				~ delete head branches on merge false → true
+				~ topics [cli] → [cli, go]
+				~ branch protection for "main": required approving reviews 0 → 2
+				~ branch protection for "main": required status checks [] → [ci]
+				~ branch protection for "main": enforce for admins false → true
+				✓ Edited repository OWNER/REPO (7 changes)
+			`),
+		},
+		{
+			name: "no changes needed",
+			settings: heredoc.Doc(`
+				default-branch: master
+				allow-squash-merge: true
+			`),
+			httpStubs: func(t *testing.T, r *httpmock.Registry) {
+				r.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(repoInfoResponse))
+			},
+			wantsStdout: "✓ Repository OWNER/REPO already matches the settings file\n",
+		},
+		{
+			name:     "dry run",
+			settings: "default-branch: main\n",
+			dryRun:   true,
+			httpStubs: func(t *testing.T, r *httpmock.Registry) {
+				r.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(repoInfoResponse))
+			},
+			wantsStdout: heredoc.Doc(`
+				~ default branch "master" → "main"
+				Would have edited repository OWNER/REPO
+			`),
+		},
+		{
+			name:     "invalid settings file",
+			settings: "default_branch: main\n",
+			wantsErr: "invalid settings file: yaml: unmarshal errors:\n  line 1: field default_branch not found in type edit.repoSettings",
+		},
+		{
+			name: "branch protection without branch name",
+			settings: heredoc.Doc(`
+				branch-protection:
+				  - required-approving-reviews: 2
+			`),
+			wantsErr: "invalid settings file: branch protection entries require a branch name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			ios.SetStdinTTY(true)
+			ios.SetStderrTTY(true)
+
+			httpReg := &httpmock.Registry{}
+			defer httpReg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, httpReg)
+			}
+
+			settingsFile := filepath.Join(t.TempDir(), "repo-settings.yaml")
+			require.NoError(t, os.WriteFile(settingsFile, []byte(tt.settings), 0o600))
+
+			opts := &EditOptions{
+				Repository: ghrepo.NewWithHost("OWNER", "REPO", "github.com"),
+				HTTPClient: &http.Client{Transport: httpReg},
+				IO:         ios,
+				FromFile:   settingsFile,
+				DryRun:     tt.dryRun,
+			}
+			err := fromFileRun(context.Background(), opts)
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantsStdout, stdout.String())
+		})
+	}
+}
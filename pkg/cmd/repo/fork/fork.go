@@ -51,6 +51,9 @@ type ForkOptions struct {
 	ForkName          string
 	Rename            bool
 	DefaultBranchOnly bool
+	UpstreamFetchOnly bool
+	Triangular        bool
+	SyncReminder      time.Duration
 }
 
 type errWithExitCode interface {
@@ -94,6 +97,12 @@ func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Comman
 
 			The %[1]supstream%[1]s remote will be set as the default remote repository.
 
+			When cloning the fork, %[1]s--upstream-fetch-only%[1]s disables pushing to the
+			%[1]supstream%[1]s remote, %[1]s--triangular%[1]s configures the default branch to pull
+			from %[1]supstream%[1]s while pushing to the fork, and %[1]s--sync-reminder%[1]s records
+			an interval in the clone's git config after which %[1]sgh repo sync%[1]s should be run
+			to keep the fork from drifting.
+
 			Additional %[1]sgit clone%[1]s flags can be passed after %[1]s--%[1]s.
 		`, "`"),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -113,6 +122,10 @@ func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Comman
 				opts.Rename = true // Any existing 'origin' will be renamed to upstream
 			}
 
+			if (opts.UpstreamFetchOnly || opts.Triangular || opts.SyncReminder > 0) && !opts.Clone {
+				return cmdutil.FlagErrorf("`--upstream-fetch-only`, `--triangular`, and `--sync-reminder` can only be used with `--clone`")
+			}
+
 			if promptOk {
 				// We can prompt for these if they were not specified.
 				opts.PromptClone = !cmd.Flags().Changed("clone")
@@ -138,6 +151,9 @@ func NewCmdFork(f *cmdutil.Factory, runF func(*ForkOptions) error) *cobra.Comman
 	cmd.Flags().StringVar(&opts.Organization, "org", "", "Create the fork in an organization")
 	cmd.Flags().StringVar(&opts.ForkName, "fork-name", "", "Rename the forked repository")
 	cmd.Flags().BoolVar(&opts.DefaultBranchOnly, "default-branch-only", false, "Only include the default branch in the fork")
+	cmd.Flags().BoolVar(&opts.UpstreamFetchOnly, "upstream-fetch-only", false, "Disable pushing to the upstream remote of the clone")
+	cmd.Flags().BoolVar(&opts.Triangular, "triangular", false, "Configure the clone's default branch to pull from upstream and push to the fork")
+	cmd.Flags().DurationVar(&opts.SyncReminder, "sync-reminder", 0, "Record an `interval` after which the fork should be synced from upstream")
 
 	return cmd
 }
@@ -377,8 +393,51 @@ func forkRun(opts *ForkOptions) error {
 				return err
 			}
 
+			if opts.UpstreamFetchOnly {
+				// A disabled push URL makes `git push upstream` fail loudly
+				// instead of accidentally pushing to the parent repository.
+				setURLCmd, err := gc.Command(ctx, "remote", "set-url", "--push", upstreamRemote, "DISABLED")
+				if err != nil {
+					return err
+				}
+				if _, err := setURLCmd.Output(); err != nil {
+					return err
+				}
+			}
+
+			if opts.Triangular {
+				branch, err := gc.CurrentBranch(ctx)
+				if err != nil {
+					return err
+				}
+				if err := gc.SetLocalConfig(ctx, fmt.Sprintf("branch.%s.remote", branch), upstreamRemote); err != nil {
+					return err
+				}
+				if err := gc.SetLocalConfig(ctx, fmt.Sprintf("branch.%s.pushRemote", branch), defaultRemoteName); err != nil {
+					return err
+				}
+				if err := gc.SetLocalConfig(ctx, "remote.pushDefault", defaultRemoteName); err != nil {
+					return err
+				}
+			}
+
+			if opts.SyncReminder > 0 {
+				if err := gc.SetLocalConfig(ctx, "gh.syncReminder", opts.SyncReminder.String()); err != nil {
+					return err
+				}
+			}
+
 			if connectedToTerminal {
 				fmt.Fprintf(stderr, "%s Cloned fork\n", cs.SuccessIcon())
+				if opts.UpstreamFetchOnly {
+					fmt.Fprintf(stderr, "%s Disabled pushing to the %s remote\n", cs.SuccessIcon(), cs.Bold(upstreamRemote))
+				}
+				if opts.Triangular {
+					fmt.Fprintf(stderr, "%s Configured the default branch to pull from %s and push to %s\n", cs.SuccessIcon(), cs.Bold(upstreamRemote), cs.Bold(defaultRemoteName))
+				}
+				if opts.SyncReminder > 0 {
+					fmt.Fprintf(stderr, "%s Remember to run %s every %s to keep the fork up to date\n", cs.WarningIcon(), cs.Bold("gh repo sync"), opts.SyncReminder)
+				}
 				fmt.Fprintf(stderr, "%s Repository %s set as the default repository. To learn more about the default repository, run: gh repo set-default --help\n", cs.WarningIcon(), cs.Bold(ghrepo.FullName(repoToFork)))
 			}
 		}
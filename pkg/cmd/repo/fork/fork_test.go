@@ -144,6 +144,23 @@ func TestNewCmdFork(t *testing.T) {
 				Rename:     false,
 			},
 		},
+		{
+			name: "clone with upstream wiring",
+			cli:  "--clone --upstream-fetch-only --triangular --sync-reminder 24h",
+			wants: ForkOptions{
+				RemoteName:        "origin",
+				Rename:            true,
+				UpstreamFetchOnly: true,
+				Triangular:        true,
+				SyncReminder:      24 * time.Hour,
+			},
+		},
+		{
+			name:    "upstream fetch only without clone",
+			cli:     "--upstream-fetch-only",
+			wantErr: true,
+			errMsg:  "`--upstream-fetch-only`, `--triangular`, and `--sync-reminder` can only be used with `--clone`",
+		},
 	}
 
 	for _, tt := range tests {
@@ -184,6 +201,9 @@ func TestNewCmdFork(t *testing.T) {
 			assert.Equal(t, tt.wants.PromptClone, gotOpts.PromptClone)
 			assert.Equal(t, tt.wants.Organization, gotOpts.Organization)
 			assert.Equal(t, tt.wants.GitArgs, gotOpts.GitArgs)
+			assert.Equal(t, tt.wants.UpstreamFetchOnly, gotOpts.UpstreamFetchOnly)
+			assert.Equal(t, tt.wants.Triangular, gotOpts.Triangular)
+			assert.Equal(t, tt.wants.SyncReminder, gotOpts.SyncReminder)
 		})
 	}
 }
@@ -450,6 +470,31 @@ func TestRepoFork(t *testing.T) {
 			},
 			wantErrOut: "✓ Created fork someone/REPO\n✓ Cloned fork\n! Repository OWNER/REPO set as the default repository. To learn more about the default repository, run: gh repo set-default --help\n",
 		},
+		{
+			name: "clone with upstream wiring",
+			tty:  true,
+			opts: &ForkOptions{
+				Repository:        "OWNER/REPO",
+				Clone:             true,
+				UpstreamFetchOnly: true,
+				Triangular:        true,
+				SyncReminder:      24 * time.Hour,
+			},
+			httpStubs: forkPost,
+			execStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git clone https://github.com/someone/REPO\.git`, 0, "")
+				cs.Register(`git -C REPO remote add upstream https://github\.com/OWNER/REPO\.git`, 0, "")
+				cs.Register(`git -C REPO fetch upstream`, 0, "")
+				cs.Register(`git -C REPO config --add remote.upstream.gh-resolved base`, 0, "")
+				cs.Register(`git -C REPO remote set-url --push upstream DISABLED`, 0, "")
+				cs.Register(`git -C REPO symbolic-ref --quiet HEAD`, 0, "refs/heads/main")
+				cs.Register(`git -C REPO config --local branch\.main\.remote upstream`, 0, "")
+				cs.Register(`git -C REPO config --local branch\.main\.pushRemote origin`, 0, "")
+				cs.Register(`git -C REPO config --local remote\.pushDefault origin`, 0, "")
+				cs.Register(`git -C REPO config --local gh\.syncReminder 24h0m0s`, 0, "")
+			},
+			wantErrOut: "✓ Created fork someone/REPO\n✓ Cloned fork\n✓ Disabled pushing to the upstream remote\n✓ Configured the default branch to pull from upstream and push to origin\n! Remember to run gh repo sync every 24h0m0s to keep the fork up to date\n! Repository OWNER/REPO set as the default repository. To learn more about the default repository, run: gh repo set-default --help\n",
+		},
 		{
 			name: "repo arg fork to org",
 			tty:  true,
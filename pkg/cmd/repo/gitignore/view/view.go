@@ -1,13 +1,17 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"net/http"
+	"os"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -18,7 +22,10 @@ type ViewOptions struct {
 	IO         *iostreams.IOStreams
 	HTTPClient func() (*http.Client, error)
 	Config     func() (gh.Config, error)
+	GitClient  *git.Client
 	Template   string
+	Apply      bool
+	Commit     bool
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -26,6 +33,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 		IO:         f.IOStreams,
 		HTTPClient: f.HttpClient,
 		Config:     f.Config,
+		GitClient:  f.GitClient,
 		Template:   "",
 	}
 
@@ -37,6 +45,9 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 			%[1]s<template>%[1]s is a case-sensitive %[1]s.gitignore%[1]s template name.
 
+			With %[1]s--apply%[1]s, write the template to %[1]s.gitignore%[1]s in the current
+			directory instead of printing it, and with %[1]s--commit%[1]s also commit the file.
+
 			For a list of available templates, run %[1]sgh repo gitignore list%[1]s.
 		`, "`"),
 		Example: heredoc.Doc(`
@@ -51,16 +62,25 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 			# Create a new .gitignore file using the Python template
 			$ gh repo gitignore view Python > .gitignore
+
+			# Write and commit a .gitignore file using the Go template
+			$ gh repo gitignore view Go --apply --commit
 		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.Template = args[0]
+			if opts.Commit && !opts.Apply {
+				return cmdutil.FlagErrorf("`--commit` requires `--apply`")
+			}
 			if runF != nil {
 				return runF(opts)
 			}
 			return viewRun(opts)
 		},
 	}
+
+	cmd.Flags().BoolVar(&opts.Apply, "apply", false, "Write the template to .gitignore in the current directory")
+	cmd.Flags().BoolVar(&opts.Commit, "commit", false, "Commit the written .gitignore file")
 	return cmd
 }
 
@@ -79,10 +99,12 @@ func viewRun(opts *ViewOptions) error {
 		return err
 	}
 
-	if err := opts.IO.StartPager(); err != nil {
-		fmt.Fprintf(opts.IO.ErrOut, "starting pager failed: %v\n", err)
+	if !opts.Apply {
+		if err := opts.IO.StartPager(); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "starting pager failed: %v\n", err)
+		}
+		defer opts.IO.StopPager()
 	}
-	defer opts.IO.StopPager()
 
 	hostname, _ := cfg.Authentication().DefaultHost()
 	gitIgnore, err := api.RepoGitIgnoreTemplate(client, hostname, opts.Template)
@@ -96,9 +118,54 @@ func viewRun(opts *ViewOptions) error {
 		return err
 	}
 
+	if opts.Apply {
+		return applyGitIgnore(gitIgnore, opts)
+	}
+
 	return renderGitIgnore(gitIgnore, opts)
 }
 
+func applyGitIgnore(template *api.GitIgnore, opts *ViewOptions) error {
+	if _, err := os.Stat(".gitignore"); err == nil {
+		return errors.New("a .gitignore file already exists in this directory")
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err := os.WriteFile(".gitignore", []byte(template.Source), 0o644); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Wrote .gitignore using the %s template\n", cs.SuccessIcon(), template.Name)
+	}
+
+	if opts.Commit {
+		ctx := context.Background()
+		if err := runGit(ctx, opts.GitClient, "add", "--", ".gitignore"); err != nil {
+			return err
+		}
+		if err := runGit(ctx, opts.GitClient, "commit", "-m", "Add .gitignore"); err != nil {
+			return err
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Committed .gitignore\n", cs.SuccessIcon())
+		}
+	}
+
+	return nil
+}
+
+func runGit(ctx context.Context, gitClient *git.Client, args ...string) error {
+	cmd, err := gitClient.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	_, err = cmd.Output()
+	return err
+}
+
 func renderGitIgnore(licenseTemplate *api.GitIgnore, opts *ViewOptions) error {
 	// I wanted to render this in a markdown code block and benefit
 	// from .gitignore syntax highlighting. But, the upstream syntax highlighter
@@ -3,11 +3,14 @@ package view
 import (
 	"bytes"
 	"net/http"
+	"os"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -122,6 +125,56 @@ func TestViewRun(t *testing.T) {
 	}
 }
 
+func TestViewRun_Apply(t *testing.T) {
+	tempDir := t.TempDir()
+	oldWd, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tempDir))
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "gitignore/templates/Go"),
+		httpmock.StringResponse(`{"name": "Go", "source": "*.exe\n"}`))
+	reg.Register(
+		httpmock.REST("GET", "gitignore/templates/Go"),
+		httpmock.StringResponse(`{"name": "Go", "source": "*.exe\n"}`))
+
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ViewOptions{
+		IO: ios,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		GitClient: &git.Client{},
+		Template:  "Go",
+		Apply:     true,
+		Commit:    true,
+	}
+
+	cs, teardown := run.Stub()
+	defer teardown(t)
+	cs.Register(`git add -- \.gitignore`, 0, "")
+	cs.Register(`git commit -m Add \.gitignore`, 0, "")
+
+	require.NoError(t, viewRun(opts))
+
+	data, err := os.ReadFile(".gitignore")
+	require.NoError(t, err)
+	assert.Equal(t, "*.exe\n", string(data))
+	assert.Equal(t, "✓ Wrote .gitignore using the Go template\n✓ Committed .gitignore\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+
+	// A second apply refuses to overwrite the existing file.
+	err = viewRun(opts)
+	assert.EqualError(t, err, "a .gitignore file already exists in this directory")
+}
+
 func TestNewCmdView(t *testing.T) {
 	tests := []struct {
 		name     string
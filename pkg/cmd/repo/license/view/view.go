@@ -1,13 +1,17 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"io/fs"
 	"net/http"
+	"os"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/text"
@@ -20,8 +24,11 @@ type ViewOptions struct {
 	IO         *iostreams.IOStreams
 	HTTPClient func() (*http.Client, error)
 	Config     func() (gh.Config, error)
+	GitClient  *git.Client
 	License    string
 	Web        bool
+	Apply      bool
+	Commit     bool
 	Browser    browser.Browser
 }
 
@@ -30,6 +37,7 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 		IO:         f.IOStreams,
 		HTTPClient: f.HttpClient,
 		Config:     f.Config,
+		GitClient:  f.GitClient,
 		Browser:    f.Browser,
 	}
 
@@ -39,6 +47,9 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 		Long: heredoc.Docf(`
 			View a specific repository license by license key or SPDX ID.
 
+			With %[1]s--apply%[1]s, write the license body to %[1]sLICENSE%[1]s in the current
+			directory instead of printing it, and with %[1]s--commit%[1]s also commit the file.
+
 			Run %[1]sgh repo license list%[1]s to see available commonly used licenses. For even more licenses, visit <https://choosealicense.com/appendix>.
 		`, "`"),
 		Example: heredoc.Doc(`
@@ -56,10 +67,23 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 			# Create a LICENSE.md with the MIT license
 			$ gh repo license view MIT > LICENSE.md
+
+			# Write and commit a LICENSE file with the MIT license
+			$ gh repo license view MIT --apply --commit
 		`),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.License = args[0]
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--web` or `--apply`",
+				opts.Web,
+				opts.Apply,
+			); err != nil {
+				return err
+			}
+			if opts.Commit && !opts.Apply {
+				return cmdutil.FlagErrorf("`--commit` requires `--apply`")
+			}
 			if runF != nil {
 				return runF(opts)
 			}
@@ -68,6 +92,8 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	}
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open https://choosealicense.com/ in the browser")
+	cmd.Flags().BoolVar(&opts.Apply, "apply", false, "Write the license to LICENSE in the current directory")
+	cmd.Flags().BoolVar(&opts.Commit, "commit", false, "Commit the written LICENSE file")
 
 	return cmd
 }
@@ -87,10 +113,12 @@ func viewRun(opts *ViewOptions) error {
 		return err
 	}
 
-	if err := opts.IO.StartPager(); err != nil {
-		fmt.Fprintf(opts.IO.ErrOut, "starting pager failed: %v\n", err)
+	if !opts.Apply {
+		if err := opts.IO.StartPager(); err != nil {
+			fmt.Fprintf(opts.IO.ErrOut, "starting pager failed: %v\n", err)
+		}
+		defer opts.IO.StopPager()
 	}
-	defer opts.IO.StopPager()
 
 	hostname, _ := cfg.Authentication().DefaultHost()
 	license, err := api.RepoLicense(client, hostname, opts.License)
@@ -112,9 +140,54 @@ func viewRun(opts *ViewOptions) error {
 		return opts.Browser.Browse(url)
 	}
 
+	if opts.Apply {
+		return applyLicense(license, opts)
+	}
+
 	return renderLicense(license, opts)
 }
 
+func applyLicense(license *api.License, opts *ViewOptions) error {
+	if _, err := os.Stat("LICENSE"); err == nil {
+		return errors.New("a LICENSE file already exists in this directory")
+	} else if !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	if err := os.WriteFile("LICENSE", []byte(license.Body), 0o644); err != nil {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Wrote LICENSE using the %s license\n", cs.SuccessIcon(), license.SPDXID)
+	}
+
+	if opts.Commit {
+		ctx := context.Background()
+		if err := runGit(ctx, opts.GitClient, "add", "--", "LICENSE"); err != nil {
+			return err
+		}
+		if err := runGit(ctx, opts.GitClient, "commit", "-m", "Add LICENSE"); err != nil {
+			return err
+		}
+		if opts.IO.IsStdoutTTY() {
+			fmt.Fprintf(opts.IO.Out, "%s Committed LICENSE\n", cs.SuccessIcon())
+		}
+	}
+
+	return nil
+}
+
+func runGit(ctx context.Context, gitClient *git.Client, args ...string) error {
+	cmd, err := gitClient.Command(ctx, args...)
+	if err != nil {
+		return err
+	}
+	_, err = cmd.Output()
+	return err
+}
+
 func renderLicense(license *api.License, opts *ViewOptions) error {
 	cs := opts.IO.ColorScheme()
 	var out strings.Builder
@@ -3,12 +3,15 @@ package view
 import (
 	"bytes"
 	"net/http"
+	"os"
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -79,6 +82,56 @@ func TestNewCmdView(t *testing.T) {
 	}
 }
 
+func TestViewRun_Apply(t *testing.T) {
+	tempDir := t.TempDir()
+	oldWd, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tempDir))
+	defer func() { _ = os.Chdir(oldWd) }()
+
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+	reg.Register(
+		httpmock.REST("GET", "licenses/mit"),
+		httpmock.StringResponse(`{"key": "mit", "name": "MIT License", "spdx_id": "MIT", "body": "Permission is hereby granted\n"}`))
+	reg.Register(
+		httpmock.REST("GET", "licenses/mit"),
+		httpmock.StringResponse(`{"key": "mit", "name": "MIT License", "spdx_id": "MIT", "body": "Permission is hereby granted\n"}`))
+
+	ios, _, stdout, stderr := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ViewOptions{
+		IO: ios,
+		HTTPClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		GitClient: &git.Client{},
+		License:   "mit",
+		Apply:     true,
+		Commit:    true,
+	}
+
+	cs, teardown := run.Stub()
+	defer teardown(t)
+	cs.Register(`git add -- LICENSE`, 0, "")
+	cs.Register(`git commit -m Add LICENSE`, 0, "")
+
+	require.NoError(t, viewRun(opts))
+
+	data, err := os.ReadFile("LICENSE")
+	require.NoError(t, err)
+	assert.Equal(t, "Permission is hereby granted\n", string(data))
+	assert.Equal(t, "✓ Wrote LICENSE using the MIT license\n✓ Committed LICENSE\n", stdout.String())
+	assert.Equal(t, "", stderr.String())
+
+	// A second apply refuses to overwrite the existing file.
+	err = viewRun(opts)
+	assert.EqualError(t, err, "a LICENSE file already exists in this directory")
+}
+
 func TestViewRun(t *testing.T) {
 	tests := []struct {
 		name           string
@@ -0,0 +1,152 @@
+package remotes
+
+import (
+	"fmt"
+
+	"github.com/MakeNowJust/heredoc"
+	ghContext "github.com/cli/cli/v2/context"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+type RemotesOptions struct {
+	IO       *iostreams.IOStreams
+	Remotes  func() (ghContext.Remotes, error)
+	Exporter cmdutil.Exporter
+}
+
+var remoteFields = []string{
+	"name",
+	"host",
+	"owner",
+	"repo",
+	"fetchUrl",
+	"pushUrl",
+	"resolved",
+	"isBase",
+}
+
+type remoteInfo struct {
+	Name     string         `json:"name"`
+	Host     string         `json:"host,omitempty"`
+	Owner    string         `json:"owner,omitempty"`
+	Repo     string         `json:"repo,omitempty"`
+	FetchURL *git.RemoteURL `json:"fetchUrl,omitempty"`
+	PushURL  *git.RemoteURL `json:"pushUrl,omitempty"`
+	Resolved string         `json:"resolved,omitempty"`
+	IsBase   bool           `json:"isBase"`
+}
+
+func (r remoteInfo) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(r, fields)
+}
+
+func NewCmdRemotes(f *cmdutil.Factory, runF func(*RemotesOptions) error) *cobra.Command {
+	opts := &RemotesOptions{
+		IO:      f.IOStreams,
+		Remotes: f.Remotes,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "remotes",
+		Short: "List git remotes as gh resolves them",
+		Long: heredoc.Docf(`
+			List the git remotes of the current repository together with how gh
+			interprets them: the owner and repository each URL parses to, the
+			effective host, any %[1]sgh-resolved%[1]s configuration value, and which
+			remote gh treats as the base repository.
+
+			The remotes shown here are exactly the candidates gh considers when
+			resolving a repository, which makes this useful for debugging
+			%[1]sno matching remote found%[1]s errors.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Inspect how gh interprets the remotes of the current repository
+			$ gh repo remotes
+
+			# Machine-readable output including raw and normalized URLs
+			$ gh repo remotes --json name,fetchUrl,isBase
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return remotesRun(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, remoteFields)
+
+	return cmd
+}
+
+func remotesRun(opts *RemotesOptions) error {
+	remotes, err := opts.Remotes()
+	if err != nil {
+		return err
+	}
+
+	// The base repository is the remote with a gh-resolved value if there is
+	// one, and the highest-priority remote otherwise.
+	baseIdx := -1
+	for i, r := range remotes {
+		if r.Resolved != "" {
+			baseIdx = i
+			break
+		}
+	}
+	if baseIdx == -1 && len(remotes) > 0 {
+		baseIdx = 0
+	}
+
+	infos := make([]remoteInfo, 0, len(remotes))
+	for i, r := range remotes {
+		info := remoteInfo{
+			Name:     r.Name,
+			FetchURL: git.NewRemoteURL(r.FetchURL),
+			PushURL:  git.NewRemoteURL(r.PushURL),
+			Resolved: r.Resolved,
+			IsBase:   i == baseIdx,
+		}
+		if r.Repo != nil {
+			info.Host = r.RepoHost()
+			info.Owner = r.RepoOwner()
+			info.Repo = r.RepoName()
+		}
+		infos = append(infos, info)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, infos)
+	}
+
+	cs := opts.IO.ColorScheme()
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("NAME", "REPOSITORY", "HOST", "FETCH URL", "RESOLVED", "BASE"))
+	for _, info := range infos {
+		tp.AddField(info.Name, tableprinter.WithColor(cs.Bold))
+		if info.Owner != "" {
+			tp.AddField(fmt.Sprintf("%s/%s", info.Owner, info.Repo))
+		} else {
+			tp.AddField("")
+		}
+		tp.AddField(info.Host)
+		if info.FetchURL != nil {
+			tp.AddField(info.FetchURL.Raw)
+		} else {
+			tp.AddField("")
+		}
+		tp.AddField(info.Resolved)
+		if info.IsBase {
+			tp.AddField("✓", tableprinter.WithColor(cs.Green))
+		} else {
+			tp.AddField("")
+		}
+		tp.EndRow()
+	}
+
+	return tp.Render()
+}
@@ -0,0 +1,148 @@
+package remotes
+
+import (
+	"bytes"
+	"testing"
+
+	ghContext "github.com/cli/cli/v2/context"
+	"github.com/cli/cli/v2/git"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdRemotes(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:  "no arguments",
+			input: "",
+		},
+		{
+			name:    "extra arguments",
+			input:   "origin",
+			wantErr: true,
+			errMsg:  `unknown command "origin" for "remotes"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			cmd := NewCmdRemotes(f, func(opts *RemotesOptions) error {
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestRemotesRun(t *testing.T) {
+	originRemote := func(resolved string) *ghContext.Remote {
+		r := git.NewRemote("origin", "https://github.com/forker/repo.git")
+		r.Resolved = resolved
+		return &ghContext.Remote{
+			Remote: r,
+			Repo:   ghrepo.New("forker", "repo"),
+		}
+	}
+	upstreamRemote := func(resolved string) *ghContext.Remote {
+		r := git.NewRemote("upstream", "https://github.com/OWNER/REPO.git")
+		r.Resolved = resolved
+		return &ghContext.Remote{
+			Remote: r,
+			Repo:   ghrepo.New("OWNER", "REPO"),
+		}
+	}
+
+	tests := []struct {
+		name       string
+		tty        bool
+		remotes    ghContext.Remotes
+		jsonFields []string
+		wantStdout string
+	}{
+		{
+			name:    "tty",
+			tty:     true,
+			remotes: ghContext.Remotes{upstreamRemote(""), originRemote("")},
+			wantStdout: "NAME      REPOSITORY   HOST        FETCH URL                      RESOLVED  BASE\n" +
+				"upstream  OWNER/REPO   github.com  https://github.com/OWNER/R...            ✓\n" +
+				"origin    forker/repo  github.com  https://github.com/forker/...            \n",
+		},
+		{
+			name:    "resolved remote is base",
+			tty:     true,
+			remotes: ghContext.Remotes{upstreamRemote(""), originRemote("base")},
+			wantStdout: "NAME      REPOSITORY   HOST        FETCH URL                      RESOLVED  BASE\n" +
+				"upstream  OWNER/REPO   github.com  https://github.com/OWNER/R...            \n" +
+				"origin    forker/repo  github.com  https://github.com/forker/...  base      ✓\n",
+		},
+		{
+			name:       "non-tty",
+			remotes:    ghContext.Remotes{upstreamRemote(""), originRemote("")},
+			wantStdout: "upstream\tOWNER/REPO\tgithub.com\thttps://github.com/OWNER/REPO.git\t\t✓\norigin\tforker/repo\tgithub.com\thttps://github.com/forker/repo.git\t\t\n",
+		},
+		{
+			name:       "json export",
+			remotes:    ghContext.Remotes{upstreamRemote(""), originRemote("base")},
+			jsonFields: []string{"name", "host", "resolved", "isBase"},
+			wantStdout: "[{\"host\":\"github.com\",\"isBase\":false,\"name\":\"upstream\",\"resolved\":\"\"},{\"host\":\"github.com\",\"isBase\":true,\"name\":\"origin\",\"resolved\":\"base\"}]\n",
+		},
+		{
+			name:       "json export with URLs",
+			remotes:    ghContext.Remotes{originRemote("")},
+			jsonFields: []string{"name", "fetchUrl"},
+			wantStdout: "[{\"fetchUrl\":{\"raw\":\"https://github.com/forker/repo.git\",\"normalized\":\"https://github.com/forker/repo\"},\"name\":\"origin\"}]\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			opts := &RemotesOptions{
+				IO: ios,
+				Remotes: func() (ghContext.Remotes, error) {
+					return tt.remotes, nil
+				},
+			}
+
+			if tt.jsonFields != nil {
+				exporter := cmdutil.NewJSONExporter()
+				exporter.SetFields(tt.jsonFields)
+				opts.Exporter = exporter
+			}
+
+			err := remotesRun(opts)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -48,6 +48,17 @@ type SetDefaultOptions struct {
 	Repo      ghrepo.Interface
 	ViewMode  bool
 	UnsetMode bool
+	AutoMode  bool
+	Exporter  cmdutil.Exporter
+}
+
+var defaultRepoFields = []string{"name", "owner", "host"}
+
+// defaultRepo is the shape of the current default repository for `--view --json`.
+type defaultRepo struct {
+	Name  string `json:"name"`
+	Owner string `json:"owner"`
+	Host  string `json:"host"`
 }
 
 func NewCmdSetDefault(f *cmdutil.Factory, runF func(*SetDefaultOptions) error) *cobra.Command {
@@ -73,6 +84,9 @@ func NewCmdSetDefault(f *cmdutil.Factory, runF func(*SetDefaultOptions) error) *
 			# View the current default repository
 			$ gh repo set-default --view
 
+			# Automatically select the base repository of the network without prompting
+			$ gh repo set-default --auto
+
 			# Show more repository options in the interactive picker
 			$ git remote add newrepo https://github.com/owner/repo
 			$ gh repo set-default
@@ -87,7 +101,24 @@ func NewCmdSetDefault(f *cmdutil.Factory, runF func(*SetDefaultOptions) error) *
 				}
 			}
 
-			if !opts.ViewMode && !opts.IO.CanPrompt() && opts.Repo == nil {
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--view`, `--unset`, or `--auto`",
+				opts.ViewMode,
+				opts.UnsetMode,
+				opts.AutoMode,
+			); err != nil {
+				return err
+			}
+
+			if opts.AutoMode && opts.Repo != nil {
+				return cmdutil.FlagErrorf("cannot specify a repository with `--auto`")
+			}
+
+			if opts.Exporter != nil && !opts.ViewMode {
+				return cmdutil.FlagErrorf("`--json` can only be used with `--view`")
+			}
+
+			if !opts.ViewMode && !opts.AutoMode && !opts.IO.CanPrompt() && opts.Repo == nil {
 				return cmdutil.FlagErrorf("repository required when not running interactively")
 			}
 
@@ -107,6 +138,8 @@ func NewCmdSetDefault(f *cmdutil.Factory, runF func(*SetDefaultOptions) error) *
 
 	cmd.Flags().BoolVarP(&opts.ViewMode, "view", "v", false, "View the current default repository")
 	cmd.Flags().BoolVarP(&opts.UnsetMode, "unset", "u", false, "Unset the current default repository")
+	cmd.Flags().BoolVar(&opts.AutoMode, "auto", false, "Automatically select the base repository of the network without prompting")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, defaultRepoFields)
 
 	return cmd
 }
@@ -122,13 +155,24 @@ func setDefaultRun(opts *SetDefaultOptions) error {
 	cs := opts.IO.ColorScheme()
 
 	if opts.ViewMode {
-		if currentDefaultRepo != nil {
-			fmt.Fprintln(opts.IO.Out, displayRemoteRepoName(currentDefaultRepo))
-		} else {
+		if currentDefaultRepo == nil {
+			if opts.Exporter != nil {
+				return errors.New("no default repository has been set")
+			}
 			fmt.Fprintf(opts.IO.ErrOut,
 				"%s No default remote repository has been set. To learn more about the default repository, run: gh repo set-default --help\n",
 				cs.FailureIcon())
+			return nil
+		}
+		if opts.Exporter != nil {
+			repo := remoteRepo(currentDefaultRepo)
+			return opts.Exporter.Write(opts.IO, defaultRepo{
+				Name:  repo.RepoName(),
+				Owner: repo.RepoOwner(),
+				Host:  repo.RepoHost(),
+			})
 		}
+		fmt.Fprintln(opts.IO.Out, displayRemoteRepoName(currentDefaultRepo))
 		return nil
 	}
 
@@ -185,6 +229,13 @@ func setDefaultRun(opts *SetDefaultOptions) error {
 		}
 	}
 
+	if selectedRepo == nil && opts.AutoMode {
+		// NetworkRepos lists the parent of a fork ahead of the fork itself, so
+		// the first entry is the same base repository that SmartBaseRepoFunc
+		// resolution would settle on.
+		selectedRepo = knownRepos[0]
+	}
+
 	if selectedRepo == nil {
 		if len(knownRepos) == 1 {
 			selectedRepo = knownRepos[0]
@@ -245,14 +296,18 @@ func setDefaultRun(opts *SetDefaultOptions) error {
 }
 
 func displayRemoteRepoName(remote *context.Remote) string {
+	return ghrepo.FullName(remoteRepo(remote))
+}
+
+func remoteRepo(remote *context.Remote) ghrepo.Interface {
 	if remote.Resolved == "" || remote.Resolved == "base" {
-		return ghrepo.FullName(remote)
+		return remote
 	}
 
 	repo, err := ghrepo.FromFullName(remote.Resolved)
 	if err != nil {
-		return ghrepo.FullName(remote)
+		return remote
 	}
 
-	return ghrepo.FullName(repo)
+	return repo
 }
@@ -65,6 +65,28 @@ func TestNewCmdSetDefault(t *testing.T) {
 			input:  "--unset",
 			output: SetDefaultOptions{UnsetMode: true},
 		},
+		{
+			name: "auto flag",
+			gitStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git rev-parse --git-dir`, 0, ".git")
+			},
+			input:  "--auto",
+			output: SetDefaultOptions{AutoMode: true},
+		},
+		{
+			name:     "auto flag with repo argument",
+			gitStubs: func(cs *run.CommandStubber) {},
+			input:    "--auto cli/cli",
+			wantErr:  true,
+			errMsg:   "cannot specify a repository with `--auto`",
+		},
+		{
+			name:     "json flag without view flag",
+			gitStubs: func(cs *run.CommandStubber) {},
+			input:    "--json name",
+			wantErr:  true,
+			errMsg:   "`--json` can only be used with `--view`",
+		},
 		{
 			name: "run from non-git directory",
 			gitStubs: func(cs *run.CommandStubber) {
@@ -114,6 +136,7 @@ func TestNewCmdSetDefault(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, tt.output.Repo, gotOpts.Repo)
 			assert.Equal(t, tt.output.ViewMode, gotOpts.ViewMode)
+			assert.Equal(t, tt.output.AutoMode, gotOpts.AutoMode)
 		})
 	}
 }
@@ -212,6 +235,70 @@ func TestDefaultRun(t *testing.T) {
 			},
 			wantStdout: "PARENT/REPO\n",
 		},
+		{
+			name: "view mode json with non-base resolved current default",
+			opts: SetDefaultOptions{ViewMode: true, Exporter: cmdutil.NewJSONExporter()},
+			remotes: []*context.Remote{
+				{
+					Remote: &git.Remote{Name: "origin", Resolved: "PARENT/REPO"},
+					Repo:   repo1,
+				},
+			},
+			wantStdout: "{\"name\":\"REPO\",\"owner\":\"PARENT\",\"host\":\"github.com\"}\n",
+		},
+		{
+			name: "view mode json no current default",
+			opts: SetDefaultOptions{ViewMode: true, Exporter: cmdutil.NewJSONExporter()},
+			remotes: []*context.Remote{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   repo1,
+				},
+			},
+			wantErr: true,
+			errMsg:  "no default repository has been set",
+		},
+		{
+			name: "auto mode picks the network parent",
+			tty:  true,
+			opts: SetDefaultOptions{AutoMode: true},
+			remotes: []*context.Remote{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   repo1,
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryNetwork\b`),
+					httpmock.StringResponse(`{"data":{"repo_000":{"name":"REPO","owner":{"login":"OWNER"},"parent":{"name":"REPO2","owner":{"login":"OWNER2"}}}}}`),
+				)
+			},
+			gitStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config --add remote.origin.gh-resolved OWNER2/REPO2`, 0, "")
+			},
+			wantStdout: "✓ Set OWNER2/REPO2 as the default repository for the current directory\n",
+		},
+		{
+			name: "auto mode without a network parent",
+			opts: SetDefaultOptions{AutoMode: true},
+			remotes: []*context.Remote{
+				{
+					Remote: &git.Remote{Name: "origin"},
+					Repo:   repo1,
+				},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryNetwork\b`),
+					httpmock.StringResponse(`{"data":{"repo_000":{"name":"REPO","owner":{"login":"OWNER"}}}}`),
+				)
+			},
+			gitStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git config --add remote.origin.gh-resolved base`, 0, "")
+			},
+			wantStdout: "",
+		},
 		{
 			name: "tty non-interactive mode no current default",
 			tty:  true,
@@ -3,20 +3,33 @@ package sync
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cli/cli/v2/git"
 )
 
 type gitClient interface {
+	AheadBehind(string, string) (int, int, error)
 	CurrentBranch() (string, error)
+	DeleteBranch(string) error
 	UpdateBranch(string, string) error
 	CreateBranch(string, string, string) error
 	Fetch(string, string) error
+	FetchPrune(string) error
 	HasLocalBranch(string) bool
 	IsAncestor(string, string) (bool, error)
 	IsDirty() (bool, error)
 	MergeFastForward(string) error
 	ResetHard(string) error
+	TrackingBranches() ([]trackingBranch, error)
+}
+
+// trackingBranch is a local branch that tracks an upstream branch.
+type trackingBranch struct {
+	Name     string
+	Upstream string // remote-tracking ref, e.g. "origin/trunk"
+	Remote   string
+	Gone     bool
 }
 
 type gitExecuter struct {
@@ -94,6 +107,68 @@ func (g *gitExecuter) MergeFastForward(ref string) error {
 	return err
 }
 
+func (g *gitExecuter) AheadBehind(branch, upstream string) (int, int, error) {
+	args := []string{"rev-list", "--left-right", "--count", fmt.Sprintf("%s...%s", branch, upstream)}
+	cmd, err := g.client.Command(context.Background(), args...)
+	if err != nil {
+		return 0, 0, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return 0, 0, err
+	}
+	var ahead, behind int
+	if _, err := fmt.Sscanf(string(out), "%d\t%d", &ahead, &behind); err != nil {
+		return 0, 0, fmt.Errorf("unexpected rev-list output: %q", string(out))
+	}
+	return ahead, behind, nil
+}
+
+func (g *gitExecuter) DeleteBranch(branch string) error {
+	cmd, err := g.client.Command(context.Background(), "branch", "-D", branch)
+	if err != nil {
+		return err
+	}
+	_, err = cmd.Output()
+	return err
+}
+
+func (g *gitExecuter) FetchPrune(remote string) error {
+	args := []string{"fetch", "-q", "--prune", remote}
+	cmd, err := g.client.AuthenticatedCommand(context.Background(), git.AllMatchingCredentialsPattern, args...)
+	if err != nil {
+		return err
+	}
+	return cmd.Run()
+}
+
+func (g *gitExecuter) TrackingBranches() ([]trackingBranch, error) {
+	format := "%(refname:short)%00%(upstream:short)%00%(upstream:remotename)%00%(upstream:track)"
+	args := []string{"for-each-ref", "--format", format, "refs/heads"}
+	cmd, err := g.client.Command(context.Background(), args...)
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+	var branches []trackingBranch
+	for _, line := range strings.Split(string(out), "\n") {
+		parts := strings.Split(line, "\x00")
+		if len(parts) < 4 || parts[1] == "" {
+			continue
+		}
+		branches = append(branches, trackingBranch{
+			Name:     parts[0],
+			Upstream: parts[1],
+			Remote:   parts[2],
+			Gone:     parts[3] == "[gone]",
+		})
+	}
+	return branches, nil
+}
+
 func (g *gitExecuter) ResetHard(ref string) error {
 	args := []string{"reset", "--hard", ref}
 	cmd, err := g.client.Command(context.Background(), args...)
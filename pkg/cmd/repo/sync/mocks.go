@@ -8,6 +8,26 @@ type mockGitClient struct {
 	mock.Mock
 }
 
+func (g *mockGitClient) AheadBehind(b, u string) (int, int, error) {
+	args := g.Called(b, u)
+	return args.Int(0), args.Int(1), args.Error(2)
+}
+
+func (g *mockGitClient) DeleteBranch(b string) error {
+	args := g.Called(b)
+	return args.Error(0)
+}
+
+func (g *mockGitClient) FetchPrune(r string) error {
+	args := g.Called(r)
+	return args.Error(0)
+}
+
+func (g *mockGitClient) TrackingBranches() ([]trackingBranch, error) {
+	args := g.Called()
+	return args.Get(0).([]trackingBranch), args.Error(1)
+}
+
 func (g *mockGitClient) UpdateBranch(b, r string) error {
 	args := g.Called(b, r)
 	return args.Error(0)
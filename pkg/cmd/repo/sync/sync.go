@@ -31,6 +31,9 @@ type SyncOptions struct {
 	SrcArg     string
 	Branch     string
 	Force      bool
+
+	AllTracking bool
+	Prune       bool
 }
 
 func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Command {
@@ -56,6 +59,11 @@ func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Comman
 
 			The source repository is the parent of the destination repository by default.
 			This can be overridden with the %[1]s--source%[1]s flag.
+
+			With %[1]s--all-tracking%[1]s, every local branch that tracks an upstream branch is
+			fast-forwarded instead of a single branch. Branches that have diverged from their
+			upstream are reported and left untouched, and %[1]s--prune%[1]s additionally deletes
+			local branches whose upstream branch no longer exists.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Sync local repository from remote parent
@@ -69,12 +77,31 @@ func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Comman
 
 			# Sync remote repository from another remote repository
 			$ gh repo sync owner/repo --source owner2/repo2
+
+			# Fast-forward all local branches that track an upstream branch
+			$ gh repo sync --all-tracking
+
+			# Additionally delete local branches whose upstream branch is gone
+			$ gh repo sync --all-tracking --prune
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.DestArg = args[0]
 			}
+			if opts.AllTracking {
+				if opts.DestArg != "" {
+					return cmdutil.FlagErrorf("`--all-tracking` cannot be used with a destination repository")
+				}
+				if opts.Branch != "" {
+					return cmdutil.FlagErrorf("`--branch` cannot be used with `--all-tracking`")
+				}
+				if opts.Force {
+					return cmdutil.FlagErrorf("`--force` cannot be used with `--all-tracking`")
+				}
+			} else if opts.Prune {
+				return cmdutil.FlagErrorf("`--prune` requires `--all-tracking`")
+			}
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -85,10 +112,15 @@ func NewCmdSync(f *cmdutil.Factory, runF func(*SyncOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.SrcArg, "source", "s", "", "Source repository")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Branch to sync (default [default branch])")
 	cmd.Flags().BoolVarP(&opts.Force, "force", "", false, "Hard reset the branch of the destination repository to match the source repository")
+	cmd.Flags().BoolVar(&opts.AllTracking, "all-tracking", false, "Fast-forward all local branches that track an upstream branch")
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false, "Delete local branches whose upstream branch is gone (with --all-tracking)")
 	return cmd
 }
 
 func syncRun(opts *SyncOptions) error {
+	if opts.AllTracking {
+		return syncAllTrackingBranches(opts)
+	}
 	if opts.DestArg == "" {
 		return syncLocalRepo(opts)
 	} else {
@@ -96,6 +128,94 @@ func syncRun(opts *SyncOptions) error {
 	}
 }
 
+func syncAllTrackingBranches(opts *SyncOptions) error {
+	git := opts.Git
+
+	branches, err := git.TrackingBranches()
+	if err != nil {
+		return err
+	}
+	if len(branches) == 0 {
+		return errors.New("no local branches track an upstream branch")
+	}
+
+	// Git fetch might require input from user, so do it before starting progress indicator.
+	var remotes []string
+	seen := map[string]bool{}
+	for _, b := range branches {
+		if !seen[b.Remote] {
+			seen[b.Remote] = true
+			remotes = append(remotes, b.Remote)
+		}
+	}
+	for _, remote := range remotes {
+		if err := git.FetchPrune(remote); err != nil {
+			return err
+		}
+	}
+
+	// Refresh tracking information since pruning during the fetch may have
+	// removed upstream branches.
+	branches, err = git.TrackingBranches()
+	if err != nil {
+		return err
+	}
+
+	currentBranch, err := git.CurrentBranch()
+	if err != nil && !errors.Is(err, gitpkg.ErrNotOnAnyBranch) {
+		return err
+	}
+
+	cs := opts.IO.ColorScheme()
+	for _, b := range branches {
+		if b.Gone {
+			if !opts.Prune {
+				fmt.Fprintf(opts.IO.Out, "%s Skipped the %q branch because its upstream is gone; use `--prune` to delete it\n", cs.WarningIcon(), b.Name)
+				continue
+			}
+			if b.Name == currentBranch {
+				fmt.Fprintf(opts.IO.Out, "%s Can't prune the %q branch because it is checked out\n", cs.WarningIcon(), b.Name)
+				continue
+			}
+			if err := git.DeleteBranch(b.Name); err != nil {
+				return err
+			}
+			fmt.Fprintf(opts.IO.Out, "%s Pruned the %q branch because its upstream is gone\n", cs.SuccessIcon(), b.Name)
+			continue
+		}
+
+		ahead, behind, err := git.AheadBehind(b.Name, b.Upstream)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case behind == 0:
+			// Up to date, or only ahead of the upstream; nothing to sync.
+		case ahead > 0:
+			fmt.Fprintf(opts.IO.Out, "%s Skipped the %q branch because it has diverged from %q\n", cs.WarningIcon(), b.Name, b.Upstream)
+		case b.Name == currentBranch:
+			if isDirty, err := git.IsDirty(); err != nil {
+				return err
+			} else if isDirty {
+				fmt.Fprintf(opts.IO.Out, "%s Skipped the %q branch due to uncommitted/untracked local changes\n", cs.WarningIcon(), b.Name)
+				continue
+			}
+			if err := git.MergeFastForward(b.Upstream); err != nil {
+				return err
+			}
+			fmt.Fprintf(opts.IO.Out, "%s Synced the %q branch from %q\n", cs.SuccessIcon(), b.Name, b.Upstream)
+		default:
+			if err := git.UpdateBranch(b.Name, b.Upstream); err != nil {
+				return err
+			}
+			fmt.Fprintf(opts.IO.Out, "%s Synced the %q branch from %q\n", cs.SuccessIcon(), b.Name, b.Upstream)
+		}
+	}
+
+	return nil
+}
+
 func syncLocalRepo(opts *SyncOptions) error {
 	var srcRepo ghrepo.Interface
 
@@ -63,6 +63,44 @@ func TestNewCmdSync(t *testing.T) {
 				Force: true,
 			},
 		},
+		{
+			name:  "all tracking",
+			tty:   true,
+			input: "--all-tracking",
+			output: SyncOptions{
+				AllTracking: true,
+			},
+		},
+		{
+			name:  "all tracking with prune",
+			tty:   true,
+			input: "--all-tracking --prune",
+			output: SyncOptions{
+				AllTracking: true,
+				Prune:       true,
+			},
+		},
+		{
+			name:    "all tracking with branch",
+			tty:     true,
+			input:   "--all-tracking --branch trunk",
+			wantErr: true,
+			errMsg:  "`--branch` cannot be used with `--all-tracking`",
+		},
+		{
+			name:    "all tracking with destination repo",
+			tty:     true,
+			input:   "--all-tracking cli/cli",
+			wantErr: true,
+			errMsg:  "`--all-tracking` cannot be used with a destination repository",
+		},
+		{
+			name:    "prune without all tracking",
+			tty:     true,
+			input:   "--prune",
+			wantErr: true,
+			errMsg:  "`--prune` requires `--all-tracking`",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -96,6 +134,8 @@ func TestNewCmdSync(t *testing.T) {
 			assert.Equal(t, tt.output.SrcArg, gotOpts.SrcArg)
 			assert.Equal(t, tt.output.Branch, gotOpts.Branch)
 			assert.Equal(t, tt.output.Force, gotOpts.Force)
+			assert.Equal(t, tt.output.AllTracking, gotOpts.AllTracking)
+			assert.Equal(t, tt.output.Prune, gotOpts.Prune)
 		})
 	}
 }
@@ -523,6 +563,61 @@ func Test_SyncRun(t *testing.T) {
 			wantErr: true,
 			errMsg:  "Upstream commits contain workflow changes, which require the `workflow` scope or permission to merge. To request it, run: gh auth refresh -s workflow",
 		},
+		{
+			name: "sync all tracking branches",
+			tty:  true,
+			opts: &SyncOptions{
+				AllTracking: true,
+			},
+			gitStubs: func(mgc *mockGitClient) {
+				mgc.On("TrackingBranches").Return([]trackingBranch{
+					{Name: "trunk", Upstream: "origin/trunk", Remote: "origin"},
+					{Name: "feature", Upstream: "origin/feature", Remote: "origin"},
+					{Name: "diverged", Upstream: "origin/diverged", Remote: "origin"},
+					{Name: "stale", Upstream: "origin/stale", Remote: "origin", Gone: true},
+				}, nil).Twice()
+				mgc.On("FetchPrune", "origin").Return(nil).Once()
+				mgc.On("CurrentBranch").Return("trunk", nil).Once()
+				mgc.On("AheadBehind", "trunk", "origin/trunk").Return(0, 1, nil).Once()
+				mgc.On("IsDirty").Return(false, nil).Once()
+				mgc.On("MergeFastForward", "origin/trunk").Return(nil).Once()
+				mgc.On("AheadBehind", "feature", "origin/feature").Return(0, 2, nil).Once()
+				mgc.On("UpdateBranch", "feature", "origin/feature").Return(nil).Once()
+				mgc.On("AheadBehind", "diverged", "origin/diverged").Return(1, 1, nil).Once()
+			},
+			wantStdout: "✓ Synced the \"trunk\" branch from \"origin/trunk\"\n✓ Synced the \"feature\" branch from \"origin/feature\"\n! Skipped the \"diverged\" branch because it has diverged from \"origin/diverged\"\n! Skipped the \"stale\" branch because its upstream is gone; use `--prune` to delete it\n",
+		},
+		{
+			name: "sync all tracking branches with prune",
+			tty:  true,
+			opts: &SyncOptions{
+				AllTracking: true,
+				Prune:       true,
+			},
+			gitStubs: func(mgc *mockGitClient) {
+				mgc.On("TrackingBranches").Return([]trackingBranch{
+					{Name: "trunk", Upstream: "origin/trunk", Remote: "origin"},
+					{Name: "stale", Upstream: "origin/stale", Remote: "origin", Gone: true},
+				}, nil).Twice()
+				mgc.On("FetchPrune", "origin").Return(nil).Once()
+				mgc.On("CurrentBranch").Return("trunk", nil).Once()
+				mgc.On("AheadBehind", "trunk", "origin/trunk").Return(0, 0, nil).Once()
+				mgc.On("DeleteBranch", "stale").Return(nil).Once()
+			},
+			wantStdout: "✓ Pruned the \"stale\" branch because its upstream is gone\n",
+		},
+		{
+			name: "sync all tracking branches with no tracking branches",
+			tty:  true,
+			opts: &SyncOptions{
+				AllTracking: true,
+			},
+			gitStubs: func(mgc *mockGitClient) {
+				mgc.On("TrackingBranches").Return([]trackingBranch{}, nil).Once()
+			},
+			wantErr: true,
+			errMsg:  "no local branches track an upstream branch",
+		},
 	}
 	for _, tt := range tests {
 		reg := &httpmock.Registry{}
@@ -20,6 +20,7 @@ type UnarchiveOptions struct {
 	Config     func() (gh.Config, error)
 	BaseRepo   func() (ghrepo.Interface, error)
 	Confirmed  bool
+	DryRun     bool
 	IO         *iostreams.IOStreams
 	RepoArg    string
 	Prompter   prompter.Prompter
@@ -39,14 +40,18 @@ func NewCmdUnarchive(f *cmdutil.Factory, runF func(*UnarchiveOptions) error) *co
 		Short: "Unarchive a repository",
 		Long: heredoc.Doc(`Unarchive a GitHub repository.
 
-With no argument, unarchives the current repository.`),
+With no argument, unarchives the current repository.
+
+Confirm the operation by typing the full name of the repository, or pass --yes
+to skip confirmation. Use --dry-run to see what unarchiving would do without
+changing anything.`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.RepoArg = args[0]
 			}
 
-			if !opts.Confirmed && !opts.IO.CanPrompt() {
+			if !opts.Confirmed && !opts.DryRun && !opts.IO.CanPrompt() {
 				return cmdutil.FlagErrorf("--yes required when not running interactively")
 			}
 
@@ -61,9 +66,20 @@ With no argument, unarchives the current repository.`),
 	cmd.Flags().BoolVar(&opts.Confirmed, "confirm", false, "Skip the confirmation prompt")
 	_ = cmd.Flags().MarkDeprecated("confirm", "use `--yes` instead")
 	cmd.Flags().BoolVarP(&opts.Confirmed, "yes", "y", false, "Skip the confirmation prompt")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print the consequences of unarchiving without making changes")
 	return cmd
 }
 
+// unarchiveConsequences describes what unarchiving does to a repository. It is
+// shown before the confirmation prompt and as part of `--dry-run` output.
+func unarchiveConsequences() string {
+	return heredoc.Doc(`
+		Unarchiving makes the repository writable again:
+		- Issues, pull requests, and wikis can be created and edited
+		- Scheduled workflows stay disabled until they are re-enabled
+	`)
+}
+
 func unarchiveRun(opts *UnarchiveOptions) error {
 	cs := opts.IO.ColorScheme()
 	httpClient, err := opts.HttpClient()
@@ -114,12 +130,19 @@ func unarchiveRun(opts *UnarchiveOptions) error {
 		return nil
 	}
 
+	if opts.DryRun {
+		fmt.Fprintf(opts.IO.Out, "Would have unarchived repository %s\n", fullName)
+		fmt.Fprint(opts.IO.Out, unarchiveConsequences())
+		return nil
+	}
+
 	if !opts.Confirmed {
-		confirmed, err := opts.Prompter.Confirm(fmt.Sprintf("Unarchive %s?", fullName), false)
+		fmt.Fprint(opts.IO.ErrOut, unarchiveConsequences())
+		answer, err := opts.Prompter.Input(fmt.Sprintf("Type %s to confirm unarchiving this repository", fullName), "")
 		if err != nil {
 			return err
 		}
-		if !confirmed {
+		if !strings.EqualFold(answer, fullName) {
 			return cmdutil.CancelError
 		}
 	}
@@ -34,6 +34,11 @@ func TestNewCmdUnarchive(t *testing.T) {
 			input:  "OWNER/REPO --confirm",
 			output: UnarchiveOptions{RepoArg: "OWNER/REPO", Confirmed: true},
 		},
+		{
+			name:   "dry run no tty",
+			input:  "OWNER/REPO --dry-run",
+			output: UnarchiveOptions{RepoArg: "OWNER/REPO", DryRun: true},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -61,6 +66,7 @@ func TestNewCmdUnarchive(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, tt.output.RepoArg, gotOpts.RepoArg)
 			assert.Equal(t, tt.output.Confirmed, gotOpts.Confirmed)
+			assert.Equal(t, tt.output.DryRun, gotOpts.DryRun)
 		})
 	}
 }
@@ -79,12 +85,13 @@ func Test_UnarchiveRun(t *testing.T) {
 		{
 			name:       "archived repo tty",
 			wantStdout: "✓ Unarchived repository OWNER/REPO\n",
+			wantStderr: unarchiveConsequences(),
 			prompterStubs: func(pm *prompter.PrompterMock) {
-				pm.ConfirmFunc = func(p string, d bool) (bool, error) {
-					if p == "Unarchive OWNER/REPO?" {
-						return true, nil
+				pm.InputFunc = func(p, d string) (string, error) {
+					if p == "Type OWNER/REPO to confirm unarchiving this repository" {
+						return "OWNER/REPO", nil
 					}
-					return false, prompter.NoSuchPromptErr(p)
+					return "", prompter.NoSuchPromptErr(p)
 				}
 			},
 			isTTY: true,
@@ -101,13 +108,14 @@ func Test_UnarchiveRun(t *testing.T) {
 		{
 			name:       "infer base repo",
 			wantStdout: "✓ Unarchived repository OWNER/REPO\n",
+			wantStderr: unarchiveConsequences(),
 			opts:       UnarchiveOptions{},
 			prompterStubs: func(pm *prompter.PrompterMock) {
-				pm.ConfirmFunc = func(p string, d bool) (bool, error) {
-					if p == "Unarchive OWNER/REPO?" {
-						return true, nil
+				pm.InputFunc = func(p, d string) (string, error) {
+					if p == "Type OWNER/REPO to confirm unarchiving this repository" {
+						return "OWNER/REPO", nil
 					}
-					return false, prompter.NoSuchPromptErr(p)
+					return "", prompter.NoSuchPromptErr(p)
 				}
 			},
 			isTTY: true,
@@ -120,6 +128,17 @@ func Test_UnarchiveRun(t *testing.T) {
 					httpmock.StringResponse(`{}`))
 			},
 		},
+		{
+			name:       "dry run",
+			wantStdout: "Would have unarchived repository OWNER/REPO\n" + unarchiveConsequences(),
+			isTTY:      true,
+			opts:       UnarchiveOptions{RepoArg: "OWNER/REPO", DryRun: true},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query RepositoryInfo\b`),
+					httpmock.StringResponse(fmt.Sprintf(queryResponse, "true")))
+			},
+		},
 		{
 			name:       "unarchived repo tty",
 			wantStderr: "! Repository OWNER/REPO is not archived\n",
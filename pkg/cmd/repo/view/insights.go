@@ -0,0 +1,118 @@
+package view
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+)
+
+// insightsFields are the JSON fields available with `--insights`.
+var insightsFields = []string{"clones", "views", "popularPaths", "referrers"}
+
+type trafficStat struct {
+	Count   int `json:"count"`
+	Uniques int `json:"uniques"`
+}
+
+type popularPath struct {
+	Path    string `json:"path"`
+	Title   string `json:"title"`
+	Count   int    `json:"count"`
+	Uniques int    `json:"uniques"`
+}
+
+type referrer struct {
+	Referrer string `json:"referrer"`
+	Count    int    `json:"count"`
+	Uniques  int    `json:"uniques"`
+}
+
+// repoInsights is the traffic data for a repository over the last 14 days,
+// as reported by the repository traffic REST API.
+type repoInsights struct {
+	Clones       trafficStat   `json:"clones"`
+	Views        trafficStat   `json:"views"`
+	PopularPaths []popularPath `json:"popularPaths"`
+	Referrers    []referrer    `json:"referrers"`
+}
+
+func (i repoInsights) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(i, fields)
+}
+
+func fetchInsights(httpClient *http.Client, repo ghrepo.Interface) (*repoInsights, error) {
+	apiClient := api.NewClientFromHTTP(httpClient)
+	hostname := repo.RepoHost()
+	basePath := fmt.Sprintf("repos/%s/traffic/", ghrepo.FullName(repo))
+
+	var insights repoInsights
+	if err := apiClient.REST(hostname, "GET", basePath+"clones", nil, &insights.Clones); err != nil {
+		return nil, err
+	}
+	if err := apiClient.REST(hostname, "GET", basePath+"views", nil, &insights.Views); err != nil {
+		return nil, err
+	}
+	if err := apiClient.REST(hostname, "GET", basePath+"popular/paths", nil, &insights.PopularPaths); err != nil {
+		return nil, err
+	}
+	if err := apiClient.REST(hostname, "GET", basePath+"popular/referrers", nil, &insights.Referrers); err != nil {
+		return nil, err
+	}
+	return &insights, nil
+}
+
+func renderInsights(io *iostreams.IOStreams, repo ghrepo.Interface, insights *repoInsights) error {
+	cs := io.ColorScheme()
+	out := io.Out
+
+	if !io.IsStdoutTTY() {
+		fmt.Fprintf(out, "clones:\t%d\nunique cloners:\t%d\n", insights.Clones.Count, insights.Clones.Uniques)
+		fmt.Fprintf(out, "views:\t%d\nunique visitors:\t%d\n", insights.Views.Count, insights.Views.Uniques)
+		for _, p := range insights.PopularPaths {
+			fmt.Fprintf(out, "path:\t%s\t%d\t%d\n", p.Path, p.Count, p.Uniques)
+		}
+		for _, r := range insights.Referrers {
+			fmt.Fprintf(out, "referrer:\t%s\t%d\t%d\n", r.Referrer, r.Count, r.Uniques)
+		}
+		return nil
+	}
+
+	fmt.Fprintf(out, "%s · traffic over the last 14 days\n\n", cs.Bold(ghrepo.FullName(repo)))
+	fmt.Fprintf(out, "Clones: %d (%d unique cloners)\n", insights.Clones.Count, insights.Clones.Uniques)
+	fmt.Fprintf(out, "Views:  %d (%d unique visitors)\n", insights.Views.Count, insights.Views.Uniques)
+
+	if len(insights.PopularPaths) > 0 {
+		fmt.Fprintf(out, "\n%s\n", cs.Bold("Popular paths"))
+		tp := tableprinter.New(io, tableprinter.WithHeader("PATH", "VIEWS", "UNIQUE"))
+		for _, p := range insights.PopularPaths {
+			tp.AddField(p.Path)
+			tp.AddField(fmt.Sprintf("%d", p.Count))
+			tp.AddField(fmt.Sprintf("%d", p.Uniques))
+			tp.EndRow()
+		}
+		if err := tp.Render(); err != nil {
+			return err
+		}
+	}
+
+	if len(insights.Referrers) > 0 {
+		fmt.Fprintf(out, "\n%s\n", cs.Bold("Top referrers"))
+		tp := tableprinter.New(io, tableprinter.WithHeader("REFERRER", "VIEWS", "UNIQUE"))
+		for _, r := range insights.Referrers {
+			tp.AddField(r.Referrer)
+			tp.AddField(fmt.Sprintf("%d", r.Count))
+			tp.AddField(fmt.Sprintf("%d", r.Uniques))
+			tp.EndRow()
+		}
+		if err := tp.Render(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
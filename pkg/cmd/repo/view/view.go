@@ -28,9 +28,10 @@ type ViewOptions struct {
 	Exporter   cmdutil.Exporter
 	Config     func() (gh.Config, error)
 
-	RepoArg string
-	Web     bool
-	Branch  string
+	RepoArg  string
+	Web      bool
+	Branch   string
+	Insights bool
 }
 
 func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Command {
@@ -53,12 +54,29 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 			With %[1]s--web%[1]s, open the repository in a web browser instead.
 
 			With %[1]s--branch%[1]s, view a specific branch of the repository.
+
+			With %[1]s--insights%[1]s, display traffic data for the repository over the last
+			14 days: clone counts, unique visitors, and the most popular paths and
+			referrers. Viewing traffic data requires push access to the repository.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(c *cobra.Command, args []string) error {
 			if len(args) > 0 {
 				opts.RepoArg = args[0]
 			}
+			if opts.Insights && opts.Branch != "" {
+				return cmdutil.FlagErrorf("`--branch` cannot be used with `--insights`")
+			}
+			if opts.Exporter != nil {
+				for _, f := range opts.Exporter.Fields() {
+					if opts.Insights != isInsightsField(f) {
+						if opts.Insights {
+							return cmdutil.FlagErrorf("the %q field is not available with `--insights`", f)
+						}
+						return cmdutil.FlagErrorf("the %q field is only available with `--insights`", f)
+					}
+				}
+			}
 			if runF != nil {
 				return runF(&opts)
 			}
@@ -68,7 +86,8 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open a repository in the browser")
 	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "View a specific branch of the repository")
-	cmdutil.AddJSONFlags(cmd, &opts.Exporter, api.RepositoryFields)
+	cmd.Flags().BoolVar(&opts.Insights, "insights", false, "Display traffic data for the repository")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, append(api.RepositoryFields, insightsFields...))
 
 	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "branch")
 
@@ -77,6 +96,15 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 
 var defaultFields = []string{"name", "owner", "description"}
 
+func isInsightsField(name string) bool {
+	for _, f := range insightsFields {
+		if f == name {
+			return true
+		}
+	}
+	return false
+}
+
 func viewRun(opts *ViewOptions) error {
 	httpClient, err := opts.HttpClient()
 	if err != nil {
@@ -111,6 +139,28 @@ func viewRun(opts *ViewOptions) error {
 		}
 	}
 
+	if opts.Insights {
+		if opts.Web {
+			openURL := ghrepo.GenerateRepoURL(toView, "graphs/traffic")
+			if opts.IO.IsStdoutTTY() {
+				opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
+			}
+			return opts.Browser.Browse(openURL)
+		}
+
+		opts.IO.StartProgressIndicator()
+		insights, err := fetchInsights(httpClient, toView)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+
+		if opts.Exporter != nil {
+			return opts.Exporter.Write(opts.IO, insights)
+		}
+		return renderInsights(opts.IO, toView, insights)
+	}
+
 	var readme *RepoReadme
 	fields := defaultFields
 	if opts.Exporter != nil {
@@ -132,7 +182,7 @@ func viewRun(opts *ViewOptions) error {
 	openURL := generateBranchURL(toView, opts.Branch)
 	if opts.Web {
 		if opts.IO.IsStdoutTTY() {
-			fmt.Fprintf(opts.IO.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(openURL))
+			opts.IO.Infof("Opening %s in your browser.\n", text.DisplayURL(openURL))
 		}
 		return opts.Browser.Browse(openURL)
 	}
@@ -90,6 +90,11 @@ func TestJSONFields(t *testing.T) {
 		"viewerSubscription",
 		"visibility",
 		"watchers",
+		// fields available with `--insights`
+		"clones",
+		"views",
+		"popularPaths",
+		"referrers",
 	})
 }
 
@@ -132,6 +137,29 @@ func TestNewCmdView(t *testing.T) {
 				Branch:  "feat/awesome",
 			},
 		},
+		{
+			name: "sets insights",
+			cli:  "--insights",
+			wants: ViewOptions{
+				RepoArg:  "",
+				Insights: true,
+			},
+		},
+		{
+			name:     "insights with branch",
+			cli:      "--insights -b feat/awesome",
+			wantsErr: true,
+		},
+		{
+			name:     "insights with repository json field",
+			cli:      "--insights --json name",
+			wantsErr: true,
+		},
+		{
+			name:     "insights json field without insights",
+			cli:      "--json clones",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -167,6 +195,7 @@ func TestNewCmdView(t *testing.T) {
 			assert.Equal(t, tt.wants.Web, gotOpts.Web)
 			assert.Equal(t, tt.wants.Branch, gotOpts.Branch)
 			assert.Equal(t, tt.wants.RepoArg, gotOpts.RepoArg)
+			assert.Equal(t, tt.wants.Insights, gotOpts.Insights)
 		})
 	}
 }
@@ -739,6 +768,93 @@ func Test_viewRun_json(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func Test_ViewRun_Insights(t *testing.T) {
+	tests := []struct {
+		name      string
+		stdoutTTY bool
+		wantOut   string
+	}{
+		{
+			name:      "tty",
+			stdoutTTY: true,
+			wantOut: heredoc.Doc(`
+				OWNER/REPO · traffic over the last 14 days
+
+				Clones: 110 (12 unique cloners)
+				Views:  2900 (340 unique visitors)
+
+				Popular paths
+				PATH                VIEWS  UNIQUE
+				/OWNER/REPO         1500   200
+				/OWNER/REPO/issues  400    80
+
+				Top referrers
+				REFERRER    VIEWS  UNIQUE
+				github.com  900    150
+				Google      300    60
+			`),
+		},
+		{
+			name:      "nontty",
+			stdoutTTY: false,
+			wantOut: heredoc.Doc(`
+				clones:	110
+				unique cloners:	12
+				views:	2900
+				unique visitors:	340
+				path:	/OWNER/REPO	1500	200
+				path:	/OWNER/REPO/issues	400	80
+				referrer:	github.com	900	150
+				referrer:	Google	300	60
+			`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/traffic/clones"),
+				httpmock.StringResponse(`{"count": 110, "uniques": 12}`))
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/traffic/views"),
+				httpmock.StringResponse(`{"count": 2900, "uniques": 340}`))
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/traffic/popular/paths"),
+				httpmock.StringResponse(`[
+					{"path": "/OWNER/REPO", "title": "REPO", "count": 1500, "uniques": 200},
+					{"path": "/OWNER/REPO/issues", "title": "Issues", "count": 400, "uniques": 80}
+				]`))
+			reg.Register(
+				httpmock.REST("GET", "repos/OWNER/REPO/traffic/popular/referrers"),
+				httpmock.StringResponse(`[
+					{"referrer": "github.com", "count": 900, "uniques": 150},
+					{"referrer": "Google", "count": 300, "uniques": 60}
+				]`))
+
+			io, _, stdout, stderr := iostreams.Test()
+			io.SetStdoutTTY(tt.stdoutTTY)
+
+			opts := &ViewOptions{
+				IO:       io,
+				Insights: true,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+				BaseRepo: func() (ghrepo.Interface, error) {
+					return ghrepo.New("OWNER", "REPO"), nil
+				},
+			}
+
+			err := viewRun(opts)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			assert.Equal(t, "", stderr.String())
+		})
+	}
+}
+
 type testExporter struct {
 	fields []string
 }
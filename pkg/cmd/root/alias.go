@@ -6,6 +6,8 @@ import (
 	"os/exec"
 	"regexp"
 	"runtime"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/cli/cli/v2/internal/run"
@@ -47,12 +49,12 @@ func NewCmdShellAlias(io *iostreams.IOStreams, aliasName, aliasValue string) *co
 	}
 }
 
-func NewCmdAlias(io *iostreams.IOStreams, aliasName, aliasValue string) *cobra.Command {
+func NewCmdAlias(io *iostreams.IOStreams, aliasName, aliasValue string, aliases map[string]string) *cobra.Command {
 	return &cobra.Command{
 		Use:   aliasName,
 		Short: fmt.Sprintf("Alias for %q", text.Truncate(80, aliasValue)),
 		RunE: func(c *cobra.Command, args []string) error {
-			expandedArgs, err := expandAlias(aliasValue, args)
+			expandedArgs, err := expandAlias(aliasName, aliasValue, args, aliases)
 			if err != nil {
 				return err
 			}
@@ -68,19 +70,73 @@ func NewCmdAlias(io *iostreams.IOStreams, aliasName, aliasValue string) *cobra.C
 	}
 }
 
-// ExpandAlias processes argv to see if it should be rewritten according to a user's aliases.
-func expandAlias(expansion string, args []string) ([]string, error) {
+var (
+	dollarPlaceholderRE = regexp.MustCompile(`\$(\d+)`)
+	bracePlaceholderRE  = regexp.MustCompile(`\{(\d+)\}`)
+	flagPlaceholderRE   = regexp.MustCompile(`\{flag:([a-zA-Z0-9][-a-zA-Z0-9_]*)\}`)
+)
+
+// expandAlias processes argv to see if it should be rewritten according to a user's aliases,
+// following nested aliases until a real command is reached. A chain of the alias names seen
+// so far guards against aliases that expand to each other in a cycle.
+func expandAlias(aliasName, expansion string, args []string, aliases map[string]string) ([]string, error) {
+	chain := []string{aliasName}
+	for {
+		expanded, err := expandAliasOnce(expansion, args)
+		if err != nil {
+			return nil, err
+		}
+		name, nested, rest, ok := findNestedAlias(expanded, aliases)
+		if !ok || strings.HasPrefix(nested, "!") {
+			// shell aliases are left for the root command to execute
+			return expanded, nil
+		}
+		if slices.Contains(chain, name) {
+			return nil, fmt.Errorf("alias cycle detected: %s", strings.Join(append(chain, name), " -> "))
+		}
+		chain = append(chain, name)
+		expansion, args = nested, rest
+	}
+}
+
+// expandAliasOnce performs a single round of placeholder substitution. Positional arguments
+// are referenced as $1 or {1}, and flags passed to the alias as {flag:name}. The $1 form is
+// substituted into the expansion before it is split into words, while the brace forms are
+// substituted afterwards so that values containing spaces or quotes stay a single argument.
+func expandAliasOnce(expansion string, args []string) ([]string, error) {
+	flagValues := map[string]string{}
+	for _, m := range flagPlaceholderRE.FindAllStringSubmatch(expansion, -1) {
+		flagName := m[1]
+		if _, ok := flagValues[flagName]; ok {
+			continue
+		}
+		value, rest, err := consumeFlagArg(args, flagName, expansion)
+		if err != nil {
+			return nil, err
+		}
+		flagValues[flagName] = value
+		args = rest
+	}
+
+	maxRef := 0
+	for _, re := range []*regexp.Regexp{dollarPlaceholderRE, bracePlaceholderRE} {
+		for _, m := range re.FindAllStringSubmatch(expansion, -1) {
+			if n, err := strconv.Atoi(m[1]); err == nil && n > maxRef {
+				maxRef = n
+			}
+		}
+	}
+
 	extraArgs := []string{}
 	for i, a := range args {
-		if !strings.Contains(expansion, "$") {
-			extraArgs = append(extraArgs, a)
-		} else {
+		if i < maxRef {
 			expansion = strings.ReplaceAll(expansion, fmt.Sprintf("$%d", i+1), a)
+		} else {
+			extraArgs = append(extraArgs, a)
 		}
 	}
 
-	lingeringRE := regexp.MustCompile(`\$\d`)
-	if lingeringRE.MatchString(expansion) {
+	if maxRef > len(args) {
 		return nil, fmt.Errorf("not enough arguments for alias: %s", expansion)
 	}
 
@@ -89,11 +145,61 @@ func expandAlias(expansion string, args []string) ([]string, error) {
 		return nil, err
 	}
 
+	for i, w := range newArgs {
+		w = bracePlaceholderRE.ReplaceAllStringFunc(w, func(m string) string {
+			n, _ := strconv.Atoi(m[1 : len(m)-1])
+			return args[n-1]
+		})
+		w = flagPlaceholderRE.ReplaceAllStringFunc(w, func(m string) string {
+			return flagValues[flagPlaceholderRE.FindStringSubmatch(m)[1]]
+		})
+		newArgs[i] = w
+	}
+
 	expanded := append(newArgs, extraArgs...)
 
 	return expanded, nil
 }
 
+// consumeFlagArg removes the first occurrence of --name from args, accepting both the
+// "--name value" and "--name=value" spellings, and returns its value and the remaining args.
+func consumeFlagArg(args []string, name, expansion string) (string, []string, error) {
+	flag := "--" + name
+	for i, a := range args {
+		if a == flag {
+			if i+1 >= len(args) {
+				return "", nil, fmt.Errorf("flag %s for alias needs an argument", flag)
+			}
+			return args[i+1], append(args[:i:i], args[i+2:]...), nil
+		}
+		if strings.HasPrefix(a, flag+"=") {
+			return a[len(flag)+1:], append(args[:i:i], args[i+1:]...), nil
+		}
+	}
+	return "", nil, fmt.Errorf("missing flag %s for alias: %s", flag, expansion)
+}
+
+// findNestedAlias reports whether expanded begins with the name of another alias, preferring
+// the longest matching name, and returns that alias along with the arguments left over for it.
+func findNestedAlias(expanded []string, aliases map[string]string) (string, string, []string, bool) {
+	best := ""
+	bestWords := 0
+	for name := range aliases {
+		words := strings.Fields(name)
+		if len(words) == 0 || len(words) > len(expanded) || len(words) <= bestWords {
+			continue
+		}
+		if slices.Equal(words, expanded[:len(words)]) {
+			best = name
+			bestWords = len(words)
+		}
+	}
+	if best == "" {
+		return "", "", nil, false
+	}
+	return best, aliases[best], expanded[bestWords:], true
+}
+
 // ExpandShellAlias processes argv to see if it should be rewritten according to a user's aliases.
 func expandShellAlias(expansion string, args []string, findShFunc func() (string, error)) ([]string, error) {
 	if findShFunc == nil {
@@ -12,6 +12,7 @@ func TestExpandAlias(t *testing.T) {
 		name         string
 		expansion    string
 		args         []string
+		aliases      map[string]string
 		wantExpanded []string
 		wantErr      string
 	}{
@@ -57,10 +58,75 @@ func TestExpandAlias(t *testing.T) {
 			args:         []string{"$coolmoney$"},
 			wantExpanded: []string{"issue", "list", "--author=$coolmoney$", "--assignee=$coolmoney$"},
 		},
+		{
+			name:         "braced placeholders keep arguments intact",
+			expansion:    `issue list --label={1}`,
+			args:         []string{`help "wanted"`},
+			wantExpanded: []string{"issue", "list", `--label=help "wanted"`},
+		},
+		{
+			name:      "not enough arguments for braced placeholder",
+			expansion: `issue list --label={1}`,
+			args:      []string{},
+			wantErr:   `not enough arguments for alias: issue list --label={1}`,
+		},
+		{
+			name:         "flag placeholder with separate value",
+			expansion:    `issue list --label={flag:label} --state=open`,
+			args:         []string{"--label", "bug", "42"},
+			wantExpanded: []string{"issue", "list", "--label=bug", "--state=open", "42"},
+		},
+		{
+			name:         "flag placeholder with inline value",
+			expansion:    `issue list --label={flag:label}`,
+			args:         []string{"--label=help wanted"},
+			wantExpanded: []string{"issue", "list", "--label=help wanted"},
+		},
+		{
+			name:      "missing flag placeholder value",
+			expansion: `issue list --label={flag:label}`,
+			args:      []string{},
+			wantErr:   `missing flag --label for alias: issue list --label={flag:label}`,
+		},
+		{
+			name:      "flag placeholder without argument",
+			expansion: `issue list --label={flag:label}`,
+			args:      []string{"--label"},
+			wantErr:   `flag --label for alias needs an argument`,
+		},
+		{
+			name:      "nested alias",
+			expansion: "bugs",
+			args:      []string{"--web"},
+			aliases: map[string]string{
+				"co":   "pr checkout",
+				"bugs": "issue list --label=bug",
+			},
+			wantExpanded: []string{"issue", "list", "--label=bug", "--web"},
+		},
+		{
+			name:      "nested alias with positional arguments",
+			expansion: `co "$1"`,
+			args:      []string{"123"},
+			aliases: map[string]string{
+				"co": "pr checkout",
+			},
+			wantExpanded: []string{"pr", "checkout", "123"},
+		},
+		{
+			name:      "alias cycle",
+			expansion: "pong",
+			args:      []string{},
+			aliases: map[string]string{
+				"ping": "pong",
+				"pong": "ping",
+			},
+			wantErr: "alias cycle detected: ping -> pong -> ping",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			gotExpanded, err := expandAlias(tt.expansion, tt.args)
+			gotExpanded, err := expandAlias("ping", tt.expansion, tt.args, tt.aliases)
 			if tt.wantErr != "" {
 				assert.Nil(t, gotExpanded)
 				assert.EqualError(t, err, tt.wantErr)
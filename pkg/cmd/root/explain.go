@@ -0,0 +1,103 @@
+package root
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// Explain prints how args would resolve — the alias expansion chain, the
+// extension binary that would be dispatched to, or the internal command with
+// its bound flags — without executing anything. It backs `gh --explain` and
+// exists to debug alias and extension conflicts safely.
+func Explain(w io.Writer, rootCmd *cobra.Command, f *cmdutil.Factory, args []string) error {
+	if len(args) == 0 {
+		return errors.New("usage: gh --explain <command> [flags]")
+	}
+
+	cfg, err := f.Config()
+	if err != nil {
+		return err
+	}
+	aliases := cfg.Aliases().All()
+
+	var chain []string
+	for {
+		name, expansion, rest, ok := findNestedAlias(args, aliases)
+		if !ok {
+			break
+		}
+		if slices.Contains(chain, name) {
+			return fmt.Errorf("alias cycle detected: %s", strings.Join(append(chain, name), " -> "))
+		}
+		chain = append(chain, name)
+
+		if strings.HasPrefix(expansion, "!") {
+			fmt.Fprintf(w, "alias: %s => %s\n", name, expansion)
+			fmt.Fprintf(w, "shell command: %s\n", expansion[1:])
+			if len(rest) > 0 {
+				fmt.Fprintf(w, "arguments: %s\n", strings.Join(rest, " "))
+			}
+			return nil
+		}
+
+		expanded, err := expandAliasOnce(expansion, rest)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "alias: %s => %s\n", name, strings.Join(expanded, " "))
+		args = expanded
+	}
+
+	cmd, restArgs, err := rootCmd.Find(args)
+	if err != nil {
+		return err
+	}
+	if cmd == rootCmd {
+		return fmt.Errorf("unknown command %q for %q", args[0], "gh")
+	}
+
+	if cmd.GroupID == "extension" {
+		fmt.Fprintf(w, "extension: %s\n", cmd.Name())
+		for _, ext := range f.ExtensionManager.List() {
+			if ext.Name() == cmd.Name() {
+				fmt.Fprintf(w, "executes: %s\n", ext.Path())
+				break
+			}
+		}
+		if len(restArgs) > 0 {
+			fmt.Fprintf(w, "arguments: %s\n", strings.Join(restArgs, " "))
+		}
+		return nil
+	}
+
+	fmt.Fprintf(w, "command: %s\n", cmd.CommandPath())
+
+	if cmd.DisableFlagParsing {
+		if len(restArgs) > 0 {
+			fmt.Fprintf(w, "arguments: %s\n", strings.Join(restArgs, " "))
+		}
+		return nil
+	}
+
+	if err := cmd.ParseFlags(restArgs); err != nil {
+		return err
+	}
+	var flags []string
+	cmd.Flags().Visit(func(fl *pflag.Flag) {
+		flags = append(flags, fmt.Sprintf("--%s=%s", fl.Name, fl.Value.String()))
+	})
+	if len(flags) > 0 {
+		fmt.Fprintf(w, "flags: %s\n", strings.Join(flags, " "))
+	}
+	if positional := cmd.Flags().Args(); len(positional) > 0 {
+		fmt.Fprintf(w, "arguments: %s\n", strings.Join(positional, " "))
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package root
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/extensions"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplain(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		aliases string
+		wantOut string
+		wantErr string
+	}{
+		{
+			name:    "internal command with flags",
+			args:    []string{"pr", "checkout", "123", "--detach"},
+			wantOut: "command: gh pr checkout\nflags: --detach=true\narguments: 123\n",
+		},
+		{
+			name:    "alias expansion",
+			aliases: "aliases:\n  co: pr checkout\n",
+			args:    []string{"co", "123"},
+			wantOut: "alias: co => pr checkout 123\ncommand: gh pr checkout\narguments: 123\n",
+		},
+		{
+			name:    "nested alias expansion",
+			aliases: "aliases:\n  co: pr checkout\n  cod: co --detach\n",
+			args:    []string{"cod", "123"},
+			wantOut: "alias: cod => co --detach 123\nalias: co => pr checkout --detach 123\ncommand: gh pr checkout\nflags: --detach=true\narguments: 123\n",
+		},
+		{
+			name:    "shell alias",
+			aliases: "aliases:\n  igrep: '!gh issue list | grep'\n",
+			args:    []string{"igrep", "foo"},
+			wantOut: "alias: igrep => !gh issue list | grep\nshell command: gh issue list | grep\narguments: foo\n",
+		},
+		{
+			name:    "alias cycle",
+			aliases: "aliases:\n  one: two\n  two: one\n",
+			args:    []string{"one"},
+			wantErr: "alias cycle detected: one -> two -> one",
+		},
+		{
+			name:    "extension",
+			args:    []string{"whale", "--flag"},
+			wantOut: "extension: whale\nexecutes: /path/to/gh-whale\narguments: --flag\n",
+		},
+		{
+			name:    "unknown command",
+			args:    []string{"nonexistent"},
+			wantErr: `unknown command "nonexistent" for "gh"`,
+		},
+		{
+			name:    "no arguments",
+			wantErr: "usage: gh --explain <command> [flags]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rootCmd := &cobra.Command{Use: "gh"}
+			prCmd := &cobra.Command{Use: "pr"}
+			checkoutCmd := &cobra.Command{Use: "checkout", Run: func(*cobra.Command, []string) {}}
+			checkoutCmd.Flags().Bool("detach", false, "")
+			prCmd.AddCommand(checkoutCmd)
+			rootCmd.AddCommand(prCmd)
+			rootCmd.AddCommand(&cobra.Command{
+				Use:                "whale",
+				GroupID:            "extension",
+				Run:                func(*cobra.Command, []string) {},
+				DisableFlagParsing: true,
+			})
+
+			f := &cmdutil.Factory{
+				Config: func() (gh.Config, error) {
+					return config.NewFromString(tt.aliases), nil
+				},
+				ExtensionManager: &extensions.ExtensionManagerMock{
+					ListFunc: func() []extensions.Extension {
+						return []extensions.Extension{
+							&extensions.ExtensionMock{
+								NameFunc: func() string { return "whale" },
+								PathFunc: func() string { return "/path/to/gh-whale" },
+							},
+						}
+					},
+				},
+			}
+
+			buf := &bytes.Buffer{}
+			err := Explain(buf, rootCmd, f, tt.args)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantOut, buf.String())
+		})
+	}
+}
@@ -68,6 +68,9 @@ var HelpTopics = []helpTopic{
 			%[1]sDEBUG%[1]s (deprecated): set to %[1]s1%[1]s, %[1]strue%[1]s, or %[1]syes%[1]s to enable verbose output on standard
 			error.
 
+			%[1]sGH_ERROR_FORMAT%[1]s: set to %[1]sjson%[1]s to print command failures to standard error as a structured
+			JSON object instead of human-readable text.
+
 			%[1]sGH_PAGER%[1]s, %[1]sPAGER%[1]s (in order of precedence): a terminal paging program to send standard output
 			to, e.g. %[1]sless%[1]s.
 
@@ -81,6 +84,9 @@ var HelpTopics = []helpTopic{
 			%[1]sCLICOLOR_FORCE%[1]s: set to a value other than %[1]s0%[1]s to keep ANSI colors in output
 			even when the output is piped.
 
+			%[1]sFORCE_COLOR%[1]s: set to %[1]s0%[1]s to disable colors, or to %[1]s1%[1]s, %[1]s2%[1]s, or %[1]s3%[1]s to force 16 color,
+			256 color, or truecolor output respectively, even when the output is piped.
+
 			%[1]sGH_COLOR_LABELS%[1]s: set to any value to display labels using their RGB hex color codes in terminals that
 			support truecolor.
 
@@ -107,6 +113,9 @@ var HelpTopics = []helpTopic{
 
 			%[1]sGH_PROMPT_DISABLED%[1]s: set to any value to disable interactive prompting in the terminal.
 
+			%[1]sGH_PROMPT_ANSWERS%[1]s: set to the path of a JSON file that maps prompt messages to their
+			answers to pre-seed interactive prompts, turning interactive flows into scriptable ones.
+
 			%[1]sGH_PATH%[1]s: set the path to the gh executable, useful for when gh can not properly determine
 			its own path such as in the cygwin terminal.
 
@@ -119,6 +128,13 @@ var HelpTopics = []helpTopic{
 
 			%[1]sGH_SPINNER_DISABLED%[1]s: set to a truthy value to replace the spinner animation with
 			a textual progress indicator.
+
+			%[1]sGH_METRICS_ENABLED%[1]s: set to a truthy value to record command name, duration, API call
+			count, and exit status of each invocation to a local file, summarized by %[1]sgh metrics report%[1]s.
+			No data leaves the machine.
+
+			%[1]sGH_OFFLINE%[1]s: set to a truthy value to fail any network request immediately instead
+			of contacting GitHub; equivalent to the %[1]s--offline%[1]s flag.
 		`, "`"),
 	},
 	{
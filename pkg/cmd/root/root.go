@@ -24,6 +24,7 @@ import (
 	gpgKeyCmd "github.com/cli/cli/v2/pkg/cmd/gpg-key"
 	issueCmd "github.com/cli/cli/v2/pkg/cmd/issue"
 	labelCmd "github.com/cli/cli/v2/pkg/cmd/label"
+	metricsCmd "github.com/cli/cli/v2/pkg/cmd/metrics"
 	orgCmd "github.com/cli/cli/v2/pkg/cmd/org"
 	prCmd "github.com/cli/cli/v2/pkg/cmd/pr"
 	projectCmd "github.com/cli/cli/v2/pkg/cmd/project"
@@ -72,6 +73,18 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 			"versionInfo": versionCmd.Format(version, buildDate),
 		},
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			if quiet, _ := cmd.Flags().GetBool("quiet"); quiet {
+				io.SetQuiet(true)
+			}
+			if verbose, _ := cmd.Flags().GetBool("verbose"); verbose {
+				io.SetVerbose(true)
+			}
+			if offline, _ := cmd.Flags().GetBool("offline"); offline {
+				// the HTTP client reads GH_OFFLINE when it is built, and child
+				// processes such as git credential helpers inherit it
+				os.Setenv("GH_OFFLINE", "1")
+			}
+
 			// require that the user is authenticated before running most commands
 			if cmdutil.IsAuthCheckEnabled(cmd) && !cmdutil.CheckAuth(cfg) {
 				parent := cmd.Parent()
@@ -90,6 +103,13 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	// cmd.SetErr(f.IOStreams.ErrOut) // just let it default to os.Stderr instead
 
 	cmd.PersistentFlags().Bool("help", false, "Show help for command")
+	// no shorthands here: -q and -v are taken by subcommand flags such as `gh api --jq`
+	cmd.PersistentFlags().Bool("quiet", false, "Suppress informational messages")
+	cmd.PersistentFlags().Bool("verbose", false, "Print extra diagnostic output")
+	cmd.PersistentFlags().Bool("offline", false, "Fail any network request immediately instead of contacting GitHub")
+	// this flag is intercepted before command dispatch; it is declared here so
+	// that it shows up in help output
+	cmd.Flags().Bool("explain", false, "Print how a command line would resolve without executing it")
 
 	// override Cobra's default behaviors unless an opt-out has been set
 	if os.Getenv("GH_COBRA") == "" {
@@ -134,6 +154,7 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 	cmd.AddCommand(gpgKeyCmd.NewCmdGPGKey(f))
 	cmd.AddCommand(completionCmd.NewCmdCompletion(f.IOStreams))
 	cmd.AddCommand(extensionCmd.NewCmdExtension(f))
+	cmd.AddCommand(metricsCmd.NewCmdMetrics(f))
 	cmd.AddCommand(searchCmd.NewCmdSearch(f))
 	cmd.AddCommand(secretCmd.NewCmdSecret(f))
 	cmd.AddCommand(variableCmd.NewCmdVariable(f))
@@ -198,7 +219,7 @@ func NewCmdRoot(f *cmdutil.Factory, version, buildDate string) (*cobra.Command,
 				shellAliasCmd := NewCmdShellAlias(io, parentArgs[0], aliasValue)
 				parentCmd.AddCommand(shellAliasCmd)
 			} else {
-				aliasCmd := NewCmdAlias(io, parentArgs[0], aliasValue)
+				aliasCmd := NewCmdAlias(io, parentArgs[0], aliasValue, aliases.All())
 				split, _ := shlex.Split(aliasValue)
 				child, _, _ := cmd.Find(split)
 				aliasCmd.SetUsageFunc(func(_ *cobra.Command) error {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
@@ -30,6 +31,7 @@ type CheckOptions struct {
 	Branch  string
 	Default bool
 	WebMode bool
+	Commits string
 }
 
 func NewCmdCheck(f *cmdutil.Factory, runF func(*CheckOptions) error) *cobra.Command {
@@ -53,11 +55,20 @@ func NewCmdCheck(f *cmdutil.Factory, runF func(*CheckOptions) error) *cobra.Comm
 
 			The %[1]s--default%[1]s flag can be used to view rules that apply to the default branch of the
 			repository.
+
+			The %[1]s--commits%[1]s flag can be used to evaluate the local commits in a revision range
+			against the rules before pushing them. Signed commits and linear history requirements are
+			verified locally; rules that can only be evaluated by the server, such as required status
+			checks, are reported as warnings. The command exits with a non-zero status if any rule
+			would block the push.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# View all rules that apply to the current branch
 			$ gh ruleset check
 
+			# Evaluate the local commits that are about to be pushed against the rules
+			$ gh ruleset check my-branch --commits origin/my-branch..HEAD
+
 			# View all rules that apply to a branch named "my-branch" in a different repository
 			$ gh ruleset check my-branch --repo owner/repo
 
@@ -87,6 +98,14 @@ func NewCmdCheck(f *cmdutil.Factory, runF func(*CheckOptions) error) *cobra.Comm
 				return err
 			}
 
+			if err := cmdutil.MutuallyExclusive(
+				"specify only one of `--web` or `--commits`",
+				opts.WebMode,
+				opts.Commits != "",
+			); err != nil {
+				return err
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -97,6 +116,7 @@ func NewCmdCheck(f *cmdutil.Factory, runF func(*CheckOptions) error) *cobra.Comm
 
 	cmd.Flags().BoolVar(&opts.Default, "default", false, "Check rules on default branch")
 	cmd.Flags().BoolVarP(&opts.WebMode, "web", "w", false, "Open the branch rules page in a web browser")
+	cmd.Flags().StringVar(&opts.Commits, "commits", "", "Evaluate the local commits in a revision `range` (such as \"origin/main..HEAD\") against the rules")
 
 	return cmd
 }
@@ -159,5 +179,125 @@ func checkRun(opts *CheckOptions) error {
 		fmt.Fprint(w, shared.ParseRulesForDisplay(rules))
 	}
 
+	if opts.Commits != "" {
+		commits, err := localCommits(git, opts.Commits)
+		if err != nil {
+			return fmt.Errorf("could not list local commits: %w", err)
+		}
+		return evaluatePush(opts, rules, commits)
+	}
+
 	return nil
 }
+
+// localCommit holds the subset of commit information needed to evaluate a
+// commit against branch rules.
+type localCommit struct {
+	Sha             string
+	SignatureStatus string
+	Parents         []string
+	Subject         string
+}
+
+func localCommits(gitClient *git.Client, revRange string) ([]localCommit, error) {
+	cmd, err := gitClient.Command(context.Background(), "-c", "log.showSignature=false", "log", "--format=%H%x00%G?%x00%P%x00%s", revRange)
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, err
+	}
+
+	var commits []localCommit
+	for _, line := range strings.Split(string(out), "\n") {
+		parts := strings.SplitN(line, "\x00", 4)
+		if len(parts) < 4 {
+			continue
+		}
+		commits = append(commits, localCommit{
+			Sha:             parts[0],
+			SignatureStatus: parts[1],
+			Parents:         strings.Fields(parts[2]),
+			Subject:         parts[3],
+		})
+	}
+	return commits, nil
+}
+
+// evaluatePush reports which of the rules would reject a push of the given
+// commits. Rules that can only be evaluated by the server are reported as
+// warnings.
+func evaluatePush(opts *CheckOptions, rules []shared.RulesetRule, commits []localCommit) error {
+	w := opts.IO.Out
+	cs := opts.IO.ColorScheme()
+
+	if len(commits) == 0 {
+		fmt.Fprintf(w, "\nNo local commits found in range %s\n", opts.Commits)
+		return nil
+	}
+
+	fmt.Fprintf(w, "\nEvaluating %s from %s against these rules:\n\n", text.Pluralize(len(commits), "local commit"), opts.Commits)
+
+	blocked := false
+	// the same rule type may be configured in multiple rulesets; evaluate it once
+	seen := map[string]bool{}
+	for _, rule := range rules {
+		if seen[rule.Type] {
+			continue
+		}
+		seen[rule.Type] = true
+
+		switch rule.Type {
+		case "required_signatures":
+			var unsigned []localCommit
+			for _, commit := range commits {
+				if commit.SignatureStatus == "N" {
+					unsigned = append(unsigned, commit)
+				}
+			}
+			if len(unsigned) == 0 {
+				fmt.Fprintf(w, "%s required_signatures: all commits are signed\n", cs.SuccessIcon())
+				continue
+			}
+			blocked = true
+			fmt.Fprintf(w, "%s required_signatures: %d of %d commits are not signed\n", cs.FailureIcon(), len(unsigned), len(commits))
+			for _, commit := range unsigned {
+				fmt.Fprintf(w, "  %s %s\n", shortSha(commit.Sha), commit.Subject)
+			}
+		case "required_linear_history":
+			var merges []localCommit
+			for _, commit := range commits {
+				if len(commit.Parents) > 1 {
+					merges = append(merges, commit)
+				}
+			}
+			if len(merges) == 0 {
+				fmt.Fprintf(w, "%s required_linear_history: no merge commits\n", cs.SuccessIcon())
+				continue
+			}
+			blocked = true
+			fmt.Fprintf(w, "%s required_linear_history: %s would be rejected\n", cs.FailureIcon(), text.Pluralize(len(merges), "merge commit"))
+			for _, commit := range merges {
+				fmt.Fprintf(w, "  %s %s\n", shortSha(commit.Sha), commit.Subject)
+			}
+		case "required_status_checks":
+			fmt.Fprintf(w, "%s required_status_checks: cannot be verified locally, status checks run after the push\n", cs.WarningIcon())
+		}
+	}
+
+	if blocked {
+		fmt.Fprintf(w, "\n%s Some rules would block this push\n", cs.FailureIcon())
+		return cmdutil.SilentError
+	}
+
+	fmt.Fprintf(w, "\n%s No rules block this push\n", cs.SuccessIcon())
+	return nil
+}
+
+func shortSha(sha string) string {
+	if len(sha) > 7 {
+		return sha[:7]
+	}
+	return sha
+}
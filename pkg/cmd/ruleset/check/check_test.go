@@ -7,8 +7,10 @@ import (
 	"testing"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/browser"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/run"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -65,12 +67,29 @@ func Test_NewCmdCheck(t *testing.T) {
 				WebMode: true,
 			},
 		},
+		{
+			name:  "commits",
+			args:  "--commits origin/main..HEAD",
+			isTTY: true,
+			want: CheckOptions{
+				Branch:  "",
+				Default: false,
+				WebMode: false,
+				Commits: "origin/main..HEAD",
+			},
+		},
 		{
 			name:    "both --default and branch name specified",
 			args:    "--default asdf",
 			isTTY:   true,
 			wantErr: "specify only one of `--default` or a branch name",
 		},
+		{
+			name:    "both --web and --commits specified",
+			args:    "--web --commits origin/main..HEAD",
+			isTTY:   true,
+			wantErr: "specify only one of `--web` or `--commits`",
+		},
 	}
 
 	for _, tt := range tests {
@@ -110,6 +129,7 @@ func Test_NewCmdCheck(t *testing.T) {
 			assert.Equal(t, tt.want.Branch, opts.Branch)
 			assert.Equal(t, tt.want.Default, opts.Default)
 			assert.Equal(t, tt.want.WebMode, opts.WebMode)
+			assert.Equal(t, tt.want.Commits, opts.Commits)
 		})
 	}
 }
@@ -119,6 +139,7 @@ func Test_checkRun(t *testing.T) {
 		name       string
 		isTTY      bool
 		opts       CheckOptions
+		gitStubs   func(*run.CommandStubber)
 		wantErr    string
 		wantStdout string
 		wantStderr string
@@ -155,6 +176,92 @@ func Test_checkRun(t *testing.T) {
 			wantStderr: "",
 			wantBrowse: "",
 		},
+		{
+			name:  "evaluates local commits that would be blocked",
+			isTTY: true,
+			opts: CheckOptions{
+				Branch:  "my-branch",
+				Commits: "origin/main..HEAD",
+			},
+			gitStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git -c log\.showSignature=false log`, 0,
+					"aaaaaaa1111\x00N\x00bbbbbbb\x00first commit\nccccccc2222\x00G\x00aaaaaaa1111\x00second commit\n")
+			},
+			wantErr: "SilentError",
+			wantStdout: heredoc.Doc(`
+			6 rules apply to branch my-branch in repo my-org/repo-name
+
+			- commit_author_email_pattern: [name: ] [negate: false] [operator: ends_with] [pattern: @example.com] 
+			  (configured in ruleset 1234 from organization my-org)
+
+			- commit_author_email_pattern: [name: ] [negate: false] [operator: ends_with] [pattern: @example.com] 
+			  (configured in ruleset 5678 from repository my-org/repo-name)
+
+			- commit_message_pattern: [name: ] [negate: false] [operator: starts_with] [pattern: fff] 
+			  (configured in ruleset 1234 from organization my-org)
+
+			- commit_message_pattern: [name: ] [negate: false] [operator: contains] [pattern: asdf] 
+			  (configured in ruleset 5678 from repository my-org/repo-name)
+
+			- creation
+			  (configured in ruleset 5678 from repository my-org/repo-name)
+
+			- required_signatures
+			  (configured in ruleset 1234 from organization my-org)
+
+
+			Evaluating 2 local commits from origin/main..HEAD against these rules:
+
+			X required_signatures: 1 of 2 commits are not signed
+			  aaaaaaa first commit
+
+			X Some rules would block this push
+			`),
+			wantStderr: "",
+			wantBrowse: "",
+		},
+		{
+			name:  "evaluates signed local commits",
+			isTTY: true,
+			opts: CheckOptions{
+				Branch:  "my-branch",
+				Commits: "origin/main..HEAD",
+			},
+			gitStubs: func(cs *run.CommandStubber) {
+				cs.Register(`git -c log\.showSignature=false log`, 0,
+					"ccccccc2222\x00G\x00aaaaaaa1111\x00second commit\n")
+			},
+			wantStdout: heredoc.Doc(`
+			6 rules apply to branch my-branch in repo my-org/repo-name
+
+			- commit_author_email_pattern: [name: ] [negate: false] [operator: ends_with] [pattern: @example.com] 
+			  (configured in ruleset 1234 from organization my-org)
+
+			- commit_author_email_pattern: [name: ] [negate: false] [operator: ends_with] [pattern: @example.com] 
+			  (configured in ruleset 5678 from repository my-org/repo-name)
+
+			- commit_message_pattern: [name: ] [negate: false] [operator: starts_with] [pattern: fff] 
+			  (configured in ruleset 1234 from organization my-org)
+
+			- commit_message_pattern: [name: ] [negate: false] [operator: contains] [pattern: asdf] 
+			  (configured in ruleset 5678 from repository my-org/repo-name)
+
+			- creation
+			  (configured in ruleset 5678 from repository my-org/repo-name)
+
+			- required_signatures
+			  (configured in ruleset 1234 from organization my-org)
+
+
+			Evaluating 1 local commit from origin/main..HEAD against these rules:
+
+			✓ required_signatures: all commits are signed
+
+			✓ No rules block this push
+			`),
+			wantStderr: "",
+			wantBrowse: "",
+		},
 		{
 			name:  "web mode, TTY",
 			isTTY: true,
@@ -212,6 +319,13 @@ func Test_checkRun(t *testing.T) {
 			}
 			browser := &browser.Stub{}
 			tt.opts.Browser = browser
+			tt.opts.Git = &git.Client{GitPath: "git"}
+
+			if tt.gitStubs != nil {
+				cmdStubs, restore := run.Stub()
+				defer restore(t)
+				tt.gitStubs(cmdStubs)
+			}
 
 			err := checkRun(&tt.opts)
 
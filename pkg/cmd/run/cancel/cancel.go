@@ -6,23 +6,34 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
+type iprompter interface {
+	Select(string, string, []string) (int, error)
+	Confirm(string, bool) (bool, error)
+}
+
 type CancelOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
 	BaseRepo   func() (ghrepo.Interface, error)
-	Prompter   shared.Prompter
+	Prompter   iprompter
 
 	Prompt bool
 
-	RunID string
+	RunID    string
+	Branch   string
+	All      bool
+	PRNumber int
 }
 
 func NewCmdCancel(f *cmdutil.Factory, runF func(*CancelOptions) error) *cobra.Command {
@@ -35,16 +46,47 @@ func NewCmdCancel(f *cmdutil.Factory, runF func(*CancelOptions) error) *cobra.Co
 	cmd := &cobra.Command{
 		Use:   "cancel [<run-id>]",
 		Short: "Cancel a workflow run",
-		Args:  cobra.MaximumNArgs(1),
+		Long: heredoc.Docf(`
+			Cancel a workflow run.
+
+			With %[1]s--branch%[1]s, only runs for that branch are considered. Combined with
+			%[1]s--all%[1]s, every queued or in progress run for the branch is cancelled. With
+			%[1]s--pr%[1]s, every queued or in progress run for the head branch of a pull
+			request is cancelled.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Interactively select an in progress run to cancel
+			$ gh run cancel
+
+			# Cancel a specific run
+			$ gh run cancel 12345
+
+			# Cancel all queued or in progress runs for a branch
+			$ gh run cancel --branch feature-x --all
+
+			# Cancel all queued or in progress runs for a pull request
+			$ gh run cancel --pr 123
+		`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
 			if len(args) > 0 {
 				opts.RunID = args[0]
-			} else if !opts.IO.CanPrompt() {
-				return cmdutil.FlagErrorf("run ID required when not running interactively")
-			} else {
+			}
+
+			if err := cmdutil.MutuallyExclusive("specify only one of run ID, `--branch`, or `--pr`", opts.RunID != "", opts.Branch != "", opts.PRNumber > 0); err != nil {
+				return err
+			}
+			if opts.All && opts.Branch == "" {
+				return cmdutil.FlagErrorf("`--all` requires `--branch`")
+			}
+
+			if opts.RunID == "" && opts.PRNumber == 0 && !(opts.Branch != "" && opts.All) {
+				if !opts.IO.CanPrompt() {
+					return cmdutil.FlagErrorf("run ID required when not running interactively")
+				}
 				opts.Prompt = true
 			}
 
@@ -56,6 +98,10 @@ func NewCmdCancel(f *cmdutil.Factory, runF func(*CancelOptions) error) *cobra.Co
 		},
 	}
 
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Cancel runs for a specific branch")
+	cmd.Flags().BoolVar(&opts.All, "all", false, "Cancel all queued or in progress runs for the branch")
+	cmd.Flags().IntVar(&opts.PRNumber, "pr", 0, "Cancel runs for the head branch of a pull request")
+
 	return cmd
 }
 
@@ -79,11 +125,19 @@ func runCancel(opts *CancelOptions) error {
 		return fmt.Errorf("failed to determine base repo: %w", err)
 	}
 
+	if (opts.Branch != "" && opts.All) || opts.PRNumber > 0 {
+		return cancelMatchingRuns(opts, client, repo)
+	}
+
 	runID := opts.RunID
 	var run *shared.Run
 
 	if opts.Prompt {
-		runs, err := shared.GetRunsWithFilter(client, repo, nil, 10, func(run shared.Run) bool {
+		var filters *shared.FilterOptions
+		if opts.Branch != "" {
+			filters = &shared.FilterOptions{Branch: opts.Branch}
+		}
+		runs, err := shared.GetRunsWithFilter(client, repo, filters, 10, func(run shared.Run) bool {
 			return run.Status != shared.Completed
 		})
 		if err != nil {
@@ -132,6 +186,84 @@ func runCancel(opts *CancelOptions) error {
 	return nil
 }
 
+// cancelMatchingRuns cancels every queued or in progress run for a branch or
+// for the head branch of a pull request.
+func cancelMatchingRuns(opts *CancelOptions, client *api.Client, repo ghrepo.Interface) error {
+	cs := opts.IO.ColorScheme()
+
+	branch := opts.Branch
+	target := fmt.Sprintf("branch %q", branch)
+	if opts.PRNumber > 0 {
+		var err error
+		branch, err = prHeadBranch(client, repo, opts.PRNumber)
+		if err != nil {
+			return err
+		}
+		target = fmt.Sprintf("pull request #%d", opts.PRNumber)
+	}
+
+	runs, err := shared.GetRunsWithFilter(client, repo, &shared.FilterOptions{Branch: branch}, 50, func(run shared.Run) bool {
+		return run.Status != shared.Completed
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get runs: %w", err)
+	}
+	if len(runs) == 0 {
+		return fmt.Errorf("found no queued or in progress runs for %s", target)
+	}
+
+	if opts.IO.CanPrompt() {
+		confirmed, err := opts.Prompter.Confirm(fmt.Sprintf("Cancel %s for %s?", text.Pluralize(len(runs), "run"), target), false)
+		if err != nil {
+			return err
+		}
+		if !confirmed {
+			return cmdutil.CancelError
+		}
+	}
+
+	g := errgroup.Group{}
+	for _, run := range runs {
+		g.Go(func() error {
+			if err := cancelWorkflowRun(client, repo, fmt.Sprintf("%d", run.ID)); err != nil {
+				var httpErr api.HTTPError
+				if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusConflict {
+					// The run completed after it was listed; nothing left to cancel.
+					return nil
+				}
+				return fmt.Errorf("failed to cancel run %d: %w", run.ID, err)
+			}
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(opts.IO.Out, "%s Request to cancel %s for %s submitted.\n", cs.SuccessIcon(), text.Pluralize(len(runs), "workflow run"), target)
+
+	return nil
+}
+
+func prHeadBranch(client *api.Client, repo ghrepo.Interface, prNumber int) (string, error) {
+	path := fmt.Sprintf("repos/%s/pulls/%d", ghrepo.FullName(repo), prNumber)
+
+	var response struct {
+		Head struct {
+			Ref string
+		}
+	}
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &response); err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return "", fmt.Errorf("could not find pull request #%d", prNumber)
+		}
+		return "", err
+	}
+
+	return response.Head.Ref, nil
+}
+
 func cancelWorkflowRun(client *api.Client, repo ghrepo.Interface, runID string) error {
 	path := fmt.Sprintf("repos/%s/actions/runs/%s/cancel", ghrepo.FullName(repo), runID)
 
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"net/url"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -43,6 +44,50 @@ func TestNewCmdCancel(t *testing.T) {
 				RunID: "1234",
 			},
 		},
+		{
+			name: "branch and all",
+			cli:  "--branch feature-x --all",
+			wants: CancelOptions{
+				Branch: "feature-x",
+				All:    true,
+			},
+		},
+		{
+			name: "branch tty",
+			cli:  "--branch feature-x",
+			tty:  true,
+			wants: CancelOptions{
+				Branch: "feature-x",
+				Prompt: true,
+			},
+		},
+		{
+			name:     "branch nontty",
+			cli:      "--branch feature-x",
+			wantsErr: true,
+		},
+		{
+			name: "pr",
+			cli:  "--pr 123",
+			wants: CancelOptions{
+				PRNumber: 123,
+			},
+		},
+		{
+			name:     "all without branch",
+			cli:      "--all",
+			wantsErr: true,
+		},
+		{
+			name:     "run ID with branch",
+			cli:      "1234 --branch feature-x",
+			wantsErr: true,
+		},
+		{
+			name:     "branch with pr",
+			cli:      "--branch feature-x --pr 123",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -78,6 +123,10 @@ func TestNewCmdCancel(t *testing.T) {
 			assert.NoError(t, err)
 
 			assert.Equal(t, tt.wants.RunID, gotOpts.RunID)
+			assert.Equal(t, tt.wants.Branch, gotOpts.Branch)
+			assert.Equal(t, tt.wants.All, gotOpts.All)
+			assert.Equal(t, tt.wants.PRNumber, gotOpts.PRNumber)
+			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
 		})
 	}
 }
@@ -85,6 +134,7 @@ func TestNewCmdCancel(t *testing.T) {
 func TestRunCancel(t *testing.T) {
 	inProgressRun := shared.TestRun(1234, shared.InProgress, "")
 	completedRun := shared.TestRun(4567, shared.Completed, shared.Failure)
+	queuedRun := shared.TestRun(2345, shared.Queued, "")
 	tests := []struct {
 		name        string
 		httpStubs   func(*httpmock.Registry)
@@ -203,6 +253,149 @@ func TestRunCancel(t *testing.T) {
 			},
 			wantOut: "✓ Request to cancel workflow 1234 submitted.\n",
 		},
+		{
+			name: "cancel all runs for branch",
+			opts: &CancelOptions{
+				Branch: "feature-x",
+				All:    true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "repos/OWNER/REPO/actions/runs", url.Values{"branch": []string{"feature-x"}}),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{
+							inProgressRun,
+							queuedRun,
+							completedRun,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/cancel"),
+					httpmock.StatusStringResponse(202, "{}"))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/2345/cancel"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			promptStubs: func(pm *prompter.MockPrompter) {
+				pm.RegisterConfirm(`Cancel 2 runs for branch "feature-x"?`, func(_ string, _ bool) (bool, error) {
+					return true, nil
+				})
+			},
+			wantOut: "✓ Request to cancel 2 workflow runs for branch \"feature-x\" submitted.\n",
+		},
+		{
+			name: "cancel all runs for branch, declined",
+			opts: &CancelOptions{
+				Branch: "feature-x",
+				All:    true,
+			},
+			wantErr: true,
+			errMsg:  "CancelError",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "repos/OWNER/REPO/actions/runs", url.Values{"branch": []string{"feature-x"}}),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{
+							inProgressRun,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+			},
+			promptStubs: func(pm *prompter.MockPrompter) {
+				pm.RegisterConfirm(`Cancel 1 run for branch "feature-x"?`, func(_ string, _ bool) (bool, error) {
+					return false, nil
+				})
+			},
+		},
+		{
+			name: "cancel all runs for branch, no matching runs",
+			opts: &CancelOptions{
+				Branch: "feature-x",
+				All:    true,
+			},
+			wantErr: true,
+			errMsg:  `found no queued or in progress runs for branch "feature-x"`,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.QueryMatcher("GET", "repos/OWNER/REPO/actions/runs", url.Values{"branch": []string{"feature-x"}}),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{
+							completedRun,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+			},
+		},
+		{
+			name: "cancel runs for pull request",
+			opts: &CancelOptions{
+				PRNumber: 123,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/pulls/123"),
+					httpmock.JSONResponse(map[string]interface{}{
+						"head": map[string]interface{}{
+							"ref": "feature-x",
+						},
+					}))
+				reg.Register(
+					httpmock.QueryMatcher("GET", "repos/OWNER/REPO/actions/runs", url.Values{"branch": []string{"feature-x"}}),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{
+							inProgressRun,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/cancel"),
+					httpmock.StatusStringResponse(202, "{}"))
+			},
+			promptStubs: func(pm *prompter.MockPrompter) {
+				pm.RegisterConfirm("Cancel 1 run for pull request #123?", func(_ string, _ bool) (bool, error) {
+					return true, nil
+				})
+			},
+			wantOut: "✓ Request to cancel 1 workflow run for pull request #123 submitted.\n",
+		},
+		{
+			name: "cancel runs for pull request, not found",
+			opts: &CancelOptions{
+				PRNumber: 123,
+			},
+			wantErr: true,
+			errMsg:  "could not find pull request #123",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/pulls/123"),
+					httpmock.StatusStringResponse(404, ""))
+			},
+		},
 		{
 			name: "invalid run-id",
 			opts: &CancelOptions{
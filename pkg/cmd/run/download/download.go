@@ -12,23 +12,27 @@ import (
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/cli/cli/v2/pkg/set"
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
 )
 
+const maxConcurrentDownloads = 5
+
 type DownloadOptions struct {
 	IO       *iostreams.IOStreams
 	Platform platform
 	Prompter iprompter
 
-	DoPrompt       bool
-	RunID          string
-	DestinationDir string
-	Names          []string
-	FilePatterns   []string
+	DoPrompt        bool
+	RunID           string
+	DestinationDir  string
+	Names           []string
+	FilePatterns    []string
+	IncludePatterns []string
 }
 
 type platform interface {
 	List(runID string) ([]shared.Artifact, error)
-	Download(url string, dir safepaths.Absolute) error
+	Download(url string, dir safepaths.Absolute, includePatterns []string, progress *iostreams.ProgressBar) error
 }
 
 type iprompter interface {
@@ -54,6 +58,10 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 			By default, this command downloads the latest artifact created and uploaded through
 			GitHub Actions. Because workflows can delete or overwrite artifacts, %[1]s<run-id>%[1]s
 			must be used to select an artifact from a specific workflow run.
+
+			With %[1]s--include%[1]s, only files within each artifact that match the given glob
+			pattern are extracted. A pattern ending in %[1]s/**%[1]s matches everything under the
+			named directory.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		Example: heredoc.Doc(`
@@ -68,6 +76,9 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 
 			# Select artifacts to download interactively
 			$ gh run download
+
+			# Only extract coverage reports from each downloaded artifact
+			$ gh run download <run-id> --include 'coverage/**'
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -101,6 +112,7 @@ func NewCmdDownload(f *cmdutil.Factory, runF func(*DownloadOptions) error) *cobr
 	cmd.Flags().StringVarP(&opts.DestinationDir, "dir", "D", ".", "The directory to download artifacts into")
 	cmd.Flags().StringArrayVarP(&opts.Names, "name", "n", nil, "Download artifacts that match any of the given names")
 	cmd.Flags().StringArrayVarP(&opts.FilePatterns, "pattern", "p", nil, "Download artifacts that match a glob pattern")
+	cmd.Flags().StringArrayVar(&opts.IncludePatterns, "include", nil, "Only extract artifact files that match a glob `pattern`")
 
 	return cmd
 }
@@ -162,6 +174,12 @@ func runDownload(opts *DownloadOptions) error {
 		return fmt.Errorf("error parsing destination directory: %w", err)
 	}
 
+	type downloadTask struct {
+		artifact shared.Artifact
+		destDir  safepaths.Absolute
+	}
+	var tasks []downloadTask
+
 	for _, a := range artifacts {
 		if a.Expired {
 			continue
@@ -187,18 +205,32 @@ func runDownload(opts *DownloadOptions) error {
 			}
 		}
 
-		err := opts.Platform.Download(a.DownloadURL, destDir)
-		if err != nil {
-			return fmt.Errorf("error downloading %s: %w", a.Name, err)
-		}
+		tasks = append(tasks, downloadTask{artifact: a, destDir: destDir})
 		downloaded.Add(a.Name)
 	}
 
-	if downloaded.Len() == 0 {
+	if len(tasks) == 0 {
 		return errors.New("no artifact matches any of the names or patterns provided")
 	}
 
-	return nil
+	opts.IO.StopProgressIndicator()
+	progress := opts.IO.NewMultiProgress()
+	defer progress.Finish()
+
+	var wg errgroup.Group
+	wg.SetLimit(maxConcurrentDownloads)
+	for _, task := range tasks {
+		bar := progress.AddBar(task.artifact.Name, int64(task.artifact.Size))
+		wg.Go(func() error {
+			defer bar.Finish()
+			if err := opts.Platform.Download(task.artifact.DownloadURL, task.destDir, opts.IncludePatterns, bar); err != nil {
+				return fmt.Errorf("error downloading %s: %w", task.artifact.Name, err)
+			}
+			return nil
+		})
+	}
+
+	return wg.Wait()
 }
 
 func isolateArtifacts(wantNames []string, wantPatterns []string) bool {
@@ -84,6 +84,18 @@ func Test_NewCmdDownload(t *testing.T) {
 				DestinationDir: ".",
 			},
 		},
+		{
+			name:  "with include patterns",
+			args:  "2345 --include coverage/** --include *.md",
+			isTTY: true,
+			want: DownloadOptions{
+				RunID:           "2345",
+				DoPrompt:        false,
+				Names:           []string(nil),
+				IncludePatterns: []string{"coverage/**", "*.md"},
+				DestinationDir:  ".",
+			},
+		},
 		{
 			name:  "repo level with names and patterns",
 			args:  "-p o*e -p tw* -n three -n four",
@@ -140,6 +152,7 @@ func Test_NewCmdDownload(t *testing.T) {
 			assert.Equal(t, tt.want.RunID, opts.RunID)
 			assert.Equal(t, tt.want.Names, opts.Names)
 			assert.Equal(t, tt.want.FilePatterns, opts.FilePatterns)
+			assert.Equal(t, tt.want.IncludePatterns, opts.IncludePatterns)
 			assert.Equal(t, tt.want.DestinationDir, opts.DestinationDir)
 			assert.Equal(t, tt.want.DoPrompt, opts.DoPrompt)
 		})
@@ -186,7 +199,7 @@ func (f *fakePlatform) List(runID string) ([]shared.Artifact, error) {
 	return artifacts, nil
 }
 
-func (f *fakePlatform) Download(url string, dir safepaths.Absolute) error {
+func (f *fakePlatform) Download(url string, dir safepaths.Absolute, includePatterns []string, progress *iostreams.ProgressBar) error {
 	if err := os.MkdirAll(dir.String(), 0755); err != nil {
 		return err
 	}
@@ -199,9 +212,15 @@ func (f *fakePlatform) Download(url string, dir safepaths.Absolute) error {
 		for _, testArtifact := range run.testArtifacts {
 			if testArtifact.artifact.DownloadURL == url {
 				for _, file := range testArtifact.files {
+					if len(includePatterns) > 0 && !matchAnyIncludePattern(includePatterns, file) {
+						continue
+					}
 					path := filepath.Join(dir.String(), file)
-					return os.WriteFile(path, []byte{}, 0600)
+					if err := os.WriteFile(path, []byte{}, 0600); err != nil {
+						return err
+					}
 				}
+				return nil
 			}
 		}
 	}
@@ -580,6 +599,46 @@ func Test_runDownload(t *testing.T) {
 				filepath.Join("artifact-3", "artifact-3-file"),
 			},
 		},
+		{
+			name: "include patterns filter extracted files",
+			opts: DownloadOptions{
+				RunID:           "2345",
+				IncludePatterns: []string{"coverage-*"},
+			},
+			platform: &fakePlatform{
+				runs: []run{
+					{
+						id: "2345",
+						testArtifacts: []testArtifact{
+							{
+								artifact: shared.Artifact{
+									Name:        "artifact-1",
+									DownloadURL: "http://download.com/artifact1.zip",
+									Expired:     false,
+								},
+								files: []string{
+									"coverage-report",
+									"build-log",
+								},
+							},
+							{
+								artifact: shared.Artifact{
+									Name:        "artifact-2",
+									DownloadURL: "http://download.com/artifact2.zip",
+									Expired:     false,
+								},
+								files: []string{
+									"test-results",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedFiles: []string{
+				filepath.Join("artifact-1", "coverage-report"),
+			},
+		},
 		{
 			name: "avoid redownloading files of the same name",
 			opts: DownloadOptions{
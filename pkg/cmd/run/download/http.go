@@ -11,6 +11,7 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/safepaths"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
 type apiPlatform struct {
@@ -22,11 +23,11 @@ func (p *apiPlatform) List(runID string) ([]shared.Artifact, error) {
 	return shared.ListArtifacts(p.client, p.repo, runID)
 }
 
-func (p *apiPlatform) Download(url string, dir safepaths.Absolute) error {
-	return downloadArtifact(p.client, url, dir)
+func (p *apiPlatform) Download(url string, dir safepaths.Absolute, includePatterns []string, progress *iostreams.ProgressBar) error {
+	return downloadArtifact(p.client, url, dir, includePatterns, progress)
 }
 
-func downloadArtifact(httpClient *http.Client, url string, destDir safepaths.Absolute) error {
+func downloadArtifact(httpClient *http.Client, url string, destDir safepaths.Absolute, includePatterns []string, progress *iostreams.ProgressBar) error {
 	req, err := http.NewRequest("GET", url, nil)
 	if err != nil {
 		return err
@@ -44,6 +45,9 @@ func downloadArtifact(httpClient *http.Client, url string, destDir safepaths.Abs
 		return api.HandleHTTPError(resp)
 	}
 
+	// The ZIP central directory lives at the end of the archive, so random
+	// access is needed to extract it. The response body is streamed to a
+	// temporary file rather than being buffered whole in memory.
 	tmpfile, err := os.CreateTemp("", "gh-artifact.*.zip")
 	if err != nil {
 		return fmt.Errorf("error initializing temporary file: %w", err)
@@ -53,7 +57,17 @@ func downloadArtifact(httpClient *http.Client, url string, destDir safepaths.Abs
 		_ = os.Remove(tmpfile.Name())
 	}()
 
-	size, err := io.Copy(tmpfile, resp.Body)
+	var body io.Reader = resp.Body
+	if progress != nil {
+		// The artifact's reported size is its uncompressed size; the response
+		// length reflects what actually goes over the wire.
+		if resp.ContentLength > 0 {
+			progress.SetTotal(resp.ContentLength)
+		}
+		body = progress.ProxyReader(resp.Body)
+	}
+
+	size, err := io.Copy(tmpfile, body)
 	if err != nil {
 		return fmt.Errorf("error writing zip archive: %w", err)
 	}
@@ -62,7 +76,7 @@ func downloadArtifact(httpClient *http.Client, url string, destDir safepaths.Abs
 	if err != nil {
 		return fmt.Errorf("error extracting zip archive: %w", err)
 	}
-	if err := extractZip(zipfile, destDir); err != nil {
+	if err := extractZip(zipfile, destDir, includePatterns); err != nil {
 		return fmt.Errorf("error extracting zip archive: %w", err)
 	}
 
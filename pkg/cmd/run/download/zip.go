@@ -6,7 +6,9 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path"
 	"path/filepath"
+	"strings"
 
 	"github.com/cli/cli/v2/internal/safepaths"
 )
@@ -17,8 +19,12 @@ const (
 	execMode os.FileMode = 0755
 )
 
-func extractZip(zr *zip.Reader, destDir safepaths.Absolute) error {
+func extractZip(zr *zip.Reader, destDir safepaths.Absolute, includePatterns []string) error {
 	for _, zf := range zr.File {
+		if len(includePatterns) > 0 && !matchAnyIncludePattern(includePatterns, zf.Name) {
+			continue
+		}
+
 		fpath, err := destDir.Join(zf.Name)
 		if err != nil {
 			var pathTraversalError safepaths.PathTraversalError
@@ -68,6 +74,33 @@ func extractZipFile(zf *zip.File, dest safepaths.Absolute) (extractErr error) {
 	return
 }
 
+// matchAnyIncludePattern reports whether a zip entry path matches any of the
+// given glob patterns. path.Match has no "**" support, so a pattern ending in
+// "/**" is additionally matched against every parent directory of the entry.
+func matchAnyIncludePattern(patterns []string, name string) bool {
+	name = strings.TrimSuffix(name, "/")
+	for _, p := range patterns {
+		if isMatch, err := path.Match(p, name); err == nil && isMatch {
+			return true
+		}
+		prefix, found := strings.CutSuffix(p, "/**")
+		if !found {
+			continue
+		}
+		for dir := name; ; {
+			if isMatch, err := path.Match(prefix, dir); err == nil && isMatch {
+				return true
+			}
+			parent := path.Dir(dir)
+			if parent == dir || parent == "." {
+				break
+			}
+			dir = parent
+		}
+	}
+	return false
+}
+
 func getPerm(m os.FileMode) os.FileMode {
 	if m&0111 == 0 {
 		return fileMode
@@ -19,9 +19,82 @@ func Test_extractZip(t *testing.T) {
 	require.NoError(t, err)
 	defer zipFile.Close()
 
-	err = extractZip(&zipFile.Reader, extractPath)
+	err = extractZip(&zipFile.Reader, extractPath, nil)
 	require.NoError(t, err)
 
 	_, err = os.Stat(filepath.Join(extractPath.String(), "src", "main.go"))
 	require.NoError(t, err)
 }
+
+func Test_extractZip_includePatterns(t *testing.T) {
+	tmpDir := t.TempDir()
+	extractPath, err := safepaths.ParseAbsolute(filepath.Join(tmpDir, "artifact"))
+	require.NoError(t, err)
+
+	zipFile, err := zip.OpenReader("./fixtures/myproject.zip")
+	require.NoError(t, err)
+	defer zipFile.Close()
+
+	err = extractZip(&zipFile.Reader, extractPath, []string{"src/**"})
+	require.NoError(t, err)
+
+	_, err = os.Stat(filepath.Join(extractPath.String(), "src", "main.go"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(extractPath.String(), "src", "util.go"))
+	require.NoError(t, err)
+	_, err = os.Stat(filepath.Join(extractPath.String(), "readme.md"))
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(filepath.Join(extractPath.String(), "bin"))
+	require.True(t, os.IsNotExist(err))
+}
+
+func Test_matchAnyIncludePattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		entry    string
+		want     bool
+	}{
+		{
+			name:     "plain glob matches file",
+			patterns: []string{"*.md"},
+			entry:    "readme.md",
+			want:     true,
+		},
+		{
+			name:     "plain glob does not cross directories",
+			patterns: []string{"*.go"},
+			entry:    "src/main.go",
+			want:     false,
+		},
+		{
+			name:     "double star matches nested file",
+			patterns: []string{"coverage/**"},
+			entry:    "coverage/html/index.html",
+			want:     true,
+		},
+		{
+			name:     "double star matches directory entry",
+			patterns: []string{"coverage/**"},
+			entry:    "coverage/",
+			want:     true,
+		},
+		{
+			name:     "double star does not match sibling",
+			patterns: []string{"coverage/**"},
+			entry:    "reports/index.html",
+			want:     false,
+		},
+		{
+			name:     "any pattern may match",
+			patterns: []string{"coverage/**", "*.md"},
+			entry:    "readme.md",
+			want:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, matchAnyIncludePattern(tt.patterns, tt.entry))
+		})
+	}
+}
@@ -158,7 +158,13 @@ func listRun(opts *ListOptions) error {
 		return opts.Exporter.Write(opts.IO, runs)
 	}
 
-	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("STATUS", "TITLE", "WORKFLOW", "BRANCH", "EVENT", "ID", "ELAPSED", "AGE"))
+	tp := tableprinter.New(opts.IO, tableprinter.WithHeader("STATUS", "TITLE", "WORKFLOW", "BRANCH", "EVENT", "ID", "ELAPSED", "AGE"),
+		tableprinter.ColumnPolicy{},
+		tableprinter.ColumnPolicy{MinWidth: 20, Priority: 1},
+		tableprinter.ColumnPolicy{MinWidth: 10, Priority: 2},
+		tableprinter.ColumnPolicy{MinWidth: 10, Priority: 3},
+		tableprinter.ColumnPolicy{MinWidth: 6},
+	)
 
 	cs := opts.IO.ColorScheme()
 
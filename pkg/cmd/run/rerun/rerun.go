@@ -7,16 +7,23 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/pmezard/go-difflib/difflib"
 	"github.com/spf13/cobra"
 )
 
+const rerunPollInterval = 3 * time.Second
+
 type RerunOptions struct {
 	HttpClient func() (*http.Client, error)
 	IO         *iostreams.IOStreams
@@ -27,6 +34,8 @@ type RerunOptions struct {
 	OnlyFailed bool
 	JobID      string
 	Debug      bool
+	Watch      bool
+	DiffLogs   bool
 
 	Prompt bool
 }
@@ -54,6 +63,10 @@ func NewCmdRerun(f *cmdutil.Factory, runF func(*RerunOptions) error) *cobra.Comm
 				gh run view <run-id> --json jobs --jq '.jobs[] | {name, databaseId}'
 
 			You will need to use databaseId field for triggering job re-runs.
+
+			With %[1]s--watch%[1]s, wait until the rerun has completed. Adding %[1]s--diff-logs%[1]s then prints a
+			unified diff between the failing job logs of the previous and the new attempt,
+			to highlight what changed between reruns.
 		`, "`"),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -78,6 +91,14 @@ func NewCmdRerun(f *cmdutil.Factory, runF func(*RerunOptions) error) *cobra.Comm
 				}
 			}
 
+			if opts.DiffLogs && !opts.Watch {
+				return cmdutil.FlagErrorf("`--diff-logs` requires `--watch`")
+			}
+
+			if opts.JobID != "" && opts.Watch {
+				return cmdutil.FlagErrorf("`--watch` cannot be used with `--job`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -88,6 +109,8 @@ func NewCmdRerun(f *cmdutil.Factory, runF func(*RerunOptions) error) *cobra.Comm
 	cmd.Flags().BoolVar(&opts.OnlyFailed, "failed", false, "Rerun only failed jobs, including dependencies")
 	cmd.Flags().StringVarP(&opts.JobID, "job", "j", "", "Rerun a specific job ID from a run, including dependencies")
 	cmd.Flags().BoolVarP(&opts.Debug, "debug", "d", false, "Rerun with debug logging")
+	cmd.Flags().BoolVar(&opts.Watch, "watch", false, "Watch the rerun until it completes")
+	cmd.Flags().BoolVar(&opts.DiffLogs, "diff-logs", false, "Print a diff of failing job logs between attempts once the rerun completes")
 
 	return cmd
 }
@@ -165,6 +188,16 @@ func runRerun(opts *RerunOptions) error {
 			return fmt.Errorf("failed to get run: %w", err)
 		}
 
+		var previousFailedLogs map[string]string
+		if opts.DiffLogs {
+			opts.IO.StartProgressIndicator()
+			previousFailedLogs, err = failedJobLogs(c, client, repo, run)
+			opts.IO.StopProgressIndicator()
+			if err != nil {
+				return fmt.Errorf("failed to get logs for failed jobs: %w", err)
+			}
+		}
+
 		err = rerunRun(client, repo, run, opts.OnlyFailed, opts.Debug)
 		if err != nil {
 			return err
@@ -180,11 +213,146 @@ func runRerun(opts *RerunOptions) error {
 				cs.Cyanf("%d", run.ID),
 				debugMsg)
 		}
+
+		if opts.Watch {
+			opts.IO.StartProgressIndicator()
+			run, err = waitForRerun(client, repo, fmt.Sprintf("%d", run.ID))
+			opts.IO.StopProgressIndicator()
+			if err != nil {
+				return err
+			}
+
+			if opts.IO.IsStdoutTTY() {
+				symbol, symbolColor := shared.Symbol(cs, run.Status, run.Conclusion)
+				fmt.Fprintf(opts.IO.Out, "%s Run %s (%s) completed with '%s'\n",
+					symbolColor(symbol),
+					cs.Bold(run.WorkflowName()),
+					cs.Cyanf("%d", run.ID),
+					run.Conclusion)
+			}
+
+			if opts.DiffLogs {
+				opts.IO.StartProgressIndicator()
+				newFailedLogs, err := failedJobLogs(c, client, repo, run)
+				opts.IO.StopProgressIndicator()
+				if err != nil {
+					return fmt.Errorf("failed to get logs for failed jobs: %w", err)
+				}
+				return printLogDiffs(opts.IO, previousFailedLogs, newFailedLogs)
+			}
+		}
 	}
 
 	return nil
 }
 
+func waitForRerun(client *api.Client, repo ghrepo.Interface, runID string) (*shared.Run, error) {
+	for {
+		run, err := shared.GetRun(client, repo, runID, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get run: %w", err)
+		}
+		if run.Status == shared.Completed {
+			return run, nil
+		}
+		time.Sleep(rerunPollInterval)
+	}
+}
+
+// failedJobLogs returns the log of every failed job of a run, keyed by job
+// name so that logs can be compared across attempts, where job IDs differ.
+func failedJobLogs(httpClient *http.Client, client *api.Client, repo ghrepo.Interface, run *shared.Run) (map[string]string, error) {
+	jobs, err := shared.GetJobs(client, repo, run, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := map[string]string{}
+	for _, job := range jobs {
+		if !shared.IsFailureState(job.Conclusion) {
+			continue
+		}
+		log, err := getJobLog(httpClient, repo, job.ID)
+		if err != nil {
+			return nil, err
+		}
+		logs[job.Name] = log
+	}
+	return logs, nil
+}
+
+func getJobLog(httpClient *http.Client, repo ghrepo.Interface, jobID int64) (string, error) {
+	logURL := fmt.Sprintf("%srepos/%s/actions/jobs/%d/logs",
+		ghinstance.RESTPrefix(repo.RepoHost()), ghrepo.FullName(repo), jobID)
+
+	req, err := http.NewRequest("GET", logURL, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != 200 {
+		return "", api.HandleHTTPError(resp)
+	}
+
+	log, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(log), nil
+}
+
+func printLogDiffs(io *iostreams.IOStreams, previous, current map[string]string) error {
+	cs := io.ColorScheme()
+
+	jobNames := make([]string, 0, len(current))
+	for jobName := range current {
+		jobNames = append(jobNames, jobName)
+	}
+	sort.Strings(jobNames)
+
+	if len(jobNames) == 0 {
+		fmt.Fprintf(io.Out, "%s No jobs failed on the new attempt\n", cs.SuccessIcon())
+		return nil
+	}
+
+	for _, jobName := range jobNames {
+		diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        splitLogLines(previous[jobName]),
+			B:        splitLogLines(current[jobName]),
+			FromFile: fmt.Sprintf("%s (previous attempt)", jobName),
+			ToFile:   fmt.Sprintf("%s (new attempt)", jobName),
+			Context:  3,
+		})
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintln(io.Out)
+		fmt.Fprintln(io.Out, cs.Bold(jobName))
+		if diff == "" {
+			fmt.Fprintln(io.Out, "no changes in failing job log")
+			continue
+		}
+		fmt.Fprint(io.Out, diff)
+	}
+	return nil
+}
+
+// splitLogLines prepares a log for diffing. Unlike difflib.SplitLines, it does
+// not turn the trailing newline of a log into a phantom blank line.
+func splitLogLines(log string) []string {
+	if log == "" {
+		return nil
+	}
+	return difflib.SplitLines(strings.TrimSuffix(log, "\n"))
+}
+
 func rerunRun(client *api.Client, repo ghrepo.Interface, run *shared.Run, onlyFailed, debug bool) error {
 	runVerb := "rerun"
 	if onlyFailed {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmd/run/shared"
@@ -122,6 +123,26 @@ func TestNewCmdRerun(t *testing.T) {
 				Debug: false,
 			},
 		},
+		{
+			name: "watch and diff logs",
+			cli:  "4321 --failed --watch --diff-logs",
+			wants: RerunOptions{
+				RunID:      "4321",
+				OnlyFailed: true,
+				Watch:      true,
+				DiffLogs:   true,
+			},
+		},
+		{
+			name:     "diff logs without watch fails",
+			cli:      "4321 --diff-logs",
+			wantsErr: true,
+		},
+		{
+			name:     "watch with job fails",
+			cli:      "--job 1234 --watch",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -157,6 +178,8 @@ func TestNewCmdRerun(t *testing.T) {
 
 			assert.Equal(t, tt.wants.RunID, gotOpts.RunID)
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
+			assert.Equal(t, tt.wants.Watch, gotOpts.Watch)
+			assert.Equal(t, tt.wants.DiffLogs, gotOpts.DiffLogs)
 		})
 	}
 
@@ -306,6 +329,106 @@ func TestRerun(t *testing.T) {
 			wantOut:   "✓ Requested rerun of job 20 on run 1234 with debug logging enabled\n",
 			wantDebug: true,
 		},
+		{
+			name: "failed jobs with watch and diff logs",
+			tty:  true,
+			opts: &RerunOptions{
+				RunID:      "1234",
+				OnlyFailed: true,
+				Watch:      true,
+				DiffLogs:   true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/1234/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{shared.FailedJob},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20/logs"),
+					httpmock.StringResponse("step 1 ok\nstep 2 fail: exit 1\n"))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/rerun-failed-jobs"),
+					httpmock.StringResponse("{}"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/1234/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{shared.FailedJob},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20/logs"),
+					httpmock.StringResponse("step 1 ok\nstep 2 fail: exit 2\n"))
+			},
+			wantOut: heredoc.Doc(`
+				✓ Requested rerun (failed jobs) of run 1234
+				X Run CI (1234) completed with 'failure'
+
+				sad job
+				--- sad job (previous attempt)
+				+++ sad job (new attempt)
+				@@ -1,2 +1,2 @@
+				 step 1 ok
+				-step 2 fail: exit 1
+				+step 2 fail: exit 2
+			`),
+		},
+		{
+			name: "failed jobs with watch and diff logs, new attempt succeeds",
+			tty:  true,
+			opts: &RerunOptions{
+				RunID:      "1234",
+				OnlyFailed: true,
+				Watch:      true,
+				DiffLogs:   true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/1234/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{shared.FailedJob},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/jobs/20/logs"),
+					httpmock.StringResponse("step 1 ok\nstep 2 fail: exit 1\n"))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/runs/1234/rerun-failed-jobs"),
+					httpmock.StringResponse("{}"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.SuccessfulRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{shared.SuccessfulJob},
+					}))
+			},
+			wantOut: heredoc.Doc(`
+				✓ Requested rerun (failed jobs) of run 1234
+				✓ Run CI (3) completed with 'success'
+				✓ No jobs failed on the new attempt
+			`),
+		},
 		{
 			name: "prompt",
 			tty:  true,
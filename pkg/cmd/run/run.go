@@ -6,6 +6,7 @@ import (
 	cmdDownload "github.com/cli/cli/v2/pkg/cmd/run/download"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/run/list"
 	cmdRerun "github.com/cli/cli/v2/pkg/cmd/run/rerun"
+	cmdStats "github.com/cli/cli/v2/pkg/cmd/run/stats"
 	cmdView "github.com/cli/cli/v2/pkg/cmd/run/view"
 	cmdWatch "github.com/cli/cli/v2/pkg/cmd/run/watch"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -28,6 +29,7 @@ func NewCmdRun(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdWatch.NewCmdWatch(f, nil))
 	cmd.AddCommand(cmdCancel.NewCmdCancel(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdStats.NewCmdStats(f, nil))
 
 	return cmd
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/iostreams"
 )
 
@@ -47,6 +48,63 @@ func RenderJobs(cs *iostreams.ColorScheme, jobs []Job, verbose bool) string {
 	return strings.Join(lines, "\n")
 }
 
+// RenderAnnotationSummary aggregates annotations into per-job counts of
+// failures, warnings, and notices, which is easier to scan than the full
+// annotation listing when a run has many jobs.
+func RenderAnnotationSummary(cs *iostreams.ColorScheme, annotations []Annotation) string {
+	type annotationCounts struct {
+		failures int
+		warnings int
+		notices  int
+	}
+
+	jobNames := []string{}
+	countsByJob := map[string]*annotationCounts{}
+	var totalFailures, totalWarnings int
+
+	for _, a := range annotations {
+		counts, ok := countsByJob[a.JobName]
+		if !ok {
+			counts = &annotationCounts{}
+			countsByJob[a.JobName] = counts
+			jobNames = append(jobNames, a.JobName)
+		}
+		switch a.Level {
+		case AnnotationFailure:
+			counts.failures++
+			totalFailures++
+		case AnnotationWarning:
+			counts.warnings++
+			totalWarnings++
+		default:
+			counts.notices++
+		}
+	}
+
+	lines := []string{}
+	for _, name := range jobNames {
+		counts := countsByJob[name]
+		parts := []string{}
+		if counts.failures > 0 {
+			parts = append(parts, text.Pluralize(counts.failures, "failure"))
+		}
+		if counts.warnings > 0 {
+			parts = append(parts, text.Pluralize(counts.warnings, "warning"))
+		}
+		if counts.notices > 0 {
+			parts = append(parts, text.Pluralize(counts.notices, "notice"))
+		}
+		lines = append(lines, fmt.Sprintf("%s: %s", cs.Bold(name), strings.Join(parts, ", ")))
+	}
+	lines = append(lines, "")
+	lines = append(lines, cs.Mutedf("%s and %s in %s",
+		text.Pluralize(totalFailures, "failure"),
+		text.Pluralize(totalWarnings, "warning"),
+		text.Pluralize(len(jobNames), "job")))
+
+	return strings.Join(lines, "\n")
+}
+
 func RenderAnnotations(cs *iostreams.ColorScheme, annotations []Annotation) string {
 	lines := []string{}
 
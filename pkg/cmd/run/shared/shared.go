@@ -85,7 +85,7 @@ var RunFields = []string{
 	"url",
 }
 
-var SingleRunFields = append(RunFields, "jobs")
+var SingleRunFields = append(RunFields, "jobs", "annotations")
 
 type Run struct {
 	Name           string    `json:"name"` // the semantics of this field are unclear
@@ -97,17 +97,18 @@ type Run struct {
 	Conclusion     Conclusion
 	Event          string
 	ID             int64
-	workflowName   string // cache column
-	WorkflowID     int64  `json:"workflow_id"`
-	Number         int64  `json:"run_number"`
-	Attempt        uint64 `json:"run_attempt"`
-	HeadBranch     string `json:"head_branch"`
-	JobsURL        string `json:"jobs_url"`
-	HeadCommit     Commit `json:"head_commit"`
-	HeadSha        string `json:"head_sha"`
-	URL            string `json:"html_url"`
-	HeadRepository Repo   `json:"head_repository"`
-	Jobs           []Job  `json:"-"` // populated by GetJobs
+	workflowName   string       // cache column
+	WorkflowID     int64        `json:"workflow_id"`
+	Number         int64        `json:"run_number"`
+	Attempt        uint64       `json:"run_attempt"`
+	HeadBranch     string       `json:"head_branch"`
+	JobsURL        string       `json:"jobs_url"`
+	HeadCommit     Commit       `json:"head_commit"`
+	HeadSha        string       `json:"head_sha"`
+	URL            string       `json:"html_url"`
+	HeadRepository Repo         `json:"head_repository"`
+	Jobs           []Job        `json:"-"` // populated by GetJobs
+	Annotations    []Annotation `json:"-"` // populated by GetAnnotations
 }
 
 func (r *Run) StartedTime() time.Time {
@@ -211,6 +212,18 @@ func (r *Run) ExportData(fields []string) map[string]interface{} {
 				})
 			}
 			data[f] = jobs
+		case "annotations":
+			annotations := make([]interface{}, 0, len(r.Annotations))
+			for _, a := range r.Annotations {
+				annotations = append(annotations, map[string]interface{}{
+					"jobName":   a.JobName,
+					"level":     a.Level,
+					"message":   a.Message,
+					"path":      a.Path,
+					"startLine": a.StartLine,
+				})
+			}
+			data[f] = annotations
 		default:
 			sf := fieldByName(v, f)
 			data[f] = sf.Interface()
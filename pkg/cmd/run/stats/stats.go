@@ -0,0 +1,357 @@
+package stats
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	workflowShared "github.com/cli/cli/v2/pkg/cmd/workflow/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+)
+
+const defaultLast = 20
+
+// maxFailingJobs caps how many entries the most-failing-jobs list shows.
+const maxFailingJobs = 5
+
+var statsFields = []string{
+	"runs",
+	"failures",
+	"failureRate",
+	"averageDuration",
+	"medianDuration",
+	"failingJobs",
+	"billableMinutes",
+}
+
+type StatsOptions struct {
+	IO         *iostreams.IOStreams
+	HttpClient func() (*http.Client, error)
+	BaseRepo   func() (ghrepo.Interface, error)
+	Prompter   iprompter
+
+	Exporter cmdutil.Exporter
+
+	WorkflowSelector string
+	Branch           string
+	Last             int
+	CSV              bool
+
+	now time.Time
+}
+
+type iprompter interface {
+	Select(string, string, []string) (int, error)
+}
+
+func NewCmdStats(f *cmdutil.Factory, runF func(*StatsOptions) error) *cobra.Command {
+	opts := &StatsOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+		Prompter:   f.Prompter,
+		now:        time.Now(),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Summarize duration, failures, and billable minutes of recent runs",
+		Long: heredoc.Docf(`
+			Summarize recent workflow runs: average and median duration, failure rate,
+			the jobs that fail most often, and billable minutes by runner type.
+
+			Only completed runs are counted. Use %[1]s--csv%[1]s to dump the underlying
+			per-run data for a spreadsheet instead of the computed summary.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Summarize the last 50 runs of a workflow
+			$ gh run stats --workflow build.yml --last 50
+
+			# Export per-run durations and billable minutes for a spreadsheet
+			$ gh run stats --workflow build.yml --csv > runs.csv
+		`),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			// support `-R, --repo` override
+			opts.BaseRepo = f.BaseRepo
+
+			if opts.Last < 1 {
+				return cmdutil.FlagErrorf("invalid number of runs: %v", opts.Last)
+			}
+			if opts.CSV && opts.Exporter != nil {
+				return cmdutil.FlagErrorf("cannot use `--csv` with `--json`")
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+
+			return statsRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.WorkflowSelector, "workflow", "w", "", "Limit statistics to runs of a workflow")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Limit statistics to runs on a branch")
+	cmd.Flags().IntVarP(&opts.Last, "last", "L", defaultLast, "Number of recent runs to summarize")
+	cmd.Flags().BoolVar(&opts.CSV, "csv", false, "Output per-run data as CSV instead of a summary")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, statsFields)
+
+	_ = cmdutil.RegisterBranchCompletionFlags(f.GitClient, cmd, "branch")
+
+	return cmd
+}
+
+// jobFailureCount is one entry of the most-failing-jobs list.
+type jobFailureCount struct {
+	Name     string `json:"name"`
+	Failures int    `json:"failures"`
+}
+
+// runStatsSummary aggregates a window of completed workflow runs.
+type runStatsSummary struct {
+	Runs            int                `json:"runs"`
+	Failures        int                `json:"failures"`
+	FailureRate     float64            `json:"failureRate"`
+	AverageDuration string             `json:"averageDuration"`
+	MedianDuration  string             `json:"medianDuration"`
+	FailingJobs     []jobFailureCount  `json:"failingJobs"`
+	BillableMinutes map[string]float64 `json:"billableMinutes"`
+}
+
+func (s *runStatsSummary) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(s, fields)
+}
+
+// runTiming is the subset of the workflow run timing API that billable
+// minutes are computed from.
+type runTiming struct {
+	Billable map[string]struct {
+		TotalMS int64 `json:"total_ms"`
+	} `json:"billable"`
+}
+
+func statsRun(opts *StatsOptions) error {
+	baseRepo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	filters := &shared.FilterOptions{
+		Branch: opts.Branch,
+		Status: string(shared.Completed),
+	}
+
+	opts.IO.StartProgressIndicator()
+	defer opts.IO.StopProgressIndicator()
+
+	if opts.WorkflowSelector != "" {
+		states := []workflowShared.WorkflowState{workflowShared.Active}
+		workflow, err := workflowShared.ResolveWorkflow(opts.Prompter, opts.IO, client, baseRepo, false, opts.WorkflowSelector, states)
+		if err != nil {
+			return err
+		}
+		filters.WorkflowID = workflow.ID
+		filters.WorkflowName = workflow.Name
+	}
+
+	runsResult, err := shared.GetRuns(client, baseRepo, filters, opts.Last)
+	if err != nil {
+		return fmt.Errorf("failed to get runs: %w", err)
+	}
+	runs := runsResult.WorkflowRuns
+	if len(runs) == 0 {
+		return cmdutil.NewNoResultsError("no completed runs found")
+	}
+
+	timings := make(map[int64]*runTiming, len(runs))
+	for i := range runs {
+		// The timing API has no data for some runs, e.g. ones that only used
+		// self-hosted runners; treat those as having no billable time.
+		if timing, err := getRunTiming(client, baseRepo, runs[i].ID); err == nil {
+			timings[runs[i].ID] = timing
+		}
+	}
+
+	if opts.CSV {
+		opts.IO.StopProgressIndicator()
+		return printRunsCSV(opts.IO, runs, timings, opts.now)
+	}
+
+	var failedRuns []*shared.Run
+	for i := range runs {
+		if runs[i].Conclusion == shared.Failure {
+			failedRuns = append(failedRuns, &runs[i])
+		}
+	}
+	failingJobs := make(map[string]int)
+	for _, run := range failedRuns {
+		jobs, err := shared.GetJobs(client, baseRepo, run, 0)
+		if err != nil {
+			return fmt.Errorf("failed to get jobs for run %d: %w", run.ID, err)
+		}
+		for _, job := range jobs {
+			if job.Conclusion == shared.Failure {
+				failingJobs[job.Name]++
+			}
+		}
+	}
+
+	summary := summarizeRuns(runs, failingJobs, timings, opts.now)
+
+	opts.IO.StopProgressIndicator()
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, summary)
+	}
+
+	printSummary(opts.IO, summary, filters.WorkflowName)
+	return nil
+}
+
+func getRunTiming(client *api.Client, repo ghrepo.Interface, runID int64) (*runTiming, error) {
+	path := fmt.Sprintf("repos/%s/actions/runs/%d/timing", ghrepo.FullName(repo), runID)
+	var result runTiming
+	if err := client.REST(repo.RepoHost(), "GET", path, nil, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func summarizeRuns(runs []shared.Run, failingJobs map[string]int, timings map[int64]*runTiming, now time.Time) *runStatsSummary {
+	summary := &runStatsSummary{
+		BillableMinutes: map[string]float64{},
+	}
+
+	var durations []time.Duration
+	var total time.Duration
+	for i := range runs {
+		run := &runs[i]
+		summary.Runs++
+		if run.Conclusion == shared.Failure {
+			summary.Failures++
+		}
+		d := run.Duration(now)
+		durations = append(durations, d)
+		total += d
+
+		if timing := timings[run.ID]; timing != nil {
+			for runner, usage := range timing.Billable {
+				summary.BillableMinutes[runner] += float64(usage.TotalMS) / 1000 / 60
+			}
+		}
+	}
+
+	if summary.Runs > 0 {
+		summary.FailureRate = float64(summary.Failures) / float64(summary.Runs)
+		summary.AverageDuration = (total / time.Duration(summary.Runs)).Round(time.Second).String()
+		summary.MedianDuration = medianDuration(durations).String()
+	}
+
+	for name, count := range failingJobs {
+		summary.FailingJobs = append(summary.FailingJobs, jobFailureCount{Name: name, Failures: count})
+	}
+	sort.Slice(summary.FailingJobs, func(i, j int) bool {
+		if summary.FailingJobs[i].Failures != summary.FailingJobs[j].Failures {
+			return summary.FailingJobs[i].Failures > summary.FailingJobs[j].Failures
+		}
+		return summary.FailingJobs[i].Name < summary.FailingJobs[j].Name
+	})
+	if len(summary.FailingJobs) > maxFailingJobs {
+		summary.FailingJobs = summary.FailingJobs[:maxFailingJobs]
+	}
+
+	return summary
+}
+
+func medianDuration(durations []time.Duration) time.Duration {
+	if len(durations) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(durations))
+	copy(sorted, durations)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return ((sorted[middle-1] + sorted[middle]) / 2).Round(time.Second)
+	}
+	return sorted[middle].Round(time.Second)
+}
+
+func printSummary(ios *iostreams.IOStreams, summary *runStatsSummary, workflowName string) {
+	out := ios.Out
+	cs := ios.ColorScheme()
+
+	subject := fmt.Sprintf("last %d completed runs", summary.Runs)
+	if workflowName != "" {
+		subject += fmt.Sprintf(" of %s", workflowName)
+	}
+	fmt.Fprintf(out, "Statistics for the %s:\n\n", cs.Bold(subject))
+	fmt.Fprintf(out, "Runs: %d (%d passed or skipped, %d failed)\n", summary.Runs, summary.Runs-summary.Failures, summary.Failures)
+	fmt.Fprintf(out, "Failure rate: %.1f%%\n", summary.FailureRate*100)
+	fmt.Fprintf(out, "Average duration: %s\n", summary.AverageDuration)
+	fmt.Fprintf(out, "Median duration: %s\n", summary.MedianDuration)
+
+	if len(summary.FailingJobs) > 0 {
+		fmt.Fprintf(out, "\nMost failing jobs:\n")
+		for _, job := range summary.FailingJobs {
+			fmt.Fprintf(out, "  %s: %d\n", job.Name, job.Failures)
+		}
+	}
+
+	if len(summary.BillableMinutes) > 0 {
+		runners := make([]string, 0, len(summary.BillableMinutes))
+		for runner := range summary.BillableMinutes {
+			runners = append(runners, runner)
+		}
+		sort.Strings(runners)
+		fmt.Fprintf(out, "\nBillable minutes by runner:\n")
+		for _, runner := range runners {
+			fmt.Fprintf(out, "  %s: %.1f\n", runner, summary.BillableMinutes[runner])
+		}
+	}
+}
+
+func printRunsCSV(ios *iostreams.IOStreams, runs []shared.Run, timings map[int64]*runTiming, now time.Time) error {
+	w := csv.NewWriter(ios.Out)
+	if err := w.Write([]string{"id", "workflow", "branch", "started_at", "duration_seconds", "conclusion", "billable_minutes"}); err != nil {
+		return err
+	}
+	for i := range runs {
+		run := &runs[i]
+		var billable float64
+		if timing := timings[run.ID]; timing != nil {
+			for _, usage := range timing.Billable {
+				billable += float64(usage.TotalMS) / 1000 / 60
+			}
+		}
+		record := []string{
+			strconv.FormatInt(run.ID, 10),
+			run.WorkflowName(),
+			run.HeadBranch,
+			run.StartedTime().Format(time.RFC3339),
+			strconv.FormatFloat(run.Duration(now).Seconds(), 'f', 0, 64),
+			string(run.Conclusion),
+			strconv.FormatFloat(billable, 'f', 1, 64),
+		}
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
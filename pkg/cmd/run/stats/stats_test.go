@@ -0,0 +1,227 @@
+package stats
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/run/shared"
+	workflowShared "github.com/cli/cli/v2/pkg/cmd/workflow/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdStats(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    StatsOptions
+		wantsErr bool
+	}{
+		{
+			name: "blank",
+			wants: StatsOptions{
+				Last: defaultLast,
+			},
+		},
+		{
+			name: "workflow and last",
+			cli:  "--workflow build.yml --last 50",
+			wants: StatsOptions{
+				WorkflowSelector: "build.yml",
+				Last:             50,
+			},
+		},
+		{
+			name: "csv",
+			cli:  "--csv",
+			wants: StatsOptions{
+				Last: defaultLast,
+				CSV:  true,
+			},
+		},
+		{
+			name:     "invalid last",
+			cli:      "--last 0",
+			wantsErr: true,
+		},
+		{
+			name:     "csv with json",
+			cli:      "--csv --json runs",
+			wantsErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *StatsOptions
+			cmd := NewCmdStats(f, func(opts *StatsOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Last, gotOpts.Last)
+			assert.Equal(t, tt.wants.WorkflowSelector, gotOpts.WorkflowSelector)
+			assert.Equal(t, tt.wants.CSV, gotOpts.CSV)
+		})
+	}
+}
+
+func TestStatsRun(t *testing.T) {
+	timing := func(minutes map[string]int64) httpmock.Responder {
+		billable := map[string]interface{}{}
+		for runner, ms := range minutes {
+			billable[runner] = map[string]int64{"total_ms": ms}
+		}
+		return httpmock.JSONResponse(map[string]interface{}{"billable": billable})
+	}
+
+	stubRuns := func(reg *httpmock.Registry) {
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+			httpmock.JSONResponse(shared.RunsPayload{
+				WorkflowRuns: []shared.Run{shared.SuccessfulRun, shared.FailedRun},
+			}))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+			httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+				Workflows: []workflowShared.Workflow{shared.TestWorkflow},
+			}))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/timing"),
+			timing(map[string]int64{"UBUNTU": 120000}))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234/timing"),
+			timing(map[string]int64{"UBUNTU": 60000, "MACOS": 30000}))
+	}
+
+	t.Run("summary output", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		stubRuns(reg)
+		reg.Register(
+			httpmock.REST("GET", "runs/1234/jobs"),
+			httpmock.JSONResponse(shared.JobsPayload{
+				Jobs: []shared.Job{shared.FailedJob},
+			}))
+
+		ios, _, stdout, _ := iostreams.Test()
+		opts := &StatsOptions{
+			IO: ios,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			},
+			Last: defaultLast,
+			now:  shared.TestRunStartTime.Add(time.Minute*4 + time.Second*34),
+		}
+
+		err := statsRun(opts)
+		assert.NoError(t, err)
+		assert.Equal(t, heredoc.Doc(`
+			Statistics for the last 2 completed runs:
+
+			Runs: 2 (1 passed or skipped, 1 failed)
+			Failure rate: 50.0%
+			Average duration: 4m34s
+			Median duration: 4m34s
+
+			Most failing jobs:
+			  sad job: 1
+
+			Billable minutes by runner:
+			  MACOS: 0.5
+			  UBUNTU: 3.0
+		`), stdout.String())
+	})
+
+	t.Run("csv output", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		stubRuns(reg)
+
+		ios, _, stdout, _ := iostreams.Test()
+		opts := &StatsOptions{
+			IO: ios,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			},
+			Last: defaultLast,
+			CSV:  true,
+			now:  shared.TestRunStartTime.Add(time.Minute*4 + time.Second*34),
+		}
+
+		err := statsRun(opts)
+		assert.NoError(t, err)
+		assert.Equal(t, heredoc.Doc(`
+			id,workflow,branch,started_at,duration_seconds,conclusion,billable_minutes
+			3,CI,trunk,2021-02-23T04:51:00Z,274,success,2.0
+			1234,CI,trunk,2021-02-23T04:51:00Z,274,failure,1.5
+		`), stdout.String())
+	})
+
+	t.Run("no runs", func(t *testing.T) {
+		reg := &httpmock.Registry{}
+		defer reg.Verify(t)
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+			httpmock.JSONResponse(shared.RunsPayload{}))
+
+		ios, _, _, _ := iostreams.Test()
+		opts := &StatsOptions{
+			IO: ios,
+			HttpClient: func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			},
+			BaseRepo: func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			},
+			Last: defaultLast,
+		}
+
+		err := statsRun(opts)
+		var noResults cmdutil.NoResultsError
+		assert.ErrorAs(t, err, &noResults)
+	})
+}
+
+func Test_medianDuration(t *testing.T) {
+	assert.Equal(t, time.Duration(0), medianDuration(nil))
+	assert.Equal(t, 2*time.Minute, medianDuration([]time.Duration{
+		3 * time.Minute, time.Minute, 2 * time.Minute,
+	}))
+	assert.Equal(t, 150*time.Second, medianDuration([]time.Duration{
+		time.Minute, 2 * time.Minute, 3 * time.Minute, 4 * time.Minute,
+	}))
+}
@@ -3,7 +3,6 @@ package view
 import (
 	"archive/zip"
 	"bufio"
-	"bytes"
 	"errors"
 	"fmt"
 	"io"
@@ -65,6 +64,14 @@ func (c RunLogCache) Create(key string, content io.Reader) error {
 	return nil
 }
 
+func (c RunLogCache) Remove(key string) error {
+	if err := os.Remove(c.filepath(key)); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("removing cache entry: %v", err)
+	}
+
+	return nil
+}
+
 func (c RunLogCache) Open(key string) (*zip.ReadCloser, error) {
 	r, err := zip.OpenReader(c.filepath(key))
 	if err != nil {
@@ -86,14 +93,17 @@ type ViewOptions struct {
 	Prompter    shared.Prompter
 	RunLogCache RunLogCache
 
-	RunID      string
-	JobID      string
-	Verbose    bool
-	ExitStatus bool
-	Log        bool
-	LogFailed  bool
-	Web        bool
-	Attempt    uint64
+	RunID       string
+	JobID       string
+	Verbose     bool
+	ExitStatus  bool
+	Log         bool
+	LogFailed   bool
+	LogStep     string
+	LogSince    string
+	Annotations bool
+	Web         bool
+	Attempt     uint64
 
 	Prompt   bool
 	Exporter cmdutil.Exporter
@@ -137,9 +147,18 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 			# View a specific job within a run
 			$ gh run view --job 456789
 
+			# Summarize annotations across the jobs of a run
+			$ gh run view 12345 --annotations
+
 			# View the full log for a specific job
 			$ gh run view --log --job 456789
 
+			# View only the log lines of a single step
+			$ gh run view 12345 --log --step "Run tests"
+
+			# View log lines from the last five minutes of a run
+			$ gh run view 12345 --log --since 5m
+
 			# Exit non-zero if a run failed
 			$ gh run view 0451 --exit-status && echo "run pending or passed"
 		`),
@@ -182,6 +201,14 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 				return cmdutil.FlagErrorf("specify only one of --log or --log-failed")
 			}
 
+			if opts.Annotations && (opts.Log || opts.LogFailed) {
+				return cmdutil.FlagErrorf("specify only one of --annotations or --log")
+			}
+
+			if (opts.LogStep != "" || opts.LogSince != "") && !opts.Log && !opts.LogFailed {
+				return cmdutil.FlagErrorf("`--step` and `--since` require `--log` or `--log-failed`")
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -194,6 +221,9 @@ func NewCmdView(f *cmdutil.Factory, runF func(*ViewOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.JobID, "job", "j", "", "View a specific job ID from a run")
 	cmd.Flags().BoolVar(&opts.Log, "log", false, "View full log for either a run or specific job")
 	cmd.Flags().BoolVar(&opts.LogFailed, "log-failed", false, "View the log for any failed steps in a run or specific job")
+	cmd.Flags().StringVar(&opts.LogStep, "step", "", "Only display log lines for the step with the given `name`")
+	cmd.Flags().StringVar(&opts.LogSince, "since", "", "Only display log lines after the given `time` (a duration like 5m, or an RFC 3339 timestamp)")
+	cmd.Flags().BoolVar(&opts.Annotations, "annotations", false, "Show an aggregated summary of job annotations")
 	cmd.Flags().BoolVarP(&opts.Web, "web", "w", false, "Open run in the browser")
 	cmd.Flags().Uint64VarP(&opts.Attempt, "attempt", "a", 0, "The attempt number of the workflow run")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.SingleRunFields)
@@ -279,6 +309,16 @@ func runView(opts *ViewOptions) error {
 	}
 
 	if opts.Exporter != nil {
+		if exportsAnnotations(opts.Exporter) {
+			annotations, missingPermissions, err := getJobAnnotations(client, repo, jobs)
+			if err != nil {
+				return fmt.Errorf("failed to get annotations: %w", err)
+			}
+			if missingPermissions {
+				return errors.New(missingAnnotationsPermissionsMsg)
+			}
+			run.Annotations = annotations
+		}
 		return opts.Exporter.Write(opts.IO, run)
 	}
 
@@ -314,6 +354,14 @@ func runView(opts *ViewOptions) error {
 			return fmt.Errorf("run %d is still in progress; logs will be available when it is complete", run.ID)
 		}
 
+		var since time.Time
+		if opts.LogSince != "" {
+			since, err = parseSince(opts.Now(), opts.LogSince)
+			if err != nil {
+				return err
+			}
+		}
+
 		opts.IO.StartProgressIndicator()
 		runLogZip, err := getRunLog(opts.RunLogCache, httpClient, repo, run, attempt)
 		opts.IO.StopProgressIndicator()
@@ -324,7 +372,7 @@ func runView(opts *ViewOptions) error {
 
 		attachRunLog(&runLogZip.Reader, jobs)
 
-		return displayRunLog(opts.IO.Out, jobs, opts.LogFailed)
+		return displayRunLog(opts.IO.Out, jobs, opts.LogFailed, opts.LogStep, since)
 	}
 
 	prNumber := ""
@@ -341,20 +389,9 @@ func runView(opts *ViewOptions) error {
 		}
 	}
 
-	var annotations []shared.Annotation
-	var missingAnnotationsPermissions bool
-
-	for _, job := range jobs {
-		as, err := shared.GetAnnotations(client, repo, job)
-		if err != nil {
-			if err != shared.ErrMissingAnnotationsPermissions {
-				return fmt.Errorf("failed to get annotations: %w", err)
-			}
-
-			missingAnnotationsPermissions = true
-			break
-		}
-		annotations = append(annotations, as...)
+	annotations, missingAnnotationsPermissions, err := getJobAnnotations(client, repo, jobs)
+	if err != nil {
+		return fmt.Errorf("failed to get annotations: %w", err)
 	}
 
 	out := opts.IO.Out
@@ -387,11 +424,16 @@ func runView(opts *ViewOptions) error {
 	if missingAnnotationsPermissions {
 		fmt.Fprintln(out)
 		fmt.Fprintln(out, cs.Bold("ANNOTATIONS"))
-		fmt.Fprintln(out, "requesting annotations returned 403 Forbidden as the token does not have sufficient permissions. Note that it is not currently possible to create a fine-grained PAT with the `checks:read` permission.")
+		fmt.Fprintln(out, missingAnnotationsPermissionsMsg)
 	} else if len(annotations) > 0 {
 		fmt.Fprintln(out)
 		fmt.Fprintln(out, cs.Bold("ANNOTATIONS"))
 		fmt.Fprintln(out, shared.RenderAnnotations(cs, annotations))
+		if opts.Annotations {
+			fmt.Fprintln(out)
+			fmt.Fprintln(out, cs.Bold("ANNOTATIONS SUMMARY"))
+			fmt.Fprintln(out, shared.RenderAnnotationSummary(cs, annotations))
+		}
 	}
 
 	if selectedJob == nil {
@@ -437,13 +479,29 @@ func runView(opts *ViewOptions) error {
 	return nil
 }
 
+const missingAnnotationsPermissionsMsg = "requesting annotations returned 403 Forbidden as the token does not have sufficient permissions. Note that it is not currently possible to create a fine-grained PAT with the `checks:read` permission."
+
+func getJobAnnotations(client *api.Client, repo ghrepo.Interface, jobs []shared.Job) (annotations []shared.Annotation, missingPermissions bool, err error) {
+	for _, job := range jobs {
+		as, err := shared.GetAnnotations(client, repo, job)
+		if err != nil {
+			if err != shared.ErrMissingAnnotationsPermissions {
+				return nil, false, err
+			}
+			return nil, true, nil
+		}
+		annotations = append(annotations, as...)
+	}
+	return annotations, false, nil
+}
+
 func shouldFetchJobs(opts *ViewOptions) bool {
 	if opts.Prompt {
 		return true
 	}
 	if opts.Exporter != nil {
 		for _, f := range opts.Exporter.Fields() {
-			if f == "jobs" {
+			if f == "jobs" || f == "annotations" {
 				return true
 			}
 		}
@@ -451,6 +509,15 @@ func shouldFetchJobs(opts *ViewOptions) bool {
 	return false
 }
 
+func exportsAnnotations(exporter cmdutil.Exporter) bool {
+	for _, f := range exporter.Fields() {
+		if f == "annotations" {
+			return true
+		}
+	}
+	return false
+}
+
 func getLog(httpClient *http.Client, logURL string) (io.ReadCloser, error) {
 	req, err := http.NewRequest("GET", logURL, nil)
 	if err != nil {
@@ -494,25 +561,31 @@ func getRunLog(cache RunLogCache, httpClient *http.Client, repo ghrepo.Interface
 		}
 		defer resp.Close()
 
-		data, err := io.ReadAll(resp)
-		if err != nil {
-			return nil, err
-		}
-		respReader := bytes.NewReader(data)
-
-		// Check if the response is a valid zip format
-		_, err = zip.NewReader(respReader, respReader.Size())
-		if err != nil {
+		// Stream the response into the cache file rather than buffering the
+		// whole archive in memory; the zip is validated when opened below.
+		if err := cache.Create(cacheKey, resp); err != nil {
 			return nil, err
 		}
+	}
 
-		err = cache.Create(cacheKey, respReader)
-		if err != nil {
-			return nil, err
-		}
+	rlz, err := cache.Open(cacheKey)
+	if err != nil && !isCached {
+		// a fresh download that is not a valid zip should not poison the cache
+		_ = cache.Remove(cacheKey)
 	}
+	return rlz, err
+}
 
-	return cache.Open(cacheKey)
+// parseSince interprets a --since value as either a duration relative to now
+// (e.g. "5m") or an absolute RFC 3339 timestamp.
+func parseSince(now time.Time, since string) (time.Time, error) {
+	if d, err := time.ParseDuration(since); err == nil {
+		return now.Add(-d), nil
+	}
+	if t, err := time.Parse(time.RFC3339, since); err == nil {
+		return t, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid value for --since: %q is neither a duration nor an RFC 3339 timestamp", since)
 }
 
 func promptForJob(prompter shared.Prompter, cs *iostreams.ColorScheme, jobs []shared.Job) (*shared.Job, error) {
@@ -697,7 +770,9 @@ func matchFileInZIPArchive(zr *zip.Reader, re *regexp.Regexp) *zip.File {
 	return nil
 }
 
-func displayRunLog(w io.Writer, jobs []shared.Job, failed bool) error {
+func displayRunLog(w io.Writer, jobs []shared.Job, failed bool, stepFilter string, since time.Time) error {
+	var stepFilterMatched bool
+
 	for _, job := range jobs {
 		// To display a run log, we first try to compile it from individual step
 		// logs, because this way we can prepend lines with the corresponding
@@ -712,12 +787,16 @@ func displayRunLog(w io.Writer, jobs []shared.Job, failed bool) error {
 			if failed && !shared.IsFailureState(step.Conclusion) {
 				continue
 			}
+			if stepFilter != "" && !strings.EqualFold(step.Name, stepFilter) {
+				continue
+			}
+			stepFilterMatched = true
 			if step.Log == nil {
 				continue
 			}
 			hasStepLogs = true
 			prefix := fmt.Sprintf("%s\t%s\t", job.Name, step.Name)
-			if err := printZIPFile(w, step.Log, prefix); err != nil {
+			if err := printZIPFile(w, step.Log, prefix, since); err != nil {
 				return err
 			}
 		}
@@ -726,6 +805,12 @@ func displayRunLog(w io.Writer, jobs []shared.Job, failed bool) error {
 			continue
 		}
 
+		// When filtering by step name, the job run log cannot be used as a
+		// fallback because its lines cannot be attributed to a step.
+		if stepFilter != "" {
+			continue
+		}
+
 		if failed && !shared.IsFailureState(job.Conclusion) {
 			continue
 		}
@@ -740,15 +825,19 @@ func displayRunLog(w io.Writer, jobs []shared.Job, failed bool) error {
 		// tab-delimited formatting. So, an unknown-step placeholder is used
 		// instead of the actual step name.
 		prefix := fmt.Sprintf("%s\tUNKNOWN STEP\t", job.Name)
-		if err := printZIPFile(w, job.Log, prefix); err != nil {
+		if err := printZIPFile(w, job.Log, prefix, since); err != nil {
 			return err
 		}
 	}
 
+	if stepFilter != "" && !stepFilterMatched {
+		return fmt.Errorf("no step named %q found in the run log", stepFilter)
+	}
+
 	return nil
 }
 
-func printZIPFile(w io.Writer, file *zip.File, prefix string) error {
+func printZIPFile(w io.Writer, file *zip.File, prefix string, since time.Time) error {
 	f, err := file.Open()
 	if err != nil {
 		return err
@@ -757,7 +846,24 @@ func printZIPFile(w io.Writer, file *zip.File, prefix string) error {
 
 	scanner := bufio.NewScanner(f)
 	for scanner.Scan() {
-		fmt.Fprintf(w, "%s%s\n", prefix, scanner.Text())
+		line := scanner.Text()
+		if !since.IsZero() {
+			// log lines open with an RFC 3339 timestamp; lines without one
+			// (or with an unparsable one) are never filtered out
+			if ts, ok := parseLogTimestamp(line); ok && ts.Before(since) {
+				continue
+			}
+		}
+		fmt.Fprintf(w, "%s%s\n", prefix, line)
 	}
 	return nil
 }
+
+func parseLogTimestamp(line string) (time.Time, bool) {
+	field, _, _ := strings.Cut(line, " ")
+	ts, err := time.Parse(time.RFC3339Nano, field)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return ts, true
+}
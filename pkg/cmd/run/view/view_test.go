@@ -76,6 +76,20 @@ func TestNewCmdView(t *testing.T) {
 			cli:      "--log --log-failed",
 			wantsErr: true,
 		},
+		{
+			name: "annotations",
+			cli:  "1234 --annotations",
+			wants: ViewOptions{
+				RunID:       "1234",
+				Annotations: true,
+			},
+		},
+		{
+			name:     "disallow annotations and log",
+			tty:      true,
+			cli:      "--annotations --log",
+			wantsErr: true,
+		},
 		{
 			name: "exit status",
 			cli:  "--exit-status 1234",
@@ -131,6 +145,34 @@ func TestNewCmdView(t *testing.T) {
 				Attempt: 2,
 			},
 		},
+		{
+			name: "log with step filter",
+			cli:  "1234 --log --step 'Run tests'",
+			wants: ViewOptions{
+				RunID:   "1234",
+				Log:     true,
+				LogStep: "Run tests",
+			},
+		},
+		{
+			name: "log with since",
+			cli:  "1234 --log-failed --since 5m",
+			wants: ViewOptions{
+				RunID:     "1234",
+				LogFailed: true,
+				LogSince:  "5m",
+			},
+		},
+		{
+			name:     "disallow step without log",
+			cli:      "1234 --step build",
+			wantsErr: true,
+		},
+		{
+			name:     "disallow since without log",
+			cli:      "1234 --since 5m",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -172,6 +214,9 @@ func TestNewCmdView(t *testing.T) {
 			assert.Equal(t, tt.wants.ExitStatus, gotOpts.ExitStatus)
 			assert.Equal(t, tt.wants.Verbose, gotOpts.Verbose)
 			assert.Equal(t, tt.wants.Attempt, gotOpts.Attempt)
+			assert.Equal(t, tt.wants.Annotations, gotOpts.Annotations)
+			assert.Equal(t, tt.wants.LogStep, gotOpts.LogStep)
+			assert.Equal(t, tt.wants.LogSince, gotOpts.LogSince)
 		})
 	}
 }
@@ -783,6 +828,65 @@ func TestViewRun(t *testing.T) {
 			},
 			wantOut: expectedRunLogOutput,
 		},
+		{
+			name: "noninteractive with run log and step filter",
+			tty:  true,
+			opts: &ViewOptions{
+				RunID:   "3",
+				Log:     true,
+				LogStep: "barf the quux",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(shared.SuccessfulRun))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							shared.SuccessfulJob,
+							shared.FailedJob,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/logs"),
+					httpmock.FileResponse("./fixtures/run_log.zip"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+			},
+			wantOut: barfTheQuuxLogOutput,
+		},
+		{
+			name: "noninteractive with run log and step filter that matches no step",
+			tty:  true,
+			opts: &ViewOptions{
+				RunID:   "3",
+				Log:     true,
+				LogStep: "nope",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3"),
+					httpmock.JSONResponse(shared.SuccessfulRun))
+				reg.Register(
+					httpmock.REST("GET", "runs/3/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							shared.SuccessfulJob,
+							shared.FailedJob,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/3/logs"),
+					httpmock.FileResponse("./fixtures/run_log.zip"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+			},
+			wantErr: true,
+			errMsg:  `no step named "nope" found in the run log`,
+		},
 		{
 			name: "interactive with log-failed",
 			tty:  true,
@@ -1933,6 +2037,79 @@ func TestViewRun(t *testing.T) {
 			errMsg:  "failed to get annotations: HTTP 500 (https://api.github.com/repos/OWNER/REPO/check-runs/20/annotations)",
 			wantErr: true,
 		},
+		{
+			name: "annotations summary across jobs",
+			tty:  true,
+			opts: &ViewOptions{
+				RunID:       "1234",
+				Annotations: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234/artifacts"),
+					httpmock.StringResponse(`{}`))
+				reg.Register(
+					httpmock.GraphQL(`query PullRequestForRun`),
+					httpmock.StringResponse(``))
+				reg.Register(
+					httpmock.REST("GET", "runs/1234/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							shared.FailedJob,
+							shared.SuccessfulJob,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/check-runs/20/annotations"),
+					httpmock.JSONResponse(shared.FailedJobAnnotations))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/check-runs/10/annotations"),
+					httpmock.JSONResponse(shared.SuccessfulJobAnnotations))
+			},
+			wantOut: "\nX trunk CI · 1234\nTriggered via push about 59 minutes ago\n\nJOBS\nX sad job in 4m34s (ID 20)\n  ✓ barf the quux\n  X quux the barf\n✓ cool job in 4m34s (ID 10)\n\nANNOTATIONS\nX the job is sad\nsad job: blaze.py#420\n\n- the job is happy\ncool job: blaze.py#420\n\n\nANNOTATIONS SUMMARY\nsad job: 1 failure\ncool job: 1 notice\n\n1 failure and 0 warnings in 2 jobs\n\nTo see what failed, try: gh run view 1234 --log-failed\nView this run on GitHub: https://github.com/runs/1234\n",
+		},
+		{
+			name: "Fetches annotations with --json flag",
+			opts: &ViewOptions{
+				RunID: "1234",
+				Exporter: shared.MakeTestExporter(
+					[]string{"annotations"},
+					func(io *iostreams.IOStreams, data interface{}) error {
+						run, ok := data.(*shared.Run)
+						if !ok {
+							return fmt.Errorf("expected data type *shared.Run")
+						}
+						fmt.Fprintf(io.Out, "fetched %d annotations\n", len(run.Annotations))
+						return nil
+					},
+				),
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1234"),
+					httpmock.JSONResponse(shared.FailedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+				reg.Register(
+					httpmock.REST("GET", "runs/1234/jobs"),
+					httpmock.JSONResponse(shared.JobsPayload{
+						Jobs: []shared.Job{
+							shared.FailedJob,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/check-runs/20/annotations"),
+					httpmock.JSONResponse(shared.FailedJobAnnotations))
+			},
+			wantOut: "fetched 1 annotations\n",
+		},
 		{
 			name: "annotation endpoint forbidden (fine grained tokens)",
 			tty:  true,
@@ -2337,6 +2514,60 @@ var expectedRunLogOutput = fmt.Sprintf("%s%s", coolJobRunLogOutput, sadJobRunLog
 var expectedRunLogOutputWithNoSteps = fmt.Sprintf("%s%s", coolJobRunWithNoStepLogsLogOutput, sadJobRunWithNoStepLogsLogOutput)
 var expectedLegacyRunLogOutputWithNoSteps = fmt.Sprintf("%s%s", legacyCoolJobRunWithNoStepLogsLogOutput, legacySadJobRunWithNoStepLogsLogOutput)
 
+func Test_parseSince(t *testing.T) {
+	now, _ := time.Parse(time.RFC3339, "2021-02-23T05:50:00Z")
+
+	t.Run("duration", func(t *testing.T) {
+		since, err := parseSince(now, "10m")
+		require.NoError(t, err)
+		require.Equal(t, now.Add(-10*time.Minute), since)
+	})
+
+	t.Run("timestamp", func(t *testing.T) {
+		since, err := parseSince(now, "2021-02-23T04:51:00Z")
+		require.NoError(t, err)
+		require.Equal(t, time.Date(2021, 2, 23, 4, 51, 0, 0, time.UTC), since)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := parseSince(now, "yesterday")
+		require.EqualError(t, err, `invalid value for --since: "yesterday" is neither a duration nor an RFC 3339 timestamp`)
+	})
+}
+
+func Test_displayRunLog_since(t *testing.T) {
+	var zipBuf bytes.Buffer
+	zw := zip.NewWriter(&zipBuf)
+	zf, err := zw.Create("cool job/1_fob the barz.txt")
+	require.NoError(t, err)
+	fmt.Fprintln(zf, "2021-02-23T04:50:00.0000000Z old line")
+	fmt.Fprintln(zf, "2021-02-23T05:49:30.0000000Z new line")
+	fmt.Fprintln(zf, "no timestamp line")
+	require.NoError(t, zw.Close())
+
+	zr, err := zip.NewReader(bytes.NewReader(zipBuf.Bytes()), int64(zipBuf.Len()))
+	require.NoError(t, err)
+
+	jobs := []shared.Job{
+		{
+			Name: "cool job",
+			Steps: []shared.Step{
+				{Name: "fob the barz", Number: 1},
+			},
+		},
+	}
+	attachRunLog(zr, jobs)
+
+	since, _ := time.Parse(time.RFC3339, "2021-02-23T05:00:00Z")
+	var out bytes.Buffer
+	require.NoError(t, displayRunLog(&out, jobs, false, "", since))
+
+	require.Equal(t, heredoc.Docf(`
+		cool job%[1]sfob the barz%[1]s2021-02-23T05:49:30.0000000Z new line
+		cool job%[1]sfob the barz%[1]sno timestamp line
+	`, "\t"), out.String())
+}
+
 func TestRunLog(t *testing.T) {
 	t.Run("when the cache dir doesn't exist, exists return false", func(t *testing.T) {
 		cacheDir := t.TempDir() + "/non-existent-dir"
@@ -2378,6 +2609,27 @@ func TestRunLog(t *testing.T) {
 		require.DirExists(t, cacheDir)
 	})
 
+	t.Run("when a cache entry has been created, removing it deletes it", func(t *testing.T) {
+		cacheDir := t.TempDir()
+		rlc := RunLogCache{cacheDir: cacheDir}
+
+		contents := strings.NewReader("unimportant-content")
+		require.NoError(t, rlc.Create("key", contents))
+
+		require.NoError(t, rlc.Remove("key"))
+
+		exists, err := rlc.Exists("key")
+		require.NoError(t, err)
+		require.False(t, exists)
+	})
+
+	t.Run("removing a non-existent cache entry is a no-op", func(t *testing.T) {
+		cacheDir := t.TempDir()
+		rlc := RunLogCache{cacheDir: cacheDir}
+
+		require.NoError(t, rlc.Remove("key"))
+	})
+
 	t.Run("when a cache entry has been created, reading it returns its contents", func(t *testing.T) {
 		cacheDir := t.TempDir()
 		rlc := RunLogCache{cacheDir: cacheDir}
@@ -26,6 +26,9 @@ type WatchOptions struct {
 	Prompter   shared.Prompter
 
 	RunID      string
+	RunIDs     []string
+	Branch     string
+	Event      string
 	Interval   int
 	ExitStatus bool
 
@@ -43,11 +46,16 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 	}
 
 	cmd := &cobra.Command{
-		Use:   "watch <run-id>",
+		Use:   "watch [<run-id>...]",
 		Short: "Watch a run until it completes, showing its progress",
 		Long: heredoc.Docf(`
 			Watch a run until it completes, showing its progress.
 
+			Several runs can be watched at once by passing multiple run IDs, or by
+			selecting in-progress runs with %[1]s--branch%[1]s or %[1]s--event%[1]s. In this mode a
+			compact dashboard of run statuses refreshes in place until every run
+			has completed.
+
 			This command does not support authenticating via fine grained PATs
 			as it is not currently possible to create a PAT with the %[1]schecks:read%[1]s permission.
 		`, "`"),
@@ -55,6 +63,12 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 			# Watch a run until it's done
 			$ gh run watch
 
+			# Watch several runs at once
+			$ gh run watch 12345 23456 34567
+
+			# Watch all in-progress runs triggered by pushes to trunk
+			$ gh run watch --branch trunk --event push
+
 			# Run some other command when the run is finished
 			$ gh run watch && notify-send 'run is done!'
 		`),
@@ -62,8 +76,16 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
-			if len(args) > 0 {
+			if len(args) > 0 && (opts.Branch != "" || opts.Event != "") {
+				return cmdutil.FlagErrorf("specify only one of run IDs or `--branch`/`--event`")
+			}
+
+			if len(args) == 1 {
 				opts.RunID = args[0]
+			} else if len(args) > 1 {
+				opts.RunIDs = args
+			} else if opts.Branch != "" || opts.Event != "" {
+				// filters select the runs to watch
 			} else if !opts.IO.CanPrompt() {
 				return cmdutil.FlagErrorf("run ID required when not running interactively")
 			} else {
@@ -74,11 +96,17 @@ func NewCmdWatch(f *cmdutil.Factory, runF func(*WatchOptions) error) *cobra.Comm
 				return runF(opts)
 			}
 
+			if len(opts.RunIDs) > 0 || opts.Branch != "" || opts.Event != "" {
+				return watchRuns(opts)
+			}
+
 			return watchRun(opts)
 		},
 	}
 	cmd.Flags().BoolVar(&opts.ExitStatus, "exit-status", false, "Exit with non-zero status if run fails")
 	cmd.Flags().IntVarP(&opts.Interval, "interval", "i", defaultInterval, "Refresh interval in seconds")
+	cmd.Flags().StringVarP(&opts.Branch, "branch", "b", "", "Watch in-progress runs on the `branch`")
+	cmd.Flags().StringVarP(&opts.Event, "event", "e", "", "Watch in-progress runs triggered by the `event`")
 
 	return cmd
 }
@@ -203,6 +231,144 @@ func watchRun(opts *WatchOptions) error {
 	return nil
 }
 
+// watchRuns polls several runs at once, rendering a compact dashboard of
+// their statuses instead of the per-job detail shown for a single run.
+func watchRuns(opts *WatchOptions) error {
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("failed to create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	repo, err := opts.BaseRepo()
+	if err != nil {
+		return fmt.Errorf("failed to determine base repo: %w", err)
+	}
+
+	cs := opts.IO.ColorScheme()
+
+	var runs []*shared.Run
+	if len(opts.RunIDs) > 0 {
+		for _, runID := range opts.RunIDs {
+			run, err := shared.GetRun(client, repo, runID, 0)
+			if err != nil {
+				return fmt.Errorf("failed to get run: %w", err)
+			}
+			runs = append(runs, run)
+		}
+	} else {
+		filters := &shared.FilterOptions{
+			Branch: opts.Branch,
+			Event:  opts.Event,
+		}
+		found, err := shared.GetRunsWithFilter(client, repo, filters, 10, func(run shared.Run) bool {
+			return run.Status != shared.Completed
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get runs: %w", err)
+		}
+		if len(found) == 0 {
+			return fmt.Errorf("found no in progress runs to watch")
+		}
+		for i := range found {
+			runs = append(runs, &found[i])
+		}
+	}
+
+	duration, err := time.ParseDuration(fmt.Sprintf("%ds", opts.Interval))
+	if err != nil {
+		return fmt.Errorf("could not parse interval: %w", err)
+	}
+
+	out := &bytes.Buffer{}
+	opts.IO.StartAlternateScreenBuffer()
+	for {
+		allCompleted := true
+		for i, run := range runs {
+			if run.Status == shared.Completed {
+				continue
+			}
+			runs[i], err = shared.GetRun(client, repo, fmt.Sprintf("%d", run.ID), 0)
+			if err != nil {
+				err = fmt.Errorf("failed to get run: %w", err)
+				break
+			}
+			if runs[i].Status != shared.Completed {
+				allCompleted = false
+			}
+		}
+		if err != nil {
+			break
+		}
+
+		renderDashboard(out, cs, opts.Now(), runs)
+
+		if allCompleted {
+			break
+		}
+
+		opts.IO.RefreshScreen()
+
+		fmt.Fprintln(opts.IO.Out, cs.Boldf("Refreshing run statuses every %d seconds. Press Ctrl+C to quit.", opts.Interval))
+		fmt.Fprintln(opts.IO.Out)
+
+		_, err = io.Copy(opts.IO.Out, out)
+		out.Reset()
+
+		if err != nil {
+			break
+		}
+
+		time.Sleep(duration)
+	}
+	opts.IO.StopAlternateScreenBuffer()
+
+	if err != nil {
+		return err
+	}
+
+	// Write the last temporary buffer one last time
+	if _, err := io.Copy(opts.IO.Out, out); err != nil {
+		return err
+	}
+
+	var failed int
+	for _, run := range runs {
+		if run.Conclusion != shared.Success {
+			failed++
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintln(opts.IO.Out)
+		if failed > 0 {
+			fmt.Fprintf(opts.IO.Out, "%s %d of %d runs completed without success\n", cs.FailureIcon(), failed, len(runs))
+		} else {
+			fmt.Fprintf(opts.IO.Out, "%s All %d runs completed successfully\n", cs.SuccessIcon(), len(runs))
+		}
+	}
+
+	if opts.ExitStatus && failed > 0 {
+		return cmdutil.SilentError
+	}
+
+	return nil
+}
+
+func renderDashboard(out io.Writer, cs *iostreams.ColorScheme, now time.Time, runs []*shared.Run) {
+	fmt.Fprintln(out, cs.Bold("RUNS"))
+	for _, run := range runs {
+		symbol, symbolColor := shared.Symbol(cs, run.Status, run.Conclusion)
+		state := string(run.Status)
+		if run.Status == shared.Completed {
+			state = string(run.Conclusion)
+		}
+		fmt.Fprintf(out, "%s %s [%s] · %s %s in %s\n",
+			symbolColor(symbol), cs.Bold(run.WorkflowName()), run.HeadBranch,
+			cs.Cyanf("%d", run.ID), state, run.Duration(now))
+	}
+}
+
 func renderRun(out io.Writer, opts WatchOptions, client *api.Client, repo ghrepo.Interface, run *shared.Run, prNumber string, annotationCache map[int64][]shared.Annotation) (*shared.Run, error) {
 	cs := opts.IO.ColorScheme()
 
@@ -57,6 +57,28 @@ func TestNewCmdWatch(t *testing.T) {
 				ExitStatus: true,
 			},
 		},
+		{
+			name: "multiple run IDs",
+			cli:  "1234 5678",
+			wants: WatchOptions{
+				Interval: defaultInterval,
+				RunIDs:   []string{"1234", "5678"},
+			},
+		},
+		{
+			name: "branch and event filters",
+			cli:  "--branch trunk --event push",
+			wants: WatchOptions{
+				Interval: defaultInterval,
+				Branch:   "trunk",
+				Event:    "push",
+			},
+		},
+		{
+			name:     "disallow run IDs with filters",
+			cli:      "1234 --branch trunk",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -94,6 +116,164 @@ func TestNewCmdWatch(t *testing.T) {
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
 			assert.Equal(t, tt.wants.ExitStatus, gotOpts.ExitStatus)
 			assert.Equal(t, tt.wants.Interval, gotOpts.Interval)
+			assert.Equal(t, tt.wants.RunIDs, gotOpts.RunIDs)
+			assert.Equal(t, tt.wants.Branch, gotOpts.Branch)
+			assert.Equal(t, tt.wants.Event, gotOpts.Event)
+		})
+	}
+}
+
+func TestWatchRuns(t *testing.T) {
+	multiRunStubs := func(reg *httpmock.Registry) {
+		failedRun := shared.TestRun(1000, shared.Completed, shared.Failure)
+		inProgressRun := shared.TestRunWithCommit(2, shared.InProgress, "", "commit2")
+		completedRun := shared.TestRun(2, shared.Completed, shared.Success)
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/1000"),
+			httpmock.JSONResponse(failedRun))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+			httpmock.JSONResponse(inProgressRun))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+			httpmock.JSONResponse(completedRun))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+			httpmock.JSONResponse(shared.TestWorkflow))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+			httpmock.JSONResponse(shared.TestWorkflow))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+			httpmock.JSONResponse(shared.TestWorkflow))
+	}
+
+	tests := []struct {
+		name      string
+		httpStubs func(*httpmock.Registry)
+		opts      *WatchOptions
+		tty       bool
+		wantErr   bool
+		errMsg    string
+		wantOut   string
+	}{
+		{
+			name: "multiple run IDs",
+			tty:  true,
+			opts: &WatchOptions{
+				Interval: 0,
+				RunIDs:   []string{"1000", "2"},
+			},
+			httpStubs: multiRunStubs,
+			wantOut:   "\x1b[?1049h\x1b[?1049lRUNS\nX CI [trunk] · 1000 failure in 4m34s\n✓ CI [trunk] · 2 success in 4m34s\n\nX 1 of 2 runs completed without success\n",
+		},
+		{
+			name: "multiple run IDs, exit status",
+			tty:  true,
+			opts: &WatchOptions{
+				Interval:   0,
+				RunIDs:     []string{"1000", "2"},
+				ExitStatus: true,
+			},
+			httpStubs: multiRunStubs,
+			wantOut:   "\x1b[?1049h\x1b[?1049lRUNS\nX CI [trunk] · 1000 failure in 4m34s\n✓ CI [trunk] · 2 success in 4m34s\n\nX 1 of 2 runs completed without success\n",
+			wantErr:   true,
+			errMsg:    "SilentError",
+		},
+		{
+			name: "branch filter",
+			tty:  true,
+			opts: &WatchOptions{
+				Interval: 0,
+				Branch:   "trunk",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				inProgressRun := shared.TestRunWithCommit(2, shared.InProgress, "", "commit2")
+				completedRun := shared.TestRun(2, shared.Completed, shared.Success)
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{
+							shared.SuccessfulRun,
+							inProgressRun,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs/2"),
+					httpmock.JSONResponse(completedRun))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/123"),
+					httpmock.JSONResponse(shared.TestWorkflow))
+			},
+			wantOut: "\x1b[?1049h\x1b[?1049lRUNS\n✓ CI [trunk] · 2 success in 4m34s\n\n✓ All 1 runs completed successfully\n",
+		},
+		{
+			name: "branch filter, no in progress runs",
+			tty:  true,
+			opts: &WatchOptions{
+				Interval: 0,
+				Branch:   "trunk",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/runs"),
+					httpmock.JSONResponse(shared.RunsPayload{
+						WorkflowRuns: []shared.Run{
+							shared.SuccessfulRun,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+						Workflows: []workflowShared.Workflow{
+							shared.TestWorkflow,
+						},
+					}))
+			},
+			wantErr: true,
+			errMsg:  "found no in progress runs to watch",
+		},
+	}
+
+	for _, tt := range tests {
+		reg := &httpmock.Registry{}
+		tt.httpStubs(reg)
+		tt.opts.HttpClient = func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		}
+
+		tt.opts.Now = func() time.Time {
+			notnow, _ := time.Parse("2006-01-02 15:04:05", "2021-02-23 05:50:00")
+			return notnow
+		}
+
+		ios, _, stdout, _ := iostreams.Test()
+		ios.SetStdoutTTY(tt.tty)
+		ios.SetAlternateScreenBufferEnabled(tt.tty)
+		tt.opts.IO = ios
+		tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("OWNER/REPO")
+		}
+
+		t.Run(tt.name, func(t *testing.T) {
+			err := watchRuns(tt.opts)
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+			} else {
+				assert.NoError(t, err)
+			}
+			// avoiding using `assert.Equal` here because it would print raw escape sequences to stdout
+			if got := stdout.String(); got != tt.wantOut {
+				t.Errorf("got stdout:\n%q\nwant:\n%q", got, tt.wantOut)
+			}
+			reg.Verify(t)
 		})
 	}
 }
@@ -88,6 +88,7 @@ func NewCmdCode(f *cmdutil.Factory, runF func(*CodeOptions) error) *cobra.Comman
 
 	// Query parameter flags
 	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of code results to fetch")
+	cmd.Flags().StringVar(&opts.Query.Cursor, "cursor", "", "Resume fetching results from a pagination `cursor` of a previous search")
 
 	// Query qualifier flags
 	cmd.Flags().StringVar(&opts.Query.Qualifiers.Extension, "extension", "", "Filter on file extension")
@@ -108,7 +109,7 @@ func codeRun(opts *CodeOptions) error {
 		// when opening web search, otherwise the Blackbird search UI will complain.
 		url := opts.Searcher.URL(opts.Query)
 		if io.IsStdoutTTY() {
-			fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(url))
+			io.Infof("Opening %s in your browser.\n", text.DisplayURL(url))
 		}
 		return opts.Browser.Browse(url)
 	}
@@ -127,7 +128,7 @@ func codeRun(opts *CodeOptions) error {
 		fmt.Fprintf(io.ErrOut, "failed to start pager: %v\n", err)
 	}
 	if opts.Exporter != nil {
-		return opts.Exporter.Write(io, results.Items)
+		return shared.Export(io, opts.Exporter, results, results.Items)
 	}
 
 	return displayResults(io, results)
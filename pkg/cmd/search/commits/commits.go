@@ -73,6 +73,9 @@ func NewCmdCommits(f *cmdutil.Factory, runF func(*CommitsOptions) error) *cobra.
 				return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
 			}
 			if c.Flags().Changed("order") {
+				if !c.Flags().Changed("sort") {
+					return cmdutil.FlagErrorf("`--order` must be used with `--sort`")
+				}
 				opts.Query.Order = order
 			}
 			if c.Flags().Changed("sort") {
@@ -97,7 +100,8 @@ func NewCmdCommits(f *cmdutil.Factory, runF func(*CommitsOptions) error) *cobra.
 
 	// Query parameter flags
 	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of commits to fetch")
-	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of commits returned, ignored unless '--sort' flag is specified")
+	cmd.Flags().StringVar(&opts.Query.Cursor, "cursor", "", "Resume fetching results from a pagination `cursor` of a previous search")
+	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of commits returned, requires '--sort' flag to be specified")
 	cmdutil.StringEnumFlag(cmd, &sort, "sort", "", "best-match", []string{"author-date", "committer-date"}, "Sort fetched commits")
 
 	// Query qualifier flags
@@ -125,7 +129,7 @@ func commitsRun(opts *CommitsOptions) error {
 	if opts.WebMode {
 		url := opts.Searcher.URL(opts.Query)
 		if io.IsStdoutTTY() {
-			fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(url))
+			io.Infof("Opening %s in your browser.\n", text.DisplayURL(url))
 		}
 		return opts.Browser.Browse(url)
 	}
@@ -144,7 +148,7 @@ func commitsRun(opts *CommitsOptions) error {
 		fmt.Fprintf(io.ErrOut, "failed to start pager: %v\n", err)
 	}
 	if opts.Exporter != nil {
-		return opts.Exporter.Write(io, result.Items)
+		return shared.Export(io, opts.Exporter, result, result.Items)
 	}
 
 	return displayResults(io, opts.Now, result)
@@ -159,7 +163,11 @@ func displayResults(io *iostreams.IOStreams, now time.Time, results search.Commi
 	for _, commit := range results.Items {
 		tp.AddField(commit.Repo.FullName)
 		tp.AddField(commit.Sha)
-		tp.AddField(text.RemoveExcessiveWhitespace(commit.Info.Message))
+		message := text.RemoveExcessiveWhitespace(commit.Info.Message)
+		if tp.IsTTY() {
+			message = shared.HighlightMatches(cs, message, commit.TextMatches)
+		}
+		tp.AddField(message)
 		tp.AddField(commit.Author.Login)
 		tp.AddTimeField(now, commit.Info.Author.Date, cs.Muted)
 		tp.EndRow()
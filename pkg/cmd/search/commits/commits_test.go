@@ -55,11 +55,16 @@ func TestNewCmdCommits(t *testing.T) {
 			wantErr: true,
 			errMsg:  "`--limit` must be between 1 and 1000",
 		},
+		{
+			name:   "cursor flag",
+			input:  "--cursor 30",
+			output: CommitsOptions{Query: search.Query{Cursor: "30", Keywords: []string{}, Kind: "commits", Limit: 30}},
+		},
 		{
 			name:  "order flag",
-			input: "--order asc",
+			input: "--sort author-date --order asc",
 			output: CommitsOptions{
-				Query: search.Query{Keywords: []string{}, Kind: "commits", Limit: 30, Order: "asc"},
+				Query: search.Query{Keywords: []string{}, Kind: "commits", Limit: 30, Order: "asc", Sort: "author-date"},
 			},
 		},
 		{
@@ -68,6 +73,12 @@ func TestNewCmdCommits(t *testing.T) {
 			wantErr: true,
 			errMsg:  "invalid argument \"invalid\" for \"--order\" flag: valid values are {asc|desc}",
 		},
+		{
+			name:    "order flag without sort flag",
+			input:   "--order asc",
+			wantErr: true,
+			errMsg:  "`--order` must be used with `--sort`",
+		},
 		{
 			name: "qualifier flags",
 			input: `
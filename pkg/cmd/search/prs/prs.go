@@ -73,6 +73,9 @@ func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobr
 				opts.Query.Qualifiers.Author = fmt.Sprintf("app/%s", appAuthor)
 			}
 			if c.Flags().Changed("order") {
+				if !c.Flags().Changed("sort") {
+					return cmdutil.FlagErrorf("`--order` must be used with `--sort`")
+				}
 				opts.Query.Order = order
 			}
 			if c.Flags().Changed("sort") {
@@ -130,7 +133,8 @@ func NewCmdPrs(f *cmdutil.Factory, runF func(*shared.IssuesOptions) error) *cobr
 
 	// Query parameter flags
 	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of results to fetch")
-	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of results returned, ignored unless '--sort' flag is specified")
+	cmd.Flags().StringVar(&opts.Query.Cursor, "cursor", "", "Resume fetching results from a pagination `cursor` of a previous search")
+	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of results returned, requires '--sort' flag to be specified")
 	cmdutil.StringEnumFlag(cmd, &sort, "sort", "", "best-match",
 		[]string{
 			"comments",
@@ -72,13 +72,14 @@ func TestNewCmdPrs(t *testing.T) {
 		},
 		{
 			name:  "order flag",
-			input: "--order asc",
+			input: "--sort comments --order asc",
 			output: shared.IssuesOptions{
 				Query: search.Query{
 					Keywords:   []string{},
 					Kind:       "issues",
 					Limit:      30,
 					Order:      "asc",
+					Sort:       "comments",
 					Qualifiers: search.Qualifiers{Type: "pr"},
 				},
 			},
@@ -89,6 +90,12 @@ func TestNewCmdPrs(t *testing.T) {
 			wantErr: true,
 			errMsg:  "invalid argument \"invalid\" for \"--order\" flag: valid values are {asc|desc}",
 		},
+		{
+			name:    "order flag without sort flag",
+			input:   "--order asc",
+			wantErr: true,
+			errMsg:  "`--order` must be used with `--sort`",
+		},
 		{
 			name:  "app flag",
 			input: "--app dependabot",
@@ -77,6 +77,9 @@ func NewCmdRepos(f *cmdutil.Factory, runF func(*ReposOptions) error) *cobra.Comm
 				return cmdutil.FlagErrorf("`--limit` must be between 1 and 1000")
 			}
 			if c.Flags().Changed("order") {
+				if !c.Flags().Changed("sort") {
+					return cmdutil.FlagErrorf("`--order` must be used with `--sort`")
+				}
 				opts.Query.Order = order
 			}
 			if c.Flags().Changed("sort") {
@@ -101,7 +104,8 @@ func NewCmdRepos(f *cmdutil.Factory, runF func(*ReposOptions) error) *cobra.Comm
 
 	// Query parameter flags
 	cmd.Flags().IntVarP(&opts.Query.Limit, "limit", "L", 30, "Maximum number of repositories to fetch")
-	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of repositories returned, ignored unless '--sort' flag is specified")
+	cmd.Flags().StringVar(&opts.Query.Cursor, "cursor", "", "Resume fetching results from a pagination `cursor` of a previous search")
+	cmdutil.StringEnumFlag(cmd, &order, "order", "", "desc", []string{"asc", "desc"}, "Order of repositories returned, requires '--sort' flag to be specified")
 	cmdutil.StringEnumFlag(cmd, &sort, "sort", "", "best-match", []string{"forks", "help-wanted-issues", "stars", "updated"}, "Sort fetched repositories")
 
 	// Query qualifier flags
@@ -131,7 +135,7 @@ func reposRun(opts *ReposOptions) error {
 	if opts.WebMode {
 		url := opts.Searcher.URL(opts.Query)
 		if io.IsStdoutTTY() {
-			fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(url))
+			io.Infof("Opening %s in your browser.\n", text.DisplayURL(url))
 		}
 		return opts.Browser.Browse(url)
 	}
@@ -150,7 +154,7 @@ func reposRun(opts *ReposOptions) error {
 		fmt.Fprintf(io.ErrOut, "failed to start pager: %v\n", err)
 	}
 	if opts.Exporter != nil {
-		return opts.Exporter.Write(io, result.Items)
+		return shared.Export(io, opts.Exporter, result, result.Items)
 	}
 
 	return displayResults(io, opts.Now, result)
@@ -57,9 +57,9 @@ func TestNewCmdRepos(t *testing.T) {
 		},
 		{
 			name:  "order flag",
-			input: "--order asc",
+			input: "--sort stars --order asc",
 			output: ReposOptions{
-				Query: search.Query{Keywords: []string{}, Kind: "repositories", Limit: 30, Order: "asc"},
+				Query: search.Query{Keywords: []string{}, Kind: "repositories", Limit: 30, Order: "asc", Sort: "stars"},
 			},
 		},
 		{
@@ -68,6 +68,12 @@ func TestNewCmdRepos(t *testing.T) {
 			wantErr: true,
 			errMsg:  "invalid argument \"invalid\" for \"--order\" flag: valid values are {asc|desc}",
 		},
+		{
+			name:    "order flag without sort flag",
+			input:   "--order asc",
+			wantErr: true,
+			errMsg:  "`--order` must be used with `--sort`",
+		},
 		{
 			name: "qualifier flags",
 			input: `
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -55,7 +56,7 @@ func SearchIssues(opts *IssuesOptions) error {
 	if opts.WebMode {
 		url := opts.Searcher.URL(opts.Query)
 		if io.IsStdoutTTY() {
-			fmt.Fprintf(io.ErrOut, "Opening %s in your browser.\n", text.DisplayURL(url))
+			io.Infof("Opening %s in your browser.\n", text.DisplayURL(url))
 		}
 		return opts.Browser.Browse(url)
 	}
@@ -85,12 +86,59 @@ func SearchIssues(opts *IssuesOptions) error {
 	}
 
 	if opts.Exporter != nil {
-		return opts.Exporter.Write(io, result.Items)
+		return Export(io, opts.Exporter, result, result.Items)
 	}
 
 	return displayIssueResults(io, opts.Now, opts.Entity, result)
 }
 
+// Export writes search results with the given exporter. When the cursor field
+// is requested, the full result object carrying the pagination cursor is
+// written instead of the bare list of items, so scripts can resume the search.
+func Export(io *iostreams.IOStreams, exporter cmdutil.Exporter, result, items interface{}) error {
+	for _, f := range exporter.Fields() {
+		if f == "cursor" {
+			return exporter.Write(io, result)
+		}
+	}
+	return exporter.Write(io, items)
+}
+
+// HighlightMatches highlights every text-matched fragment of s, to draw the eye
+// to why a result matched the search.
+func HighlightMatches(cs *iostreams.ColorScheme, s string, textMatches []search.TextMatch) string {
+	seen := map[string]struct{}{}
+	texts := make([]string, 0, len(textMatches))
+	for _, textMatch := range textMatches {
+		for _, match := range textMatch.Matches {
+			if _, ok := seen[match.Text]; ok || match.Text == "" {
+				continue
+			}
+			seen[match.Text] = struct{}{}
+			texts = append(texts, match.Text)
+		}
+	}
+	// Longest first, and skipping any text contained in an already highlighted
+	// one, so that a shorter match does not break up a longer highlight.
+	sort.Slice(texts, func(i, j int) bool { return len(texts[i]) > len(texts[j]) })
+	var highlighted []string
+	for _, t := range texts {
+		contained := false
+		for _, h := range highlighted {
+			if strings.Contains(h, t) {
+				contained = true
+				break
+			}
+		}
+		if contained {
+			continue
+		}
+		highlighted = append(highlighted, t)
+		s = strings.ReplaceAll(s, t, cs.HighlightStart()+t+cs.Reset())
+	}
+	return s
+}
+
 func displayIssueResults(io *iostreams.IOStreams, now time.Time, et EntityType, results search.IssuesResult) error {
 	if now.IsZero() {
 		now = time.Now()
@@ -130,7 +178,11 @@ func displayIssueResults(io *iostreams.IOStreams, now time.Time, et EntityType,
 		if !tp.IsTTY() {
 			tp.AddField(issue.State())
 		}
-		tp.AddField(text.RemoveExcessiveWhitespace(issue.Title))
+		title := text.RemoveExcessiveWhitespace(issue.Title)
+		if tp.IsTTY() {
+			title = HighlightMatches(cs, title, issue.TextMatches)
+		}
+		tp.AddField(title)
 		tp.AddField(listIssueLabels(&issue, cs, tp.IsTTY()))
 		tp.AddTimeField(now, issue.UpdatedAt, cs.Muted)
 		tp.EndRow()
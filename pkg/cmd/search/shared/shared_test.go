@@ -23,6 +23,26 @@ func TestSearcher(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestHighlightMatches(t *testing.T) {
+	textMatches := []search.TextMatch{
+		{Matches: []search.Match{{Text: "error handling"}, {Text: "error"}}},
+	}
+
+	ios, _, _, _ := iostreams.Test()
+	ios.SetColorEnabled(true)
+	cs := ios.ColorScheme()
+	assert.Equal(t,
+		fmt.Sprintf("improve %s in parser", cs.HighlightStart()+"error handling"+cs.Reset()),
+		HighlightMatches(cs, "improve error handling in parser", textMatches),
+	)
+
+	ios.SetColorEnabled(false)
+	assert.Equal(t,
+		"improve error handling in parser",
+		HighlightMatches(ios.ColorScheme(), "improve error handling in parser", textMatches),
+	)
+}
+
 func TestSearchIssues(t *testing.T) {
 	var now = time.Date(2022, 2, 28, 12, 30, 0, 0, time.UTC)
 	var updatedAt = time.Date(2021, 2, 28, 12, 30, 0, 0, time.UTC)
@@ -0,0 +1,51 @@
+package list
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	workflowShared "github.com/cli/cli/v2/pkg/cmd/workflow/shared"
+)
+
+// auditSecrets fetches the workflow files from the repository's default branch
+// and counts, for each secret, how many of them reference it. Secrets with a
+// count of zero are never used by any workflow.
+func auditSecrets(client *http.Client, repo ghrepo.Interface, secrets []Secret) (map[string]int, error) {
+	apiClient := api.NewClientFromHTTP(client)
+
+	workflows, err := workflowShared.GetWorkflows(apiClient, repo, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workflows: %w", err)
+	}
+
+	counts := make(map[string]int, len(secrets))
+	for _, secret := range secrets {
+		counts[secret.Name] = 0
+	}
+
+	for _, workflow := range workflows {
+		content, err := workflowShared.GetWorkflowContent(apiClient, repo, workflow, "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch %s: %w", workflow.Path, err)
+		}
+		for _, secret := range secrets {
+			if secretReferenced(content, secret.Name) {
+				counts[secret.Name]++
+			}
+		}
+	}
+
+	return counts, nil
+}
+
+// secretReferenced reports whether a workflow file references a secret, in
+// either the dotted form `secrets.NAME` or the indexed form `secrets['NAME']`.
+// Secret names are case-insensitive in workflow expressions.
+func secretReferenced(content []byte, name string) bool {
+	quoted := regexp.QuoteMeta(name)
+	re := regexp.MustCompile(fmt.Sprintf(`(?i)secrets\s*(\.\s*%[1]s\b|\[\s*'%[1]s'\s*\]|\[\s*"%[1]s"\s*\])`, quoted))
+	return re.Match(content)
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/secret/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -34,6 +35,7 @@ type ListOptions struct {
 	EnvName     string
 	UserSecrets bool
 	Application string
+	Audit       bool
 }
 
 var secretFields = []string{
@@ -58,13 +60,18 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List secrets",
-		Long: heredoc.Doc(`
+		Long: heredoc.Docf(`
 			List secrets on one of the following levels:
 			- repository (default): available to GitHub Actions runs or Dependabot in a repository
 			- environment: available to GitHub Actions runs for a deployment environment in a repository
 			- organization: available to GitHub Actions runs, Dependabot, or Codespaces within an organization
 			- user: available to Codespaces for your user
-		`),
+
+			With %[1]s--audit%[1]s, the workflow files on the repository's default branch are
+			cross-referenced against the secrets, and secrets never referenced by any
+			workflow are marked as unused. This is only supported for repository and
+			environment secrets.
+		`, "`"),
 		Aliases: []string{"ls"},
 		Args:    cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -87,6 +94,15 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 				return err
 			}
 
+			if opts.Audit {
+				if opts.OrgName != "" || opts.UserSecrets {
+					return cmdutil.FlagErrorf("the `--audit` flag is only supported for repository and environment secrets")
+				}
+				if opts.Exporter != nil {
+					return cmdutil.FlagErrorf("the `--audit` flag is not supported with `--json`")
+				}
+			}
+
 			if runF != nil {
 				return runF(opts)
 			}
@@ -99,6 +115,7 @@ func NewCmdList(f *cmdutil.Factory, runF func(*ListOptions) error) *cobra.Comman
 	cmd.Flags().StringVarP(&opts.EnvName, "env", "e", "", "List secrets for an environment")
 	cmd.Flags().BoolVarP(&opts.UserSecrets, "user", "u", false, "List a secret for your user")
 	cmdutil.StringEnumFlag(cmd, &opts.Application, "app", "a", "", []string{shared.Actions, shared.Codespaces, shared.Dependabot}, "List secrets for a specific application")
+	cmd.Flags().BoolVar(&opts.Audit, "audit", false, "Cross-reference workflow files and mark secrets not referenced by any workflow")
 	cmdutil.AddJSONFlags(cmd, &opts.Exporter, secretFields)
 	return cmd
 }
@@ -180,6 +197,14 @@ func listRun(opts *ListOptions) error {
 		return cmdutil.NewNoResultsError("no secrets found")
 	}
 
+	var referenceCounts map[string]int
+	if opts.Audit {
+		referenceCounts, err = auditSecrets(client, baseRepo, secrets)
+		if err != nil {
+			return err
+		}
+	}
+
 	if err := opts.IO.StartPager(); err == nil {
 		defer opts.IO.StopPager()
 	} else {
@@ -195,8 +220,12 @@ func listRun(opts *ListOptions) error {
 		headers = []string{"Name", "Updated", "Visibility"}
 	} else {
 		headers = []string{"Name", "Updated"}
+		if opts.Audit {
+			headers = append(headers, "Workflows")
+		}
 	}
 
+	cs := opts.IO.ColorScheme()
 	table := tableprinter.New(opts.IO, tableprinter.WithHeader(headers...))
 	for _, secret := range secrets {
 		table.AddField(secret.Name)
@@ -208,6 +237,13 @@ func listRun(opts *ListOptions) error {
 				table.AddField(strings.ToUpper(string(secret.Visibility)))
 			}
 		}
+		if opts.Audit {
+			if count := referenceCounts[secret.Name]; count == 0 {
+				table.AddField("unused", tableprinter.WithColor(cs.Yellow))
+			} else {
+				table.AddField(text.Pluralize(count, "workflow"))
+			}
+		}
 		table.EndRow()
 	}
 
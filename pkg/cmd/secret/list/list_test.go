@@ -2,6 +2,7 @@ package list
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 	"io"
 	"net/http"
@@ -10,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/MakeNowJust/heredoc"
 	ghContext "github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/config"
@@ -17,6 +19,7 @@ import (
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/prompter"
 	"github.com/cli/cli/v2/pkg/cmd/secret/shared"
+	workflowShared "github.com/cli/cli/v2/pkg/cmd/workflow/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/httpmock"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -773,6 +776,116 @@ func Test_listRun_populatesNumSelectedReposIfRequired(t *testing.T) {
 	}
 }
 
+func Test_listRun_audit(t *testing.T) {
+	reg := &httpmock.Registry{}
+	defer reg.Verify(t)
+
+	t0, _ := time.Parse("2006-01-02", "1988-10-11")
+	t1, _ := time.Parse("2006-01-02", "2020-12-04")
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/repo/actions/secrets"),
+		httpmock.JSONResponse(struct {
+			Secrets []Secret
+		}{
+			Secrets: []Secret{
+				{Name: "SECRET_ONE", UpdatedAt: t0},
+				{Name: "SECRET_TWO", UpdatedAt: t1},
+			},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/repo/actions/workflows"),
+		httpmock.JSONResponse(workflowShared.WorkflowsPayload{
+			Workflows: []workflowShared.Workflow{
+				{Name: "CI", ID: 1, Path: ".github/workflows/ci.yml", State: workflowShared.Active},
+				{Name: "Release", ID: 2, Path: ".github/workflows/release.yml", State: workflowShared.Active},
+			},
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/repo/contents/.github/workflows/ci.yml"),
+		httpmock.JSONResponse(struct {
+			Content string
+		}{
+			Content: base64.StdEncoding.EncodeToString([]byte("jobs:\n  build:\n    env:\n      TOKEN: ${{ secrets.SECRET_ONE }}\n")),
+		}))
+	reg.Register(
+		httpmock.REST("GET", "repos/owner/repo/contents/.github/workflows/release.yml"),
+		httpmock.JSONResponse(struct {
+			Content string
+		}{
+			Content: base64.StdEncoding.EncodeToString([]byte("jobs:\n  release:\n    runs-on: ubuntu-latest\n")),
+		}))
+
+	ios, _, stdout, _ := iostreams.Test()
+	ios.SetStdoutTTY(true)
+
+	opts := &ListOptions{
+		IO:    ios,
+		Audit: true,
+		BaseRepo: func() (ghrepo.Interface, error) {
+			return ghrepo.FromFullName("owner/repo")
+		},
+		HttpClient: func() (*http.Client, error) {
+			return &http.Client{Transport: reg}, nil
+		},
+		Config: func() (gh.Config, error) {
+			return config.NewBlankConfig(), nil
+		},
+		Now: func() time.Time {
+			t, _ := time.Parse(time.RFC822, "15 Mar 23 00:00 UTC")
+			return t
+		},
+	}
+
+	err := listRun(opts)
+	assert.NoError(t, err)
+
+	expected := heredoc.Doc(`
+		NAME        UPDATED             WORKFLOWS
+		SECRET_ONE  about 34 years ago  1 workflow
+		SECRET_TWO  about 2 years ago   unused
+	`)
+	assert.Equal(t, expected, stdout.String())
+}
+
+func Test_secretReferenced(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    bool
+	}{
+		{
+			name:    "dotted reference",
+			content: "env:\n  TOKEN: ${{ secrets.MY_SECRET }}\n",
+			want:    true,
+		},
+		{
+			name:    "case-insensitive reference",
+			content: "env:\n  TOKEN: ${{ secrets.my_secret }}\n",
+			want:    true,
+		},
+		{
+			name:    "indexed reference",
+			content: "env:\n  TOKEN: ${{ secrets['MY_SECRET'] }}\n",
+			want:    true,
+		},
+		{
+			name:    "prefix does not match",
+			content: "env:\n  TOKEN: ${{ secrets.MY_SECRET_TWO }}\n",
+			want:    false,
+		},
+		{
+			name:    "no reference",
+			content: "jobs:\n  build:\n    runs-on: ubuntu-latest\n",
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, secretReferenced([]byte(tt.content), "MY_SECRET"))
+		})
+	}
+}
+
 func Test_getSecrets_pagination(t *testing.T) {
 	reg := &httpmock.Registry{}
 	defer reg.Verify(t)
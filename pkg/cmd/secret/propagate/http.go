@@ -0,0 +1,99 @@
+package propagate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/secret/shared"
+	"golang.org/x/crypto/nacl/box"
+)
+
+type secretPayload struct {
+	EncryptedValue string  `json:"encrypted_value"`
+	Visibility     string  `json:"visibility,omitempty"`
+	Repositories   []int64 `json:"selected_repository_ids,omitempty"`
+	KeyID          string  `json:"key_id"`
+}
+
+type pubKey struct {
+	ID  string `json:"key_id"`
+	Key string
+}
+
+func getPubKey(client *api.Client, host, path string) (*pubKey, error) {
+	pk := pubKey{}
+	err := client.REST(host, "GET", path, nil, &pk)
+	if err != nil {
+		return nil, err
+	}
+	return &pk, nil
+}
+
+func encryptSecret(pk *pubKey, secret []byte, random io.Reader) (string, error) {
+	decodedPubKey, err := base64.StdEncoding.DecodeString(pk.Key)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode public key: %w", err)
+	}
+	var peersPubKey [32]byte
+	copy(peersPubKey[:], decodedPubKey[0:32])
+
+	eBody, err := box.SealAnonymous(nil, secret, &peersPubKey, random)
+	if err != nil {
+		return "", fmt.Errorf("failed to encrypt body: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(eBody), nil
+}
+
+func putSecret(client *api.Client, host, path string, payload interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize: %w", err)
+	}
+	requestBody := bytes.NewReader(payloadBytes)
+	return client.REST(host, "PUT", path, requestBody, nil)
+}
+
+func putRepoSecret(client *api.Client, repo ghrepo.Interface, secretName string, secret []byte, random io.Reader) error {
+	pk, err := getPubKey(client, repo.RepoHost(), fmt.Sprintf("repos/%s/actions/secrets/public-key", ghrepo.FullName(repo)))
+	if err != nil {
+		return fmt.Errorf("failed to fetch public key: %w", err)
+	}
+
+	eValue, err := encryptSecret(pk, secret, random)
+	if err != nil {
+		return err
+	}
+
+	payload := secretPayload{
+		EncryptedValue: eValue,
+		KeyID:          pk.ID,
+	}
+	path := fmt.Sprintf("repos/%s/actions/secrets/%s", ghrepo.FullName(repo), secretName)
+	return putSecret(client, repo.RepoHost(), path, payload)
+}
+
+func putOrgSecret(client *api.Client, host, orgName, secretName string, secret []byte, random io.Reader, repositoryIDs []int64) error {
+	pk, err := getPubKey(client, host, fmt.Sprintf("orgs/%s/actions/secrets/public-key", orgName))
+	if err != nil {
+		return fmt.Errorf("failed to fetch public key: %w", err)
+	}
+
+	eValue, err := encryptSecret(pk, secret, random)
+	if err != nil {
+		return err
+	}
+
+	payload := secretPayload{
+		EncryptedValue: eValue,
+		KeyID:          pk.ID,
+		Visibility:     shared.Selected,
+		Repositories:   repositoryIDs,
+	}
+	path := fmt.Sprintf("orgs/%s/actions/secrets/%s", orgName, secretName)
+	return putSecret(client, host, path, payload)
+}
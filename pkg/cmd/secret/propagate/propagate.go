@@ -0,0 +1,293 @@
+package propagate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+type PropagateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	Prompter   prompter.Prompter
+
+	RandomOverride func() io.Reader
+
+	Exporter cmdutil.Exporter
+
+	SecretName string
+	Body       string
+	ToFile     string
+	OrgName    string
+}
+
+var propagateFields = []string{
+	"repository",
+	"status",
+	"error",
+}
+
+const (
+	statusSet      = "set"
+	statusSelected = "selected"
+	statusFailed   = "failed"
+)
+
+type propagateResult struct {
+	Repository string `json:"repository"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+}
+
+func (r *propagateResult) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(r, fields)
+}
+
+func NewCmdPropagate(f *cmdutil.Factory, runF func(*PropagateOptions) error) *cobra.Command {
+	opts := &PropagateOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "propagate <secret-name>",
+		Short: "Propagate a secret across repositories",
+		Long: heredoc.Docf(`
+			Set the same Actions secret in every repository listed in a file. The file
+			contains one repository per line, in the %[1]sOWNER/REPO%[1]s format; blank lines
+			and lines starting with %[1]s#%[1]s are ignored.
+
+			GitHub never returns secret values, so the value cannot be copied from an
+			existing secret; it is read from %[1]s--body%[1]s or standard input and locally
+			encrypted before being sent, once per repository.
+
+			With %[1]s--org%[1]s, a single organization secret is set instead, with its
+			visibility restricted to the repositories listed in the file.
+
+			Each propagated repository is reported, which with %[1]s--json%[1]s produces an
+			audit-friendly record of the run.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Set a secret in each repository listed in a file
+			$ gh secret propagate MYSECRET --to-file repos.txt --body "$SECRET_VALUE"
+
+			# Set an organization secret scoped to the repositories listed in a file
+			$ gh secret propagate MYSECRET --to-file repos.txt --org myOrg < secret.txt
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot propagate secret: name argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.SecretName = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return propagateRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "The value for the secret (reads from standard input if not specified)")
+	cmd.Flags().StringVar(&opts.ToFile, "to-file", "", "Read target repositories from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Set a single `organization` secret selected for the target repositories")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, propagateFields)
+	_ = cmd.MarkFlagRequired("to-file")
+
+	return cmd
+}
+
+func propagateRun(opts *PropagateOptions) error {
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+	host, _ := cfg.Authentication().DefaultHost()
+
+	b, err := cmdutil.ReadFile(opts.ToFile, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	targets, err := parseRepoFile(b, host, opts.OrgName)
+	if err != nil {
+		return err
+	}
+
+	secret, err := getBody(opts)
+	if err != nil {
+		return fmt.Errorf("did not understand secret body: %w", err)
+	}
+
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	// gate the per-repository workers behind the shared per-host concurrency cap
+	client := api.NewClientFromHTTP(api.NewLimitedHTTPClient(c))
+
+	var results []propagateResult
+	if opts.OrgName != "" {
+		var random io.Reader
+		if opts.RandomOverride != nil {
+			random = opts.RandomOverride()
+		}
+		opts.IO.StartProgressIndicator()
+		results, err = propagateOrgSecret(client, host, opts.OrgName, opts.SecretName, secret, random, targets)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+	} else {
+		opts.IO.StartProgressIndicator()
+		results = propagateRepoSecret(client, opts.SecretName, secret, opts.RandomOverride, targets)
+		opts.IO.StopProgressIndicator()
+	}
+
+	err = nil
+	var failed int
+	for _, result := range results {
+		if result.Status == statusFailed {
+			failed++
+			err = multierror.Append(err, errors.New(result.Error))
+		}
+	}
+
+	if opts.Exporter != nil {
+		if werr := opts.Exporter.Write(opts.IO, results); werr != nil {
+			return werr
+		}
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		for _, result := range results {
+			switch result.Status {
+			case statusFailed:
+				fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), result.Repository, result.Error)
+			case statusSelected:
+				fmt.Fprintf(opts.IO.Out, "%s Selected %s for organization secret %s in %s\n", cs.SuccessIcon(), result.Repository, opts.SecretName, opts.OrgName)
+			default:
+				fmt.Fprintf(opts.IO.Out, "%s Set secret %s for %s\n", cs.SuccessIcon(), opts.SecretName, result.Repository)
+			}
+		}
+		propagated := len(results) - failed
+		noun := "repositories"
+		if propagated == 1 {
+			noun = "repository"
+		}
+		fmt.Fprintf(opts.IO.Out, "\nPropagated secret %s to %d %s\n", opts.SecretName, propagated, noun)
+	}
+
+	return err
+}
+
+// propagateRepoSecret encrypts and sets the secret in every target repository,
+// concurrently, using each repository's own public key.
+func propagateRepoSecret(client *api.Client, name string, secret []byte, randomOverride func() io.Reader, targets []ghrepo.Interface) []propagateResult {
+	type indexedResult struct {
+		index  int
+		result propagateResult
+	}
+
+	resc := make(chan indexedResult)
+	for i, target := range targets {
+		go func(i int, target ghrepo.Interface) {
+			var random io.Reader
+			if randomOverride != nil {
+				random = randomOverride()
+			}
+			result := propagateResult{Repository: ghrepo.FullName(target), Status: statusSet}
+			if err := putRepoSecret(client, target, name, secret, random); err != nil {
+				result.Status = statusFailed
+				result.Error = fmt.Sprintf("failed to set secret %q for %s: %v", name, result.Repository, err)
+			}
+			resc <- indexedResult{index: i, result: result}
+		}(i, target)
+	}
+
+	results := make([]propagateResult, len(targets))
+	for range targets {
+		r := <-resc
+		results[r.index] = r.result
+	}
+	return results
+}
+
+// propagateOrgSecret sets a single organization secret whose visibility is
+// restricted to the target repositories.
+func propagateOrgSecret(client *api.Client, host, orgName, name string, secret []byte, random io.Reader, targets []ghrepo.Interface) ([]propagateResult, error) {
+	repositoryIDs, err := api.GetRepoIDs(client, host, targets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up IDs for target repositories: %w", err)
+	}
+
+	if err := putOrgSecret(client, host, orgName, name, secret, random, repositoryIDs); err != nil {
+		return nil, fmt.Errorf("failed to set organization secret %q: %w", name, err)
+	}
+
+	results := make([]propagateResult, len(targets))
+	for i, target := range targets {
+		results[i] = propagateResult{Repository: ghrepo.FullName(target), Status: statusSelected}
+	}
+	return results, nil
+}
+
+func getBody(opts *PropagateOptions) ([]byte, error) {
+	if opts.Body != "" {
+		return []byte(opts.Body), nil
+	}
+
+	if opts.IO.CanPrompt() {
+		bodyInput, err := opts.Prompter.Password("Paste your secret:")
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintln(opts.IO.Out)
+		return []byte(bodyInput), nil
+	}
+
+	body, err := io.ReadAll(opts.IO.In)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read from standard input: %w", err)
+	}
+
+	return bytes.TrimRight(body, "\r\n"), nil
+}
+
+func parseRepoFile(b []byte, host, defaultOwner string) ([]ghrepo.Interface, error) {
+	var repos []ghrepo.Interface
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.Contains(line, "/") || defaultOwner == "" {
+			repo, err := ghrepo.FromFullNameWithHost(line, host)
+			if err != nil {
+				return nil, fmt.Errorf("invalid repository %q: %w", line, err)
+			}
+			repos = append(repos, repo)
+		} else {
+			repos = append(repos, ghrepo.NewWithHost(defaultOwner, line, host))
+		}
+	}
+	if len(repos) == 0 {
+		return nil, errors.New("no repositories found in file")
+	}
+	return repos, nil
+}
@@ -0,0 +1,225 @@
+package propagate
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdPropagate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  PropagateOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no argument",
+			input:   "--to-file repos.txt",
+			wantErr: true,
+			errMsg:  "cannot propagate secret: name argument required",
+		},
+		{
+			name:    "missing to-file",
+			input:   "MYSECRET",
+			wantErr: true,
+			errMsg:  `required flag(s) "to-file" not set`,
+		},
+		{
+			name:  "repository targets",
+			input: "MYSECRET --to-file repos.txt -bs",
+			output: PropagateOptions{
+				SecretName: "MYSECRET",
+				ToFile:     "repos.txt",
+				Body:       "s",
+			},
+		},
+		{
+			name:  "org flag",
+			input: "MYSECRET --to-file repos.txt -bs --org myOrg",
+			output: PropagateOptions{
+				SecretName: "MYSECRET",
+				ToFile:     "repos.txt",
+				Body:       "s",
+				OrgName:    "myOrg",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var gotOpts *PropagateOptions
+			cmd := NewCmdPropagate(f, func(opts *PropagateOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.SecretName, gotOpts.SecretName)
+			assert.Equal(t, tt.output.ToFile, gotOpts.ToFile)
+			assert.Equal(t, tt.output.Body, gotOpts.Body)
+			assert.Equal(t, tt.output.OrgName, gotOpts.OrgName)
+		})
+	}
+}
+
+func Test_propagateRun(t *testing.T) {
+	pubKeyStub := func(path string) func(*httpmock.Registry) {
+		return func(reg *httpmock.Registry) {
+			reg.Register(httpmock.REST("GET", path),
+				httpmock.JSONResponse(pubKey{ID: "123", Key: "CDjXqf7AJBXWhMczcy+Fs7JlACEptgceysutztHaFQI="}))
+		}
+	}
+
+	tests := []struct {
+		name       string
+		tty        bool
+		opts       *PropagateOptions
+		repoFile   string
+		httpStubs  func(*testing.T, *httpmock.Registry)
+		wantStdout string
+		wantErrMsg string
+	}{
+		{
+			name:     "propagates to repositories",
+			tty:      true,
+			opts:     &PropagateOptions{},
+			repoFile: "octo/one\n# a comment\n\nocto/two\n",
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				pubKeyStub("repos/octo/one/actions/secrets/public-key")(reg)
+				pubKeyStub("repos/octo/two/actions/secrets/public-key")(reg)
+				reg.Register(
+					httpmock.REST("PUT", "repos/octo/one/actions/secrets/MYSECRET"),
+					httpmock.RESTPayload(201, "{}", func(params map[string]interface{}) {
+						assert.Equal(t, "123", params["key_id"])
+						assert.Equal(t, "UKYUCbHd0DJemxa3AOcZ6XcsBwALG9d4bpB8ZT0gSV39vl3BHiGSgj8zJapDxgB2BwqNqRhpjC4=", params["encrypted_value"])
+					}),
+				)
+				reg.Register(
+					httpmock.REST("PUT", "repos/octo/two/actions/secrets/MYSECRET"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+			},
+			wantStdout: "✓ Set secret MYSECRET for octo/one\n✓ Set secret MYSECRET for octo/two\n\nPropagated secret MYSECRET to 2 repositories\n",
+		},
+		{
+			name:     "org secret with selected repos",
+			tty:      true,
+			opts:     &PropagateOptions{OrgName: "myOrg"},
+			repoFile: "octo/one\ntwo\n",
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.GraphQL(`query MapRepositoryNames\b`),
+					httpmock.StringResponse(`{"data":{"repo_0001":{"databaseId":1},"repo_0002":{"databaseId":2}}}`),
+				)
+				pubKeyStub("orgs/myOrg/actions/secrets/public-key")(reg)
+				reg.Register(
+					httpmock.REST("PUT", "orgs/myOrg/actions/secrets/MYSECRET"),
+					httpmock.RESTPayload(201, "{}", func(params map[string]interface{}) {
+						assert.Equal(t, "123", params["key_id"])
+						assert.Equal(t, "selected", params["visibility"])
+						assert.Equal(t, []interface{}{float64(1), float64(2)}, params["selected_repository_ids"])
+					}),
+				)
+			},
+			wantStdout: "✓ Selected octo/one for organization secret MYSECRET in myOrg\n✓ Selected myOrg/two for organization secret MYSECRET in myOrg\n\nPropagated secret MYSECRET to 2 repositories\n",
+		},
+		{
+			name:     "json report",
+			tty:      false,
+			opts:     &PropagateOptions{},
+			repoFile: "octo/one\n",
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				pubKeyStub("repos/octo/one/actions/secrets/public-key")(reg)
+				reg.Register(
+					httpmock.REST("PUT", "repos/octo/one/actions/secrets/MYSECRET"),
+					httpmock.StatusStringResponse(201, "{}"),
+				)
+			},
+			wantStdout: "[{\"repository\":\"octo/one\",\"status\":\"set\"}]\n",
+		},
+		{
+			name:       "empty repo file",
+			tty:        true,
+			opts:       &PropagateOptions{},
+			repoFile:   "# nothing here\n",
+			wantErrMsg: "no repositories found in file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			tt.opts.SecretName = "MYSECRET"
+			tt.opts.Body = "a secret"
+			tt.opts.RandomOverride = fakeRandom
+			tt.opts.ToFile = filepath.Join(t.TempDir(), "repos.txt")
+			assert.NoError(t, os.WriteFile(tt.opts.ToFile, []byte(tt.repoFile), 0600))
+
+			if tt.name == "json report" {
+				exporter := cmdutil.NewJSONExporter()
+				exporter.SetFields([]string{"repository", "status"})
+				tt.opts.Exporter = exporter
+			}
+
+			err := propagateRun(tt.opts)
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
+func fakeRandom() io.Reader {
+	return bytes.NewReader(bytes.Repeat([]byte{5}, 32))
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/MakeNowJust/heredoc"
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/secret/delete"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/secret/list"
+	cmdPropagate "github.com/cli/cli/v2/pkg/cmd/secret/propagate"
 	cmdSet "github.com/cli/cli/v2/pkg/cmd/secret/set"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -26,6 +27,7 @@ func NewCmdSecret(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdSet.NewCmdSet(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdPropagate.NewCmdPropagate(f, nil))
 
 	return cmd
 }
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -57,6 +58,43 @@ func getEnvPubKey(client *api.Client, repo ghrepo.Interface, envName string) (*P
 		ghrepo.FullName(repo), envName))
 }
 
+func getSecretNames(client *api.Client, host, path string) (map[string]struct{}, error) {
+	names := map[string]struct{}{}
+	path = fmt.Sprintf("%s?per_page=100", path)
+	for path != "" {
+		response := struct {
+			Secrets []struct {
+				Name string `json:"name"`
+			}
+		}{}
+		var err error
+		path, err = client.RESTWithNext(host, "GET", path, nil, &response)
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range response.Secrets {
+			names[strings.ToUpper(s.Name)] = struct{}{}
+		}
+	}
+	return names, nil
+}
+
+func getOrgSecretNames(client *api.Client, host, orgName string, app shared.App) (map[string]struct{}, error) {
+	return getSecretNames(client, host, fmt.Sprintf("orgs/%s/%s/secrets", orgName, app))
+}
+
+func getUserSecretNames(client *api.Client, host string) (map[string]struct{}, error) {
+	return getSecretNames(client, host, "user/codespaces/secrets")
+}
+
+func getEnvSecretNames(client *api.Client, repo ghrepo.Interface, envName string) (map[string]struct{}, error) {
+	return getSecretNames(client, repo.RepoHost(), fmt.Sprintf("repos/%s/environments/%s/secrets", ghrepo.FullName(repo), envName))
+}
+
+func getRepoSecretNames(client *api.Client, repo ghrepo.Interface, app shared.App) (map[string]struct{}, error) {
+	return getSecretNames(client, repo.RepoHost(), fmt.Sprintf("repos/%s/%s/secrets", ghrepo.FullName(repo), app))
+}
+
 func putSecret(client *api.Client, host, path string, payload interface{}) error {
 	payloadBytes, err := json.Marshal(payload)
 	if err != nil {
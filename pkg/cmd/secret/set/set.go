@@ -7,6 +7,7 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/cli/cli/v2/internal/prompter"
@@ -15,6 +16,7 @@ import (
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/secret/shared"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -39,6 +41,7 @@ type SetOptions struct {
 	UserSecrets     bool
 	Body            string
 	DoNotStore      bool
+	DryRun          bool
 	Visibility      string
 	RepositoryNames []string
 	EnvFile         string
@@ -101,6 +104,9 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 
 			# Set multiple secrets from stdin
 			$ gh secret set -f - < myfile.txt
+
+			# Preview which secrets would be created or updated when importing from a file
+			$ gh secret set -f .env.production --env production --dry-run
 		`),
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -131,6 +137,10 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 				return err
 			}
 
+			if opts.DryRun && opts.EnvFile == "" {
+				return cmdutil.FlagErrorf("`--dry-run` can only be used with `--env-file`")
+			}
+
 			if len(args) == 0 {
 				if !opts.DoNotStore && opts.EnvFile == "" {
 					return cmdutil.FlagErrorf("must pass name argument")
@@ -173,6 +183,7 @@ func NewCmdSet(f *cmdutil.Factory, runF func(*SetOptions) error) *cobra.Command
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "The value for the secret (reads from standard input if not specified)")
 	cmd.Flags().BoolVar(&opts.DoNotStore, "no-store", false, "Print the encrypted, base64-encoded value instead of storing it on GitHub")
 	cmd.Flags().StringVarP(&opts.EnvFile, "env-file", "f", "", "Load secret names and values from a dotenv-formatted `file`")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Print which secrets would be created or updated without setting them")
 	cmdutil.StringEnumFlag(cmd, &opts.Application, "app", "a", "", []string{shared.Actions, shared.Codespaces, shared.Dependabot}, "Set the application for a secret")
 
 	return cmd
@@ -225,6 +236,10 @@ func setRun(opts *SetOptions) error {
 		return fmt.Errorf("%s secrets are not supported for %s", secretEntity, secretApp)
 	}
 
+	if opts.DryRun {
+		return setDryRun(opts, client, host, baseRepo, secrets, secretApp, secretEntity)
+	}
+
 	var pk *PubKey
 	switch secretEntity {
 	case shared.Organization:
@@ -275,6 +290,7 @@ func setRun(opts *SetOptions) error {
 
 	err = nil
 	cs := opts.IO.ColorScheme()
+	target := secretTarget(opts, baseRepo)
 	for i := 0; i < len(secrets); i++ {
 		result := <-setc
 		if result.err != nil {
@@ -288,17 +304,67 @@ func setRun(opts *SetOptions) error {
 		if !opts.IO.IsStdoutTTY() {
 			continue
 		}
-		target := orgName
-		if opts.UserSecrets {
-			target = "your user"
-		} else if orgName == "" {
-			target = ghrepo.FullName(baseRepo)
-		}
 		fmt.Fprintf(opts.IO.Out, "%s Set %s secret %s for %s\n", cs.SuccessIcon(), secretApp.Title(), result.key, target)
 	}
+
+	if opts.EnvFile != "" && err == nil && opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "%s Set %s for %s\n", cs.SuccessIcon(), text.Pluralize(len(secrets), fmt.Sprintf("%s secret", secretApp.Title())), target)
+	}
 	return err
 }
 
+// setDryRun prints which of the given secrets would be created and which would
+// be updated, without setting any of them. Since GitHub never returns secret
+// values, an existing secret is always reported as an update even if its value
+// already matches.
+func setDryRun(opts *SetOptions, client *api.Client, host string, baseRepo ghrepo.Interface, secrets map[string][]byte, app shared.App, entity shared.SecretEntity) error {
+	var existing map[string]struct{}
+	var err error
+	switch entity {
+	case shared.Organization:
+		existing, err = getOrgSecretNames(client, host, opts.OrgName, app)
+	case shared.Environment:
+		existing, err = getEnvSecretNames(client, baseRepo, opts.EnvName)
+	case shared.User:
+		existing, err = getUserSecretNames(client, host)
+	default:
+		existing, err = getRepoSecretNames(client, baseRepo, app)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to list existing secrets: %w", err)
+	}
+
+	keys := make([]string, 0, len(secrets))
+	for key := range secrets {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		// Secret names are case-insensitive and stored upcased on GitHub.
+		if _, ok := existing[strings.ToUpper(key)]; ok {
+			fmt.Fprintf(opts.IO.Out, "%s\tupdate\n", key)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "%s\tcreate\n", key)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nDry run: %s would be set for %s\n", text.Pluralize(len(keys), fmt.Sprintf("%s secret", app.Title())), secretTarget(opts, baseRepo))
+	}
+	return nil
+}
+
+func secretTarget(opts *SetOptions, baseRepo ghrepo.Interface) string {
+	if opts.UserSecrets {
+		return "your user"
+	}
+	if opts.OrgName != "" {
+		return opts.OrgName
+	}
+	return ghrepo.FullName(baseRepo)
+}
+
 type setResult struct {
 	key       string
 	encrypted string
@@ -146,6 +146,20 @@ func TestNewCmdSet(t *testing.T) {
 				DoNotStore: true,
 			},
 		},
+		{
+			name: "dry run with env file",
+			cli:  `-f .env --dry-run`,
+			wants: SetOptions{
+				Visibility: shared.Private,
+				EnvFile:    ".env",
+				DryRun:     true,
+			},
+		},
+		{
+			name:     "dry run without env file",
+			cli:      `cool_secret -b"a secret" --dry-run`,
+			wantsErr: true,
+		},
 		{
 			name: "Dependabot repo",
 			cli:  `cool_secret -b"a secret" --app Dependabot`,
@@ -218,6 +232,8 @@ func TestNewCmdSet(t *testing.T) {
 			assert.Equal(t, tt.wants.OrgName, gotOpts.OrgName)
 			assert.Equal(t, tt.wants.EnvName, gotOpts.EnvName)
 			assert.Equal(t, tt.wants.DoNotStore, gotOpts.DoNotStore)
+			assert.Equal(t, tt.wants.EnvFile, gotOpts.EnvFile)
+			assert.Equal(t, tt.wants.DryRun, gotOpts.DryRun)
 			assert.ElementsMatch(t, tt.wants.RepositoryNames, gotOpts.RepositoryNames)
 			assert.Equal(t, tt.wants.Application, gotOpts.Application)
 		})
@@ -685,6 +701,73 @@ func Test_setRun_shouldNotStore(t *testing.T) {
 	assert.Equal(t, "", stderr.String())
 }
 
+func Test_setRun_dryRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		tty        bool
+		opts       *SetOptions
+		httpStubs  func(*httpmock.Registry)
+		wantStdout string
+	}{
+		{
+			name: "repo secrets",
+			opts: &SetOptions{},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", "repos/owner/repo/actions/secrets"),
+					httpmock.StringResponse(`{"secrets":[{"name":"QUUX"}]}`))
+			},
+			wantStdout: "FOO\tcreate\nQUUX\tupdate\n",
+		},
+		{
+			name: "env secrets with summary",
+			tty:  true,
+			opts: &SetOptions{EnvName: "production"},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(httpmock.REST("GET", "repos/owner/repo/environments/production/secrets"),
+					httpmock.StringResponse(`{"secrets":[]}`))
+			},
+			wantStdout: "FOO\tcreate\nQUUX\tcreate\n\nDry run: 2 Actions secrets would be set for owner/repo\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := os.CreateTemp(t.TempDir(), "gh-env.*")
+			assert.NoError(t, err)
+			_, err = f.WriteString("FOO=bar\nQUUX=baz\n")
+			assert.NoError(t, err)
+			assert.NoError(t, f.Close())
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			tt.opts.IO = ios
+			tt.opts.EnvFile = f.Name()
+			tt.opts.DryRun = true
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("owner/repo")
+			}
+
+			err = setRun(tt.opts)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
+
 func Test_getBody(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -0,0 +1,199 @@
+package create
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/pkg/cmd/ssh-key/add"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/ssh"
+	"github.com/spf13/cobra"
+)
+
+type CreateOptions struct {
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+	HTTPClient func() (*http.Client, error)
+	Prompter   prompter.Prompter
+
+	Title           string
+	Type            string
+	UpdateSSHConfig bool
+
+	sshContext ssh.Context
+}
+
+func NewCmdCreate(f *cmdutil.Factory, runF func(*CreateOptions) error) *cobra.Command {
+	opts := &CreateOptions{
+		HTTPClient: f.HttpClient,
+		Config:     f.Config,
+		IO:         f.IOStreams,
+		Prompter:   f.Prompter,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an SSH key and add it to your GitHub account",
+		Long: heredoc.Docf(`
+			Generate a new SSH key with %[1]sssh-keygen%[1]s and upload its public part to your
+			GitHub account in one step.
+
+			The key is written to %[1]s~/.ssh%[1]s under a name derived from its type, for example
+			%[1]s~/.ssh/id_ed25519%[1]s. If a key with that name already exists it is not
+			regenerated; its public part is uploaded instead.
+
+			The %[1]s--update-ssh-config%[1]s flag appends a host entry for your GitHub host to
+			%[1]s~/.ssh/config%[1]s so that SSH uses the new key, unless an entry for the host
+			already exists.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Generate an ed25519 key and upload it
+			$ gh ssh-key create --title work-laptop
+
+			# Generate an RSA key and configure SSH to use it
+			$ gh ssh-key create --title work-laptop --type rsa --update-ssh-config
+		`),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if runF != nil {
+				return runF(opts)
+			}
+			return createRun(opts)
+		},
+	}
+
+	typeEnums := []string{"ed25519", "rsa"}
+	cmdutil.StringEnumFlag(cmd, &opts.Type, "type", "", "ed25519", typeEnums, "Type of the key to generate")
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title for the new key")
+	cmd.Flags().BoolVar(&opts.UpdateSSHConfig, "update-ssh-config", false, "Configure SSH to use the new key for your GitHub host")
+
+	return cmd
+}
+
+func createRun(opts *CreateOptions) error {
+	cs := opts.IO.ColorScheme()
+
+	if !opts.sshContext.HasKeygen() {
+		return errors.New("could not find `ssh-keygen` on your PATH")
+	}
+
+	var passphrase string
+	if opts.IO.CanPrompt() {
+		var err error
+		passphrase, err = opts.Prompter.Password(
+			"Enter a passphrase for your new SSH key (Optional):")
+		if err != nil {
+			return err
+		}
+	}
+
+	keyName := "id_" + opts.Type
+	keyPair, err := opts.sshContext.GenerateSSHKeyOfType(keyName, opts.Type, passphrase)
+	if err != nil && !errors.Is(err, ssh.ErrKeyAlreadyExists) {
+		return err
+	}
+
+	if errors.Is(err, ssh.ErrKeyAlreadyExists) {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Using existing SSH key %s\n", cs.WarningIcon(), keyPair.PrivateKeyPath)
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Generated SSH key %s\n", cs.SuccessIcon(), keyPair.PrivateKeyPath)
+	}
+
+	httpClient, err := opts.HTTPClient()
+	if err != nil {
+		return err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return err
+	}
+
+	hostname, _ := cfg.Authentication().DefaultHost()
+
+	keyFile, err := os.Open(keyPair.PublicKeyPath)
+	if err != nil {
+		return err
+	}
+	defer keyFile.Close()
+
+	uploaded, err := add.SSHKeyUpload(httpClient, hostname, keyFile, opts.Title)
+	if err != nil {
+		return err
+	}
+
+	if uploaded {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Public key added to your account\n", cs.SuccessIcon())
+	} else {
+		fmt.Fprintf(opts.IO.ErrOut, "%s Public key already exists on your account\n", cs.SuccessIcon())
+	}
+
+	if opts.UpdateSSHConfig {
+		updated, err := updateSSHConfig(&opts.sshContext, hostname, keyPair.PrivateKeyPath)
+		if err != nil {
+			return err
+		}
+		if updated {
+			fmt.Fprintf(opts.IO.ErrOut, "%s Added %s entry to your SSH configuration\n", cs.SuccessIcon(), hostname)
+		} else {
+			fmt.Fprintf(opts.IO.ErrOut, "%s An entry for %s already exists in your SSH configuration, skipping\n", cs.WarningIcon(), hostname)
+		}
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(opts.IO.Out, "\nTo test your connection, run:\n  $ ssh -T git@%s\n", hostname)
+	}
+
+	return nil
+}
+
+// updateSSHConfig appends a host entry for hostname to the SSH configuration
+// file, unless the file already contains one.
+func updateSSHConfig(sshContext *ssh.Context, hostname string, privateKeyPath string) (bool, error) {
+	sshDir, err := sshContext.SshDir()
+	if err != nil {
+		return false, err
+	}
+
+	configPath := filepath.Join(sshDir, "config")
+	contents, err := os.ReadFile(configPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || !strings.EqualFold(fields[0], "Host") {
+			continue
+		}
+		for _, host := range fields[1:] {
+			if strings.EqualFold(host, hostname) {
+				return false, nil
+			}
+		}
+	}
+
+	f, err := os.OpenFile(configPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	entry := fmt.Sprintf("Host %s\n  IdentityFile %s\n  IdentitiesOnly yes\n", hostname, privateKeyPath)
+	if len(contents) > 0 {
+		entry = "\n" + entry
+	}
+
+	if _, err := f.WriteString(entry); err != nil {
+		return false, err
+	}
+	return true, nil
+}
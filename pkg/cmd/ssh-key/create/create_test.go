@@ -0,0 +1,257 @@
+package create
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/prompter"
+	"github.com/cli/cli/v2/internal/run"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/ssh"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdCreate(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    CreateOptions
+		wantsErr string
+	}{
+		{
+			name: "no arguments",
+			cli:  "",
+			wants: CreateOptions{
+				Type: "ed25519",
+			},
+		},
+		{
+			name: "title and type",
+			cli:  "--title work-laptop --type rsa",
+			wants: CreateOptions{
+				Title: "work-laptop",
+				Type:  "rsa",
+			},
+		},
+		{
+			name: "update ssh config",
+			cli:  "--update-ssh-config",
+			wants: CreateOptions{
+				Type:            "ed25519",
+				UpdateSSHConfig: true,
+			},
+		},
+		{
+			name:     "invalid type",
+			cli:      "--type dsa",
+			wantsErr: "invalid argument \"dsa\" for \"--type\" flag: valid values are {ed25519|rsa}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := &cmdutil.Factory{}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *CreateOptions
+			cmd := NewCmdCreate(f, func(opts *CreateOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr != "" {
+				assert.EqualError(t, err, tt.wantsErr)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Title, gotOpts.Title)
+			assert.Equal(t, tt.wants.Type, gotOpts.Type)
+			assert.Equal(t, tt.wants.UpdateSSHConfig, gotOpts.UpdateSSHConfig)
+		})
+	}
+}
+
+func Test_createRun(t *testing.T) {
+	tests := []struct {
+		name           string
+		opts           CreateOptions
+		existingKey    bool
+		existingConfig string
+		httpStubs      func(*httpmock.Registry)
+		keygenStubs    func(*run.CommandStubber, string)
+		wantStdout     string
+		wantStderr     func(sshDir string) string
+		wantErrMsg     string
+		wantConfig     func(sshDir string) string
+	}{
+		{
+			name: "generates and uploads a new key",
+			opts: CreateOptions{
+				Title: "work-laptop",
+				Type:  "ed25519",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "user/keys"),
+					httpmock.StringResponse("[]"))
+				reg.Register(
+					httpmock.REST("POST", "user/keys"),
+					httpmock.RESTPayload(200, ``, func(payload map[string]interface{}) {
+						assert.Equal(t, "ssh-ed25519 asdf\n", payload["key"])
+						assert.Equal(t, "work-laptop", payload["title"])
+					}))
+			},
+			keygenStubs: func(cs *run.CommandStubber, sshDir string) {
+				cs.Register(`ssh-keygen -t ed25519`, 0, "", func(args []string) {
+					keyFile := filepath.Join(sshDir, "id_ed25519")
+					assert.NoError(t, os.WriteFile(keyFile, []byte("PRIVATE KEY"), 0600))
+					assert.NoError(t, os.WriteFile(keyFile+".pub", []byte("ssh-ed25519 asdf\n"), 0644))
+				})
+			},
+			wantStdout: "\nTo test your connection, run:\n  $ ssh -T git@github.com\n",
+			wantStderr: func(sshDir string) string {
+				return fmt.Sprintf("✓ Generated SSH key %s\n✓ Public key added to your account\n", filepath.Join(sshDir, "id_ed25519"))
+			},
+		},
+		{
+			name: "reuses an existing key",
+			opts: CreateOptions{
+				Type: "ed25519",
+			},
+			existingKey: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "user/keys"),
+					httpmock.StringResponse(`[{ "id": 1, "key": "ssh-ed25519 asdf" }]`))
+			},
+			wantStdout: "\nTo test your connection, run:\n  $ ssh -T git@github.com\n",
+			wantStderr: func(sshDir string) string {
+				return fmt.Sprintf("! Using existing SSH key %s\n✓ Public key already exists on your account\n", filepath.Join(sshDir, "id_ed25519"))
+			},
+		},
+		{
+			name: "updates SSH configuration",
+			opts: CreateOptions{
+				Type:            "ed25519",
+				UpdateSSHConfig: true,
+			},
+			existingKey: true,
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "user/keys"),
+					httpmock.StringResponse(`[{ "id": 1, "key": "ssh-ed25519 asdf" }]`))
+			},
+			wantStdout: "\nTo test your connection, run:\n  $ ssh -T git@github.com\n",
+			wantStderr: func(sshDir string) string {
+				return fmt.Sprintf(
+					"! Using existing SSH key %[1]s\n✓ Public key already exists on your account\n✓ Added github.com entry to your SSH configuration\n",
+					filepath.Join(sshDir, "id_ed25519"))
+			},
+			wantConfig: func(sshDir string) string {
+				return fmt.Sprintf("Host github.com\n  IdentityFile %s\n  IdentitiesOnly yes\n", filepath.Join(sshDir, "id_ed25519"))
+			},
+		},
+		{
+			name: "skips SSH configuration when host entry exists",
+			opts: CreateOptions{
+				Type:            "ed25519",
+				UpdateSSHConfig: true,
+			},
+			existingKey:    true,
+			existingConfig: "Host github.com\n  IdentityFile ~/.ssh/other_key\n",
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "user/keys"),
+					httpmock.StringResponse(`[{ "id": 1, "key": "ssh-ed25519 asdf" }]`))
+			},
+			wantStdout: "\nTo test your connection, run:\n  $ ssh -T git@github.com\n",
+			wantStderr: func(sshDir string) string {
+				return fmt.Sprintf(
+					"! Using existing SSH key %[1]s\n✓ Public key already exists on your account\n! An entry for github.com already exists in your SSH configuration, skipping\n",
+					filepath.Join(sshDir, "id_ed25519"))
+			},
+			wantConfig: func(sshDir string) string {
+				return "Host github.com\n  IdentityFile ~/.ssh/other_key\n"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, stderr := iostreams.Test()
+			ios.SetStdinTTY(true)
+			ios.SetStdoutTTY(true)
+			ios.SetStderrTTY(true)
+
+			sshDir := t.TempDir()
+
+			if tt.existingKey {
+				keyFile := filepath.Join(sshDir, "id_ed25519")
+				assert.NoError(t, os.WriteFile(keyFile, []byte("PRIVATE KEY"), 0600))
+				assert.NoError(t, os.WriteFile(keyFile+".pub", []byte("ssh-ed25519 asdf\n"), 0644))
+			}
+			if tt.existingConfig != "" {
+				assert.NoError(t, os.WriteFile(filepath.Join(sshDir, "config"), []byte(tt.existingConfig), 0600))
+			}
+
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			cmdStubs, restore := run.Stub()
+			defer restore(t)
+			if tt.keygenStubs != nil {
+				tt.keygenStubs(cmdStubs, sshDir)
+			}
+
+			tt.opts.IO = ios
+			tt.opts.HTTPClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			tt.opts.Prompter = &prompter.PrompterMock{
+				PasswordFunc: func(_ string) (string, error) {
+					return "", nil
+				},
+			}
+			tt.opts.sshContext = ssh.NewContextForTests(sshDir, "ssh-keygen")
+
+			err := createRun(&tt.opts)
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStdout, stdout.String())
+			assert.Equal(t, tt.wantStderr(sshDir), stderr.String())
+
+			if tt.wantConfig != nil {
+				contents, err := os.ReadFile(filepath.Join(sshDir, "config"))
+				assert.NoError(t, err)
+				assert.Equal(t, tt.wantConfig(sshDir), string(contents))
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package key
 
 import (
 	cmdAdd "github.com/cli/cli/v2/pkg/cmd/ssh-key/add"
+	cmdCreate "github.com/cli/cli/v2/pkg/cmd/ssh-key/create"
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/ssh-key/delete"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/ssh-key/list"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -16,6 +17,7 @@ func NewCmdSSHKey(f *cmdutil.Factory) *cobra.Command {
 	}
 
 	cmd.AddCommand(cmdAdd.NewCmdAdd(f, nil))
+	cmd.AddCommand(cmdCreate.NewCmdCreate(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 
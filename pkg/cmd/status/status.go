@@ -16,6 +16,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/tableprinter"
+	"github.com/cli/cli/v2/internal/text"
 	"github.com/cli/cli/v2/pkg/cmd/factory"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/cli/cli/v2/pkg/iostreams"
@@ -34,8 +35,10 @@ type StatusOptions struct {
 	HostConfig   hostConfig
 	CachedClient func(*http.Client, time.Duration) *http.Client
 	IO           *iostreams.IOStreams
+	Exporter     cmdutil.Exporter
 	Org          string
 	Exclude      []string
+	MineOnly     bool
 }
 
 func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Command {
@@ -49,18 +52,23 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 	cmd := &cobra.Command{
 		Use:   "status",
 		Short: "Print information about relevant issues, pull requests, and notifications across repositories",
-		Long: heredoc.Doc(`
+		Long: heredoc.Docf(`
 			The status command prints information about your work on GitHub across all the repositories you're subscribed to, including:
 
+			- Items blocking others (review requests, failing checks on your own pull requests)
+			- Items waiting on others (your own pull requests awaiting review)
 			- Assigned Issues
 			- Assigned Pull Requests
-			- Review Requests
 			- Mentions
 			- Repository Activity (new issues/pull requests, comments)
-		`),
+
+			The %[1]s--mine-only%[1]s flag limits output to items concerning your own issues and
+			pull requests, omitting mentions and repository activity.
+		`, "`"),
 		Example: heredoc.Doc(`
 			$ gh status -e cli/cli -e cli/go-gh # Exclude multiple repositories
 			$ gh status -o cli # Limit results to a single organization
+			$ gh status --mine-only # Only show items on your own issues and pull requests
 		`),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cfg, err := f.Config()
@@ -80,6 +88,8 @@ func NewCmdStatus(f *cmdutil.Factory, runF func(*StatusOptions) error) *cobra.Co
 
 	cmd.Flags().StringVarP(&opts.Org, "org", "o", "", "Report status within an organization")
 	cmd.Flags().StringSliceVarP(&opts.Exclude, "exclude", "e", []string{}, "Comma separated list of repos to exclude in owner/name format")
+	cmd.Flags().BoolVar(&opts.MineOnly, "mine-only", false, "Only show items on your own issues and pull requests")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, statusFields)
 
 	return cmd
 }
@@ -105,7 +115,8 @@ type StatusItem struct {
 	Repository string // owner/repo
 	Identifier string // eg cli/cli#1234 or just 1234
 	preview    string // eg This is the truncated body of something...
-	Reason     string // only used in repo activity
+	Reason     string // only used in repo activity and actionable groups
+	UpdatedAt  time.Time
 	index      int
 }
 
@@ -148,6 +159,32 @@ type SearchResult struct {
 	}
 }
 
+type AuthoredPR struct {
+	SearchResult
+	ReviewDecision string
+	Commits        struct {
+		Nodes []struct {
+			Commit struct {
+				StatusCheckRollup struct {
+					State string
+				}
+			}
+		}
+	}
+}
+
+// FailingChecks reports whether the head commit of the pull request has a
+// failing status check rollup.
+func (pr AuthoredPR) FailingChecks() bool {
+	for _, node := range pr.Commits.Nodes {
+		state := node.Commit.StatusCheckRollup.State
+		if state == "FAILURE" || state == "ERROR" {
+			return true
+		}
+	}
+	return false
+}
+
 type Results []SearchResult
 
 func (rs Results) Len() int {
@@ -169,16 +206,19 @@ type stringSet interface {
 }
 
 type StatusGetter struct {
-	Client         *http.Client
-	cachedClient   func(*http.Client, time.Duration) *http.Client
-	host           string
-	Org            string
-	Exclude        []string
-	AssignedPRs    []StatusItem
-	AssignedIssues []StatusItem
-	Mentions       []StatusItem
-	ReviewRequests []StatusItem
-	RepoActivity   []StatusItem
+	Client          *http.Client
+	cachedClient    func(*http.Client, time.Duration) *http.Client
+	host            string
+	Org             string
+	Exclude         []string
+	MineOnly        bool
+	AssignedPRs     []StatusItem
+	AssignedIssues  []StatusItem
+	Mentions        []StatusItem
+	ReviewRequests  []StatusItem
+	RepoActivity    []StatusItem
+	BlockingOthers  []StatusItem
+	WaitingOnOthers []StatusItem
 
 	authErrors   stringSet
 	authErrorsMu sync.Mutex
@@ -192,6 +232,7 @@ func NewStatusGetter(client *http.Client, hostname string, opts *StatusOptions)
 		Client:       client,
 		Org:          opts.Org,
 		Exclude:      opts.Exclude,
+		MineOnly:     opts.MineOnly,
 		cachedClient: opts.CachedClient,
 		host:         hostname,
 	}
@@ -397,7 +438,26 @@ fragment pr on PullRequest {
 		nameWithOwner
 	}
 }
-query AssignedSearch($searchAssigns: String!, $searchReviews: String!, $limit: Int = 25) {
+fragment authoredPr on PullRequest {
+	__typename
+	updatedAt
+	title
+	number
+	reviewDecision
+	repository {
+		nameWithOwner
+	}
+	commits(last: 1) {
+		nodes {
+			commit {
+				statusCheckRollup {
+					state
+				}
+			}
+		}
+	}
+}
+query AssignedSearch($searchAssigns: String!, $searchReviews: String!, $searchAuthored: String!, $limit: Int = 25) {
 	assignments: search(first: $limit, type: ISSUE, query: $searchAssigns) {
 		nodes {
 			...issue
@@ -409,6 +469,11 @@ query AssignedSearch($searchAssigns: String!, $searchReviews: String!, $limit: I
 			...pr
 		}
 	}
+	authored: search(first: $limit, type: ISSUE, query: $searchAuthored) {
+		nodes {
+			...authoredPr
+		}
+	}
 }`
 
 // Populate .AssignedPRs, .AssignedIssues, .ReviewRequests
@@ -417,17 +482,21 @@ func (s *StatusGetter) LoadSearchResults() error {
 
 	searchAssigns := `assignee:@me state:open archived:false`
 	searchReviews := `review-requested:@me state:open archived:false`
+	searchAuthored := `author:@me is:pr state:open archived:false`
 	if s.Org != "" {
 		searchAssigns += " org:" + s.Org
 		searchReviews += " org:" + s.Org
+		searchAuthored += " org:" + s.Org
 	}
 	for _, repo := range s.Exclude {
 		searchAssigns += " -repo:" + repo
 		searchReviews += " -repo:" + repo
+		searchAuthored += " -repo:" + repo
 	}
 	variables := map[string]interface{}{
-		"searchAssigns": searchAssigns,
-		"searchReviews": searchReviews,
+		"searchAssigns":  searchAssigns,
+		"searchReviews":  searchReviews,
+		"searchAuthored": searchAuthored,
 	}
 
 	var resp struct {
@@ -437,6 +506,9 @@ func (s *StatusGetter) LoadSearchResults() error {
 		ReviewRequested struct {
 			Nodes []*SearchResult
 		}
+		Authored struct {
+			Nodes []*AuthoredPR
+		}
 	}
 	err := c.GraphQL(s.hostname(), searchQuery, variables, &resp)
 	if err != nil {
@@ -469,6 +541,7 @@ func (s *StatusGetter) LoadSearchResults() error {
 	prs := []SearchResult{}
 	issues := []SearchResult{}
 	reviewRequested := []SearchResult{}
+	authored := []AuthoredPR{}
 
 	for _, node := range resp.Assignments.Nodes {
 		if node == nil {
@@ -491,6 +564,13 @@ func (s *StatusGetter) LoadSearchResults() error {
 		reviewRequested = append(reviewRequested, *node)
 	}
 
+	for _, node := range resp.Authored.Nodes {
+		if node == nil {
+			continue // likely due to FORBIDDEN results
+		}
+		authored = append(authored, *node)
+	}
+
 	sort.Sort(Results(issues))
 	sort.Sort(Results(prs))
 	sort.Sort(Results(reviewRequested))
@@ -498,6 +578,8 @@ func (s *StatusGetter) LoadSearchResults() error {
 	s.AssignedIssues = []StatusItem{}
 	s.AssignedPRs = []StatusItem{}
 	s.ReviewRequests = []StatusItem{}
+	s.BlockingOthers = []StatusItem{}
+	s.WaitingOnOthers = []StatusItem{}
 
 	for _, i := range issues {
 		s.AssignedIssues = append(s.AssignedIssues, StatusItem{
@@ -520,9 +602,48 @@ func (s *StatusGetter) LoadSearchResults() error {
 			Repository: r.Repository.NameWithOwner,
 			Identifier: fmt.Sprintf("%s#%d", r.Repository.NameWithOwner, r.Number),
 			preview:    r.Title,
+			UpdatedAt:  r.UpdatedAt,
 		})
 	}
 
+	// review requests block their authors; with --mine-only, only your own
+	// pull requests are considered
+	if !s.MineOnly {
+		for _, rr := range s.ReviewRequests {
+			rr.Reason = "review requested"
+			s.BlockingOthers = append(s.BlockingOthers, rr)
+		}
+	}
+
+	for _, pr := range authored {
+		item := StatusItem{
+			Repository: pr.Repository.NameWithOwner,
+			Identifier: fmt.Sprintf("%s#%d", pr.Repository.NameWithOwner, pr.Number),
+			preview:    pr.Title,
+			UpdatedAt:  pr.UpdatedAt,
+		}
+		switch {
+		case pr.FailingChecks():
+			item.Reason = "failing checks"
+			s.BlockingOthers = append(s.BlockingOthers, item)
+		case pr.ReviewDecision == "CHANGES_REQUESTED":
+			item.Reason = "changes requested"
+			s.BlockingOthers = append(s.BlockingOthers, item)
+		case pr.ReviewDecision == "APPROVED":
+			// nothing pending; the pull request is ready to merge
+		default:
+			item.Reason = "needs review"
+			s.WaitingOnOthers = append(s.WaitingOnOthers, item)
+		}
+	}
+
+	sort.Slice(s.BlockingOthers, func(i, j int) bool {
+		return s.BlockingOthers[i].UpdatedAt.After(s.BlockingOthers[j].UpdatedAt)
+	})
+	sort.Slice(s.WaitingOnOthers, func(i, j int) bool {
+		return s.WaitingOnOthers[i].UpdatedAt.After(s.WaitingOnOthers[j].UpdatedAt)
+	})
+
 	return nil
 }
 
@@ -619,6 +740,55 @@ func (s *StatusGetter) addAuthError(msg, ssoURL string) {
 	}
 }
 
+var statusFields = []string{
+	"assignedIssues",
+	"assignedPullRequests",
+	"blockingOthers",
+	"waitingOnOthers",
+	"mentions",
+	"repoActivity",
+}
+
+func (s *StatusGetter) ExportData(fields []string) map[string]interface{} {
+	itemData := func(items []StatusItem) []map[string]interface{} {
+		data := make([]map[string]interface{}, 0, len(items))
+		for _, si := range items {
+			item := map[string]interface{}{
+				"repository": si.Repository,
+				"identifier": si.Identifier,
+				"preview":    si.Preview(),
+			}
+			if si.Reason != "" {
+				item["reason"] = si.Reason
+			}
+			if !si.UpdatedAt.IsZero() {
+				item["updatedAt"] = si.UpdatedAt
+			}
+			data = append(data, item)
+		}
+		return data
+	}
+
+	data := map[string]interface{}{}
+	for _, f := range fields {
+		switch f {
+		case "assignedIssues":
+			data[f] = itemData(s.AssignedIssues)
+		case "assignedPullRequests":
+			data[f] = itemData(s.AssignedPRs)
+		case "blockingOthers":
+			data[f] = itemData(s.BlockingOthers)
+		case "waitingOnOthers":
+			data[f] = itemData(s.WaitingOnOthers)
+		case "mentions":
+			data[f] = itemData(s.Mentions)
+		case "repoActivity":
+			data[f] = itemData(s.RepoActivity)
+		}
+	}
+	return data
+}
+
 func (s *StatusGetter) HasAuthErrors() bool {
 	s.authErrorsMu.Lock()
 	defer s.authErrorsMu.Unlock()
@@ -639,18 +809,20 @@ func statusRun(opts *StatusOptions) error {
 	// TODO break out sections into individual subcommands
 
 	g := new(errgroup.Group)
-	g.Go(func() error {
-		if err := sg.LoadNotifications(); err != nil {
-			return fmt.Errorf("could not load notifications: %w", err)
-		}
-		return nil
-	})
-	g.Go(func() error {
-		if err := sg.LoadEvents(); err != nil {
-			return fmt.Errorf("could not load events: %w", err)
-		}
-		return nil
-	})
+	if !opts.MineOnly {
+		g.Go(func() error {
+			if err := sg.LoadNotifications(); err != nil {
+				return fmt.Errorf("could not load notifications: %w", err)
+			}
+			return nil
+		})
+		g.Go(func() error {
+			if err := sg.LoadEvents(); err != nil {
+				return fmt.Errorf("could not load events: %w", err)
+			}
+			return nil
+		})
+	}
 	g.Go(func() error {
 		if err := sg.LoadSearchResults(); err != nil {
 			return fmt.Errorf("failed to search: %w", err)
@@ -665,6 +837,10 @@ func statusRun(opts *StatusOptions) error {
 		return err
 	}
 
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, sg)
+	}
+
 	cs := opts.IO.ColorScheme()
 	out := opts.IO.Out
 	fullWidth := opts.IO.TerminalWidth()
@@ -674,6 +850,8 @@ func statusRun(opts *StatusOptions) error {
 	leftHalfStyle := lipgloss.NewStyle().Width(halfWidth).Padding(0).MarginRight(1).BorderRight(true).BorderStyle(lipgloss.NormalBorder())
 	rightHalfStyle := lipgloss.NewStyle().Width(halfWidth).Padding(0)
 
+	now := time.Now()
+
 	section := func(header string, items []StatusItem, width, rowLimit int) (string, error) {
 		tableOut := &bytes.Buffer{}
 		fmt.Fprintln(tableOut, cs.Bold(header))
@@ -687,6 +865,9 @@ func statusRun(opts *StatusOptions) error {
 					break
 				}
 				tp.AddField(si.Identifier, tableprinter.WithColor(idStyle), tableprinter.WithTruncate(nil))
+				if !si.UpdatedAt.IsZero() {
+					tp.AddField(text.FuzzyAgoAbbr(now, si.UpdatedAt), tableprinter.WithTruncate(nil))
+				}
 				if si.Reason != "" {
 					tp.AddField(si.Reason)
 				}
@@ -703,17 +884,17 @@ func statusRun(opts *StatusOptions) error {
 		return tableOut.String(), nil
 	}
 
-	mSection, err := section("Mentions", sg.Mentions, halfWidth, 5)
+	// the actionable groups get the full width so that the age and reason fit
+	// next to the preview
+	blockingSection, err := section("Blocking Others", sg.BlockingOthers, fullWidth, 5)
 	if err != nil {
-		return fmt.Errorf("failed to render 'Mentions': %w", err)
+		return fmt.Errorf("failed to render 'Blocking Others': %w", err)
 	}
-	mSection = rightHalfStyle.Render(mSection)
 
-	rrSection, err := section("Review Requests", sg.ReviewRequests, halfWidth, 5)
+	waitingSection, err := section("Waiting on Others", sg.WaitingOnOthers, fullWidth, 5)
 	if err != nil {
-		return fmt.Errorf("failed to render 'Review Requests': %w", err)
+		return fmt.Errorf("failed to render 'Waiting on Others': %w", err)
 	}
-	rrSection = leftHalfStyle.Render(rrSection)
 
 	prSection, err := section("Assigned Pull Requests", sg.AssignedPRs, halfWidth, 5)
 	if err != nil {
@@ -727,14 +908,24 @@ func statusRun(opts *StatusOptions) error {
 	}
 	issueSection = leftHalfStyle.Render(issueSection)
 
-	raSection, err := section("Repository Activity", sg.RepoActivity, fullWidth, 10)
-	if err != nil {
-		return fmt.Errorf("failed to render 'Repository Activity': %w", err)
-	}
-
+	fmt.Fprintln(out, blockingSection)
+	fmt.Fprintln(out, waitingSection)
 	fmt.Fprintln(out, lipgloss.JoinHorizontal(lipgloss.Top, issueSection, prSection))
-	fmt.Fprintln(out, lipgloss.JoinHorizontal(lipgloss.Top, rrSection, mSection))
-	fmt.Fprintln(out, raSection)
+
+	if !opts.MineOnly {
+		mSection, err := section("Mentions", sg.Mentions, fullWidth, 5)
+		if err != nil {
+			return fmt.Errorf("failed to render 'Mentions': %w", err)
+		}
+
+		raSection, err := section("Repository Activity", sg.RepoActivity, fullWidth, 10)
+		if err != nil {
+			return fmt.Errorf("failed to render 'Repository Activity': %w", err)
+		}
+
+		fmt.Fprintln(out, mSection)
+		fmt.Fprintln(out, raSection)
+	}
 
 	if sg.HasAuthErrors() {
 		errs := sg.authErrors.ToSlice()
@@ -8,7 +8,6 @@ import (
 	"testing"
 	"time"
 
-	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/internal/config"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/pkg/cmdutil"
@@ -47,6 +46,13 @@ func TestNewCmdStatus(t *testing.T) {
 				Exclude: []string{"cli/cli", "cli/go-gh"},
 			},
 		},
+		{
+			name: "mine only",
+			cli:  "--mine-only",
+			wants: StatusOptions{
+				MineOnly: true,
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -80,6 +86,7 @@ func TestNewCmdStatus(t *testing.T) {
 
 			assert.Equal(t, tt.wants.Org, gotOpts.Org)
 			assert.Equal(t, tt.wants.Exclude, gotOpts.Exclude)
+			assert.Equal(t, tt.wants.MineOnly, gotOpts.MineOnly)
 		})
 	}
 }
@@ -109,7 +116,7 @@ func TestStatusRun(t *testing.T) {
 					httpmock.StringResponse(`[]`))
 			},
 			opts:    &StatusOptions{},
-			wantOut: "Assigned Issues                       │ Assigned Pull Requests                \nNothing here ^_^                      │ Nothing here ^_^                      \n                                      │                                       \nReview Requests                       │ Mentions                              \nNothing here ^_^                      │ Nothing here ^_^                      \n                                      │                                       \nRepository Activity\nNothing here ^_^\n\n",
+			wantOut: "Blocking Others\nNothing here ^_^\n\nWaiting on Others\nNothing here ^_^\n\nAssigned Issues                       │ Assigned Pull Requests                \nNothing here ^_^                      │ Nothing here ^_^                      \n                                      │                                       \nMentions\nNothing here ^_^\n\nRepository Activity\nNothing here ^_^\n\n",
 		},
 		{
 			name: "something",
@@ -143,7 +150,7 @@ func TestStatusRun(t *testing.T) {
 					httpmock.FileResponse("./fixtures/events.json"))
 			},
 			opts:    &StatusOptions{},
-			wantOut: "Assigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ rpd/todo#110               hello @j...\nvilmibm/testing#1234  Foobar          │ cli/cli#1096               @jillval...\nrpd/todo#50           Welcome party...│ vilmibm/gh-screensaver#15  a messag...\ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nrpd/todo#5326         new PR                        Only write UTF-8 BOM on W...\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\ncli/cli#5319          comment on [Codespaces] D...  Wondering if we shouldn't...\ncli/cli#5300          new issue                     Terminal bell when a runn...\n\n",
+			wantOut: "Blocking Others\ncli/cli#5272          Mar 15, 2022  review requested  Pin extensions\nrpd/todo#55           Mar 14, 2022  failing checks    Escape the mansion\nvilmibm/testing#1234  Feb 18, 2022  review requested  Foobar\nrpd/todo#50           Feb  4, 2022  review requested  Welcome party for Leon\ncli/cli#4671          Dec 20, 2021  review requested  This pull request adds ...\n\nWaiting on Others\ncli/cli#4890  Mar 12, 2022  needs review  Add tests for the herb garden\n\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nMentions\nrpd/todo#110               hello @jillvalentine how are you\ncli/cli#1096               @jillvalentine hi\nvilmibm/gh-screensaver#15  a message for @jillvalentine\n\nRepository Activity\nrpd/todo#5326         new PR                        Only write UTF-8 BOM on W...\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\ncli/cli#5319          comment on [Codespaces] D...  Wondering if we shouldn't...\ncli/cli#5300          new issue                     Terminal bell when a runn...\n\n",
 		},
 		{
 			name: "exclude a repository",
@@ -178,7 +185,7 @@ func TestStatusRun(t *testing.T) {
 			},
 			// NOTA BENE: you'll see cli/cli in search results because that happens
 			// server side and the fixture doesn't account for that
-			wantOut: "Assigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ rpd/todo#110               hello @j...\nvilmibm/testing#1234  Foobar          │ vilmibm/gh-screensaver#15  a messag...\nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nrpd/todo#5326         new PR                        Only write UTF-8 BOM on W...\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\n\n",
+			wantOut: "Blocking Others\ncli/cli#5272          Mar 15, 2022  review requested  Pin extensions\nrpd/todo#55           Mar 14, 2022  failing checks    Escape the mansion\nvilmibm/testing#1234  Feb 18, 2022  review requested  Foobar\nrpd/todo#50           Feb  4, 2022  review requested  Welcome party for Leon\ncli/cli#4671          Dec 20, 2021  review requested  This pull request adds ...\n\nWaiting on Others\ncli/cli#4890  Mar 12, 2022  needs review  Add tests for the herb garden\n\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nMentions\nrpd/todo#110               hello @jillvalentine how are you\nvilmibm/gh-screensaver#15  a message for @jillvalentine\n\nRepository Activity\nrpd/todo#5326         new PR                        Only write UTF-8 BOM on W...\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\n\n",
 		},
 		{
 			name: "exclude repositories",
@@ -204,7 +211,7 @@ func TestStatusRun(t *testing.T) {
 			},
 			// NOTA BENE: you'll see cli/cli in search results because that happens
 			// server side and the fixture doesn't account for that
-			wantOut: "Assigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ vilmibm/gh-screensaver#15  a messag...\nvilmibm/testing#1234  Foobar          │                                       \nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\n\n",
+			wantOut: "Blocking Others\ncli/cli#5272          Mar 15, 2022  review requested  Pin extensions\nrpd/todo#55           Mar 14, 2022  failing checks    Escape the mansion\nvilmibm/testing#1234  Feb 18, 2022  review requested  Foobar\nrpd/todo#50           Feb  4, 2022  review requested  Welcome party for Leon\ncli/cli#4671          Dec 20, 2021  review requested  This pull request adds ...\n\nWaiting on Others\ncli/cli#4890  Mar 12, 2022  needs review  Add tests for the herb garden\n\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nMentions\nvilmibm/gh-screensaver#15  a message for @jillvalentine\n\nRepository Activity\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\n\n",
 		},
 		{
 			name: "filter to an org",
@@ -234,7 +241,7 @@ func TestStatusRun(t *testing.T) {
 			opts: &StatusOptions{
 				Org: "rpd",
 			},
-			wantOut: "Assigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nReview Requests                       │ Mentions                              \ncli/cli#5272          Pin extensions  │ rpd/todo#110  hello @jillvalentine ...\nvilmibm/testing#1234  Foobar          │                                       \nrpd/todo#50           Welcome party...│                                       \ncli/cli#4671          This pull req...│                                       \nrpd/todo#49           Haircut for Leon│                                       \n                                      │                                       \nRepository Activity\nrpd/todo#5326  new PR  Only write UTF-8 BOM on Windows where it is needed\n\n",
+			wantOut: "Blocking Others\ncli/cli#5272          Mar 15, 2022  review requested  Pin extensions\nrpd/todo#55           Mar 14, 2022  failing checks    Escape the mansion\nvilmibm/testing#1234  Feb 18, 2022  review requested  Foobar\nrpd/todo#50           Feb  4, 2022  review requested  Welcome party for Leon\ncli/cli#4671          Dec 20, 2021  review requested  This pull request adds ...\n\nWaiting on Others\ncli/cli#4890  Mar 12, 2022  needs review  Add tests for the herb garden\n\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nMentions\nrpd/todo#110  hello @jillvalentine how are you\n\nRepository Activity\nrpd/todo#5326  new PR  Only write UTF-8 BOM on Windows where it is needed\n\n",
 		},
 		{
 			name: "forbidden errors",
@@ -277,24 +284,8 @@ func TestStatusRun(t *testing.T) {
 					httpmock.REST("GET", "users/jillvalentine/received_events"),
 					httpmock.FileResponse("./fixtures/events.json"))
 			},
-			opts: &StatusOptions{},
-			wantOut: heredoc.Doc(`
-				Assigned Issues                       │ Assigned Pull Requests                
-				vilmibm/testing#157  yolo             │ cli/cli#5272  Pin extensions          
-				                                      │                                       
-				Review Requests                       │ Mentions                              
-				cli/cli#5272  Pin extensions          │ cli/cli#1096               @jillval...
-				                                      │ vilmibm/gh-screensaver#15  a messag...
-				                                                                              
-				Repository Activity
-				rpd/todo#5326         new PR                        Only write UTF-8 BOM on W...
-				vilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...
-				cli/cli#5319          comment on [Codespaces] D...  Wondering if we shouldn't...
-				cli/cli#5300          new issue                     Terminal bell when a runn...
-				
-				warning: Resource protected by organization SAML enforcement. You must grant your OAuth token access to this organization.
-				warning: You don't have permission to access this resource.
-			`),
+			opts:    &StatusOptions{},
+			wantOut: "Blocking Others\ncli/cli#5272  Mar 15, 2022  review requested  Pin extensions\n\nWaiting on Others\nNothing here ^_^\n\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157  yolo             │ cli/cli#5272  Pin extensions          \n                                      │                                       \nMentions\ncli/cli#1096               @jillvalentine hi\nvilmibm/gh-screensaver#15  a message for @jillvalentine\n\nRepository Activity\nrpd/todo#5326         new PR                        Only write UTF-8 BOM on W...\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\ncli/cli#5319          comment on [Codespaces] D...  Wondering if we shouldn't...\ncli/cli#5300          new issue                     Terminal bell when a runn...\n\nwarning: Resource protected by organization SAML enforcement. You must grant your OAuth token access to this organization.\nwarning: You don't have permission to access this resource.\n",
 		},
 		{
 			name: "not found errors",
@@ -329,29 +320,8 @@ func TestStatusRun(t *testing.T) {
 					httpmock.REST("GET", "users/jillvalentine/received_events"),
 					httpmock.FileResponse("./fixtures/events.json"))
 			},
-			opts: &StatusOptions{},
-			wantOut: heredoc.Doc(`
-				Assigned Issues                       │ Assigned Pull Requests                
-				vilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          
-				cli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    
-				rpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          
-				vilmibm/testing#74      welp          │                                       
-				adreyer/arkestrator#22  complete mo...│                                       
-				                                      │                                       
-				Review Requests                       │ Mentions                              
-				cli/cli#5272          Pin extensions  │ rpd/todo#110               hello @j...
-				vilmibm/testing#1234  Foobar          │ cli/cli#1096               @jillval...
-				rpd/todo#50           Welcome party...│ vilmibm/gh-screensaver#15  a messag...
-				cli/cli#4671          This pull req...│                                       
-				rpd/todo#49           Haircut for Leon│                                       
-				                                      │                                       
-				Repository Activity
-				rpd/todo#5326         new PR                        Only write UTF-8 BOM on W...
-				vilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...
-				cli/cli#5319          comment on [Codespaces] D...  Wondering if we shouldn't...
-				cli/cli#5300          new issue                     Terminal bell when a runn...
-				
-			`),
+			opts:    &StatusOptions{},
+			wantOut: "Blocking Others\ncli/cli#5272          Mar 15, 2022  review requested  Pin extensions\nrpd/todo#55           Mar 14, 2022  failing checks    Escape the mansion\nvilmibm/testing#1234  Feb 18, 2022  review requested  Foobar\nrpd/todo#50           Feb  4, 2022  review requested  Welcome party for Leon\ncli/cli#4671          Dec 20, 2021  review requested  This pull request adds ...\n\nWaiting on Others\ncli/cli#4890  Mar 12, 2022  needs review  Add tests for the herb garden\n\nAssigned Issues                       │ Assigned Pull Requests                \nvilmibm/testing#157     yolo          │ cli/cli#5272  Pin extensions          \ncli/cli#3223            Repo garden...│ rpd/todo#73   Board up RPD windows    \nrpd/todo#514            Reducing zo...│ cli/cli#4768  Issue Frecency          \nvilmibm/testing#74      welp          │                                       \nadreyer/arkestrator#22  complete mo...│                                       \n                                      │                                       \nMentions\nrpd/todo#110               hello @jillvalentine how are you\ncli/cli#1096               @jillvalentine hi\nvilmibm/gh-screensaver#15  a message for @jillvalentine\n\nRepository Activity\nrpd/todo#5326         new PR                        Only write UTF-8 BOM on W...\nvilmibm/testing#5325  comment on Ability to sea...  We are working on dedicat...\ncli/cli#5319          comment on [Codespaces] D...  Wondering if we shouldn't...\ncli/cli#5300          new issue                     Terminal bell when a runn...\n\n",
 		},
 		{
 			name: "notification errors",
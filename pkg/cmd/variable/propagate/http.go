@@ -0,0 +1,73 @@
+package propagate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+)
+
+type setPayload struct {
+	Name         string  `json:"name,omitempty"`
+	Repositories []int64 `json:"selected_repository_ids,omitempty"`
+	Value        string  `json:"value,omitempty"`
+	Visibility   string  `json:"visibility,omitempty"`
+}
+
+func postVariable(client *api.Client, host, path string, payload interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize: %w", err)
+	}
+	requestBody := bytes.NewReader(payloadBytes)
+	return client.REST(host, "POST", path, requestBody, nil)
+}
+
+func patchVariable(client *api.Client, host, path string, payload interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize: %w", err)
+	}
+	requestBody := bytes.NewReader(payloadBytes)
+	return client.REST(host, "PATCH", path, requestBody, nil)
+}
+
+func postOrgVariable(client *api.Client, host, orgName, visibility, variableName, value string, repositoryIDs []int64) error {
+	payload := setPayload{
+		Name:         variableName,
+		Value:        value,
+		Visibility:   visibility,
+		Repositories: repositoryIDs,
+	}
+	path := fmt.Sprintf(`orgs/%s/actions/variables`, orgName)
+	return postVariable(client, host, path, payload)
+}
+
+func patchOrgVariable(client *api.Client, host, orgName, visibility, variableName, value string, repositoryIDs []int64) error {
+	payload := setPayload{
+		Value:        value,
+		Visibility:   visibility,
+		Repositories: repositoryIDs,
+	}
+	path := fmt.Sprintf(`orgs/%s/actions/variables/%s`, orgName, variableName)
+	return patchVariable(client, host, path, payload)
+}
+
+func postRepoVariable(client *api.Client, repo ghrepo.Interface, variableName, value string) error {
+	payload := setPayload{
+		Name:  variableName,
+		Value: value,
+	}
+	path := fmt.Sprintf(`repos/%s/actions/variables`, ghrepo.FullName(repo))
+	return postVariable(client, repo.RepoHost(), path, payload)
+}
+
+func patchRepoVariable(client *api.Client, repo ghrepo.Interface, variableName, value string) error {
+	payload := setPayload{
+		Value: value,
+	}
+	path := fmt.Sprintf(`repos/%s/actions/variables/%s`, ghrepo.FullName(repo), variableName)
+	return patchVariable(client, repo.RepoHost(), path, payload)
+}
@@ -0,0 +1,281 @@
+package propagate
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmd/variable/shared"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+)
+
+type PropagateOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+	Config     func() (gh.Config, error)
+
+	Exporter cmdutil.Exporter
+
+	VariableName string
+	FromRepo     string
+	ToFile       string
+	OrgName      string
+}
+
+var propagateFields = []string{
+	"repository",
+	"status",
+	"error",
+}
+
+const (
+	statusCreated  = "created"
+	statusUpdated  = "updated"
+	statusSelected = "selected"
+	statusFailed   = "failed"
+)
+
+type propagateResult struct {
+	Repository string `json:"repository"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+}
+
+func (r *propagateResult) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(r, fields)
+}
+
+func NewCmdPropagate(f *cmdutil.Factory, runF func(*PropagateOptions) error) *cobra.Command {
+	opts := &PropagateOptions{
+		IO:         f.IOStreams,
+		Config:     f.Config,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "propagate <variable-name>",
+		Short: "Propagate a variable across repositories",
+		Long: heredoc.Docf(`
+			Copy the value of a variable from a source repository to every repository
+			listed in a file. The file contains one repository per line, in the
+			%[1]sOWNER/REPO%[1]s format; blank lines and lines starting with %[1]s#%[1]s are ignored.
+
+			With %[1]s--org%[1]s, a single organization variable is set instead, with its
+			visibility restricted to the repositories listed in the file.
+
+			Each propagated repository is reported, which with %[1]s--json%[1]s produces an
+			audit-friendly record of the run.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Copy a variable from one repository to the repositories listed in a file
+			$ gh variable propagate MYVARIABLE --from monalisa/source --to-file repos.txt
+
+			# Set an organization variable scoped to the repositories listed in a file
+			$ gh variable propagate MYVARIABLE --from monalisa/source --to-file repos.txt --org myOrg
+		`),
+		Args: cmdutil.ExactArgs(1, "cannot propagate variable: name argument required"),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.VariableName = args[0]
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return propagateRun(opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.FromRepo, "from", "", "Source `repository` to read the variable from")
+	cmd.Flags().StringVar(&opts.ToFile, "to-file", "", "Read target repositories from `file` (use \"-\" to read from standard input)")
+	cmd.Flags().StringVarP(&opts.OrgName, "org", "o", "", "Set a single `organization` variable selected for the target repositories")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, propagateFields)
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("to-file")
+
+	return cmd
+}
+
+func propagateRun(opts *PropagateOptions) error {
+	source, err := ghrepo.FromFullName(opts.FromRepo)
+	if err != nil {
+		return fmt.Errorf("invalid value for `--from`: %w", err)
+	}
+
+	b, err := cmdutil.ReadFile(opts.ToFile, opts.IO.In)
+	if err != nil {
+		return err
+	}
+
+	targets, err := parseRepoFile(b, source.RepoHost(), opts.OrgName)
+	if err != nil {
+		return err
+	}
+
+	c, err := opts.HttpClient()
+	if err != nil {
+		return fmt.Errorf("could not create http client: %w", err)
+	}
+	client := api.NewClientFromHTTP(c)
+
+	var variable shared.Variable
+	path := fmt.Sprintf("repos/%s/actions/variables/%s", ghrepo.FullName(source), opts.VariableName)
+	if err := client.REST(source.RepoHost(), "GET", path, nil, &variable); err != nil {
+		var httpErr api.HTTPError
+		if errors.As(err, &httpErr) && httpErr.StatusCode == http.StatusNotFound {
+			return fmt.Errorf("variable %s was not found in %s", opts.VariableName, ghrepo.FullName(source))
+		}
+		return fmt.Errorf("failed to get variable %s: %w", opts.VariableName, err)
+	}
+
+	var results []propagateResult
+	if opts.OrgName != "" {
+		cfg, err := opts.Config()
+		if err != nil {
+			return err
+		}
+		host, _ := cfg.Authentication().DefaultHost()
+
+		opts.IO.StartProgressIndicator()
+		results, err = propagateOrgVariable(client, host, opts.OrgName, opts.VariableName, variable.Value, targets)
+		opts.IO.StopProgressIndicator()
+		if err != nil {
+			return err
+		}
+	} else {
+		opts.IO.StartProgressIndicator()
+		results = propagateRepoVariable(client, opts.VariableName, variable.Value, targets)
+		opts.IO.StopProgressIndicator()
+	}
+
+	err = nil
+	var failed int
+	for _, result := range results {
+		if result.Status == statusFailed {
+			failed++
+			err = multierror.Append(err, errors.New(result.Error))
+		}
+	}
+
+	if opts.Exporter != nil {
+		if werr := opts.Exporter.Write(opts.IO, results); werr != nil {
+			return werr
+		}
+		return err
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		cs := opts.IO.ColorScheme()
+		for _, result := range results {
+			switch result.Status {
+			case statusFailed:
+				fmt.Fprintf(opts.IO.Out, "%s %s: %s\n", cs.FailureIcon(), result.Repository, result.Error)
+			case statusSelected:
+				fmt.Fprintf(opts.IO.Out, "%s Selected %s for organization variable %s in %s\n", cs.SuccessIcon(), result.Repository, opts.VariableName, opts.OrgName)
+			default:
+				operation := "Created"
+				if result.Status == statusUpdated {
+					operation = "Updated"
+				}
+				fmt.Fprintf(opts.IO.Out, "%s %s variable %s for %s\n", cs.SuccessIcon(), operation, opts.VariableName, result.Repository)
+			}
+		}
+		propagated := len(results) - failed
+		noun := "repositories"
+		if propagated == 1 {
+			noun = "repository"
+		}
+		fmt.Fprintf(opts.IO.Out, "\nPropagated variable %s to %d %s\n", opts.VariableName, propagated, noun)
+	}
+
+	return err
+}
+
+// propagateRepoVariable sets the variable in every target repository,
+// concurrently, creating it where it does not exist yet.
+func propagateRepoVariable(client *api.Client, name, value string, targets []ghrepo.Interface) []propagateResult {
+	type indexedResult struct {
+		index  int
+		result propagateResult
+	}
+
+	resc := make(chan indexedResult)
+	for i, target := range targets {
+		go func(i int, target ghrepo.Interface) {
+			result := propagateResult{Repository: ghrepo.FullName(target), Status: statusCreated}
+			err := postRepoVariable(client, target, name, value)
+			var httpErr api.HTTPError
+			if errors.As(err, &httpErr) && httpErr.StatusCode == 409 {
+				// Server will return a 409 if variable already exists
+				result.Status = statusUpdated
+				err = patchRepoVariable(client, target, name, value)
+			}
+			if err != nil {
+				result.Status = statusFailed
+				result.Error = fmt.Sprintf("failed to set variable %q for %s: %v", name, result.Repository, err)
+			}
+			resc <- indexedResult{index: i, result: result}
+		}(i, target)
+	}
+
+	results := make([]propagateResult, len(targets))
+	for range targets {
+		r := <-resc
+		results[r.index] = r.result
+	}
+	return results
+}
+
+// propagateOrgVariable sets a single organization variable whose visibility is
+// restricted to the target repositories.
+func propagateOrgVariable(client *api.Client, host, orgName, name, value string, targets []ghrepo.Interface) ([]propagateResult, error) {
+	repositoryIDs, err := api.GetRepoIDs(client, host, targets)
+	if err != nil {
+		return nil, fmt.Errorf("failed to look up IDs for target repositories: %w", err)
+	}
+
+	err = postOrgVariable(client, host, orgName, shared.Selected, name, value, repositoryIDs)
+	var httpErr api.HTTPError
+	if errors.As(err, &httpErr) && httpErr.StatusCode == 409 {
+		// Server will return a 409 if variable already exists
+		err = patchOrgVariable(client, host, orgName, shared.Selected, name, value, repositoryIDs)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set organization variable %q: %w", name, err)
+	}
+
+	results := make([]propagateResult, len(targets))
+	for i, target := range targets {
+		results[i] = propagateResult{Repository: ghrepo.FullName(target), Status: statusSelected}
+	}
+	return results, nil
+}
+
+func parseRepoFile(b []byte, host, defaultOwner string) ([]ghrepo.Interface, error) {
+	var repos []ghrepo.Interface
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.Contains(line, "/") || defaultOwner == "" {
+			repo, err := ghrepo.FromFullNameWithHost(line, host)
+			if err != nil {
+				return nil, fmt.Errorf("invalid repository %q: %w", line, err)
+			}
+			repos = append(repos, repo)
+		} else {
+			repos = append(repos, ghrepo.NewWithHost(defaultOwner, line, host))
+		}
+	}
+	if len(repos) == 0 {
+		return nil, errors.New("no repositories found in file")
+	}
+	return repos, nil
+}
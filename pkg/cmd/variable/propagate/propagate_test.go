@@ -0,0 +1,246 @@
+package propagate
+
+import (
+	"bytes"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/config"
+	"github.com/cli/cli/v2/internal/gh"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdPropagate(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		output  PropagateOptions
+		wantErr bool
+		errMsg  string
+	}{
+		{
+			name:    "no argument",
+			input:   "--from monalisa/source --to-file repos.txt",
+			wantErr: true,
+			errMsg:  "cannot propagate variable: name argument required",
+		},
+		{
+			name:    "missing from",
+			input:   "MYVARIABLE --to-file repos.txt",
+			wantErr: true,
+			errMsg:  `required flag(s) "from" not set`,
+		},
+		{
+			name:    "missing to-file",
+			input:   "MYVARIABLE --from monalisa/source",
+			wantErr: true,
+			errMsg:  `required flag(s) "to-file" not set`,
+		},
+		{
+			name:  "repository targets",
+			input: "MYVARIABLE --from monalisa/source --to-file repos.txt",
+			output: PropagateOptions{
+				VariableName: "MYVARIABLE",
+				FromRepo:     "monalisa/source",
+				ToFile:       "repos.txt",
+			},
+		},
+		{
+			name:  "org flag",
+			input: "MYVARIABLE --from monalisa/source --to-file repos.txt --org myOrg",
+			output: PropagateOptions{
+				VariableName: "MYVARIABLE",
+				FromRepo:     "monalisa/source",
+				ToFile:       "repos.txt",
+				OrgName:      "myOrg",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			io, _, _, _ := iostreams.Test()
+			f := &cmdutil.Factory{
+				IOStreams: io,
+			}
+
+			var gotOpts *PropagateOptions
+			cmd := NewCmdPropagate(f, func(opts *PropagateOptions) error {
+				gotOpts = opts
+				return nil
+			})
+
+			argv, err := shlex.Split(tt.input)
+			assert.NoError(t, err)
+
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+
+			_, err = cmd.ExecuteC()
+			if tt.wantErr {
+				assert.EqualError(t, err, tt.errMsg)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.output.VariableName, gotOpts.VariableName)
+			assert.Equal(t, tt.output.FromRepo, gotOpts.FromRepo)
+			assert.Equal(t, tt.output.ToFile, gotOpts.ToFile)
+			assert.Equal(t, tt.output.OrgName, gotOpts.OrgName)
+		})
+	}
+}
+
+func Test_propagateRun(t *testing.T) {
+	sourceStub := func(reg *httpmock.Registry) {
+		reg.Register(
+			httpmock.REST("GET", "repos/monalisa/source/actions/variables/MYVARIABLE"),
+			httpmock.StringResponse(`{"name":"MYVARIABLE","value":"hello"}`),
+		)
+	}
+
+	tests := []struct {
+		name       string
+		tty        bool
+		opts       *PropagateOptions
+		repoFile   string
+		httpStubs  func(*testing.T, *httpmock.Registry)
+		wantStdout string
+		wantErrMsg string
+	}{
+		{
+			name:     "propagates to repositories",
+			tty:      true,
+			opts:     &PropagateOptions{},
+			repoFile: "octo/one\n# a comment\n\nocto/two\n",
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				sourceStub(reg)
+				reg.Register(
+					httpmock.REST("POST", "repos/octo/one/actions/variables"),
+					httpmock.RESTPayload(201, "{}", func(params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"name":  "MYVARIABLE",
+							"value": "hello",
+						}, params)
+					}),
+				)
+				reg.Register(
+					httpmock.REST("POST", "repos/octo/two/actions/variables"),
+					httpmock.StatusStringResponse(409, `{}`),
+				)
+				reg.Register(
+					httpmock.REST("PATCH", "repos/octo/two/actions/variables/MYVARIABLE"),
+					httpmock.StatusStringResponse(204, ""),
+				)
+			},
+			wantStdout: "✓ Created variable MYVARIABLE for octo/one\n✓ Updated variable MYVARIABLE for octo/two\n\nPropagated variable MYVARIABLE to 2 repositories\n",
+		},
+		{
+			name:     "org variable with selected repos",
+			tty:      true,
+			opts:     &PropagateOptions{OrgName: "myOrg"},
+			repoFile: "octo/one\ntwo\n",
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				sourceStub(reg)
+				reg.Register(
+					httpmock.GraphQL(`query MapRepositoryNames\b`),
+					httpmock.StringResponse(`{"data":{"repo_0001":{"databaseId":1},"repo_0002":{"databaseId":2}}}`),
+				)
+				reg.Register(
+					httpmock.REST("POST", "orgs/myOrg/actions/variables"),
+					httpmock.RESTPayload(201, "{}", func(params map[string]interface{}) {
+						assert.Equal(t, map[string]interface{}{
+							"name":                    "MYVARIABLE",
+							"value":                   "hello",
+							"visibility":              "selected",
+							"selected_repository_ids": []interface{}{float64(1), float64(2)},
+						}, params)
+					}),
+				)
+			},
+			wantStdout: "✓ Selected octo/one for organization variable MYVARIABLE in myOrg\n✓ Selected myOrg/two for organization variable MYVARIABLE in myOrg\n\nPropagated variable MYVARIABLE to 2 repositories\n",
+		},
+		{
+			name:     "json report",
+			tty:      false,
+			opts:     &PropagateOptions{},
+			repoFile: "octo/one\n",
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				sourceStub(reg)
+				reg.Register(
+					httpmock.REST("POST", "repos/octo/one/actions/variables"),
+					httpmock.StatusStringResponse(201, "{}"),
+				)
+			},
+			wantStdout: "[{\"repository\":\"octo/one\",\"status\":\"created\"}]\n",
+		},
+		{
+			name:     "variable not found in source",
+			tty:      true,
+			opts:     &PropagateOptions{},
+			repoFile: "octo/one\n",
+			httpStubs: func(t *testing.T, reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/monalisa/source/actions/variables/MYVARIABLE"),
+					httpmock.StatusStringResponse(404, `{}`),
+				)
+			},
+			wantErrMsg: "variable MYVARIABLE was not found in monalisa/source",
+		},
+		{
+			name:       "empty repo file",
+			tty:        true,
+			opts:       &PropagateOptions{},
+			repoFile:   "# nothing here\n",
+			wantErrMsg: "no repositories found in file",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(t, reg)
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			tt.opts.IO = ios
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+			tt.opts.Config = func() (gh.Config, error) {
+				return config.NewBlankConfig(), nil
+			}
+			tt.opts.VariableName = "MYVARIABLE"
+			tt.opts.FromRepo = "monalisa/source"
+			tt.opts.ToFile = filepath.Join(t.TempDir(), "repos.txt")
+			assert.NoError(t, os.WriteFile(tt.opts.ToFile, []byte(tt.repoFile), 0600))
+
+			if tt.name == "json report" {
+				exporter := cmdutil.NewJSONExporter()
+				exporter.SetFields([]string{"repository", "status"})
+				tt.opts.Exporter = exporter
+			}
+
+			err := propagateRun(tt.opts)
+			if tt.wantErrMsg != "" {
+				assert.EqualError(t, err, tt.wantErrMsg)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.wantStdout, stdout.String())
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	cmdDelete "github.com/cli/cli/v2/pkg/cmd/variable/delete"
 	cmdGet "github.com/cli/cli/v2/pkg/cmd/variable/get"
 	cmdList "github.com/cli/cli/v2/pkg/cmd/variable/list"
+	cmdPropagate "github.com/cli/cli/v2/pkg/cmd/variable/propagate"
 	cmdSet "github.com/cli/cli/v2/pkg/cmd/variable/set"
 	"github.com/cli/cli/v2/pkg/cmdutil"
 	"github.com/spf13/cobra"
@@ -26,6 +27,7 @@ func NewCmdVariable(f *cmdutil.Factory) *cobra.Command {
 	cmd.AddCommand(cmdSet.NewCmdSet(f, nil))
 	cmd.AddCommand(cmdList.NewCmdList(f, nil))
 	cmd.AddCommand(cmdDelete.NewCmdDelete(f, nil))
+	cmd.AddCommand(cmdPropagate.NewCmdPropagate(f, nil))
 
 	return cmd
 }
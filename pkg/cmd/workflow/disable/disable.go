@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/MakeNowJust/heredoc"
 	"github.com/cli/cli/v2/api"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/pkg/cmd/workflow/shared"
@@ -19,8 +20,13 @@ type DisableOptions struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	Prompter   iprompter
 
+	Exporter cmdutil.Exporter
+
 	Selector string
 	Prompt   bool
+	Match    string
+	RepoFile string
+	DryRun   bool
 }
 
 type iprompter interface {
@@ -37,17 +43,49 @@ func NewCmdDisable(f *cmdutil.Factory, runF func(*DisableOptions) error) *cobra.
 	cmd := &cobra.Command{
 		Use:   "disable [<workflow-id> | <workflow-name>]",
 		Short: "Disable a workflow",
-		Long:  "Disable a workflow, preventing it from running or showing up when listing workflows.",
-		Args:  cobra.MaximumNArgs(1),
+		Long: heredoc.Docf(`
+			Disable a workflow, preventing it from running or showing up when listing workflows.
+
+			With %[1]s--match%[1]s, every enabled workflow whose name or file name matches the
+			glob pattern is disabled. Combine it with %[1]s--repo-file%[1]s to disable matching
+			workflows across many repositories at once, and %[1]s--dry-run%[1]s to preview which
+			workflows would be disabled.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Disable every deploy workflow in the current repository
+			$ gh workflow disable --match 'deploy-*'
+
+			# Preview disabling deploy workflows across a fleet of repositories
+			$ gh workflow disable --match 'deploy-*' --repo-file repos.txt --dry-run
+		`),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// support `-R, --repo` override
 			opts.BaseRepo = f.BaseRepo
 
 			if len(args) > 0 {
 				opts.Selector = args[0]
-			} else if !opts.IO.CanPrompt() {
-				return cmdutil.FlagErrorf("workflow ID or name required when not running interactively")
-			} else {
+			}
+
+			if err := cmdutil.MutuallyExclusive("specify only one of workflow argument or `--match`", opts.Selector != "", opts.Match != ""); err != nil {
+				return err
+			}
+			if opts.Match == "" {
+				if opts.RepoFile != "" {
+					return cmdutil.FlagErrorf("`--repo-file` requires `--match`")
+				}
+				if opts.DryRun {
+					return cmdutil.FlagErrorf("`--dry-run` requires `--match`")
+				}
+				if opts.Exporter != nil {
+					return cmdutil.FlagErrorf("`--json` requires `--match`")
+				}
+			}
+
+			if opts.Selector == "" && opts.Match == "" {
+				if !opts.IO.CanPrompt() {
+					return cmdutil.FlagErrorf("workflow ID or name required when not running interactively")
+				}
 				opts.Prompt = true
 			}
 
@@ -58,6 +96,11 @@ func NewCmdDisable(f *cmdutil.Factory, runF func(*DisableOptions) error) *cobra.
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.Match, "match", "", "Disable all enabled workflows whose name or file name matches a glob `pattern`")
+	cmd.Flags().StringVar(&opts.RepoFile, "repo-file", "", "Read repositories to operate on from `file` (one OWNER/REPO per line, use \"-\" for standard input)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Report which workflows would be disabled without disabling them")
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, shared.BulkToggleFields)
+
 	return cmd
 }
 
@@ -68,6 +111,10 @@ func runDisable(opts *DisableOptions) error {
 	}
 	client := api.NewClientFromHTTP(c)
 
+	if opts.Match != "" {
+		return runDisableBulk(opts, client)
+	}
+
 	repo, err := opts.BaseRepo()
 	if err != nil {
 		return err
@@ -97,3 +144,44 @@ func runDisable(opts *DisableOptions) error {
 
 	return nil
 }
+
+func runDisableBulk(opts *DisableOptions, client *api.Client) error {
+	var repos []ghrepo.Interface
+	if opts.RepoFile != "" {
+		var err error
+		repos, err = shared.ReadRepoFile(opts.RepoFile, opts.IO.In)
+		if err != nil {
+			return err
+		}
+	} else {
+		repo, err := opts.BaseRepo()
+		if err != nil {
+			return err
+		}
+		repos = []ghrepo.Interface{repo}
+	}
+
+	reports, err := shared.BulkToggle(client, repos, opts.Match, true, opts.DryRun)
+	if err != nil {
+		return err
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, reports)
+	}
+
+	if len(reports) == 0 {
+		return cmdutil.NewNoResultsError(fmt.Sprintf("no enabled workflows match %q", opts.Match))
+	}
+
+	cs := opts.IO.ColorScheme()
+	for _, report := range reports {
+		if opts.DryRun {
+			fmt.Fprintf(opts.IO.Out, "Would disable %s in %s\n", cs.Bold(report.Name), report.Repository)
+		} else {
+			fmt.Fprintf(opts.IO.Out, "%s Disabled %s in %s\n", cs.SuccessIconWithColor(cs.Red), cs.Bold(report.Name), report.Repository)
+		}
+	}
+
+	return nil
+}
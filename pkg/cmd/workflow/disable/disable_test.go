@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/cli/cli/v2/internal/ghrepo"
@@ -14,6 +16,7 @@ import (
 	"github.com/cli/cli/v2/pkg/iostreams"
 	"github.com/google/shlex"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewCmdDisable(t *testing.T) {
@@ -50,6 +53,37 @@ func TestNewCmdDisable(t *testing.T) {
 				Selector: "123",
 			},
 		},
+		{
+			name: "match",
+			cli:  "--match deploy-*",
+			wants: DisableOptions{
+				Match: "deploy-*",
+			},
+		},
+		{
+			name: "match with repo-file and dry-run",
+			cli:  "--match deploy-* --repo-file repos.txt --dry-run",
+			wants: DisableOptions{
+				Match:    "deploy-*",
+				RepoFile: "repos.txt",
+				DryRun:   true,
+			},
+		},
+		{
+			name:     "arg with match",
+			cli:      "123 --match deploy-*",
+			wantsErr: true,
+		},
+		{
+			name:     "repo-file without match",
+			cli:      "--repo-file repos.txt",
+			wantsErr: true,
+		},
+		{
+			name:     "dry-run without match",
+			cli:      "--dry-run",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -85,6 +119,142 @@ func TestNewCmdDisable(t *testing.T) {
 
 			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
+			assert.Equal(t, tt.wants.Match, gotOpts.Match)
+			assert.Equal(t, tt.wants.RepoFile, gotOpts.RepoFile)
+			assert.Equal(t, tt.wants.DryRun, gotOpts.DryRun)
+		})
+	}
+}
+
+func TestDisableRunMatch(t *testing.T) {
+	repoFile := filepath.Join(t.TempDir(), "repos.txt")
+	require.NoError(t, os.WriteFile(repoFile, []byte("OWNER/REPO\n\n# a comment\nOWNER2/REPO2\n"), 0o600))
+
+	tests := []struct {
+		name      string
+		opts      *DisableOptions
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name: "match in base repo",
+			opts: &DisableOptions{
+				Match: "another*",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							shared.AWorkflow,
+							shared.DisabledWorkflow,
+							shared.AnotherWorkflow,
+							shared.YetAnotherWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/actions/workflows/789/disable"),
+					httpmock.StatusStringResponse(204, "{}"))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/actions/workflows/1011/disable"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+			wantOut: "✓ Disabled another workflow in OWNER/REPO\n✓ Disabled another workflow in OWNER/REPO\n",
+		},
+		{
+			name: "match dry-run",
+			opts: &DisableOptions{
+				Match:  "another*",
+				DryRun: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							shared.AWorkflow,
+							shared.AnotherWorkflow,
+						},
+					}))
+			},
+			wantOut: "Would disable another workflow in OWNER/REPO\n",
+		},
+		{
+			name: "match with repo file",
+			opts: &DisableOptions{
+				Match:    "a workflow",
+				RepoFile: repoFile,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							shared.AWorkflow,
+							shared.AnotherWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/actions/workflows/123/disable"),
+					httpmock.StatusStringResponse(204, "{}"))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER2/REPO2/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							shared.AWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER2/REPO2/actions/workflows/123/disable"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+			wantOut: "✓ Disabled a workflow in OWNER/REPO\n✓ Disabled a workflow in OWNER2/REPO2\n",
+		},
+		{
+			name: "no matching workflows",
+			opts: &DisableOptions{
+				Match: "deploy-*",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							shared.AWorkflow,
+						},
+					}))
+			},
+			wantErr: true,
+			errMsg:  `no enabled workflows match "deploy-*"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			tt.httpStubs(reg)
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			tt.opts.IO = ios
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := runDisable(tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			reg.Verify(t)
 		})
 	}
 }
@@ -50,6 +50,23 @@ func TestNewCmdEnable(t *testing.T) {
 				Selector: "123",
 			},
 		},
+		{
+			name: "match",
+			cli:  "--match deploy-*",
+			wants: EnableOptions{
+				Match: "deploy-*",
+			},
+		},
+		{
+			name:     "repo-file without match",
+			cli:      "--repo-file repos.txt",
+			wantsErr: true,
+		},
+		{
+			name:     "dry-run without match",
+			cli:      "--dry-run",
+			wantsErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -85,6 +102,101 @@ func TestNewCmdEnable(t *testing.T) {
 
 			assert.Equal(t, tt.wants.Selector, gotOpts.Selector)
 			assert.Equal(t, tt.wants.Prompt, gotOpts.Prompt)
+			assert.Equal(t, tt.wants.Match, gotOpts.Match)
+		})
+	}
+}
+
+func TestEnableRunMatch(t *testing.T) {
+	tests := []struct {
+		name      string
+		opts      *EnableOptions
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+		wantErr   bool
+		errMsg    string
+	}{
+		{
+			name: "match in base repo",
+			opts: &EnableOptions{
+				Match: "terrible*",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							shared.AWorkflow,
+							shared.DisabledWorkflow,
+							shared.UniqueDisabledWorkflow,
+						},
+					}))
+				reg.Register(
+					httpmock.REST("PUT", "repos/OWNER/REPO/actions/workflows/1314/enable"),
+					httpmock.StatusStringResponse(204, "{}"))
+			},
+			wantOut: "✓ Enabled terrible workflow in OWNER/REPO\n",
+		},
+		{
+			name: "match dry-run",
+			opts: &EnableOptions{
+				Match:  "terrible*",
+				DryRun: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							shared.UniqueDisabledWorkflow,
+						},
+					}))
+			},
+			wantOut: "Would enable terrible workflow in OWNER/REPO\n",
+		},
+		{
+			name: "no matching workflows",
+			opts: &EnableOptions{
+				Match: "deploy-*",
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							shared.AWorkflow,
+						},
+					}))
+			},
+			wantErr: true,
+			errMsg:  `no disabled workflows match "deploy-*"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			tt.httpStubs(reg)
+			tt.opts.HttpClient = func() (*http.Client, error) {
+				return &http.Client{Transport: reg}, nil
+			}
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(true)
+			tt.opts.IO = ios
+			tt.opts.BaseRepo = func() (ghrepo.Interface, error) {
+				return ghrepo.FromFullName("OWNER/REPO")
+			}
+
+			err := runEnable(tt.opts)
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Equal(t, tt.errMsg, err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantOut, stdout.String())
+			reg.Verify(t)
 		})
 	}
 }
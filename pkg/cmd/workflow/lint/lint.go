@@ -0,0 +1,410 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghinstance"
+	"github.com/cli/cli/v2/internal/text"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+const defaultWorkflowDir = ".github/workflows"
+
+type LintOptions struct {
+	HttpClient func() (*http.Client, error)
+	IO         *iostreams.IOStreams
+
+	Path     string
+	Exporter cmdutil.Exporter
+}
+
+var diagnosticFields = []string{"path", "line", "column", "severity", "message"}
+
+// Diagnostic is a single problem found in a workflow file, anchored to the
+// line and column of the YAML node it concerns.
+type Diagnostic struct {
+	Path     string `json:"path"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+func (d Diagnostic) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(d, fields)
+}
+
+func NewCmdLint(f *cmdutil.Factory, runF func(*LintOptions) error) *cobra.Command {
+	opts := &LintOptions{
+		IO:         f.IOStreams,
+		HttpClient: f.HttpClient,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "lint [<path>]",
+		Short: "Lint workflow files",
+		Long: heredoc.Docf(`
+			Validate GitHub Actions workflow files locally, without pushing them.
+
+			With no argument, every workflow file under %[1]s.github/workflows%[1]s is linted. A path
+			argument may name either a single workflow file or a directory containing them.
+
+			Each workflow is checked for YAML validity, for the keys the Actions schema
+			requires (%[1]son%[1]s, %[1]sjobs%[1]s, %[1]sruns-on%[1]s, and so on), and for unbalanced %[1]s${{ ... }}%[1]s
+			expressions. Every action referenced by a %[1]suses:%[1]s clause is also checked for
+			existence via the API.
+
+			Diagnostics are anchored to a line and column so that editors can consume them
+			via %[1]s--json%[1]s.
+		`, "`"),
+		Example: heredoc.Doc(`
+			# Lint all workflow files in the current repository
+			$ gh workflow lint
+
+			# Lint a single workflow file
+			$ gh workflow lint .github/workflows/ci.yml
+
+			# Emit diagnostics as JSON for editor integration
+			$ gh workflow lint --json path,line,column,severity,message
+		`),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) > 0 {
+				opts.Path = args[0]
+			} else {
+				opts.Path = defaultWorkflowDir
+			}
+
+			if runF != nil {
+				return runF(opts)
+			}
+			return runLint(opts)
+		},
+	}
+
+	cmdutil.AddJSONFlags(cmd, &opts.Exporter, diagnosticFields)
+
+	return cmd
+}
+
+func runLint(opts *LintOptions) error {
+	paths, err := workflowFiles(opts.Path)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		return fmt.Errorf("no workflow files found in %s", opts.Path)
+	}
+
+	var checker *actionChecker
+	if c, err := opts.HttpClient(); err == nil {
+		checker = &actionChecker{
+			client: api.NewClientFromHTTP(c),
+			host:   ghinstance.Default(),
+			cache:  map[string]bool{},
+		}
+	}
+
+	diagnostics := []Diagnostic{}
+	for _, path := range paths {
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		diagnostics = append(diagnostics, lintWorkflow(path, content, checker)...)
+	}
+
+	if opts.Exporter != nil {
+		return opts.Exporter.Write(opts.IO, diagnostics)
+	}
+
+	cs := opts.IO.ColorScheme()
+	out := opts.IO.Out
+
+	errorCount := 0
+	for _, d := range diagnostics {
+		severity := cs.Yellow(d.Severity)
+		if d.Severity == "error" {
+			errorCount++
+			severity = cs.Red(d.Severity)
+		}
+		fmt.Fprintf(out, "%s:%d:%d: %s: %s\n", d.Path, d.Line, d.Column, severity, d.Message)
+	}
+
+	if errorCount > 0 {
+		return cmdutil.SilentError
+	}
+
+	if opts.IO.IsStdoutTTY() {
+		fmt.Fprintf(out, "%s %s linted, no problems found\n",
+			cs.SuccessIcon(), text.Pluralize(len(paths), "workflow file"))
+	}
+
+	return nil
+}
+
+// workflowFiles expands a path into the list of workflow files to lint. A
+// directory is expanded to the YAML files directly inside it.
+func workflowFiles(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		ext := filepath.Ext(entry.Name())
+		if ext == ".yml" || ext == ".yaml" {
+			paths = append(paths, filepath.Join(path, entry.Name()))
+		}
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
+
+func lintWorkflow(path string, content []byte, checker *actionChecker) []Diagnostic {
+	errorAt := func(node *yaml.Node, format string, args ...interface{}) Diagnostic {
+		return Diagnostic{
+			Path:     path,
+			Line:     node.Line,
+			Column:   node.Column,
+			Severity: "error",
+			Message:  fmt.Sprintf(format, args...),
+		}
+	}
+
+	var rootNode yaml.Node
+	if err := yaml.Unmarshal(content, &rootNode); err != nil {
+		return []Diagnostic{{
+			Path:     path,
+			Line:     1,
+			Column:   1,
+			Severity: "error",
+			Message:  fmt.Sprintf("unable to parse workflow YAML: %s", err),
+		}}
+	}
+
+	if len(rootNode.Content) != 1 || rootNode.Content[0].Kind != yaml.MappingNode {
+		return []Diagnostic{{
+			Path:     path,
+			Line:     1,
+			Column:   1,
+			Severity: "error",
+			Message:  "workflow must be a YAML mapping",
+		}}
+	}
+
+	doc := rootNode.Content[0]
+	var diagnostics []Diagnostic
+
+	if mappingValue(doc, "name") == nil {
+		diagnostics = append(diagnostics, Diagnostic{
+			Path:     path,
+			Line:     doc.Line,
+			Column:   doc.Column,
+			Severity: "warning",
+			Message:  `workflow has no "name"`,
+		})
+	}
+
+	if mappingValue(doc, "on") == nil {
+		diagnostics = append(diagnostics, errorAt(doc, `workflow is missing the "on" key`))
+	}
+
+	jobsNode := mappingValue(doc, "jobs")
+	if jobsNode == nil {
+		diagnostics = append(diagnostics, errorAt(doc, `workflow is missing the "jobs" key`))
+	} else if jobsNode.Kind != yaml.MappingNode {
+		diagnostics = append(diagnostics, errorAt(jobsNode, `"jobs" must be a mapping of job IDs`))
+	} else {
+		for i := 0; i+1 < len(jobsNode.Content); i += 2 {
+			keyNode := jobsNode.Content[i]
+			jobNode := jobsNode.Content[i+1]
+			diagnostics = append(diagnostics, lintJob(path, keyNode, jobNode, errorAt, checker)...)
+		}
+	}
+
+	diagnostics = append(diagnostics, checkExpressions(path, doc)...)
+
+	return diagnostics
+}
+
+func lintJob(path string, keyNode, jobNode *yaml.Node, errorAt func(*yaml.Node, string, ...interface{}) Diagnostic, checker *actionChecker) []Diagnostic {
+	var diagnostics []Diagnostic
+	jobID := keyNode.Value
+
+	if jobNode.Kind != yaml.MappingNode {
+		return append(diagnostics, errorAt(keyNode, "job %q must be a mapping", jobID))
+	}
+
+	if usesNode := mappingValue(jobNode, "uses"); usesNode != nil {
+		// a reusable workflow call; no runs-on or steps of its own
+		diagnostics = append(diagnostics, checkUses(path, usesNode, checker)...)
+		return diagnostics
+	}
+
+	if mappingValue(jobNode, "runs-on") == nil {
+		diagnostics = append(diagnostics, errorAt(keyNode, `job %q is missing "runs-on"`, jobID))
+	}
+
+	stepsNode := mappingValue(jobNode, "steps")
+	if stepsNode == nil {
+		diagnostics = append(diagnostics, errorAt(keyNode, "job %q has no steps", jobID))
+		return diagnostics
+	}
+	if stepsNode.Kind != yaml.SequenceNode {
+		return append(diagnostics, errorAt(stepsNode, `"steps" of job %q must be a sequence`, jobID))
+	}
+
+	for i, stepNode := range stepsNode.Content {
+		if stepNode.Kind != yaml.MappingNode {
+			diagnostics = append(diagnostics, errorAt(stepNode, "step %d of job %q must be a mapping", i+1, jobID))
+			continue
+		}
+		runNode := mappingValue(stepNode, "run")
+		usesNode := mappingValue(stepNode, "uses")
+		if runNode == nil && usesNode == nil {
+			diagnostics = append(diagnostics, errorAt(stepNode, `step %d of job %q must have either "run" or "uses"`, i+1, jobID))
+		} else if runNode != nil && usesNode != nil {
+			diagnostics = append(diagnostics, errorAt(stepNode, `step %d of job %q cannot have both "run" and "uses"`, i+1, jobID))
+		}
+		if usesNode != nil {
+			diagnostics = append(diagnostics, checkUses(path, usesNode, checker)...)
+		}
+	}
+
+	return diagnostics
+}
+
+// checkUses validates an action reference and, when a checker is available,
+// confirms via the API that the repository hosting the action exists.
+func checkUses(path string, usesNode *yaml.Node, checker *actionChecker) []Diagnostic {
+	ref := usesNode.Value
+
+	// local actions and container actions cannot be checked against the API
+	if strings.HasPrefix(ref, "./") || strings.HasPrefix(ref, "docker://") {
+		return nil
+	}
+
+	spec, _, hasVersion := strings.Cut(ref, "@")
+	if !hasVersion {
+		return []Diagnostic{{
+			Path:     path,
+			Line:     usesNode.Line,
+			Column:   usesNode.Column,
+			Severity: "error",
+			Message:  fmt.Sprintf(`action reference %q must include a version after "@"`, ref),
+		}}
+	}
+
+	parts := strings.Split(spec, "/")
+	if len(parts) < 2 || parts[0] == "" || parts[1] == "" {
+		return []Diagnostic{{
+			Path:     path,
+			Line:     usesNode.Line,
+			Column:   usesNode.Column,
+			Severity: "error",
+			Message:  fmt.Sprintf("action reference %q must be in OWNER/REPO@version format", ref),
+		}}
+	}
+
+	if checker == nil {
+		return nil
+	}
+
+	if !checker.repoExists(parts[0] + "/" + parts[1]) {
+		return []Diagnostic{{
+			Path:     path,
+			Line:     usesNode.Line,
+			Column:   usesNode.Column,
+			Severity: "error",
+			Message:  fmt.Sprintf("referenced action %q does not exist", ref),
+		}}
+	}
+
+	return nil
+}
+
+// checkExpressions walks every scalar in the workflow looking for unbalanced
+// ${{ }} expression delimiters.
+func checkExpressions(path string, node *yaml.Node) []Diagnostic {
+	var diagnostics []Diagnostic
+
+	if node.Kind == yaml.ScalarNode {
+		if strings.Count(node.Value, "${{") != strings.Count(node.Value, "}}") {
+			diagnostics = append(diagnostics, Diagnostic{
+				Path:     path,
+				Line:     node.Line,
+				Column:   node.Column,
+				Severity: "error",
+				Message:  "unbalanced ${{ }} in expression",
+			})
+		}
+		return diagnostics
+	}
+
+	for _, child := range node.Content {
+		diagnostics = append(diagnostics, checkExpressions(path, child)...)
+	}
+	return diagnostics
+}
+
+// mappingValue returns the value node for a key of a mapping node, or nil.
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// actionChecker confirms the existence of repositories hosting referenced
+// actions, caching results so each repository is only checked once per run.
+type actionChecker struct {
+	client *api.Client
+	host   string
+	cache  map[string]bool
+}
+
+func (c *actionChecker) repoExists(nwo string) bool {
+	if exists, ok := c.cache[nwo]; ok {
+		return exists
+	}
+
+	exists := true
+	err := c.client.REST(c.host, "GET", fmt.Sprintf("repos/%s", nwo), nil, nil)
+	if err != nil {
+		var httpError api.HTTPError
+		// non-404 errors are treated as inconclusive rather than failing the lint
+		if errors.As(err, &httpError) && httpError.StatusCode == 404 {
+			exists = false
+		}
+	}
+
+	c.cache[nwo] = exists
+	return exists
+}
@@ -0,0 +1,206 @@
+package lint
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+	"github.com/cli/cli/v2/pkg/httpmock"
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/google/shlex"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewCmdLint(t *testing.T) {
+	tests := []struct {
+		name     string
+		cli      string
+		wants    LintOptions
+		wantsErr bool
+	}{
+		{
+			name: "blank",
+			wants: LintOptions{
+				Path: ".github/workflows",
+			},
+		},
+		{
+			name: "with path",
+			cli:  ".github/workflows/ci.yml",
+			wants: LintOptions{
+				Path: ".github/workflows/ci.yml",
+			},
+		},
+		{
+			name:     "too many arguments",
+			cli:      "one two",
+			wantsErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, _, _ := iostreams.Test()
+
+			f := &cmdutil.Factory{
+				IOStreams: ios,
+			}
+
+			argv, err := shlex.Split(tt.cli)
+			assert.NoError(t, err)
+
+			var gotOpts *LintOptions
+			cmd := NewCmdLint(f, func(opts *LintOptions) error {
+				gotOpts = opts
+				return nil
+			})
+			cmd.SetArgs(argv)
+			cmd.SetIn(&bytes.Buffer{})
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+
+			_, err = cmd.ExecuteC()
+			if tt.wantsErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wants.Path, gotOpts.Path)
+		})
+	}
+}
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      string
+		tty       bool
+		httpStubs func(*httpmock.Registry)
+		wantOut   string
+		wantErr   bool
+	}{
+		{
+			name: "valid workflow",
+			tty:  true,
+			file: heredoc.Doc(`
+				name: CI
+				on: push
+				jobs:
+				  build:
+				    runs-on: ubuntu-latest
+				    steps:
+				      - uses: actions/checkout@v4
+				      - run: make
+			`),
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/actions/checkout"),
+					httpmock.StringResponse("{}"))
+			},
+			wantOut: "✓ 1 workflow file linted, no problems found\n",
+		},
+		{
+			name: "missing keys and bad step",
+			file: heredoc.Doc(`
+				on: push
+				jobs:
+				  build:
+				    steps:
+				      - name: no run or uses
+			`),
+			wantOut: heredoc.Doc(`
+				{path}:1:1: warning: workflow has no "name"
+				{path}:3:3: error: job "build" is missing "runs-on"
+				{path}:5:9: error: step 1 of job "build" must have either "run" or "uses"
+			`),
+			wantErr: true,
+		},
+		{
+			name: "nonexistent action",
+			file: heredoc.Doc(`
+				name: CI
+				on: push
+				jobs:
+				  build:
+				    runs-on: ubuntu-latest
+				    steps:
+				      - uses: ghost/missing@v1
+			`),
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/ghost/missing"),
+					httpmock.StatusStringResponse(404, "{}"))
+			},
+			wantOut: "{path}:7:15: error: referenced action \"ghost/missing@v1\" does not exist\n",
+			wantErr: true,
+		},
+		{
+			name: "action reference without version",
+			file: heredoc.Doc(`
+				name: CI
+				on: push
+				jobs:
+				  build:
+				    runs-on: ubuntu-latest
+				    steps:
+				      - uses: actions/checkout
+			`),
+			wantOut: "{path}:7:15: error: action reference \"actions/checkout\" must include a version after \"@\"\n",
+			wantErr: true,
+		},
+		{
+			name: "unbalanced expression",
+			file: heredoc.Doc(`
+				name: CI
+				on: push
+				jobs:
+				  build:
+				    runs-on: ubuntu-latest
+				    steps:
+				      - run: echo "${{ matrix.os"
+			`),
+			wantOut: "{path}:7:14: error: unbalanced ${{ }} in expression\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := &httpmock.Registry{}
+			defer reg.Verify(t)
+			if tt.httpStubs != nil {
+				tt.httpStubs(reg)
+			}
+
+			path := filepath.Join(t.TempDir(), "workflow.yml")
+			assert.NoError(t, os.WriteFile(path, []byte(tt.file), 0600))
+
+			ios, _, stdout, _ := iostreams.Test()
+			ios.SetStdoutTTY(tt.tty)
+
+			opts := &LintOptions{
+				IO:   ios,
+				Path: path,
+				HttpClient: func() (*http.Client, error) {
+					return &http.Client{Transport: reg}, nil
+				},
+			}
+
+			err := runLint(opts)
+			if tt.wantErr {
+				assert.Equal(t, cmdutil.SilentError, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, strings.ReplaceAll(tt.wantOut, "{path}", path), stdout.String())
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"net/http"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/MakeNowJust/heredoc"
@@ -64,6 +65,10 @@ func NewCmdRun(f *cmdutil.Factory, runF func(*RunOptions) error) *cobra.Command
 			- Interactively
 			- Via %[1]s-f/--raw-field%[1]s or %[1]s-F/--field%[1]s flags
 			- As JSON, via standard input
+
+			Provided inputs are validated against the workflow's %[1]sworkflow_dispatch%[1]s input schema:
+			unknown inputs, missing required inputs, and values that do not match an input's type
+			or choice options fail with a precise message before the dispatch event is created.
 		`, "`"),
 		Example: heredoc.Doc(`
 			# Have gh prompt you for what workflow you'd like to run and interactively collect inputs
@@ -228,6 +233,17 @@ func collectInputs(p iprompter, yamlContent []byte) (map[string]string, error) {
 				return nil, err
 			}
 			answer = input.Options[selected]
+		} else if input.Type == "boolean" {
+			name := input.Name
+			if input.Required {
+				name += " (required)"
+			}
+			options := []string{"true", "false"}
+			selected, err := p.Select(name, input.Default, options)
+			if err != nil {
+				return nil, err
+			}
+			answer = options[selected]
 		} else if input.Required {
 			for answer == "" {
 				answer, err = p.Input(input.Name+" (required)", input.Default)
@@ -245,9 +261,79 @@ func collectInputs(p iprompter, yamlContent []byte) (map[string]string, error) {
 
 		providedInputs[input.Name] = answer
 	}
+
+	if err := validateInputs(providedInputs, inputs); err != nil {
+		return nil, err
+	}
 	return providedInputs, nil
 }
 
+// validateInputs checks provided inputs against the inputs declared by the
+// workflow's workflow_dispatch trigger, so that bad values fail with a precise
+// message before a dispatch event is created.
+func validateInputs(providedInputs map[string]string, inputs []WorkflowInput) error {
+	inputsByName := make(map[string]WorkflowInput, len(inputs))
+	inputNames := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		inputsByName[input.Name] = input
+		inputNames = append(inputNames, input.Name)
+	}
+
+	providedNames := make([]string, 0, len(providedInputs))
+	for name := range providedInputs {
+		providedNames = append(providedNames, name)
+	}
+	sort.Strings(providedNames)
+
+	for _, name := range providedNames {
+		input, ok := inputsByName[name]
+		if !ok {
+			if len(inputNames) == 0 {
+				return fmt.Errorf("unknown workflow input %q; the workflow accepts no inputs", name)
+			}
+			return fmt.Errorf("unknown workflow input %q; the workflow accepts inputs: %s", name, strings.Join(inputNames, ", "))
+		}
+
+		value := providedInputs[name]
+		if value == "" {
+			continue
+		}
+
+		switch input.Type {
+		case "choice":
+			valid := false
+			for _, option := range input.Options {
+				if option == value {
+					valid = true
+					break
+				}
+			}
+			if !valid {
+				return fmt.Errorf("invalid value %q for input %q: valid options are %s", value, name, strings.Join(input.Options, ", "))
+			}
+		case "boolean":
+			if value != "true" && value != "false" {
+				return fmt.Errorf("invalid value %q for input %q: must be \"true\" or \"false\"", value, name)
+			}
+		case "number":
+			if _, err := strconv.ParseFloat(value, 64); err != nil {
+				return fmt.Errorf("invalid value %q for input %q: must be a number", value, name)
+			}
+		}
+	}
+
+	for _, input := range inputs {
+		if !input.Required || input.Default != "" {
+			continue
+		}
+		if _, ok := providedInputs[input.Name]; !ok {
+			return fmt.Errorf("missing required workflow input %q", input.Name)
+		}
+	}
+
+	return nil
+}
+
 func runRun(opts *RunOptions) error {
 	c, err := opts.HttpClient()
 	if err != nil {
@@ -303,6 +389,20 @@ func runRun(opts *RunOptions) error {
 		}
 	}
 
+	if !opts.Prompt && len(providedInputs) > 0 {
+		yamlContent, err := shared.GetWorkflowContent(client, repo, *workflow, ref)
+		if err != nil {
+			return fmt.Errorf("unable to fetch workflow file content: %w", err)
+		}
+		inputs, err := findInputs(yamlContent)
+		if err != nil {
+			return err
+		}
+		if err := validateInputs(providedInputs, inputs); err != nil {
+			return err
+		}
+	}
+
 	path := fmt.Sprintf("repos/%s/actions/workflows/%d/dispatches",
 		ghrepo.FullName(repo), workflow.ID)
 
@@ -394,6 +394,23 @@ jobs:
     run: echo "${{ github.event.inputs.message }} ${{ fromJSON('["", "🥳"]')[github.event.inputs.use-emoji == 'true'] }} ${{ github.event.inputs.name }}"`)
 	encodedYAMLContentMissingChoiceIp := base64.StdEncoding.EncodeToString(yamlContentMissingChoiceIp)
 
+	yamlContentBooleanIp := []byte(`
+name: boolean inputs
+on:
+  workflow_dispatch:
+    inputs:
+      use-emoji:
+        type: boolean
+        description: Include an emoji in the greeting
+        default: "true"
+jobs:
+  greet:
+  runs-on: ubuntu-latest
+  steps:
+  - name: Send greeting
+    run: echo "hello ${{ fromJSON('["", "🥳"]')[github.event.inputs.use-emoji == 'true'] }}"`)
+	encodedYAMLContentBooleanIp := base64.StdEncoding.EncodeToString(yamlContentBooleanIp)
+
 	stubs := func(reg *httpmock.Registry) {
 		reg.Register(
 			httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/workflow.yml"),
@@ -401,6 +418,11 @@ jobs:
 				Path: ".github/workflows/workflow.yml",
 				ID:   12345,
 			}))
+		reg.Register(
+			httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/workflows/workflow.yml"),
+			httpmock.JSONResponse(struct{ Content string }{
+				Content: encodedYAMLContent,
+			}))
 		reg.Register(
 			httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/12345/dispatches"),
 			httpmock.StatusStringResponse(204, "cool"))
@@ -497,7 +519,6 @@ jobs:
 			wantOut:   "✓ Created workflow_dispatch event for workflow.yml at good-branch\n\nTo see runs for this workflow, try: gh run list --workflow=workflow.yml\n",
 		},
 		{
-			// TODO this test is somewhat silly; it's more of a placeholder in case I decide to handle the API error more elegantly
 			name: "good JSON, missing required input",
 			tty:  true,
 			opts: &RunOptions{
@@ -512,11 +533,13 @@ jobs:
 						ID:   12345,
 					}))
 				reg.Register(
-					httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/12345/dispatches"),
-					httpmock.StatusStringResponse(422, "missing something"))
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/workflows/workflow.yml"),
+					httpmock.JSONResponse(struct{ Content string }{
+						Content: encodedYAMLContent,
+					}))
 			},
 			wantErr: true,
-			errOut:  "could not create workflow dispatch event: HTTP 422 (https://api.github.com/repos/OWNER/REPO/actions/workflows/12345/dispatches)",
+			errOut:  `missing required workflow input "name"`,
 		},
 		{
 			name: "yaml file extension",
@@ -539,7 +562,6 @@ jobs:
 			wantErr: false,
 		},
 		{
-			// TODO this test is somewhat silly; it's more of a placeholder in case I decide to handle the API error more elegantly
 			name: "input fields, missing required",
 			opts: &RunOptions{
 				Selector:  "workflow.yml",
@@ -553,11 +575,57 @@ jobs:
 						ID:   12345,
 					}))
 				reg.Register(
-					httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/12345/dispatches"),
-					httpmock.StatusStringResponse(422, "missing something"))
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/workflows/workflow.yml"),
+					httpmock.JSONResponse(struct{ Content string }{
+						Content: encodedYAMLContent,
+					}))
+			},
+			wantErr: true,
+			errOut:  `missing required workflow input "name"`,
+		},
+		{
+			name: "input fields, unknown input",
+			opts: &RunOptions{
+				Selector:  "workflow.yml",
+				RawFields: []string{`nam=scully`},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/workflow.yml"),
+					httpmock.JSONResponse(shared.Workflow{
+						Path: ".github/workflows/workflow.yml",
+						ID:   12345,
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/workflows/workflow.yml"),
+					httpmock.JSONResponse(struct{ Content string }{
+						Content: encodedYAMLContent,
+					}))
+			},
+			wantErr: true,
+			errOut:  `unknown workflow input "nam"; the workflow accepts inputs: greeting, name`,
+		},
+		{
+			name: "input fields, invalid choice",
+			opts: &RunOptions{
+				Selector:  "workflow.yml",
+				RawFields: []string{`favourite-animal=horse`, `name=monalisa`},
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows/workflow.yml"),
+					httpmock.JSONResponse(shared.Workflow{
+						Path: ".github/workflows/workflow.yml",
+						ID:   12345,
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/workflows/workflow.yml"),
+					httpmock.JSONResponse(struct{ Content string }{
+						Content: encodedYAMLContentChoiceIp,
+					}))
 			},
 			wantErr: true,
-			errOut:  "could not create workflow dispatch event: HTTP 422 (https://api.github.com/repos/OWNER/REPO/actions/workflows/12345/dispatches)",
+			errOut:  `invalid value "horse" for input "favourite-animal": valid options are dog, cat`,
 		},
 		{
 			name: "prompt, no workflows enabled",
@@ -733,6 +801,50 @@ jobs:
 			},
 			wantOut: "✓ Created workflow_dispatch event for workflow.yml at trunk\n\nTo see runs for this workflow, try: gh run list --workflow=workflow.yml\n",
 		},
+		{
+			name: "prompt, workflow boolean input",
+			tty:  true,
+			opts: &RunOptions{
+				Prompt: true,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/actions/workflows"),
+					httpmock.JSONResponse(shared.WorkflowsPayload{
+						Workflows: []shared.Workflow{
+							{
+								Name:  "boolean inputs",
+								ID:    12345,
+								State: shared.Active,
+								Path:  ".github/workflows/workflow.yml",
+							},
+						},
+					}))
+				reg.Register(
+					httpmock.REST("GET", "repos/OWNER/REPO/contents/.github/workflows/workflow.yml"),
+					httpmock.JSONResponse(struct{ Content string }{
+						Content: encodedYAMLContentBooleanIp,
+					}))
+				reg.Register(
+					httpmock.REST("POST", "repos/OWNER/REPO/actions/workflows/12345/dispatches"),
+					httpmock.StatusStringResponse(204, "cool"))
+			},
+			promptStubs: func(pm *prompter.MockPrompter) {
+				pm.RegisterSelect("Select a workflow", []string{"boolean inputs (workflow.yml)"}, func(_, _ string, opts []string) (int, error) {
+					return 0, nil
+				})
+				pm.RegisterSelect("use-emoji", []string{"true", "false"}, func(_, _ string, opts []string) (int, error) {
+					return 1, nil
+				})
+			},
+			wantBody: map[string]interface{}{
+				"inputs": map[string]interface{}{
+					"use-emoji": "false",
+				},
+				"ref": "trunk",
+			},
+			wantOut: "✓ Created workflow_dispatch event for workflow.yml at trunk\n\nTo see runs for this workflow, try: gh run list --workflow=workflow.yml\n",
+		},
 		{
 			name: "prompt, workflow choice missing input",
 			tty:  true,
@@ -765,9 +877,6 @@ jobs:
 				pm.RegisterSelect("Select a workflow", []string{"choice missing inputs (workflow.yml)"}, func(_, _ string, opts []string) (int, error) {
 					return 0, nil
 				})
-				pm.RegisterSelect("name", []string{}, func(_, _ string, opts []string) (int, error) {
-					return 0, nil
-				})
 			},
 			wantErr: true,
 			errOut:  "workflow input \"name\" is of type choice, but has no options",
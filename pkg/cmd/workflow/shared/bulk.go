@@ -0,0 +1,131 @@
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/cli/cli/v2/api"
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/cli/cli/v2/pkg/cmdutil"
+)
+
+// BulkToggleReport describes a single workflow that a bulk enable or disable
+// operation changed, or would change in dry-run mode.
+type BulkToggleReport struct {
+	Repository string `json:"repository"`
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Path       string `json:"path"`
+}
+
+func (r *BulkToggleReport) ExportData(fields []string) map[string]interface{} {
+	return cmdutil.StructExportData(r, fields)
+}
+
+// BulkToggleFields are the fields available for JSON output of bulk enable
+// and disable operations.
+var BulkToggleFields = []string{
+	"repository",
+	"id",
+	"name",
+	"path",
+}
+
+// ReadRepoFile parses a file listing one OWNER/REPO per line. Blank lines and
+// lines beginning with "#" are ignored. A filename of "-" reads from stdin.
+func ReadRepoFile(filename string, stdin io.ReadCloser) ([]ghrepo.Interface, error) {
+	b, err := cmdutil.ReadFile(filename, stdin)
+	if err != nil {
+		return nil, err
+	}
+
+	var repos []ghrepo.Interface
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		repo, err := ghrepo.FromFullName(line)
+		if err != nil {
+			return nil, fmt.Errorf("invalid repository %q: %w", line, err)
+		}
+		repos = append(repos, repo)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+	if len(repos) == 0 {
+		return nil, fmt.Errorf("no repositories listed in %s", filename)
+	}
+
+	return repos, nil
+}
+
+// BulkToggle enables or disables every workflow whose name or file name
+// matches a glob pattern, across the given repositories. In dry-run mode it
+// only reports what would change. The returned reports cover every matched
+// workflow in the order the repositories were given.
+func BulkToggle(client *api.Client, repos []ghrepo.Interface, pattern string, disable, dryRun bool) ([]BulkToggleReport, error) {
+	fromStates := []WorkflowState{DisabledManually, DisabledInactivity}
+	endpoint := "enable"
+	if disable {
+		fromStates = []WorkflowState{Active}
+		endpoint = "disable"
+	}
+
+	var reports []BulkToggleReport
+	for _, repo := range repos {
+		workflows, err := GetWorkflows(client, repo, 0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list workflows for %s: %w", ghrepo.FullName(repo), err)
+		}
+		for _, workflow := range workflows {
+			if !slices.Contains(fromStates, workflow.State) {
+				continue
+			}
+			matched, err := matchWorkflow(workflow, pattern)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+			if !dryRun {
+				path := fmt.Sprintf("repos/%s/actions/workflows/%d/%s", ghrepo.FullName(repo), workflow.ID, endpoint)
+				if err := client.REST(repo.RepoHost(), "PUT", path, nil, nil); err != nil {
+					return nil, fmt.Errorf("failed to %s %s in %s: %w", endpoint, workflow.Name, ghrepo.FullName(repo), err)
+				}
+			}
+			reports = append(reports, BulkToggleReport{
+				Repository: ghrepo.FullName(repo),
+				ID:         workflow.ID,
+				Name:       workflow.Name,
+				Path:       workflow.Path,
+			})
+		}
+	}
+
+	return reports, nil
+}
+
+// matchWorkflow reports whether a glob pattern matches either the workflow's
+// name or its file name, e.g. "deploy-*" matches both "deploy-prod" and
+// ".github/workflows/deploy-prod.yml".
+func matchWorkflow(workflow Workflow, pattern string) (bool, error) {
+	for _, candidate := range []string{workflow.Name, workflow.Base()} {
+		matched, err := path.Match(pattern, candidate)
+		if err != nil {
+			return false, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,108 @@
+package shared
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cli/cli/v2/internal/ghrepo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRepoFile(t *testing.T) {
+	tests := []struct {
+		name          string
+		contents      string
+		expectedRepos []string
+		expectedError string
+	}{
+		{
+			name:          "one repo per line",
+			contents:      "OWNER/REPO\nOWNER2/REPO2\n",
+			expectedRepos: []string{"OWNER/REPO", "OWNER2/REPO2"},
+		},
+		{
+			name:          "skips blank lines and comments",
+			contents:      "\n# deploy fleet\nOWNER/REPO\n\n",
+			expectedRepos: []string{"OWNER/REPO"},
+		},
+		{
+			name:          "invalid repository",
+			contents:      "not-a-repo\n",
+			expectedError: `invalid repository "not-a-repo": expected the "[HOST/]OWNER/REPO" format, got "not-a-repo"`,
+		},
+		{
+			name:          "no repositories",
+			contents:      "# just a comment\n",
+			expectedError: "no repositories listed in",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filename := filepath.Join(t.TempDir(), "repos.txt")
+			require.NoError(t, os.WriteFile(filename, []byte(tt.contents), 0o600))
+
+			repos, err := ReadRepoFile(filename, nil)
+			if tt.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+
+			var names []string
+			for _, repo := range repos {
+				names = append(names, ghrepo.FullName(repo))
+			}
+			assert.Equal(t, tt.expectedRepos, names)
+		})
+	}
+}
+
+func TestMatchWorkflow(t *testing.T) {
+	tests := []struct {
+		name     string
+		workflow Workflow
+		pattern  string
+		want     bool
+		wantErr  string
+	}{
+		{
+			name:     "matches workflow name",
+			workflow: Workflow{Name: "deploy-prod", Path: ".github/workflows/prod.yml"},
+			pattern:  "deploy-*",
+			want:     true,
+		},
+		{
+			name:     "matches workflow file name",
+			workflow: Workflow{Name: "Deploy to production", Path: ".github/workflows/deploy-prod.yml"},
+			pattern:  "deploy-*",
+			want:     true,
+		},
+		{
+			name:     "no match",
+			workflow: Workflow{Name: "CI", Path: ".github/workflows/ci.yml"},
+			pattern:  "deploy-*",
+			want:     false,
+		},
+		{
+			name:     "invalid pattern",
+			workflow: Workflow{Name: "CI", Path: ".github/workflows/ci.yml"},
+			pattern:  "[",
+			wantErr:  `invalid pattern "["`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, err := matchWorkflow(tt.workflow, tt.pattern)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, matched)
+		})
+	}
+}
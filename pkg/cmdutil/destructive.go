@@ -0,0 +1,44 @@
+package cmdutil
+
+import "github.com/spf13/cobra"
+
+// DestructivePrompter is the part of the prompter interface needed to confirm
+// a destructive operation by asking the user to retype a phrase.
+type DestructivePrompter interface {
+	ConfirmDeletion(string) error
+}
+
+// DestructiveOptions holds the flags shared by destructive commands: --yes to
+// bypass the confirmation prompt and --dry-run to preview the operation
+// without performing it.
+type DestructiveOptions struct {
+	Confirmed bool
+	DryRun    bool
+}
+
+// AddDestructiveFlags registers the shared --yes and --dry-run flags of
+// destructive commands.
+func AddDestructiveFlags(cmd *cobra.Command, opts *DestructiveOptions) {
+	cmd.Flags().BoolVar(&opts.Confirmed, "yes", false, "Confirm deletion without prompting")
+	AddDryRunFlag(cmd, &opts.DryRun)
+}
+
+// AddDryRunFlag registers the shared --dry-run flag of destructive commands,
+// for commands that cannot use AddDestructiveFlags wholesale.
+func AddDryRunFlag(cmd *cobra.Command, dryRun *bool) {
+	cmd.Flags().BoolVar(dryRun, "dry-run", false, "Preview the deletion without actually deleting anything")
+}
+
+// ConfirmDestructive guards a destructive operation. Dry runs and operations
+// confirmed up front with --yes proceed without prompting; otherwise the user
+// has to retype requiredValue to confirm. When prompting is impossible, a
+// flag error instructs the user to pass --yes.
+func ConfirmDestructive(canPrompt bool, prompter DestructivePrompter, requiredValue string, opts DestructiveOptions) error {
+	if opts.DryRun || opts.Confirmed {
+		return nil
+	}
+	if !canPrompt {
+		return FlagErrorf("--yes required when not running interactively")
+	}
+	return prompter.ConfirmDeletion(requiredValue)
+}
@@ -0,0 +1,71 @@
+package cmdutil
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type stubDestructivePrompter struct {
+	confirmed string
+	err       error
+}
+
+func (p *stubDestructivePrompter) ConfirmDeletion(requiredValue string) error {
+	p.confirmed = requiredValue
+	return p.err
+}
+
+func TestConfirmDestructive(t *testing.T) {
+	tests := []struct {
+		name          string
+		canPrompt     bool
+		promptErr     error
+		requiredValue string
+		opts          DestructiveOptions
+		wantPrompted  string
+		wantErr       string
+	}{
+		{
+			name:      "dry run skips prompting",
+			canPrompt: false,
+			opts:      DestructiveOptions{DryRun: true},
+		},
+		{
+			name:      "confirmed up front skips prompting",
+			canPrompt: false,
+			opts:      DestructiveOptions{Confirmed: true},
+		},
+		{
+			name:    "non-interactive without --yes",
+			wantErr: "--yes required when not running interactively",
+		},
+		{
+			name:          "prompts with required value",
+			canPrompt:     true,
+			requiredValue: "OWNER/REPO",
+			wantPrompted:  "OWNER/REPO",
+		},
+		{
+			name:          "declined prompt",
+			canPrompt:     true,
+			promptErr:     errors.New("You entered octocat"),
+			requiredValue: "OWNER/REPO",
+			wantPrompted:  "OWNER/REPO",
+			wantErr:       "You entered octocat",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prompter := &stubDestructivePrompter{err: tt.promptErr}
+			err := ConfirmDestructive(tt.canPrompt, prompter, tt.requiredValue, tt.opts)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantPrompted, prompter.confirmed)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"os"
+)
+
+const (
+	// ExtensionContextEnv is the environment variable through which gh points an
+	// extension process at the context file written for its invocation.
+	ExtensionContextEnv = "GH_EXTENSION_CONTEXT"
+	// ExtensionContextVersion identifies the shape of ExtensionContext and is
+	// incremented whenever the contract changes incompatibly. Extensions should
+	// check it before relying on any other field.
+	ExtensionContextVersion = 1
+)
+
+// ExtensionContext is the structured state gh hands to an extension when
+// invoking it, so that extensions do not have to shell back out to commands
+// like gh repo view to rediscover it. It is serialized as JSON to a temporary
+// file whose path is exported in the GH_EXTENSION_CONTEXT environment variable
+// for the lifetime of the extension process.
+type ExtensionContext struct {
+	// Version is the contract version, currently ExtensionContextVersion.
+	Version int `json:"version"`
+	// Host is the GitHub hostname gh would direct API traffic to.
+	Host string `json:"host,omitempty"`
+	// Repository is the repository gh resolved from the current directory, if any.
+	Repository *ExtensionContextRepository `json:"repository,omitempty"`
+	// TokenScopes lists the OAuth scopes of the active token, when gh already
+	// knows them; an empty list means they were not determined, not that the
+	// token has none.
+	TokenScopes []string `json:"token_scopes,omitempty"`
+	// TTY reports which of gh's standard streams are attached to a terminal.
+	TTY ExtensionContextTTY `json:"tty"`
+	// ConfigDir is the directory gh reads its configuration from.
+	ConfigDir string `json:"config_dir,omitempty"`
+}
+
+type ExtensionContextRepository struct {
+	Owner string `json:"owner"`
+	Name  string `json:"name"`
+	Host  string `json:"host"`
+}
+
+type ExtensionContextTTY struct {
+	Stdin  bool `json:"stdin"`
+	Stdout bool `json:"stdout"`
+	Stderr bool `json:"stderr"`
+}
+
+// WriteExtensionContext serializes ctx to a temporary file and returns its
+// path along with a cleanup function that removes the file once the extension
+// process has finished.
+func WriteExtensionContext(ctx *ExtensionContext) (string, func(), error) {
+	f, err := os.CreateTemp("", "gh-extension-context-*.json")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() {
+		_ = os.Remove(f.Name())
+	}
+	enc := json.NewEncoder(f)
+	if err := enc.Encode(ctx); err != nil {
+		f.Close()
+		cleanup()
+		return "", nil, err
+	}
+	if err := f.Close(); err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return f.Name(), cleanup, nil
+}
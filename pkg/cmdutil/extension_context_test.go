@@ -0,0 +1,40 @@
+package cmdutil
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteExtensionContext(t *testing.T) {
+	ec := &ExtensionContext{
+		Version: ExtensionContextVersion,
+		Host:    "github.com",
+		Repository: &ExtensionContextRepository{
+			Owner: "monalisa",
+			Name:  "hello-world",
+			Host:  "github.com",
+		},
+		TTY: ExtensionContextTTY{
+			Stdin:  true,
+			Stdout: true,
+		},
+		ConfigDir: "/home/monalisa/.config/gh",
+	}
+
+	path, cleanup, err := WriteExtensionContext(ec)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var got ExtensionContext
+	require.NoError(t, json.Unmarshal(data, &got))
+	assert.Equal(t, *ec, got)
+
+	cleanup()
+	assert.NoFileExists(t, path)
+}
@@ -1,14 +1,16 @@
 package cmdutil
 
 import (
+	"context"
 	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
 
-	"github.com/cli/cli/v2/context"
+	ghContext "github.com/cli/cli/v2/context"
 	"github.com/cli/cli/v2/git"
 	"github.com/cli/cli/v2/internal/browser"
+	fd "github.com/cli/cli/v2/internal/featuredetection"
 	"github.com/cli/cli/v2/internal/gh"
 	"github.com/cli/cli/v2/internal/ghrepo"
 	"github.com/cli/cli/v2/internal/prompter"
@@ -20,6 +22,12 @@ type Factory struct {
 	AppVersion     string
 	ExecutableName string
 
+	// Context is canceled when the invocation should shut down, e.g. on the
+	// first interrupt or termination signal. Long-running commands should
+	// derive their subprocess and request contexts from it so that they stop
+	// cleanly. It may be nil in tests; treat nil as context.Background.
+	Context context.Context
+
 	Browser          browser.Browser
 	ExtensionManager extensions.ExtensionManager
 	GitClient        *git.Client
@@ -29,8 +37,16 @@ type Factory struct {
 	BaseRepo   func() (ghrepo.Interface, error)
 	Branch     func() (string, error)
 	Config     func() (gh.Config, error)
+	Detector   func(host string) (fd.Detector, error)
 	HttpClient func() (*http.Client, error)
-	Remotes    func() (context.Remotes, error)
+	Remotes    func() (ghContext.Remotes, error)
+
+	// HTTPMiddlewares are applied, in order, to the transport of every client
+	// returned by HttpClient. Extensions and enterprise wrappers can append
+	// functions here before commands run — for example to stamp audit headers
+	// on outgoing requests. Each function receives the current transport and
+	// returns the wrapped one; see api.Middleware.
+	HTTPMiddlewares []func(http.RoundTripper) http.RoundTripper
 }
 
 // Executable is the path to the currently invoked binary
@@ -0,0 +1,124 @@
+package cmdutil
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/cli/cli/v2/pkg/set"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+)
+
+// FormatterOptions is the state behind the shared `--format` flag set. Commands
+// that adopt it gain `--format {table|json|yaml|template}`, a `--template` flag
+// for Go template output, and `--columns` for selecting which fields are
+// emitted, without reimplementing output handling per command.
+type FormatterOptions struct {
+	Format   string
+	Template string
+	Columns  []string
+
+	fields []string
+}
+
+// AddFormatterFlags registers the shared formatting flags on cmd. The fields
+// slice lists every column that data passed to Write can export; it seeds
+// completion and validates values given to `--columns`.
+func AddFormatterFlags(cmd *cobra.Command, opts *FormatterOptions, fields []string) {
+	opts.fields = fields
+	StringEnumFlag(cmd, &opts.Format, "format", "", "table", []string{"table", "json", "yaml", "template"}, "Output format")
+	cmd.Flags().StringVar(&opts.Template, "template", "", "Format output using a Go template; see \"gh help formatting\"")
+	cmd.Flags().StringSliceVar(&opts.Columns, "columns", nil, "Restrict output to the specified `columns`")
+
+	_ = cmd.RegisterFlagCompletionFunc("columns", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		var results []string
+		var prefix string
+		if idx := strings.LastIndexByte(toComplete, ','); idx >= 0 {
+			prefix = toComplete[:idx+1]
+			toComplete = toComplete[idx+1:]
+		}
+		toComplete = strings.ToLower(toComplete)
+		for _, f := range fields {
+			if strings.HasPrefix(strings.ToLower(f), toComplete) {
+				results = append(results, prefix+f)
+			}
+		}
+		sort.Strings(results)
+		return results, cobra.ShellCompDirectiveNoSpace
+	})
+
+	oldPreRun := cmd.PreRunE
+	cmd.PreRunE = func(c *cobra.Command, args []string) error {
+		if oldPreRun != nil {
+			if err := oldPreRun(c, args); err != nil {
+				return err
+			}
+		}
+		return opts.validate(c)
+	}
+}
+
+func (f *FormatterOptions) validate(cmd *cobra.Command) error {
+	if f.Template != "" && !cmd.Flags().Changed("format") {
+		f.Format = "template"
+	}
+	if f.Format == "template" && f.Template == "" {
+		return FlagErrorf("a template must be supplied with `--template` when using `--format template`")
+	}
+	if f.Template != "" && f.Format != "template" {
+		return FlagErrorf("cannot use `--template` with `--format %s`", f.Format)
+	}
+	if len(f.Columns) > 0 {
+		allowed := set.NewStringSet()
+		allowed.AddValues(f.fields)
+		for _, c := range f.Columns {
+			if !allowed.Contains(c) {
+				sorted := make([]string, len(f.fields))
+				copy(sorted, f.fields)
+				sort.Strings(sorted)
+				return FlagErrorf("unknown column: %q\nAvailable columns:\n  %s", c, strings.Join(sorted, "\n  "))
+			}
+		}
+	}
+	return nil
+}
+
+// IsStructured reports whether the command should hand its data to Write
+// instead of rendering its usual table output.
+func (f *FormatterOptions) IsStructured() bool {
+	return f.Format != "" && f.Format != "table"
+}
+
+// Fields returns the columns selected with `--columns`, or every exportable
+// field when no selection was made.
+func (f *FormatterOptions) Fields() []string {
+	if len(f.Columns) > 0 {
+		return f.Columns
+	}
+	return f.fields
+}
+
+// Write renders data in the selected format. As with Exporter, objects that
+// implement ExportData() are reduced to the selected fields before
+// serialization.
+func (f *FormatterOptions) Write(ios *iostreams.IOStreams, data interface{}) error {
+	exporter := &jsonExporter{fields: f.Fields()}
+	switch f.Format {
+	case "json":
+		return exporter.Write(ios, data)
+	case "template":
+		exporter.template = f.Template
+		return exporter.Write(ios, data)
+	case "yaml":
+		out, err := yaml.Marshal(exporter.exportData(reflect.ValueOf(data)))
+		if err != nil {
+			return err
+		}
+		_, err = ios.Out.Write(out)
+		return err
+	}
+	return fmt.Errorf("unsupported format: %q", f.Format)
+}
@@ -0,0 +1,148 @@
+package cmdutil
+
+import (
+	"io"
+	"testing"
+
+	"github.com/cli/cli/v2/pkg/iostreams"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddFormatterFlags(t *testing.T) {
+	tests := []struct {
+		name       string
+		fields     []string
+		args       []string
+		wantsOpts  FormatterOptions
+		wantsError string
+	}{
+		{
+			name:      "defaults to table",
+			fields:    []string{"id", "name"},
+			args:      []string{},
+			wantsOpts: FormatterOptions{Format: "table"},
+		},
+		{
+			name:      "format json",
+			fields:    []string{"id", "name"},
+			args:      []string{"--format", "json"},
+			wantsOpts: FormatterOptions{Format: "json"},
+		},
+		{
+			name:      "format yaml",
+			fields:    []string{"id", "name"},
+			args:      []string{"--format", "yaml"},
+			wantsOpts: FormatterOptions{Format: "yaml"},
+		},
+		{
+			name:       "invalid format",
+			fields:     []string{"id", "name"},
+			args:       []string{"--format", "xml"},
+			wantsError: "invalid argument \"xml\" for \"--format\" flag: valid values are {table|json|yaml|template}",
+		},
+		{
+			name:      "template implies format template",
+			fields:    []string{"id", "name"},
+			args:      []string{"--template", "{{.name}}"},
+			wantsOpts: FormatterOptions{Format: "template", Template: "{{.name}}"},
+		},
+		{
+			name:       "format template without template",
+			fields:     []string{"id", "name"},
+			args:       []string{"--format", "template"},
+			wantsError: "a template must be supplied with `--template` when using `--format template`",
+		},
+		{
+			name:       "cannot combine template with another format",
+			fields:     []string{"id", "name"},
+			args:       []string{"--format", "yaml", "--template", "{{.name}}"},
+			wantsError: "cannot use `--template` with `--format yaml`",
+		},
+		{
+			name:      "column selection",
+			fields:    []string{"id", "name", "url"},
+			args:      []string{"--format", "json", "--columns", "name,url"},
+			wantsOpts: FormatterOptions{Format: "json", Columns: []string{"name", "url"}},
+		},
+		{
+			name:       "unknown column",
+			fields:     []string{"id", "name"},
+			args:       []string{"--columns", "idontexist"},
+			wantsError: "unknown column: \"idontexist\"\nAvailable columns:\n  id\n  name",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cmd := &cobra.Command{Run: func(*cobra.Command, []string) {}}
+			var opts FormatterOptions
+			AddFormatterFlags(cmd, &opts, tt.fields)
+			cmd.SetArgs(tt.args)
+			cmd.SetOut(io.Discard)
+			cmd.SetErr(io.Discard)
+			_, err := cmd.ExecuteC()
+			if tt.wantsError == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantsError)
+				return
+			}
+			assert.Equal(t, tt.wantsOpts.Format, opts.Format)
+			assert.Equal(t, tt.wantsOpts.Template, opts.Template)
+			assert.Equal(t, tt.wantsOpts.Columns, opts.Columns)
+		})
+	}
+}
+
+func TestFormatterOptionsWrite(t *testing.T) {
+	type repo struct {
+		Name string
+		URL  string
+	}
+	data := []repo{
+		{Name: "cli/cli", URL: "https://github.com/cli/cli"},
+		{Name: "cli/go-gh", URL: "https://github.com/cli/go-gh"},
+	}
+
+	tests := []struct {
+		name       string
+		opts       FormatterOptions
+		wantsOut   string
+		wantsError string
+	}{
+		{
+			name:     "json",
+			opts:     FormatterOptions{Format: "json"},
+			wantsOut: "[{\"Name\":\"cli/cli\",\"URL\":\"https://github.com/cli/cli\"},{\"Name\":\"cli/go-gh\",\"URL\":\"https://github.com/cli/go-gh\"}]\n",
+		},
+		{
+			name:     "yaml",
+			opts:     FormatterOptions{Format: "yaml"},
+			wantsOut: "- name: cli/cli\n  url: https://github.com/cli/cli\n- name: cli/go-gh\n  url: https://github.com/cli/go-gh\n",
+		},
+		{
+			name:     "template",
+			opts:     FormatterOptions{Format: "template", Template: "{{range .}}{{.Name}}\n{{end}}"},
+			wantsOut: "cli/cli\ncli/go-gh\n",
+		},
+		{
+			name:       "table is not structured output",
+			opts:       FormatterOptions{Format: "table"},
+			wantsError: "unsupported format: \"table\"",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ios, _, stdout, _ := iostreams.Test()
+			err := tt.opts.Write(ios, data)
+			if tt.wantsError == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantsError)
+				return
+			}
+			assert.Equal(t, tt.wantsOut, stdout.String())
+		})
+	}
+}
@@ -119,6 +119,18 @@ func TestAddJSONFlags(t *testing.T) {
 	}
 }
 
+func TestAddJSONFlagsWithExistingTemplateShorthand(t *testing.T) {
+	cmd := &cobra.Command{Run: func(*cobra.Command, []string) {}}
+	cmd.Flags().StringP("title", "t", "", "")
+	var exporter Exporter
+	AddJSONFlags(cmd, &exporter, []string{"id"})
+
+	tplFlag := cmd.Flags().Lookup("template")
+	require.NotNil(t, tplFlag)
+	assert.Equal(t, "", tplFlag.Shorthand)
+	assert.Equal(t, "title", cmd.Flags().ShorthandLookup("t").Name)
+}
+
 // TestAddJSONFlagsSetsAnnotations asserts that `AddJSONFlags` function adds the
 // appropriate annotation to the command, which could later be used by doc
 // generator functions.
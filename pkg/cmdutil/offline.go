@@ -0,0 +1,22 @@
+package cmdutil
+
+import (
+	"errors"
+	"os"
+	"slices"
+)
+
+// ErrOffline is returned for any HTTP request made while offline mode is
+// enabled. Commands with a local fallback can detect it with errors.Is and
+// degrade gracefully instead of failing.
+var ErrOffline = errors.New("network access is disabled while in offline mode")
+
+// IsOffline reports whether offline mode is enabled via the GH_OFFLINE
+// environment variable or the --offline flag, which sets it. While offline,
+// gh fails any network request immediately with ErrOffline instead of letting
+// it time out.
+func IsOffline() bool {
+	falseyValues := []string{"false", "0", "no", ""}
+	value, ok := os.LookupEnv("GH_OFFLINE")
+	return ok && !slices.Contains(falseyValues, value)
+}
@@ -0,0 +1,38 @@
+package cmdutil
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsOffline(t *testing.T) {
+	tests := []struct {
+		name  string
+		env   string
+		unset bool
+		want  bool
+	}{
+		{name: "unset", unset: true, want: false},
+		{name: "empty", env: "", want: false},
+		{name: "false", env: "false", want: false},
+		{name: "zero", env: "0", want: false},
+		{name: "no", env: "no", want: false},
+		{name: "one", env: "1", want: true},
+		{name: "true", env: "true", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.unset {
+				// t.Setenv registers the restore before the variable is unset
+				t.Setenv("GH_OFFLINE", "")
+				os.Unsetenv("GH_OFFLINE")
+			} else {
+				t.Setenv("GH_OFFLINE", tt.env)
+			}
+			assert.Equal(t, tt.want, IsOffline())
+		})
+	}
+}
@@ -113,6 +113,62 @@ func ExtractTitle(filePath string) string {
 	return ""
 }
 
+// stringList unmarshals a front-matter value that may be either a
+// comma-separated scalar or a YAML sequence.
+type stringList []string
+
+func (l *stringList) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		var s string
+		if err := value.Decode(&s); err != nil {
+			return err
+		}
+		for _, part := range strings.Split(s, ",") {
+			if part = strings.TrimSpace(part); part != "" {
+				*l = append(*l, part)
+			}
+		}
+	case yaml.SequenceNode:
+		var items []string
+		if err := value.Decode(&items); err != nil {
+			return err
+		}
+		*l = items
+	}
+	return nil
+}
+
+// ExtractLabels returns the default labels of the template from YAML front-matter
+func ExtractLabels(filePath string) []string {
+	contents, err := os.ReadFile(filePath)
+	frontmatterBoundaries := detectFrontmatter(contents)
+	if err == nil && frontmatterBoundaries[0] == 0 {
+		templateData := struct {
+			Labels stringList
+		}{}
+		if err := yaml.Unmarshal(contents[0:frontmatterBoundaries[1]], &templateData); err == nil {
+			return templateData.Labels
+		}
+	}
+	return nil
+}
+
+// ExtractAssignees returns the default assignees of the template from YAML front-matter
+func ExtractAssignees(filePath string) []string {
+	contents, err := os.ReadFile(filePath)
+	frontmatterBoundaries := detectFrontmatter(contents)
+	if err == nil && frontmatterBoundaries[0] == 0 {
+		templateData := struct {
+			Assignees stringList
+		}{}
+		if err := yaml.Unmarshal(contents[0:frontmatterBoundaries[1]], &templateData); err == nil {
+			return templateData.Assignees
+		}
+	}
+	return nil
+}
+
 // ExtractContents returns the template contents without the YAML front-matter
 func ExtractContents(filePath string) []byte {
 	contents, err := os.ReadFile(filePath)
@@ -380,6 +380,135 @@ about: This is how you report bugs
 	}
 }
 
+func TestExtractLabels(t *testing.T) {
+	tmpfile, err := os.CreateTemp(t.TempDir(), "gh-cli")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tmpfile.Close()
+
+	type args struct {
+		filePath string
+	}
+	tests := []struct {
+		name    string
+		prepare string
+		args    args
+		want    []string
+	}{
+		{
+			name: "Comma-separated labels",
+			prepare: `---
+name: Bug Report
+labels: bug, help wanted
+---
+
+**Template contents**
+`,
+			args: args{
+				filePath: tmpfile.Name(),
+			},
+			want: []string{"bug", "help wanted"},
+		},
+		{
+			name: "List of labels",
+			prepare: `---
+name: Bug Report
+labels:
+  - bug
+  - help wanted
+---
+`,
+			args: args{
+				filePath: tmpfile.Name(),
+			},
+			want: []string{"bug", "help wanted"},
+		},
+		{
+			name: "No labels",
+			prepare: `---
+name: Bug Report
+---
+`,
+			args: args{
+				filePath: tmpfile.Name(),
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = os.WriteFile(tmpfile.Name(), []byte(tt.prepare), 0600)
+			if got := ExtractLabels(tt.args.filePath); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractLabels() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractAssignees(t *testing.T) {
+	tmpfile, err := os.CreateTemp(t.TempDir(), "gh-cli")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tmpfile.Close()
+
+	type args struct {
+		filePath string
+	}
+	tests := []struct {
+		name    string
+		prepare string
+		args    args
+		want    []string
+	}{
+		{
+			name: "Comma-separated assignees",
+			prepare: `---
+name: Bug Report
+assignees: monalisa,hubot
+---
+`,
+			args: args{
+				filePath: tmpfile.Name(),
+			},
+			want: []string{"monalisa", "hubot"},
+		},
+		{
+			name: "List of assignees",
+			prepare: `---
+name: Bug Report
+assignees:
+  - monalisa
+---
+`,
+			args: args{
+				filePath: tmpfile.Name(),
+			},
+			want: []string{"monalisa"},
+		},
+		{
+			name: "No assignees",
+			prepare: `---
+name: Bug Report
+---
+`,
+			args: args{
+				filePath: tmpfile.Name(),
+			},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_ = os.WriteFile(tmpfile.Name(), []byte(tt.prepare), 0600)
+			if got := ExtractAssignees(tt.args.filePath); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ExtractAssignees() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestExtractContents(t *testing.T) {
 	tmpfile, err := os.CreateTemp(t.TempDir(), "gh-cli")
 	if err != nil {
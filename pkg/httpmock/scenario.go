@@ -0,0 +1,158 @@
+package httpmock
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+)
+
+// scenarioVersion identifies the fixture format and is incremented whenever it
+// changes incompatibly.
+const scenarioVersion = 1
+
+// Scenario is a recorded sequence of HTTP interactions that can be replayed
+// through a Registry, so integration-style tests of commands that make many
+// requests can be authored without hand-writing each stub.
+type Scenario struct {
+	Version      int           `json:"version"`
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction is a single request/response pair in a Scenario. Request headers
+// are deliberately not captured: they carry credentials and none of them are
+// needed to replay a response.
+type Interaction struct {
+	Method       string `json:"method"`
+	Path         string `json:"path"`
+	Query        string `json:"query,omitempty"`
+	RequestBody  string `json:"request_body,omitempty"`
+	Status       int    `json:"status"`
+	ContentType  string `json:"content_type,omitempty"`
+	ResponseBody string `json:"response_body"`
+}
+
+// tokenRE matches GitHub token formats so recorded fixtures never contain a
+// usable credential, even when a token appears in a request or response body.
+var tokenRE = regexp.MustCompile(`(?:ghp|gho|ghu|ghs|ghr|github_pat)_[A-Za-z0-9_]+`)
+
+func sanitize(s string) string {
+	return tokenRE.ReplaceAllString(s, "REDACTED")
+}
+
+// Recorder is an http.RoundTripper that forwards requests to a real transport
+// while capturing each interaction. Call Flush to write the sanitized fixture
+// once the interactions of interest have happened.
+type Recorder struct {
+	rt   http.RoundTripper
+	path string
+
+	mu           sync.Mutex
+	interactions []Interaction
+}
+
+// NewRecorder returns a Recorder that captures interactions going through rt
+// and writes them to the fixture file at path on Flush.
+func NewRecorder(rt http.RoundTripper, path string) *Recorder {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+	return &Recorder{rt: rt, path: path}
+}
+
+// RoundTrip satisfies http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	var requestBody []byte
+	if req.Body != nil {
+		var err error
+		if requestBody, err = readBody(req); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := r.rt.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	responseBody, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	r.mu.Lock()
+	r.interactions = append(r.interactions, Interaction{
+		Method:       req.Method,
+		Path:         req.URL.Path,
+		Query:        req.URL.RawQuery,
+		RequestBody:  sanitize(string(requestBody)),
+		Status:       resp.StatusCode,
+		ContentType:  resp.Header.Get("Content-Type"),
+		ResponseBody: sanitize(string(responseBody)),
+	})
+	r.mu.Unlock()
+
+	return resp, nil
+}
+
+// Flush writes the recorded interactions to the fixture file.
+func (r *Recorder) Flush() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	scenario := Scenario{
+		Version:      scenarioVersion,
+		Interactions: r.interactions,
+	}
+	data, err := json.MarshalIndent(scenario, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0644)
+}
+
+// RegisterScenario loads a fixture recorded with Recorder and registers a stub
+// for each of its interactions. Interactions sharing a method and path, such
+// as consecutive GraphQL requests, are replayed in the order they were
+// recorded, since each stub responds at most once.
+func (r *Registry) RegisterScenario(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var scenario Scenario
+	if err := json.Unmarshal(data, &scenario); err != nil {
+		return fmt.Errorf("could not parse scenario fixture %s: %w", path, err)
+	}
+	if scenario.Version != scenarioVersion {
+		return fmt.Errorf("scenario fixture %s has version %d, expected %d; re-record it", path, scenario.Version, scenarioVersion)
+	}
+
+	for _, interaction := range scenario.Interactions {
+		interaction := interaction
+		matcher := func(req *http.Request) bool {
+			return req.Method == interaction.Method && req.URL.Path == interaction.Path
+		}
+		responder := func(req *http.Request) (*http.Response, error) {
+			header := http.Header{}
+			if interaction.ContentType != "" {
+				header.Set("Content-Type", interaction.ContentType)
+			}
+			return httpResponseWithHeader(interaction.Status, req, bytes.NewBufferString(interaction.ResponseBody), header), nil
+		}
+		r.Register(matcher, responder)
+	}
+
+	return nil
+}
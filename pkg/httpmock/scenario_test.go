@@ -0,0 +1,128 @@
+package httpmock
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type scriptedTripper struct {
+	responses []*http.Response
+}
+
+func (s *scriptedTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp := s.responses[0]
+	s.responses = s.responses[1:]
+	resp.Request = req
+	return resp, nil
+}
+
+func stubResponse(status int, contentType, body string) *http.Response {
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{contentType}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestRecorderAndReplay(t *testing.T) {
+	fixturePath := filepath.Join(t.TempDir(), "fixtures", "scenario.json")
+
+	rt := &scriptedTripper{responses: []*http.Response{
+		stubResponse(200, "application/json", `{"data":{"viewer":{"login":"monalisa"}}}`),
+		stubResponse(404, "application/json", `{"message":"Not Found"}`),
+	}}
+	recorder := NewRecorder(rt, fixturePath)
+	client := &http.Client{Transport: recorder}
+
+	resp, err := client.Post("https://api.github.com/graphql", "application/json", bytes.NewBufferString(`{"query":"query { viewer { login } }"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	resp, err = client.Get("https://api.github.com/repos/OWNER/REPO?page=2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if err := recorder.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	reg := &Registry{}
+	if err := reg.RegisterScenario(fixturePath); err != nil {
+		t.Fatalf("RegisterScenario: %v", err)
+	}
+	defer reg.Verify(t)
+
+	replayClient := &http.Client{Transport: reg}
+
+	resp, err = replayClient.Post("https://api.github.com/graphql", "application/json", bytes.NewBufferString(`{"query":"query { viewer { login } }"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if string(body) != `{"data":{"viewer":{"login":"monalisa"}}}` {
+		t.Errorf("unexpected replayed body: %s", body)
+	}
+
+	resp, err = replayClient.Get("https://api.github.com/repos/OWNER/REPO?page=2")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != 404 {
+		t.Errorf("expected status 404, got %d", resp.StatusCode)
+	}
+}
+
+func TestRecorderSanitizesTokens(t *testing.T) {
+	fixturePath := filepath.Join(t.TempDir(), "scenario.json")
+
+	rt := &scriptedTripper{responses: []*http.Response{
+		stubResponse(200, "application/json", `{"token":"gho_secret123"}`),
+	}}
+	recorder := NewRecorder(rt, fixturePath)
+	client := &http.Client{Transport: recorder}
+
+	resp, err := client.Post("https://api.github.com/login/oauth/access_token", "application/json", bytes.NewBufferString(`{"token":"ghp_anothersecret"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	resp.Body.Close()
+
+	if err := recorder.Flush(); err != nil {
+		t.Fatalf("Flush: %v", err)
+	}
+
+	data, err := os.ReadFile(fixturePath)
+	if err != nil {
+		t.Fatalf("ReadFile: %v", err)
+	}
+	if strings.Contains(string(data), "secret") {
+		t.Errorf("fixture contains an unsanitized token:\n%s", data)
+	}
+	if !strings.Contains(string(data), "REDACTED") {
+		t.Errorf("fixture is missing redaction markers:\n%s", data)
+	}
+}
+
+func TestRegisterScenarioVersionMismatch(t *testing.T) {
+	fixturePath := filepath.Join(t.TempDir(), "scenario.json")
+	if err := os.WriteFile(fixturePath, []byte(`{"version":99,"interactions":[]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	reg := &Registry{}
+	err := reg.RegisterScenario(fixturePath)
+	if err == nil || !strings.Contains(err.Error(), "re-record it") {
+		t.Errorf("expected version mismatch error, got %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package iostreams
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	xterm "golang.org/x/term"
+)
+
+// builtinPager is a minimal fallback pager used when no external pager is
+// configured: output is buffered while the pager is active and, if it exceeds
+// a screenful once the command finishes, it is shown a page at a time with
+// space/enter/q navigation. It deliberately stays simple — lines longer than
+// the terminal may wrap and count as more than one row — because anyone who
+// wants more is better served by configuring a real pager.
+type builtinPager struct {
+	out    fileWriter
+	in     fileReader
+	height int
+	buf    bytes.Buffer
+
+	// readKey reads a single keypress; it is a field so tests can stub
+	// terminal input.
+	readKey func() (byte, error)
+}
+
+func newBuiltinPager(out fileWriter, in fileReader, height int) *builtinPager {
+	p := &builtinPager{
+		out:    out,
+		in:     in,
+		height: height,
+	}
+	p.readKey = p.readKeyRaw
+	return p
+}
+
+func (p *builtinPager) Write(b []byte) (int, error) {
+	return p.buf.Write(b)
+}
+
+// Close renders the buffered output, paging it interactively when it does not
+// fit on one screen.
+func (p *builtinPager) Close() error {
+	if p.buf.Len() == 0 {
+		return nil
+	}
+
+	if lineCount := bytes.Count(p.buf.Bytes(), []byte{'\n'}); lineCount < p.height {
+		_, err := p.out.Write(p.buf.Bytes())
+		return err
+	}
+
+	lines := strings.Split(strings.TrimSuffix(p.buf.String(), "\n"), "\n")
+	pageSize := p.height - 1
+	if pageSize < 1 {
+		pageSize = 1
+	}
+
+	shown := 0
+	step := pageSize
+	for shown < len(lines) {
+		end := shown + step
+		if end > len(lines) {
+			end = len(lines)
+		}
+		for _, line := range lines[shown:end] {
+			fmt.Fprintln(p.out, line)
+		}
+		shown = end
+		if shown >= len(lines) {
+			break
+		}
+
+		fmt.Fprintf(p.out, "\x1b[7m--More--(%d%%)\x1b[0m", shown*100/len(lines))
+		key, err := p.readKey()
+		fmt.Fprint(p.out, "\r\x1b[K")
+		if err != nil {
+			// keyboard input is unavailable; show everything rather than hang
+			for _, line := range lines[shown:] {
+				fmt.Fprintln(p.out, line)
+			}
+			return nil
+		}
+
+		switch key {
+		case 'q', 'Q', 3: // 3 is ctrl-c
+			return nil
+		case '\r', '\n':
+			step = 1
+		default:
+			step = pageSize
+		}
+	}
+	return nil
+}
+
+func (p *builtinPager) readKeyRaw() (byte, error) {
+	fd := int(p.in.Fd())
+	oldState, err := xterm.MakeRaw(fd)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = xterm.Restore(fd, oldState) }()
+
+	var b [1]byte
+	if _, err := p.in.Read(b[:]); err != nil {
+		return 0, err
+	}
+	return b[0], nil
+}
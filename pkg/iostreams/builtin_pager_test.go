@@ -0,0 +1,110 @@
+package iostreams
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func builtinPagerForTest(height int, keys ...byte) (*builtinPager, *bytes.Buffer) {
+	out := &bytes.Buffer{}
+	pager := newBuiltinPager(&fdWriter{fd: 1, Writer: out}, nil, height)
+	pager.readKey = func() (byte, error) {
+		if len(keys) == 0 {
+			return 0, fmt.Errorf("no more keys")
+		}
+		key := keys[0]
+		keys = keys[1:]
+		return key, nil
+	}
+	return pager, out
+}
+
+func TestBuiltinPagerShortOutputPassesThrough(t *testing.T) {
+	pager, out := builtinPagerForTest(10)
+
+	fmt.Fprintln(pager, "one")
+	fmt.Fprintln(pager, "two")
+
+	if err := pager.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := out.String(), "one\ntwo\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestBuiltinPagerPagesWithSpace(t *testing.T) {
+	pager, out := builtinPagerForTest(4, ' ', ' ')
+
+	for i := 1; i <= 8; i++ {
+		fmt.Fprintf(pager, "line %d\n", i)
+	}
+
+	if err := pager.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+	for i := 1; i <= 8; i++ {
+		if !strings.Contains(got, fmt.Sprintf("line %d\n", i)) {
+			t.Errorf("output missing %q: %q", fmt.Sprintf("line %d", i), got)
+		}
+	}
+	if !strings.Contains(got, "--More--") {
+		t.Errorf("output missing paging prompt: %q", got)
+	}
+}
+
+func TestBuiltinPagerQuitStopsOutput(t *testing.T) {
+	pager, out := builtinPagerForTest(4, 'q')
+
+	for i := 1; i <= 8; i++ {
+		fmt.Fprintf(pager, "line %d\n", i)
+	}
+
+	if err := pager.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+	if !strings.Contains(got, "line 3\n") {
+		t.Errorf("output missing first page: %q", got)
+	}
+	if strings.Contains(got, "line 4\n") {
+		t.Errorf("output should stop after quit: %q", got)
+	}
+}
+
+func TestBuiltinPagerEnterAdvancesOneLine(t *testing.T) {
+	pager, out := builtinPagerForTest(4, '\r', 'q')
+
+	for i := 1; i <= 8; i++ {
+		fmt.Fprintf(pager, "line %d\n", i)
+	}
+
+	if err := pager.Close(); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+	if !strings.Contains(got, "line 4\n") {
+		t.Errorf("enter should advance one line: %q", got)
+	}
+	if strings.Contains(got, "line 5\n") {
+		t.Errorf("output should stop after quit: %q", got)
+	}
+}
+
+func TestBuiltinPagerDumpsWhenKeyboardUnavailable(t *testing.T) {
+	pager, out := builtinPagerForTest(4)
+
+	for i := 1; i <= 8; i++ {
+		fmt.Fprintf(pager, "line %d\n", i)
+	}
+
+	if err := pager.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "line 8\n") {
+		t.Errorf("output should include everything when keys cannot be read: %q", out.String())
+	}
+}
@@ -2,6 +2,7 @@ package iostreams
 
 import (
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
 
@@ -15,6 +16,51 @@ const (
 	highlightStyle = "black:yellow"
 )
 
+// ColorCapability describes the richest color profile that terminal output
+// may use, in increasing order of fidelity.
+type ColorCapability int
+
+const (
+	// ColorCapabilityNone disables color output entirely.
+	ColorCapabilityNone ColorCapability = iota
+	// ColorCapabilityBasic is the 4-bit, 16 color ANSI palette.
+	ColorCapabilityBasic
+	// ColorCapability256 is the 8-bit, 256 color palette.
+	ColorCapability256
+	// ColorCapabilityTrueColor is 24-bit, 16 million colors.
+	ColorCapabilityTrueColor
+)
+
+// EnvColorDisabled reports whether the environment asks for color output to be
+// suppressed, per the NO_COLOR, CLICOLOR, and FORCE_COLOR conventions.
+func EnvColorDisabled() bool {
+	return os.Getenv("NO_COLOR") != "" || os.Getenv("CLICOLOR") == "0" || os.Getenv("FORCE_COLOR") == "0"
+}
+
+// EnvColorForced reports whether the environment asks for color output even
+// when standard output is not a terminal, per CLICOLOR_FORCE and FORCE_COLOR.
+func EnvColorForced() bool {
+	if v := os.Getenv("CLICOLOR_FORCE"); v != "" && v != "0" {
+		return true
+	}
+	v := os.Getenv("FORCE_COLOR")
+	return v != "" && v != "0"
+}
+
+// envColorCapability maps a FORCE_COLOR level (1 = 16 colors, 2 = 256 colors,
+// 3 = truecolor) onto a capability override.
+func envColorCapability() (ColorCapability, bool) {
+	switch os.Getenv("FORCE_COLOR") {
+	case "1":
+		return ColorCapabilityBasic, true
+	case "2":
+		return ColorCapability256, true
+	case "3":
+		return ColorCapabilityTrueColor, true
+	}
+	return ColorCapabilityNone, false
+}
+
 // Special cases like darkThemeTableHeader / lightThemeTableHeader are necessary when using color and modifiers
 // (bold, underline, dim) because ansi.ColorFunc requires a foreground color and resets formats.
 var (
@@ -49,6 +95,9 @@ type ColorScheme struct {
 	EightBitColor bool
 	// TrueColor is whether the terminal supports 24-bit, 16 million colors.
 	TrueColor bool
+	// Capability is the richest color profile that output may use. The
+	// Enabled, EightBitColor, and TrueColor fields are derived from it.
+	Capability ColorCapability
 	// Accessible is whether colors must be base 16 colors that users can customize in terminal preferences.
 	Accessible bool
 	// ColorLabels is whether labels are colored based on their truecolor RGB hex color.
@@ -294,3 +294,74 @@ func TestMuted(t *testing.T) {
 		})
 	}
 }
+
+func TestEnvColorDisabled(t *testing.T) {
+	tests := []struct {
+		name          string
+		noColor       string
+		cliColor      string
+		forceColor    string
+		wantsDisabled bool
+	}{
+		{name: "pristine environment"},
+		{name: "NO_COLOR", noColor: "1", wantsDisabled: true},
+		{name: "CLICOLOR=0", cliColor: "0", wantsDisabled: true},
+		{name: "CLICOLOR=1", cliColor: "1"},
+		{name: "FORCE_COLOR=0", forceColor: "0", wantsDisabled: true},
+		{name: "FORCE_COLOR=1", forceColor: "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("NO_COLOR", tt.noColor)
+			t.Setenv("CLICOLOR", tt.cliColor)
+			t.Setenv("FORCE_COLOR", tt.forceColor)
+			if tt.noColor == "" {
+				// t.Setenv cannot unset; an empty NO_COLOR does not disable color
+				t.Setenv("NO_COLOR", "")
+			}
+			assert.Equal(t, tt.wantsDisabled, EnvColorDisabled())
+		})
+	}
+}
+
+func TestEnvColorForced(t *testing.T) {
+	tests := []struct {
+		name          string
+		cliColorForce string
+		forceColor    string
+		wantsForced   bool
+	}{
+		{name: "pristine environment"},
+		{name: "CLICOLOR_FORCE=1", cliColorForce: "1", wantsForced: true},
+		{name: "CLICOLOR_FORCE=0", cliColorForce: "0"},
+		{name: "FORCE_COLOR=3", forceColor: "3", wantsForced: true},
+		{name: "FORCE_COLOR=0", forceColor: "0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("CLICOLOR_FORCE", tt.cliColorForce)
+			t.Setenv("FORCE_COLOR", tt.forceColor)
+			assert.Equal(t, tt.wantsForced, EnvColorForced())
+		})
+	}
+}
+
+func TestColorCapability(t *testing.T) {
+	ios, _, _, _ := Test()
+	assert.Equal(t, ColorCapabilityNone, ios.ColorCapability())
+
+	ios.SetColorEnabled(true)
+	assert.Equal(t, ColorCapabilityTrueColor, ios.ColorCapability())
+
+	ios.SetColorCapability(ColorCapability256)
+	assert.Equal(t, ColorCapability256, ios.ColorCapability())
+
+	cs := ios.ColorScheme()
+	assert.True(t, cs.Enabled)
+	assert.True(t, cs.EightBitColor)
+	assert.False(t, cs.TrueColor)
+	assert.Equal(t, ColorCapability256, cs.Capability)
+
+	ios.SetColorEnabled(false)
+	assert.Equal(t, ColorCapabilityNone, ios.ColorCapability())
+}
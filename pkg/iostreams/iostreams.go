@@ -22,6 +22,9 @@ import (
 
 const DefaultWidth = 80
 
+// DefaultHeight is assumed when the terminal height cannot be determined.
+const DefaultHeight = 24
+
 // ErrClosedPagerPipe is the error returned when writing to a pager that has been closed.
 type ErrClosedPagerPipe struct {
 	error
@@ -74,14 +77,19 @@ type IOStreams struct {
 	colorOverride           bool
 	colorEnabled            bool
 	colorLabels             bool
+	colorCapabilityOverride *ColorCapability
 	accessibleColorsEnabled bool
 
 	pagerCommand string
 	pagerProcess *os.Process
+	builtinPager *builtinPager
 
 	neverPrompt               bool
 	accessiblePrompterEnabled bool
 
+	quiet   bool
+	verbose bool
+
 	TempFileOverride *os.File
 }
 
@@ -110,6 +118,28 @@ func (s *IOStreams) ColorLabels() bool {
 	return s.colorLabels
 }
 
+// ColorCapability reports the richest color profile that output may use,
+// combining terminal detection with any FORCE_COLOR override.
+func (s *IOStreams) ColorCapability() ColorCapability {
+	if !s.ColorEnabled() {
+		return ColorCapabilityNone
+	}
+	if s.colorCapabilityOverride != nil {
+		return *s.colorCapabilityOverride
+	}
+	if s.HasTrueColor() {
+		return ColorCapabilityTrueColor
+	}
+	if s.ColorSupport256() {
+		return ColorCapability256
+	}
+	return ColorCapabilityBasic
+}
+
+func (s *IOStreams) SetColorCapability(c ColorCapability) {
+	s.colorCapabilityOverride = &c
+}
+
 // DetectTerminalTheme is a utility to call before starting the output pager so that the terminal background
 // can be reliably detected.
 func (s *IOStreams) DetectTerminalTheme() {
@@ -202,7 +232,27 @@ func (s *IOStreams) GetPager() string {
 }
 
 func (s *IOStreams) StartPager() error {
-	if s.pagerCommand == "" || s.pagerCommand == "cat" || !s.IsStdoutTTY() {
+	if s.pagerCommand == "cat" || !s.IsStdoutTTY() {
+		return nil
+	}
+
+	if s.pagerCommand == "" {
+		// No external pager is configured; fall back to the built-in pager,
+		// which needs keyboard input to navigate and a real terminal height
+		// to page by.
+		if !s.IsStdinTTY() {
+			return nil
+		}
+		_, height, err := s.term.Size()
+		if err != nil || height <= 0 {
+			return nil
+		}
+		pager := newBuiltinPager(s.Out, s.In, height)
+		s.builtinPager = pager
+		s.Out = &fdWriteCloser{
+			fd:          s.Out.Fd(),
+			WriteCloser: pager,
+		}
 		return nil
 	}
 
@@ -249,6 +299,14 @@ func (s *IOStreams) StartPager() error {
 }
 
 func (s *IOStreams) StopPager() {
+	if s.builtinPager != nil {
+		// closing flushes the buffered output, paging it if necessary
+		_ = s.Out.(io.WriteCloser).Close()
+		s.Out = s.builtinPager.out
+		s.builtinPager = nil
+		return
+	}
+
 	if s.pagerProcess == nil {
 		return
 	}
@@ -275,6 +333,40 @@ func (s *IOStreams) SetNeverPrompt(v bool) {
 	s.neverPrompt = v
 }
 
+func (s *IOStreams) GetQuiet() bool {
+	return s.quiet
+}
+
+func (s *IOStreams) SetQuiet(v bool) {
+	s.quiet = v
+}
+
+func (s *IOStreams) GetVerbose() bool {
+	return s.verbose
+}
+
+func (s *IOStreams) SetVerbose(v bool) {
+	s.verbose = v
+}
+
+// Infof prints an informational message to ErrOut, such as a note about an
+// action just taken, unless the user asked for quiet output.
+func (s *IOStreams) Infof(format string, a ...interface{}) {
+	if s.quiet {
+		return
+	}
+	fmt.Fprintf(s.ErrOut, format, a...)
+}
+
+// Verbosef prints extra diagnostic output to ErrOut, but only when the user
+// asked for verbose output.
+func (s *IOStreams) Verbosef(format string, a ...interface{}) {
+	if !s.verbose {
+		return
+	}
+	fmt.Fprintf(s.ErrOut, format, a...)
+}
+
 func (s *IOStreams) GetSpinnerDisabled() bool {
 	return s.spinnerDisabled
 }
@@ -417,11 +509,22 @@ func (s *IOStreams) TerminalWidth() int {
 	return DefaultWidth
 }
 
+// TerminalHeight returns the height of the terminal that controls the process
+func (s *IOStreams) TerminalHeight() int {
+	_, h, err := s.term.Size()
+	if err == nil && h > 0 {
+		return h
+	}
+	return DefaultHeight
+}
+
 func (s *IOStreams) ColorScheme() *ColorScheme {
+	capability := s.ColorCapability()
 	return &ColorScheme{
-		Enabled:       s.ColorEnabled(),
-		EightBitColor: s.ColorSupport256(),
-		TrueColor:     s.HasTrueColor(),
+		Enabled:       capability > ColorCapabilityNone,
+		EightBitColor: capability >= ColorCapability256,
+		TrueColor:     capability >= ColorCapabilityTrueColor,
+		Capability:    capability,
 		Accessible:    s.AccessibleColorsEnabled(),
 		ColorLabels:   s.ColorLabels(),
 		Theme:         s.TerminalTheme(),
@@ -499,6 +602,18 @@ func System() *IOStreams {
 		term:         &terminal,
 	}
 
+	if os.Getenv("TERM") == "dumb" && !EnvColorForced() {
+		// dumb terminals cannot interpret ANSI escape sequences
+		io.SetColorEnabled(false)
+	} else if EnvColorDisabled() {
+		io.SetColorEnabled(false)
+	} else if EnvColorForced() {
+		io.SetColorEnabled(true)
+	}
+	if capability, ok := envColorCapability(); ok {
+		io.SetColorCapability(capability)
+	}
+
 	stdoutIsTTY := io.IsStdoutTTY()
 	stderrIsTTY := io.IsStderrTTY()
 
@@ -24,6 +24,37 @@ func TestStopAlternateScreenBuffer(t *testing.T) {
 	}
 }
 
+func TestIOStreams_Infof(t *testing.T) {
+	ios, _, _, stderr := Test()
+
+	ios.Infof("Opening %s in your browser.\n", "github.com")
+	if got, want := stderr.String(), "Opening github.com in your browser.\n"; got != want {
+		t.Errorf("after IOStreams.Infof() got %q, want %q", got, want)
+	}
+
+	stderr.Reset()
+	ios.SetQuiet(true)
+	ios.Infof("Opening %s in your browser.\n", "github.com")
+	if got := stderr.String(); got != "" {
+		t.Errorf("after IOStreams.Infof() with quiet set got %q, want empty", got)
+	}
+}
+
+func TestIOStreams_Verbosef(t *testing.T) {
+	ios, _, _, stderr := Test()
+
+	ios.Verbosef("resolved repo in %dms\n", 42)
+	if got := stderr.String(); got != "" {
+		t.Errorf("after IOStreams.Verbosef() got %q, want empty", got)
+	}
+
+	ios.SetVerbose(true)
+	ios.Verbosef("resolved repo in %dms\n", 42)
+	if got, want := stderr.String(), "resolved repo in 42ms\n"; got != want {
+		t.Errorf("after IOStreams.Verbosef() with verbose set got %q, want %q", got, want)
+	}
+}
+
 func TestIOStreams_pager(t *testing.T) {
 	t.Skip("TODO: fix this test in race detection mode")
 	ios, _, stdout, _ := Test()
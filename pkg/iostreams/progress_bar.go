@@ -0,0 +1,267 @@
+package iostreams
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	progressBarWidth        = 20
+	progressBarDrawInterval = 100 * time.Millisecond
+	progressBarTextInterval = time.Second
+)
+
+// ProgressBar tracks completion of a single long-running transfer and renders
+// it to standard error. When animation is unavailable, either because the
+// spinner was disabled or because standard error is not a terminal, the bar
+// degrades to periodic line output so that captured logs stay readable.
+type ProgressBar struct {
+	ios   *IOStreams
+	multi *MultiProgress
+	label string
+
+	mu         sync.Mutex
+	total      int64
+	current    int64
+	lastDraw   time.Time
+	printedAny bool
+	done       bool
+}
+
+// NewProgressBar returns a determinate progress bar for an operation of total
+// bytes. A total of zero renders as an unbounded count of bytes processed.
+func (s *IOStreams) NewProgressBar(label string, total int64) *ProgressBar {
+	return &ProgressBar{ios: s, label: label, total: total, lastDraw: time.Now()}
+}
+
+// Add advances the bar by n bytes.
+func (p *ProgressBar) Add(n int64) {
+	p.mu.Lock()
+	p.current += n
+	p.mu.Unlock()
+	p.draw(false)
+}
+
+// SetTotal adjusts the expected size of the operation, for when it only
+// becomes known after the bar was created.
+func (p *ProgressBar) SetTotal(total int64) {
+	p.mu.Lock()
+	p.total = total
+	p.mu.Unlock()
+}
+
+// Reset returns the bar to zero, for when the underlying operation restarts.
+func (p *ProgressBar) Reset() {
+	p.mu.Lock()
+	p.current = 0
+	p.mu.Unlock()
+}
+
+// Write implements io.Writer so a bar can observe a transfer through
+// io.MultiWriter or io.TeeReader.
+func (p *ProgressBar) Write(b []byte) (int, error) {
+	p.Add(int64(len(b)))
+	return len(b), nil
+}
+
+// ProxyReader returns a reader that advances the bar as r is consumed.
+func (p *ProgressBar) ProxyReader(r io.ReadCloser) io.ReadCloser {
+	return &progressReader{ReadCloser: r, bar: p}
+}
+
+// Finish marks the bar complete. An animated bar is erased from the terminal;
+// in periodic line mode a final line is printed if any progress lines were.
+func (p *ProgressBar) Finish() {
+	if p.multi != nil {
+		p.mu.Lock()
+		p.done = true
+		p.mu.Unlock()
+		if p.animated() {
+			p.multi.draw(true)
+		}
+		return
+	}
+
+	if !p.animated() {
+		p.printLine(true)
+		return
+	}
+
+	p.mu.Lock()
+	alreadyDone := p.done
+	p.done = true
+	p.mu.Unlock()
+	if !alreadyDone {
+		fmt.Fprint(p.ios.ErrOut, "\r\x1b[K")
+	}
+}
+
+func (p *ProgressBar) animated() bool {
+	return p.ios.progressIndicatorEnabled && !p.ios.spinnerDisabled
+}
+
+func (p *ProgressBar) draw(final bool) {
+	if !p.animated() {
+		p.printLine(final)
+		return
+	}
+
+	if p.multi != nil {
+		p.multi.draw(false)
+		return
+	}
+
+	p.mu.Lock()
+	if p.done || (!final && time.Since(p.lastDraw) < progressBarDrawInterval) {
+		p.mu.Unlock()
+		return
+	}
+	p.lastDraw = time.Now()
+	line := p.renderLocked()
+	p.mu.Unlock()
+
+	fmt.Fprintf(p.ios.ErrOut, "\r%s\x1b[K", line)
+}
+
+// printLine emits periodic, throttled progress lines for contexts that cannot
+// redraw in place. Short operations finish before the first interval elapses
+// and produce no output at all.
+func (p *ProgressBar) printLine(final bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.done {
+		return
+	}
+	if final {
+		p.done = true
+		if !p.printedAny {
+			return
+		}
+	} else if time.Since(p.lastDraw) < progressBarTextInterval {
+		return
+	}
+	p.lastDraw = time.Now()
+	p.printedAny = true
+	fmt.Fprintln(p.ios.ErrOut, p.renderLocked())
+}
+
+func (p *ProgressBar) renderLocked() string {
+	var sb strings.Builder
+	if p.label != "" {
+		sb.WriteString(p.label)
+		sb.WriteString(" ")
+	}
+
+	if p.total <= 0 {
+		sb.WriteString(humanFileSize(p.current))
+		return sb.String()
+	}
+
+	frac := float64(p.current) / float64(p.total)
+	if frac > 1 {
+		frac = 1
+	}
+	filled := int(frac * progressBarWidth)
+	bar := strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
+	cs := p.ios.ColorScheme()
+	fmt.Fprintf(&sb, "%s %3.0f%% %s/%s", cs.Cyan(bar), frac*100, humanFileSize(p.current), humanFileSize(p.total))
+	return sb.String()
+}
+
+// MultiProgress renders a group of concurrent progress bars, one per line,
+// redrawing the group in place as any member advances.
+type MultiProgress struct {
+	ios *IOStreams
+
+	mu       sync.Mutex
+	bars     []*ProgressBar
+	drawn    int
+	lastDraw time.Time
+	done     bool
+}
+
+func (s *IOStreams) NewMultiProgress() *MultiProgress {
+	return &MultiProgress{ios: s}
+}
+
+// AddBar registers a new bar in the group. Bars may be added while other
+// members are in motion.
+func (m *MultiProgress) AddBar(label string, total int64) *ProgressBar {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	bar := &ProgressBar{ios: m.ios, multi: m, label: label, total: total, lastDraw: time.Now()}
+	m.bars = append(m.bars, bar)
+	return bar
+}
+
+// Finish erases the group from the terminal. In periodic line mode each bar
+// has already printed its own lines and there is nothing to erase.
+func (m *MultiProgress) Finish() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.done {
+		return
+	}
+	m.done = true
+	if m.animated() && m.drawn > 0 {
+		fmt.Fprintf(m.ios.ErrOut, "\x1b[%dA\x1b[J", m.drawn)
+	}
+}
+
+func (m *MultiProgress) animated() bool {
+	return m.ios.progressIndicatorEnabled && !m.ios.spinnerDisabled
+}
+
+func (m *MultiProgress) draw(final bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.done {
+		return
+	}
+	if !final && time.Since(m.lastDraw) < progressBarDrawInterval {
+		return
+	}
+	m.lastDraw = time.Now()
+
+	out := m.ios.ErrOut
+	if m.drawn > 0 {
+		fmt.Fprintf(out, "\x1b[%dA", m.drawn)
+	}
+	for _, b := range m.bars {
+		b.mu.Lock()
+		line := b.renderLocked()
+		b.mu.Unlock()
+		fmt.Fprintf(out, "\r%s\x1b[K\n", line)
+	}
+	m.drawn = len(m.bars)
+}
+
+type progressReader struct {
+	io.ReadCloser
+	bar *ProgressBar
+}
+
+func (r *progressReader) Read(b []byte) (int, error) {
+	n, err := r.ReadCloser.Read(b)
+	if n > 0 {
+		r.bar.Add(int64(n))
+	}
+	return n, err
+}
+
+func humanFileSize(n int64) string {
+	if n < 1024 {
+		return fmt.Sprintf("%d B", n)
+	}
+	f := float64(n)
+	for _, unit := range []string{"KiB", "MiB", "GiB", "TiB"} {
+		f /= 1024
+		if f < 1024 {
+			return fmt.Sprintf("%.1f %s", f, unit)
+		}
+	}
+	return fmt.Sprintf("%.1f PiB", f/1024)
+}
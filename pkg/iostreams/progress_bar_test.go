@@ -0,0 +1,95 @@
+package iostreams
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProgressBarAnimated(t *testing.T) {
+	ios, _, _, stderr := Test()
+	ios.progressIndicatorEnabled = true
+
+	bar := ios.NewProgressBar("big.zip", 100)
+	bar.lastDraw = time.Now().Add(-progressBarDrawInterval)
+	bar.Add(50)
+	assert.Contains(t, stderr.String(), "big.zip")
+	assert.Contains(t, stderr.String(), " 50% 50 B/100 B")
+
+	bar.Finish()
+	assert.True(t, strings.HasSuffix(stderr.String(), "\r\x1b[K"))
+}
+
+func TestProgressBarPeriodicLines(t *testing.T) {
+	ios, _, _, stderr := Test()
+
+	bar := ios.NewProgressBar("big.zip", 100)
+
+	// within the interval, progress stays silent
+	bar.Add(25)
+	bar.Finish()
+	assert.Equal(t, "", stderr.String())
+
+	bar = ios.NewProgressBar("big.zip", 100)
+	bar.lastDraw = time.Now().Add(-progressBarTextInterval)
+	bar.Add(25)
+	require.Equal(t, "big.zip █████░░░░░░░░░░░░░░░  25% 25 B/100 B\n", stderr.String())
+
+	bar.Add(75)
+	bar.lastDraw = time.Now().Add(-progressBarTextInterval)
+	bar.Finish()
+	assert.Equal(t, "big.zip ████████████████████ 100% 100 B/100 B\n", strings.TrimPrefix(stderr.String(), "big.zip █████░░░░░░░░░░░░░░░  25% 25 B/100 B\n"))
+}
+
+func TestProgressBarUnknownTotal(t *testing.T) {
+	ios, _, _, stderr := Test()
+
+	bar := ios.NewProgressBar("fetching", 0)
+	bar.lastDraw = time.Now().Add(-progressBarTextInterval)
+	bar.Add(2048)
+	assert.Equal(t, "fetching 2.0 KiB\n", stderr.String())
+}
+
+func TestProgressBarProxyReader(t *testing.T) {
+	ios, _, _, _ := Test()
+
+	bar := ios.NewProgressBar("asset", 10)
+	r := bar.ProxyReader(io.NopCloser(strings.NewReader("0123456789")))
+	_, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, int64(10), bar.current)
+}
+
+func TestMultiProgressAnimated(t *testing.T) {
+	ios, _, _, stderr := Test()
+	ios.progressIndicatorEnabled = true
+
+	progress := ios.NewMultiProgress()
+	one := progress.AddBar("one.zip", 10)
+	two := progress.AddBar("two.zip", 10)
+
+	progress.lastDraw = time.Now().Add(-progressBarDrawInterval)
+	one.Add(5)
+	output := stderr.String()
+	assert.Contains(t, output, "one.zip")
+	assert.Contains(t, output, "two.zip")
+
+	progress.lastDraw = time.Now().Add(-progressBarDrawInterval)
+	two.Add(10)
+	// the second draw moves back up over the two rendered lines
+	assert.Contains(t, stderr.String(), "\x1b[2A")
+
+	progress.Finish()
+	assert.True(t, strings.HasSuffix(stderr.String(), "\x1b[2A\x1b[J"))
+}
+
+func TestHumanFileSize(t *testing.T) {
+	assert.Equal(t, "512 B", humanFileSize(512))
+	assert.Equal(t, "1.0 KiB", humanFileSize(1024))
+	assert.Equal(t, "1.5 MiB", humanFileSize(1572864))
+	assert.Equal(t, "2.0 GiB", humanFileSize(2147483648))
+}
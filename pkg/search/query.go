@@ -16,6 +16,7 @@ const (
 )
 
 type Query struct {
+	Cursor     string
 	Keywords   []string
 	Kind       string
 	Limit      int
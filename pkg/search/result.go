@@ -10,6 +10,7 @@ import (
 )
 
 var CodeFields = []string{
+	"cursor",
 	"path",
 	"repository",
 	"sha",
@@ -28,15 +29,18 @@ var CommitFields = []string{
 	"author",
 	"commit",
 	"committer",
+	"cursor",
 	"sha",
 	"id",
 	"parents",
 	"repository",
+	"textMatches",
 	"url",
 }
 
 var RepositoryFields = []string{
 	"createdAt",
+	"cursor",
 	"defaultBranch",
 	"description",
 	"forksCount",
@@ -74,6 +78,7 @@ var IssueFields = []string{
 	"closedAt",
 	"commentsCount",
 	"createdAt",
+	"cursor",
 	"id",
 	"isLocked",
 	"isPullRequest",
@@ -81,6 +86,7 @@ var IssueFields = []string{
 	"number",
 	"repository",
 	"state",
+	"textMatches",
 	"title",
 	"updatedAt",
 	"url",
@@ -91,6 +97,9 @@ var PullRequestFields = append(IssueFields,
 )
 
 type CodeResult struct {
+	// Cursor that resumes the search after the items already fetched, empty when
+	// no further results are available.
+	Cursor            string `json:"cursor"`
 	IncompleteResults bool   `json:"incomplete_results"`
 	Items             []Code `json:"items"`
 	// Number of code search results matching the query on the server. Ignoring limit.
@@ -98,6 +107,9 @@ type CodeResult struct {
 }
 
 type CommitsResult struct {
+	// Cursor that resumes the search after the items already fetched, empty when
+	// no further results are available.
+	Cursor            string   `json:"cursor"`
 	IncompleteResults bool     `json:"incomplete_results"`
 	Items             []Commit `json:"items"`
 	// Number of commits matching the query on the server. Ignoring limit.
@@ -105,6 +117,9 @@ type CommitsResult struct {
 }
 
 type RepositoriesResult struct {
+	// Cursor that resumes the search after the items already fetched, empty when
+	// no further results are available.
+	Cursor            string       `json:"cursor"`
 	IncompleteResults bool         `json:"incomplete_results"`
 	Items             []Repository `json:"items"`
 	// Number of repositories matching the query on the server. Ignoring limit.
@@ -112,6 +127,9 @@ type RepositoriesResult struct {
 }
 
 type IssuesResult struct {
+	// Cursor that resumes the search after the items already fetched, empty when
+	// no further results are available.
+	Cursor            string  `json:"cursor"`
 	IncompleteResults bool    `json:"incomplete_results"`
 	Items             []Issue `json:"items"`
 	// Number of isssues matching the query on the server. Ignoring limit.
@@ -140,14 +158,15 @@ type Match struct {
 }
 
 type Commit struct {
-	Author    User       `json:"author"`
-	Committer User       `json:"committer"`
-	ID        string     `json:"node_id"`
-	Info      CommitInfo `json:"commit"`
-	Parents   []Parent   `json:"parents"`
-	Repo      Repository `json:"repository"`
-	Sha       string     `json:"sha"`
-	URL       string     `json:"html_url"`
+	Author      User        `json:"author"`
+	Committer   User        `json:"committer"`
+	ID          string      `json:"node_id"`
+	Info        CommitInfo  `json:"commit"`
+	Parents     []Parent    `json:"parents"`
+	Repo        Repository  `json:"repository"`
+	Sha         string      `json:"sha"`
+	TextMatches []TextMatch `json:"text_matches"`
+	URL         string      `json:"html_url"`
 }
 
 type CommitInfo struct {
@@ -238,11 +257,12 @@ type Issue struct {
 	PullRequest   PullRequest `json:"pull_request"`
 	RepositoryURL string      `json:"repository_url"`
 	// StateInternal should not be used directly. Use State() instead.
-	StateInternal string    `json:"state"`
-	StateReason   string    `json:"state_reason"`
-	Title         string    `json:"title"`
-	URL           string    `json:"html_url"`
-	UpdatedAt     time.Time `json:"updated_at"`
+	StateInternal string      `json:"state"`
+	StateReason   string      `json:"state_reason"`
+	TextMatches   []TextMatch `json:"text_matches"`
+	Title         string      `json:"title"`
+	URL           string      `json:"html_url"`
+	UpdatedAt     time.Time   `json:"updated_at"`
 }
 
 type PullRequest struct {
@@ -307,6 +327,12 @@ func (commit Commit) ExportData(fields []string) map[string]interface{} {
 	data := map[string]interface{}{}
 	for _, f := range fields {
 		switch f {
+		case "textMatches":
+			matches := make([]interface{}, 0, len(commit.TextMatches))
+			for _, match := range commit.TextMatches {
+				matches = append(matches, match.ExportData(textMatchFields))
+			}
+			data[f] = matches
 		case "author":
 			data[f] = commit.Author.ExportData()
 		case "commit":
@@ -438,6 +464,12 @@ func (issue Issue) ExportData(fields []string) map[string]interface{} {
 			}
 		case "state":
 			data[f] = issue.State()
+		case "textMatches":
+			matches := make([]interface{}, 0, len(issue.TextMatches))
+			for _, match := range issue.TextMatches {
+				matches = append(matches, match.ExportData(textMatchFields))
+			}
+			data[f] = matches
 		default:
 			sf := fieldByName(v, f)
 			data[f] = sf.Interface()
@@ -446,6 +478,63 @@ func (issue Issue) ExportData(fields []string) map[string]interface{} {
 	return data
 }
 
+func (res CodeResult) ExportData(fields []string) map[string]interface{} {
+	items := make([]exportable, len(res.Items))
+	for i, item := range res.Items {
+		items[i] = item
+	}
+	return exportResultData(fields, res.Cursor, items)
+}
+
+func (res CommitsResult) ExportData(fields []string) map[string]interface{} {
+	items := make([]exportable, len(res.Items))
+	for i, item := range res.Items {
+		items[i] = item
+	}
+	return exportResultData(fields, res.Cursor, items)
+}
+
+func (res RepositoriesResult) ExportData(fields []string) map[string]interface{} {
+	items := make([]exportable, len(res.Items))
+	for i, item := range res.Items {
+		items[i] = item
+	}
+	return exportResultData(fields, res.Cursor, items)
+}
+
+func (res IssuesResult) ExportData(fields []string) map[string]interface{} {
+	items := make([]exportable, len(res.Items))
+	for i, item := range res.Items {
+		items[i] = item
+	}
+	return exportResultData(fields, res.Cursor, items)
+}
+
+type exportable interface {
+	ExportData([]string) map[string]interface{}
+}
+
+// exportResultData exports a result as an object wrapping the result items so
+// that the pagination cursor can be carried alongside them. The cursor field is
+// reported on the result itself; all remaining fields apply to the items.
+func exportResultData(fields []string, cursor string, items []exportable) map[string]interface{} {
+	data := map[string]interface{}{}
+	itemFields := make([]string, 0, len(fields))
+	for _, f := range fields {
+		if f == "cursor" {
+			data[f] = cursor
+			continue
+		}
+		itemFields = append(itemFields, f)
+	}
+	exported := make([]interface{}, 0, len(items))
+	for _, item := range items {
+		exported = append(exported, item.ExportData(itemFields))
+	}
+	data["items"] = exported
+	return data
+}
+
 func fieldByName(v reflect.Value, field string) reflect.Value {
 	return v.FieldByNameFunc(func(s string) bool {
 		return strings.EqualFold(field, s)
@@ -58,6 +58,19 @@ func TestCodeExportData(t *testing.T) {
 	}
 }
 
+func TestCodeResultExportData(t *testing.T) {
+	result := CodeResult{
+		Cursor: "30",
+		Items:  []Code{{Path: "path", Name: "name"}},
+		Total:  287,
+	}
+	exported := result.ExportData([]string{"cursor", "path"})
+	buf := bytes.Buffer{}
+	enc := json.NewEncoder(&buf)
+	require.NoError(t, enc.Encode(exported))
+	assert.Equal(t, `{"cursor":"30","items":[{"path":"path"}]}`, strings.TrimSpace(buf.String()))
+}
+
 func TestCommitExportData(t *testing.T) {
 	var authoredAt = time.Date(2021, 2, 27, 11, 30, 0, 0, time.UTC)
 	var committedAt = time.Date(2021, 2, 28, 12, 30, 0, 0, time.UTC)
@@ -16,6 +16,8 @@ import (
 const (
 	// GitHub API has a limit of 100 per page
 	maxPerPage = 100
+	// GitHub API only serves the first 1000 results for any search
+	maxMatches = 1000
 	orderKey   = "order"
 	sortKey    = "sort"
 )
@@ -75,8 +77,15 @@ func (s searcher) Code(query Query) (CodeResult, error) {
 	numItemsToRetrieve := query.Limit
 	query.Limit = min(numItemsToRetrieve, maxPerPage)
 
+	offset, err := parseCursor(query.Cursor)
+	if err != nil {
+		return result, err
+	}
+	startPage := offset/query.Limit + 1
+	numItemsToSkip := offset % query.Limit
+
 	for numItemsToRetrieve > 0 {
-		query.Page = nextPage(resp)
+		query.Page = nextPage(resp, startPage)
 		if query.Page == 0 {
 			break
 		}
@@ -87,17 +96,23 @@ func (s searcher) Code(query Query) (CodeResult, error) {
 			return result, err
 		}
 
+		// A cursor resumes mid-page when the previous search stopped partway
+		// through one, so drop the items it already consumed.
+		items := page.Items[min(numItemsToSkip, len(page.Items)):]
+		numItemsToSkip = 0
+
 		// If we're going to reach the requested limit, only add that many items,
 		// otherwise add all the results.
-		numItemsToAdd := min(len(page.Items), numItemsToRetrieve)
+		numItemsToAdd := min(len(items), numItemsToRetrieve)
 		result.IncompleteResults = page.IncompleteResults
 		// The API returns how many items match the query in every response.
 		// With the example above, this would be 500.
 		result.Total = page.Total
-		result.Items = append(result.Items, page.Items[:numItemsToAdd]...)
+		result.Items = append(result.Items, items[:numItemsToAdd]...)
 		numItemsToRetrieve = numItemsToRetrieve - numItemsToAdd
 	}
 
+	result.Cursor = nextCursor(offset, len(result.Items), result.Total)
 	return result, nil
 }
 
@@ -110,8 +125,15 @@ func (s searcher) Commits(query Query) (CommitsResult, error) {
 	numItemsToRetrieve := query.Limit
 	query.Limit = min(numItemsToRetrieve, maxPerPage)
 
+	offset, err := parseCursor(query.Cursor)
+	if err != nil {
+		return result, err
+	}
+	startPage := offset/query.Limit + 1
+	numItemsToSkip := offset % query.Limit
+
 	for numItemsToRetrieve > 0 {
-		query.Page = nextPage(resp)
+		query.Page = nextPage(resp, startPage)
 		if query.Page == 0 {
 			break
 		}
@@ -122,12 +144,16 @@ func (s searcher) Commits(query Query) (CommitsResult, error) {
 			return result, err
 		}
 
-		numItemsToAdd := min(len(page.Items), numItemsToRetrieve)
+		items := page.Items[min(numItemsToSkip, len(page.Items)):]
+		numItemsToSkip = 0
+
+		numItemsToAdd := min(len(items), numItemsToRetrieve)
 		result.IncompleteResults = page.IncompleteResults
 		result.Total = page.Total
-		result.Items = append(result.Items, page.Items[:numItemsToAdd]...)
+		result.Items = append(result.Items, items[:numItemsToAdd]...)
 		numItemsToRetrieve = numItemsToRetrieve - numItemsToAdd
 	}
+	result.Cursor = nextCursor(offset, len(result.Items), result.Total)
 	return result, nil
 }
 
@@ -140,8 +166,15 @@ func (s searcher) Repositories(query Query) (RepositoriesResult, error) {
 	numItemsToRetrieve := query.Limit
 	query.Limit = min(numItemsToRetrieve, maxPerPage)
 
+	offset, err := parseCursor(query.Cursor)
+	if err != nil {
+		return result, err
+	}
+	startPage := offset/query.Limit + 1
+	numItemsToSkip := offset % query.Limit
+
 	for numItemsToRetrieve > 0 {
-		query.Page = nextPage(resp)
+		query.Page = nextPage(resp, startPage)
 		if query.Page == 0 {
 			break
 		}
@@ -152,12 +185,16 @@ func (s searcher) Repositories(query Query) (RepositoriesResult, error) {
 			return result, err
 		}
 
-		numItemsToAdd := min(len(page.Items), numItemsToRetrieve)
+		items := page.Items[min(numItemsToSkip, len(page.Items)):]
+		numItemsToSkip = 0
+
+		numItemsToAdd := min(len(items), numItemsToRetrieve)
 		result.IncompleteResults = page.IncompleteResults
 		result.Total = page.Total
-		result.Items = append(result.Items, page.Items[:numItemsToAdd]...)
+		result.Items = append(result.Items, items[:numItemsToAdd]...)
 		numItemsToRetrieve = numItemsToRetrieve - numItemsToAdd
 	}
+	result.Cursor = nextCursor(offset, len(result.Items), result.Total)
 	return result, nil
 }
 
@@ -170,8 +207,15 @@ func (s searcher) Issues(query Query) (IssuesResult, error) {
 	numItemsToRetrieve := query.Limit
 	query.Limit = min(numItemsToRetrieve, maxPerPage)
 
+	offset, err := parseCursor(query.Cursor)
+	if err != nil {
+		return result, err
+	}
+	startPage := offset/query.Limit + 1
+	numItemsToSkip := offset % query.Limit
+
 	for numItemsToRetrieve > 0 {
-		query.Page = nextPage(resp)
+		query.Page = nextPage(resp, startPage)
 		if query.Page == 0 {
 			break
 		}
@@ -182,12 +226,16 @@ func (s searcher) Issues(query Query) (IssuesResult, error) {
 			return result, err
 		}
 
-		numItemsToAdd := min(len(page.Items), numItemsToRetrieve)
+		items := page.Items[min(numItemsToSkip, len(page.Items)):]
+		numItemsToSkip = 0
+
+		numItemsToAdd := min(len(items), numItemsToRetrieve)
 		result.IncompleteResults = page.IncompleteResults
 		result.Total = page.Total
-		result.Items = append(result.Items, page.Items[:numItemsToAdd]...)
+		result.Items = append(result.Items, items[:numItemsToAdd]...)
 		numItemsToRetrieve = numItemsToRetrieve - numItemsToAdd
 	}
+	result.Cursor = nextCursor(offset, len(result.Items), result.Total)
 	return result, nil
 }
 
@@ -219,7 +267,7 @@ func (s searcher) search(query Query, result interface{}) (*http.Response, error
 	}
 	req.Header.Set("Content-Type", "application/json; charset=utf-8")
 	req.Header.Set("Accept", "application/vnd.github.v3+json")
-	if query.Kind == KindCode {
+	if query.Kind == KindCode || query.Kind == KindCommits || query.Kind == KindIssues {
 		req.Header.Set("Accept", "application/vnd.github.text-match+json")
 	}
 
@@ -283,9 +331,9 @@ func handleHTTPError(resp *http.Response) error {
 }
 
 // https://docs.github.com/en/rest/using-the-rest-api/using-pagination-in-the-rest-api
-func nextPage(resp *http.Response) (page int) {
+func nextPage(resp *http.Response, firstPage int) (page int) {
 	if resp == nil {
-		return 1
+		return firstPage
 	}
 
 	// When using pagination, responses get a "Link" field in their header.
@@ -306,6 +354,30 @@ func nextPage(resp *http.Response) (page int) {
 	return 0
 }
 
+// parseCursor decodes a pagination cursor into the number of items a previous
+// search already consumed.
+func parseCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	offset, err := strconv.Atoi(cursor)
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("invalid search cursor %q", cursor)
+	}
+	return offset, nil
+}
+
+// nextCursor encodes the pagination cursor for resuming a search after the
+// items fetched so far, or returns an empty string when no further results can
+// be fetched.
+func nextCursor(offset, count, total int) string {
+	next := offset + count
+	if count == 0 || next >= total || next >= maxMatches {
+		return ""
+	}
+	return strconv.Itoa(next)
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
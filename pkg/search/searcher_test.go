@@ -133,6 +133,7 @@ func TestSearcherCode(t *testing.T) {
 				},
 			},
 			result: CodeResult{
+				Cursor:            "110",
 				IncompleteResults: false,
 				Items: initialize(0, 110, func(i int) Code {
 					return Code{
@@ -175,6 +176,72 @@ func TestSearcherCode(t *testing.T) {
 				reg.Register(secondReq, secondRes)
 			},
 		},
+		{
+			name: "resumes results from a cursor",
+			query: Query{
+				Cursor:   "45",
+				Keywords: []string{"keyword"},
+				Kind:     "code",
+				Limit:    30,
+				Qualifiers: Qualifiers{
+					Language: "go",
+				},
+			},
+			result: CodeResult{
+				Cursor:            "75",
+				IncompleteResults: false,
+				Items: initialize(45, 75, func(i int) Code {
+					return Code{
+						Name: fmt.Sprintf("name%d.go", i),
+					}
+				}),
+				Total: 287,
+			},
+			httpStubs: func(reg *httpmock.Registry) {
+				firstReq := httpmock.QueryMatcher("GET", "search/code", url.Values{
+					"page":     []string{"2"},
+					"per_page": []string{"30"},
+					"q":        []string{"keyword language:go"},
+				})
+				firstRes := httpmock.JSONResponse(map[string]interface{}{
+					"incomplete_results": false,
+					"total_count":        287,
+					"items": initialize(30, 60, func(i int) interface{} {
+						return map[string]interface{}{
+							"name": fmt.Sprintf("name%d.go", i),
+						}
+					}),
+				})
+				firstRes = httpmock.WithHeader(firstRes, "Link", `<https://api.github.com/search/code?page=3&per_page=30&q=org%3Agithub>; rel="next"`)
+				secondReq := httpmock.QueryMatcher("GET", "search/code", url.Values{
+					"page":     []string{"3"},
+					"per_page": []string{"30"},
+					"q":        []string{"keyword language:go"},
+				})
+				secondRes := httpmock.JSONResponse(map[string]interface{}{
+					"incomplete_results": false,
+					"total_count":        287,
+					"items": initialize(60, 90, func(i int) interface{} {
+						return map[string]interface{}{
+							"name": fmt.Sprintf("name%d.go", i),
+						}
+					}),
+				})
+				reg.Register(firstReq, firstRes)
+				reg.Register(secondReq, secondRes)
+			},
+		},
+		{
+			name: "rejects an invalid cursor",
+			query: Query{
+				Cursor:   "blah",
+				Keywords: []string{"keyword"},
+				Kind:     "code",
+				Limit:    30,
+			},
+			wantErr: true,
+			errMsg:  `invalid search cursor "blah"`,
+		},
 		{
 			name:    "handles search errors",
 			query:   query,
@@ -359,6 +426,7 @@ func TestSearcherCommits(t *testing.T) {
 				},
 			},
 			result: CommitsResult{
+				Cursor:            "110",
 				IncompleteResults: false,
 				Items: initialize(0, 110, func(i int) Commit {
 					return Commit{
@@ -589,6 +657,7 @@ func TestSearcherRepositories(t *testing.T) {
 				},
 			},
 			result: RepositoriesResult{
+				Cursor:            "110",
 				IncompleteResults: false,
 				Items: initialize(0, 110, func(i int) Repository {
 					return Repository{
@@ -819,6 +888,7 @@ func TestSearcherIssues(t *testing.T) {
 				},
 			},
 			result: IssuesResult{
+				Cursor:            "110",
 				IncompleteResults: false,
 				Items: initialize(0, 110, func(i int) Issue {
 					return Issue{
@@ -49,6 +49,11 @@ func (c *Context) HasKeygen() bool {
 }
 
 func (c *Context) GenerateSSHKey(keyName string, passphrase string) (*KeyPair, error) {
+	return c.GenerateSSHKeyOfType(keyName, "ed25519", passphrase)
+}
+
+// GenerateSSHKeyOfType works like GenerateSSHKey but allows choosing the key algorithm.
+func (c *Context) GenerateSSHKeyOfType(keyName string, keyType string, passphrase string) (*KeyPair, error) {
 	keygenExe, err := c.findKeygen()
 	if err != nil {
 		return nil, err
@@ -79,7 +84,7 @@ func (c *Context) GenerateSSHKey(keyName string, passphrase string) (*KeyPair, e
 		return nil, err
 	}
 
-	keygenCmd := exec.Command(keygenExe, "-t", "ed25519", "-C", "", "-N", passphrase, "-f", keyFile)
+	keygenCmd := exec.Command(keygenExe, "-t", keyType, "-C", "", "-N", passphrase, "-f", keyFile)
 	err = run.PrepareCmd(keygenCmd).Run()
 	if err != nil {
 		return nil, err